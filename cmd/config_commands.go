@@ -0,0 +1,56 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+)
+
+const (
+	validateConfigCommand = "validate-config"
+	convertConfigCommand  = "convert-config"
+	defaultConfigFile     = "/etc/nsx-operator/nsxop.ini"
+)
+
+// runConfigCommand runs the configuration subcommand named by args[0], it returns false if
+// args is not a configuration subcommand, otherwise the exit code of the subcommand.
+//
+//	nsx-operator validate-config [-nsxconfig <file>]  prints every error of the INI or YAML file
+//	nsx-operator convert-config [-nsxconfig <file>]   prints the file as the versioned YAML configuration
+func runConfigCommand(args []string, stdout, stderr io.Writer) (int, bool) {
+	if len(args) == 0 || (args[0] != validateConfigCommand && args[0] != convertConfigCommand) {
+		return 0, false
+	}
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	path := fs.String("nsxconfig", defaultConfigFile, "NSX Operator configuration file path")
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2, true
+	}
+
+	if args[0] == convertConfigCommand {
+		data, err := config.ConvertConfigFileToYAML(*path)
+		if err != nil {
+			fmt.Fprintf(stderr, "Failed to convert configuration file %s: %v\n", *path, err)
+			return 1, true
+		}
+		fmt.Fprint(stdout, string(data))
+		return 0, true
+	}
+
+	errs := config.ValidateConfigFile(*path)
+	if len(errs) == 0 {
+		fmt.Fprintf(stdout, "Configuration file %s is valid\n", *path)
+		return 0, true
+	}
+	fmt.Fprintf(stderr, "Configuration file %s has %d error(s):\n", *path, len(errs))
+	for _, err := range errs {
+		fmt.Fprintf(stderr, "  - %v\n", err)
+	}
+	return 1, true
+}
@@ -26,11 +26,15 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	crdv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config/reloader"
+	adminnetworkpolicycontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/adminnetworkpolicy"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/gateway"
+	ingresscontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/ingress"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/inventory"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/ipaddressallocation"
 	namespacecontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/namespace"
@@ -39,13 +43,17 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/node"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/pod"
 	securitypolicycontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/securitypolicy"
+	serviceendpointcontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/serviceendpoint"
 	statefulsetcontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/statefulset"
 	staticroutecontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/staticroute"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/subnet"
 	subnetbindingcontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/subnetbinding"
 	subnetipreservationcontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/subnetipreservation"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/subnetport"
+	subnetportsettingcontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/subnetportsetting"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/subnetset"
+	virtualmachinecontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/virtualmachine"
+	vpcendpointcontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/vpcendpoint"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/health"
 	inventoryservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipblocksinfo"
@@ -55,6 +63,8 @@ import (
 	subnetbindingservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
 	subnetipreservationservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetipreservation"
 	subnetportservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
+	subnetportsettingservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetportsetting"
+	vpcendpointservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpcendpoint"
 
 	nsxserviceaccountcontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/nsxserviceaccount"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/service"
@@ -66,6 +76,7 @@ import (
 	ipaddressallocationservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipaddressallocation"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	pkgutil "github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -82,12 +93,17 @@ var (
 )
 
 func init() {
+	if code, ok := runConfigCommand(os.Args[1:], os.Stdout, os.Stderr); ok {
+		os.Exit(code)
+	}
+
 	var err error
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(crdv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(v1alpha1.AddToScheme(scheme))
 	utilruntime.Must(vmv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(gatewayv1.Install(scheme))
+	utilruntime.Must(policyv1alpha1.Install(scheme))
 	config.AddFlags()
 
 	cf, err = config.NewNSXOperatorConfigFromFile()
@@ -183,7 +199,12 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 		if err != nil {
 			log.Error(err, "Failed to initialize ipaddressallocation commonService", "controller", "IPAddressAllocation")
 		}
-		subnetPortService, err := subnetportservice.InitializeSubnetPort(commonService, vpcService, ipAddressAllocationService)
+		subnetPortSettingService, err := subnetportsettingservice.InitializeService(commonService)
+		if err != nil {
+			log.Error(err, "Failed to initialize SubnetPortSetting commonService", "controller", "SubnetPortSetting")
+			os.Exit(1)
+		}
+		subnetPortService, err := subnetportservice.InitializeSubnetPort(commonService, vpcService, ipAddressAllocationService, subnetPortSettingService)
 		if err != nil {
 			log.Error(err, "Failed to initialize subnetport commonService", "controller", "SubnetPort")
 			os.Exit(1)
@@ -223,6 +244,11 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 			log.Error(err, "Failed to initialize SubnetIPReservation commonService", "controller", "SubnetIPReservation")
 			os.Exit(1)
 		}
+		vpcEndpointService, err := vpcendpointservice.InitializeVPCEndpointService(commonService, vpcService, ipAddressAllocationService)
+		if err != nil {
+			log.Error(err, "Failed to initialize VPCEndpoint commonService", "controller", "VPCEndpoint")
+			os.Exit(1)
+		}
 
 		if _, err := os.Stat(config.WebhookCertDir); errors.Is(err, os.ErrNotExist) {
 			log.Error(err, "Server cert not found, disabling webhook server", "cert", config.WebhookCertDir)
@@ -254,16 +280,29 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 			staticroutecontroller.NewStaticRouteReconciler(mgr, staticRouteService),
 			// SubnetPort may use IPAddressAllocation for AddressBinding, reconcile IPAddressAllocation first
 			ipaddressallocation.NewIPAddressAllocationReconciler(mgr, ipAddressAllocationService, vpcService),
+			// SubnetPort may refer to SubnetPortSetting, reconcile SubnetPortSetting first
+			subnetportsettingcontroller.NewReconciler(mgr, subnetPortSettingService, subnetService),
 			subnetport.NewSubnetPortReconciler(mgr, subnetPortService, subnetService, vpcService, ipAddressAllocationService),
 			pod.NewPodReconciler(mgr, subnetPortService, subnetService, vpcService, nodeService),
 			networkpolicycontroller.NewNetworkPolicyReconciler(mgr, commonService, vpcService),
+			adminnetworkpolicycontroller.NewAdminNetworkPolicyReconciler(mgr, commonService, vpcService),
+			adminnetworkpolicycontroller.NewBaselineAdminNetworkPolicyReconciler(mgr, commonService, vpcService),
 			gateway.NewGatewayReconciler(mgr, dnsRecordService),
 			subnetbindingcontroller.NewReconciler(mgr, subnetService, subnetBindingService),
 			subnetipreservationcontroller.NewReconciler(mgr, subnetIPReservationService, subnetService),
+			// VPCEndpoint refers to the NSX VpcServiceEndpoint, reconcile ServiceEndpoint first
+			serviceendpointcontroller.NewReconciler(mgr, vpcEndpointService),
+			vpcendpointcontroller.NewReconciler(mgr, vpcEndpointService),
 		)
 		if lbReconciler := service.NewServiceLbReconciler(mgr, commonService, dnsRecordService); lbReconciler != nil {
 			reconcilerList = append(reconcilerList, lbReconciler)
 		}
+		if ingressReconciler := ingresscontroller.NewIngressReconciler(mgr, commonService, dnsRecordService); ingressReconciler != nil {
+			reconcilerList = append(reconcilerList, ingressReconciler)
+		}
+		if vmReconciler := virtualmachinecontroller.NewVirtualMachineReconciler(mgr, commonService, dnsRecordService); vmReconciler != nil {
+			reconcilerList = append(reconcilerList, vmReconciler)
+		}
 		// StatefulSet controller is always registered so that after NSX upgrades (e.g. to 9.2.0+)
 		// replica/GC logic can run without restarting the operator. Reconcile and CollectGarbage
 		// no-op until NSX version supports STS pods and vpc_wcp_enhance=true in config; delete cleanup still runs.
@@ -350,6 +389,11 @@ func electMaster(mgr manager.Manager, nsxClient *nsx.Client) {
 
 func main() {
 	log.Info("Starting NSX Operator")
+	shutdownTracing, err := tracing.Init(context.Background(), cf)
+	if err != nil {
+		log.Error(err, "Failed to initialize tracing")
+		os.Exit(1)
+	}
 	cfg, err := pkgutil.GetConfig()
 	if err != nil {
 		log.Error(err, "Failed to get rest config for manager")
@@ -378,6 +422,19 @@ func main() {
 		os.Exit(1)
 	}
 
+	configReloader := reloader.NewReloader(cf, mgr.GetEventRecorderFor("nsx-operator"), nsxOperatorNamespace, nsxOperatorPodName) //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	configReloader.AddHandler(func(cf *config.NSXOperatorConfig, _ []string) error {
+		logger.SetLogLevel(cf.Debug, config.LogLevel)
+		return nil
+	})
+	configReloader.AddHandler(func(cf *config.NSXOperatorConfig, changed []string) error {
+		return reloadNSXEndpoints(nsxClient, cf, changed)
+	})
+	if err := mgr.Add(configReloader); err != nil {
+		log.Error(err, "Failed to add configuration reloader")
+		os.Exit(1)
+	}
+
 	if err := config.InitMixedMode(context.Background(), cfg, cf.CoeConfig.EnableVPCNetwork); err != nil {
 		log.Error(err, "Failed to initialize mixed mode state")
 		os.Exit(1)
@@ -408,6 +465,20 @@ func main() {
 		log.Error(err, "Failed to start manager")
 		os.Exit(1)
 	}
+	if err := shutdownTracing(context.Background()); err != nil {
+		log.Error(err, "Failed to flush traces")
+	}
+}
+
+// reloadNSXEndpoints rebuilds the NSX cluster endpoints if the NSX managers, credentials,
+// CA files/thumbprints or HTTP timeout are changed in the reloaded configuration file.
+func reloadNSXEndpoints(nsxClient *nsx.Client, cf *config.NSXOperatorConfig, changed []string) error {
+	for _, field := range changed {
+		if config.IsNSXEndpointField(field) {
+			return nsxClient.Cluster.UpdateEndpoints(cf.NsxApiManagers, cf.NsxApiUser, cf.NsxApiPassword, cf.CaFile, cf.Thumbprint, cf.HttpTimeout)
+		}
+	}
+	return nil
 }
 
 // Function for fetching nsx health status and feeding it to the prometheus metric.
@@ -6,7 +6,9 @@ package main
 import (
 	"context"
 	"flag"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -14,6 +16,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/clean"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
 // usage:
@@ -28,6 +31,17 @@ import (
 // envoy thumbprint mode:
 //
 //	./clean -cluster=domain-c9:d75735a3-2847-45d2-a652-ef2d146afd54 -nsx-user=admin -nsx-passwd='xxx'  -mgr-ip=nsxmanager-ob-22386469-1-dev-integ-nsxt-8791 -envoyhost=localhost -envoyport=1080 -log-level=1 -thumbprint=8bc2fa2b5879c27b1180fa44e5f747832f2ded6be483e3c3d2c4816a38870868
+//
+// dry-run mode, list the resources to be deleted without deleting them:
+//
+//	./clean -cluster=domain-c9:d75735a3-2847-45d2-a652-ef2d146afd54 -nsx-user=admin -nsx-passwd='xxx'  -mgr-ip=nsxmanager-ob-22386469-1-dev-integ-nsxt-8791 -thumbprint=xxx -dry-run -report-format=yaml -report-file=./report.yaml
+//
+// A report of the resources planned, deleted or failed to delete is written in both modes if -report-format is set.
+//
+// scoped mode, only clean up the resources of the given Namespaces, VPCs and kinds, e.g.,
+//
+//	./clean -cluster=domain-c9:d75735a3-2847-45d2-a652-ef2d146afd54 -nsx-user=admin -nsx-passwd='xxx'  -mgr-ip=nsxmanager-ob-22386469-1-dev-integ-nsxt-8791 -thumbprint=xxx -namespaces=ns1,ns2 -kinds=subnetport,securitypolicy,dnsrecord
+//	./clean -cluster=domain-c9:d75735a3-2847-45d2-a652-ef2d146afd54 -nsx-user=admin -nsx-passwd='xxx'  -mgr-ip=nsxmanager-ob-22386469-1-dev-integ-nsxt-8791 -thumbprint=xxx -vpc-paths=/orgs/default/projects/proj1/vpcs/vpc1
 var (
 	log         logger.CustomLogger
 	cf          *config.NSXOperatorConfig
@@ -44,6 +58,12 @@ var (
 	cluster     string
 	envoyHost   string
 	envoyPort   int
+	dryRun      bool
+	reportFmt   string
+	reportFile  string
+	namespaces  string
+	vpcPaths    string
+	kinds       string
 )
 
 func main() {
@@ -61,8 +81,22 @@ func main() {
 	flag.StringVar(&envoyHost, "envoyhost", "", "envoy host")
 	flag.IntVar(&envoyPort, "envoyport", 0, "envoy port")
 	flag.IntVar(&config.LogLevel, "log-level", 2, "Use zap-core log system.")
+	flag.BoolVar(&dryRun, "dry-run", false, "only list the nsx resources to be deleted without deleting them")
+	flag.StringVar(&reportFmt, "report-format", "", "write the cleanup report in json or yaml format, the report is written in dry-run mode with json format by default")
+	flag.StringVar(&reportFile, "report-file", "", "file to write the cleanup report, default is stdout")
+	flag.StringVar(&namespaces, "namespaces", "", "comma separated Namespaces, only clean up the nsx resources tagged with the Namespaces")
+	flag.StringVar(&vpcPaths, "vpc-paths", "", "comma separated nsx VPC paths, only clean up the nsx resources in the VPCs")
+	flag.StringVar(&kinds, "kinds", "", "comma separated resource kinds to clean up, e.g. subnetport,securitypolicy,dnsrecord")
 	flag.Parse()
 
+	if dryRun && reportFmt == "" {
+		reportFmt = clean.ReportFormatJSON
+	}
+	if reportFmt != "" && reportFmt != clean.ReportFormatJSON && reportFmt != clean.ReportFormatYAML {
+		flag.Usage()
+		os.Exit(2)
+	}
+
 	cf = config.NewNSXOpertorConfig()
 	cf.NsxApiManagers = []string{mgrIp}
 	cf.VCUser = vcUser
@@ -83,10 +117,36 @@ func main() {
 	log = logger.ZapCustomLogger(cf.DefaultConfig.Debug, config.LogLevel)
 	logger.Log = log
 	logf.SetLogger(log.Logger)
+	report := common.NewCleanupReport(dryRun)
+	ctx = common.WithCleanupReport(ctx, report)
+	if namespaces != "" || vpcPaths != "" || kinds != "" {
+		filter := common.NewCleanupFilter(strings.Split(namespaces, ","), strings.Split(vpcPaths, ","), strings.Split(kinds, ","))
+		ctx = common.WithCleanupFilter(ctx, filter)
+	}
 	err := clean.Clean(ctx, cf, &log.Logger, cf.DefaultConfig.Debug, config.LogLevel)
+	// The report is also written if the cleanup fails, so that the resources failed to delete are known.
+	if reportFmt != "" {
+		if reportErr := writeReport(report); reportErr != nil {
+			log.Error(reportErr, "Failed to write cleanup report", "file", reportFile)
+			os.Exit(1)
+		}
+	}
 	if err != nil {
 		log.Error(err, "Failed to clean nsx resources")
 		os.Exit(1)
 	}
 	os.Exit(0)
 }
+
+func writeReport(report *common.CleanupReport) error {
+	var w io.Writer = os.Stdout
+	if reportFile != "" {
+		f, err := os.Create(reportFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return clean.WriteReport(w, report, reportFmt)
+}
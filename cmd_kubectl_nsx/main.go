@@ -0,0 +1,200 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	policyclientset "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/diagnose"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// kubectl-nsx is a kubectl plugin to troubleshoot nsx-operator, install it into the PATH to run it as "kubectl nsx".
+// usage:
+//
+//	kubectl nsx describe-namespace -n ns1
+//	kubectl nsx trace-pod -n ns1 -mgr-ip=nsxmanager -nsx-user=admin -nsx-passwd='xxx' -thumbprint=xxx pod1
+//	kubectl nsx policy-explain -n ns1 -mgr-ip=nsxmanager -nsx-user=admin -nsx-passwd='xxx' -ca-file=./ca.cert sp1
+//	kubectl nsx orphans -cluster=domain-c9:d75735a3-2847-45d2-a652-ef2d146afd54 -mgr-ip=nsxmanager -nsx-user=admin -nsx-passwd='xxx' -thumbprint=xxx -o yaml
+//
+// The NSX Manager flags are the same as the clean binary, describe-namespace reads the NSX realized states only if
+// -mgr-ip is set.
+const usage = `Usage: kubectl nsx <command> [flags] [name]
+
+Commands:
+  describe-namespace  show the NetworkInfo, VPC path, SubnetSets and Subnets, SNAT IP and LB provider of a Namespace
+  trace-pod           show the NSX subnet port, attachment, realized state and DFW rules of a Pod
+  policy-explain      map a SecurityPolicy to the NSX security policy, rule and group IDs
+  orphans             list the NSX objects tagged for the cluster which have no owning CR
+
+Run "kubectl nsx <command> -h" for the flags of a command.
+`
+
+var (
+	log         logger.CustomLogger
+	kubeconfig  string
+	kubeContext string
+	namespace   string
+	output      string
+	mgrIp       string
+	vcEndpoint  string
+	vcUser      string
+	vcPasswd    string
+	nsxUser     string
+	nsxPasswd   string
+	vcSsoDomain string
+	vcHttpsPort int
+	thumbprint  string
+	caFile      string
+	cluster     string
+	envoyHost   string
+	envoyPort   int
+	timeout     time.Duration
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	command := os.Args[1]
+	if command == "-h" || command == "--help" || command == "help" {
+		fmt.Fprint(os.Stdout, usage)
+		os.Exit(0)
+	}
+	fs := flag.NewFlagSet(command, flag.ExitOnError)
+	fs.StringVar(&kubeconfig, "kubeconfig", "", "path to the kubeconfig file, default is the kubectl kubeconfig")
+	fs.StringVar(&kubeContext, "context", "", "the kubeconfig context to use")
+	fs.StringVar(&namespace, "n", "", "the Namespace, default is the Namespace of the kubeconfig context")
+	fs.StringVar(&output, "o", diagnose.OutputFormatText, "output format, text, json or yaml")
+	fs.DurationVar(&timeout, "timeout", time.Minute, "timeout of the command")
+	fs.StringVar(&vcEndpoint, "vc-endpoint", "", "vc endpoint")
+	fs.StringVar(&vcSsoDomain, "vc-sso-domain", "", "vc sso domain")
+	fs.StringVar(&mgrIp, "mgr-ip", "", "nsx manager ip, it should be host name if want to verify cert")
+	fs.StringVar(&vcUser, "vc-user", "", "vc username")
+	fs.StringVar(&vcPasswd, "vc-passwd", "", "vc password")
+	fs.IntVar(&vcHttpsPort, "vc-https-port", 443, "vc https port")
+	fs.StringVar(&thumbprint, "thumbprint", "", "nsx thumbprint")
+	fs.StringVar(&nsxUser, "nsx-user", "", "nsx username")
+	fs.StringVar(&nsxPasswd, "nsx-passwd", "", "nsx password")
+	fs.StringVar(&caFile, "ca-file", "", "ca file")
+	fs.StringVar(&cluster, "cluster", "", "cluster name, which is the nsx-op/cluster tag of the nsx resources")
+	fs.StringVar(&envoyHost, "envoyhost", "", "envoy host")
+	fs.IntVar(&envoyPort, "envoyport", 0, "envoy port")
+	fs.IntVar(&config.LogLevel, "log-level", 0, "Use zap-core log system.")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fmt.Fprintf(fs.Output(), "\nFlags of %s:\n", command)
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(os.Args[2:])
+
+	if err := runCommand(command, fs.Arg(0)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runCommand(command, name string) error {
+	log = logger.ZapCustomLogger(false, config.LogLevel)
+	logger.Log = log
+	logf.SetLogger(log.Logger)
+
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig, Precedence: clientcmd.NewDefaultClientConfigLoadingRules().Precedence},
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext})
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	if namespace == "" {
+		if namespace, _, err = clientConfig.Namespace(); err != nil {
+			return fmt.Errorf("failed to get the Namespace of the kubeconfig context: %w", err)
+		}
+	}
+	d := &diagnose.Diagnoser{Cluster: cluster}
+	if d.KubeClient, err = kubernetes.NewForConfig(restConfig); err != nil {
+		return err
+	}
+	if d.CRDClient, err = versioned.NewForConfig(restConfig); err != nil {
+		return err
+	}
+	if d.PolicyClient, err = policyclientset.NewForConfig(restConfig); err != nil {
+		return err
+	}
+	if mgrIp != "" {
+		if d.NSX, err = newNSXReader(); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var report diagnose.Report
+	switch command {
+	case "describe-namespace":
+		if name != "" {
+			namespace = name
+		}
+		report, err = d.DescribeNamespace(ctx, namespace)
+	case "trace-pod":
+		if name == "" {
+			return errors.New("trace-pod requires the Pod name")
+		}
+		report, err = d.TracePod(ctx, namespace, name)
+	case "policy-explain":
+		if name == "" {
+			return errors.New("policy-explain requires the SecurityPolicy name")
+		}
+		report, err = d.ExplainPolicy(ctx, namespace, name)
+	case "orphans":
+		report, err = d.Orphans(ctx)
+	default:
+		return fmt.Errorf("unknown command %q, run \"kubectl nsx -h\" for the commands", command)
+	}
+	if err != nil {
+		return err
+	}
+	return diagnose.WriteReport(os.Stdout, report, output)
+}
+
+func newNSXReader() (diagnose.NSXReader, error) {
+	cf := config.NewNSXOpertorConfig()
+	cf.NsxApiManagers = []string{mgrIp}
+	cf.VCUser = vcUser
+	cf.VCPassword = vcPasswd
+	cf.VCEndPoint = vcEndpoint
+	cf.NsxApiUser = nsxUser
+	cf.NsxApiPassword = nsxPasswd
+	cf.SsoDomain = vcSsoDomain
+	cf.HttpsPort = vcHttpsPort
+	cf.Thumbprint = []string{thumbprint}
+	cf.CaFile = []string{caFile}
+	cf.Cluster = cluster
+	cf.EnvoyHost = envoyHost
+	cf.EnvoyPort = envoyPort
+	if err := cf.ValidateConfigFromCmd(); err != nil {
+		return nil, errors.Join(nsxutil.ValidationFailed, err)
+	}
+	cf.LibMode = true
+	nsxClient := nsx.GetClient(cf)
+	if nsxClient == nil {
+		return nil, nsxutil.GetNSXClientFailed
+	}
+	return diagnose.NewNSXReader(nsxClient), nil
+}
@@ -299,6 +299,26 @@ func (in *SecurityPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicyNSXRule) DeepCopyInto(out *SecurityPolicyNSXRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyNSXRule.
+func (in *SecurityPolicyNSXRule) DeepCopy() *SecurityPolicyNSXRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicyNSXRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityPolicyPeer) DeepCopyInto(out *SecurityPolicyPeer) {
 	*out = *in
@@ -322,6 +342,11 @@ func (in *SecurityPolicyPeer) DeepCopyInto(out *SecurityPolicyPeer) {
 		*out = make([]IPBlock, len(*in))
 		copy(*out, *in)
 	}
+	if in.FQDNs != nil {
+		in, out := &in.FQDNs, &out.FQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyPeer.
@@ -403,6 +428,11 @@ func (in *SecurityPolicyRule) DeepCopyInto(out *SecurityPolicyRule) {
 		*out = make([]SecurityPolicyPort, len(*in))
 		copy(*out, *in)
 	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyRule.
@@ -415,6 +445,28 @@ func (in *SecurityPolicyRule) DeepCopy() *SecurityPolicyRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicyRuleStatus) DeepCopyInto(out *SecurityPolicyRuleStatus) {
+	*out = *in
+	if in.NSXRules != nil {
+		in, out := &in.NSXRules, &out.NSXRules
+		*out = make([]SecurityPolicyNSXRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyRuleStatus.
+func (in *SecurityPolicyRuleStatus) DeepCopy() *SecurityPolicyRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicyRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityPolicySpec) DeepCopyInto(out *SecurityPolicySpec) {
 	*out = *in
@@ -454,6 +506,13 @@ func (in *SecurityPolicyStatus) DeepCopyInto(out *SecurityPolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]SecurityPolicyRuleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyStatus.
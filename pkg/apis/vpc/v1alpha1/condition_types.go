@@ -10,6 +10,7 @@ type ConditionType string
 const (
 	Ready                      ConditionType = "Ready"
 	SubnetCreationFailed       ConditionType = "SubnetCreationFailed"
+	SubnetQuotaExceeded        ConditionType = "SubnetQuotaExceeded"
 	GatewayConnectionReady     ConditionType = "GatewayConnectionReady"
 	ServiceClusterReady        ConditionType = "ServiceClusterReady"
 	AutoSnatEnabled            ConditionType = "AutoSnatEnabled"
@@ -59,8 +59,9 @@ type IPAddressAllocationSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	IPAddressBlockVisibility IPAddressVisibility `json:"ipAddressBlockVisibility,omitempty"`
 	// AllocationSize specifies the size of IPv4 allocationIPs to be allocated.
-	// It should be a power of 2.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// It should be a power of 2. It can be increased to allocate additional IP addresses,
+	// which are reported in additionalAllocationIPs.
+	// +kubebuilder:validation:XValidation:rule="self >= oldSelf",message="Value cannot be decreased"
 	// +kubebuilder:validation:Minimum:=1
 	AllocationSize int `json:"allocationSize,omitempty"`
 	// AllocationIPs specifies the Allocated IP addresses in CIDR or single IP Address format.
@@ -86,8 +87,10 @@ type IPAddressAllocationSpec struct {
 // IPAddressAllocationStatus defines the observed state of IPAddressAllocation.
 type IPAddressAllocationStatus struct {
 	// AllocationIPs is the allocated IP addresses
-	AllocationIPs string      `json:"allocationIPs"`
-	Conditions    []Condition `json:"conditions,omitempty"`
+	AllocationIPs string `json:"allocationIPs"`
+	// AdditionalAllocationIPs is the IP addresses allocated after allocationSize is increased.
+	AdditionalAllocationIPs []string    `json:"additionalAllocationIPs,omitempty"`
+	Conditions              []Condition `json:"conditions,omitempty"`
 }
 
 func init() {
@@ -48,6 +48,11 @@ type SecurityPolicySpec struct {
 	AppliedTo []SecurityPolicyTarget `json:"appliedTo,omitempty"`
 	// Rules is a list of policy rules.
 	Rules []SecurityPolicyRule `json:"rules,omitempty"`
+	// Logging enables the NSX DFW packet logging for the rules which don't set Logging.
+	Logging bool `json:"logging,omitempty"`
+	// LogLabel is the label printed in the packet logs for the rules which don't set LogLabel.
+	// +kubebuilder:validation:MaxLength=32
+	LogLabel string `json:"logLabel,omitempty"`
 }
 
 // SecurityPolicyRule defines a rule of SecurityPolicy.
@@ -75,6 +80,13 @@ type SecurityPolicyRule struct {
 	Ports []SecurityPolicyPort `json:"ports,omitempty"`
 	// Name is the display name of this rule.
 	Name string `json:"name,omitempty"`
+	// Logging enables the NSX DFW packet logging for this rule.
+	// It is the Logging of the SecurityPolicy by default.
+	Logging *bool `json:"logging,omitempty"`
+	// LogLabel is the label printed in the packet logs of this rule.
+	// It is the LogLabel of the SecurityPolicy by default.
+	// +kubebuilder:validation:MaxLength=32
+	LogLabel string `json:"logLabel,omitempty"`
 }
 
 // SecurityPolicyTarget defines the target endpoints to apply SecurityPolicy.
@@ -95,6 +107,11 @@ type SecurityPolicyPeer struct {
 	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 	// IPBlocks is a list of IP CIDRs.
 	IPBlocks []IPBlock `json:"ipBlocks,omitempty"`
+	// FQDNs is a list of fully qualified domain names. For egress rule only.
+	// A single leading wildcard label is supported, e.g. "*.example.com".
+	// A peer with FQDNs cannot be combined with selectors or IPBlocks in the same rule.
+	// +kubebuilder:validation:MaxItems=128
+	FQDNs []string `json:"fqdns,omitempty"`
 }
 
 // IPBlock describes a particular CIDR that is allowed or denied to/from the workloads matched by an AppliedTo.
@@ -121,6 +138,35 @@ type SecurityPolicyPort struct {
 type SecurityPolicyStatus struct {
 	// Conditions describes current state of security policy.
 	Conditions []Condition `json:"conditions"`
+	// Rules describes the realization state of each rule, keyed by the rule index.
+	// +listType=map
+	// +listMapKey=index
+	Rules []SecurityPolicyRuleStatus `json:"rules,omitempty"`
+}
+
+// SecurityPolicyRuleStatus defines the observed state of a SecurityPolicy rule.
+type SecurityPolicyRuleStatus struct {
+	// Index is the index of the rule in the SecurityPolicy rules.
+	Index int `json:"index"`
+	// Name is the name of the rule.
+	Name string `json:"name,omitempty"`
+	// NSXRules are the NSX rules realized for the rule. A rule with named ports is expanded
+	// to one NSX rule for each port number the named ports are resolved to.
+	NSXRules []SecurityPolicyNSXRule `json:"nsxRules,omitempty"`
+	// RealizationState is the NSX realization state of the rule, REALIZED, UNREALIZED or ERROR.
+	RealizationState string `json:"realizationState,omitempty"`
+	// Message is the error message if the rule failed to be realized.
+	Message string `json:"message,omitempty"`
+}
+
+// SecurityPolicyNSXRule describes an NSX rule realized for a SecurityPolicy rule.
+type SecurityPolicyNSXRule struct {
+	// ID is the ID of the NSX rule.
+	ID string `json:"id"`
+	// Path is the policy path of the NSX rule.
+	Path string `json:"path,omitempty"`
+	// Ports are the destination ports matched by the NSX rule, with the named ports resolved to port numbers.
+	Ports []string `json:"ports,omitempty"`
 }
 
 // +genclient
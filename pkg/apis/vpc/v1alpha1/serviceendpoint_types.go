@@ -12,6 +12,11 @@ type ServiceEndpointSpec struct {
 	// ServiceEndpointIP is the IP address of the VPC service endpoint.
 	// +kubebuilder:validation:Required
 	ServiceEndpointIP string `json:"serviceEndpointIP"`
+
+	// AllowedNamespaces lists the Namespaces whose VPCEndpoints are allowed to consume the ServiceEndpoint.
+	// The VPCEndpoints in the same Namespace as the ServiceEndpoint are always allowed.
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
 }
 
 // ServiceEndpointStatus defines the observed state of ServiceEndpoint.
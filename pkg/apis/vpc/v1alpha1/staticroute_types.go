@@ -34,11 +34,48 @@ type NextHop struct {
 	// Next hop gateway IP address.
 	// +kubebuilder:validation:Format=ip
 	IPAddress string `json:"ipAddress"`
+	// Administrative distance of the next hop. The traffic is balanced (ECMP) across the
+	// next hops with the lowest distance, the next hops with a higher distance are only
+	// used when those are unreachable. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	// +optional
+	AdminDistance int `json:"adminDistance,omitempty"`
+	// Policy paths of the interfaces the next hop is scoped to.
+	// +optional
+	Scope []string `json:"scope,omitempty"`
+}
+
+// NextHopState is the realization state of a next hop.
+type NextHopState string
+
+const (
+	// NextHopStateActive means the next hop is installed in the NSX forwarding table.
+	NextHopStateActive NextHopState = "Active"
+	// NextHopStateInactive means the next hop is not installed in the NSX forwarding table
+	// although no next hop with a lower admin distance is used, e.g. it is unreachable.
+	NextHopStateInactive NextHopState = "Inactive"
+	// NextHopStateStandby means the next hop is not installed in the NSX forwarding table
+	// because a next hop with a lower admin distance is used.
+	NextHopStateStandby NextHopState = "Standby"
+)
+
+// NextHopStatus defines the realization state of a next hop.
+type NextHopStatus struct {
+	// Next hop gateway IP address.
+	IPAddress string `json:"ipAddress"`
+	// Administrative distance of the next hop.
+	AdminDistance int `json:"adminDistance,omitempty"`
+	// State of the next hop in the NSX forwarding table.
+	State NextHopState `json:"state"`
 }
 
 // StaticRouteStatus defines the observed state of StaticRoute.
 type StaticRouteStatus struct {
 	Conditions []StaticRouteCondition `json:"conditions"`
+	// Realization state of the next hops.
+	// +optional
+	NextHops []NextHopStatus `json:"nextHops,omitempty"`
 }
 
 // +genclient
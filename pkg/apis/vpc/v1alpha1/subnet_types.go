@@ -70,9 +70,13 @@ type SubnetSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	AccessMode AccessMode `json:"accessMode,omitempty"`
 	// Subnet CIDRS.
+	// CIDRs can be appended to grow a Subnet created with ipAddresses in place, the existing CIDRs cannot be removed or changed.
+	// A Subnet whose CIDRs are auto-allocated from the VPC IP blocks is grown by setting ipAddresses to the allocated
+	// CIDRs in status.networkAddresses followed by the new CIDRs.
+	// The appended CIDRs must be in the VPC IP blocks of the Subnet access mode and not overlap with the other Subnets of the VPC.
 	// +kubebuilder:validation:MinItems=0
-	// +kubebuilder:validation:MaxItems=2
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:XValidation:rule="oldSelf.all(x, x in self)",message="Existing CIDRs cannot be removed"
 	IPAddresses []string `json:"ipAddresses,omitempty"`
 	// DHCP configuration for Subnet.
 	SubnetDHCPConfig SubnetDHCPConfig `json:"subnetDHCPConfig,omitempty"`
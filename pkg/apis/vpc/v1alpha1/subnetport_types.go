@@ -17,6 +17,8 @@ const (
 )
 
 // +kubebuilder:validation:XValidation:rule="!has(self.subnetSet) || !has(self.subnet)",message="Only one of subnet or subnetSet can be specified or both set to empty in which case default SubnetSet for VM will be used"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.portSettingName) || (has(self.portSettingName) && self.portSettingName == oldSelf.portSettingName)",message="portSettingName is immutable once set"
+// +kubebuilder:validation:XValidation:rule="!has(self.portSettingName) || has(self.subnet)",message="subnet must be specified when portSettingName is set, the SubnetPortSetting only applies to the SubnetPorts on its Subnet"
 // SubnetPortSpec defines the desired state of SubnetPort.
 type SubnetPortSpec struct {
 	// Subnet defines the parent Subnet name of the SubnetPort.
@@ -38,7 +40,8 @@ type SubnetPortSpec struct {
 	// will be back-filled based on InterfaceIPType and Subnet configuration.
 	// +kubebuilder:validation:Enum=IPv4;IPv6;IPv4IPv6;None
 	StaticIPAllocationType StaticIPAllocationType `json:"staticIPAllocationType,omitempty"`
-	// Name of PortSetting associated with this SubnetPort.
+	// Name of PortSetting associated with this SubnetPort. The SubnetPortSetting
+	// must be in the same Namespace and refer to the parent Subnet of the SubnetPort.
 	PortSettingName string `json:"portSettingName,omitempty"`
 }
 
@@ -70,7 +73,7 @@ type NetworkInterfaceConfig struct {
 	LogicalSwitchUUID string `json:"logicalSwitchUUID,omitempty"`
 	// ID of the Subnet. e.g. /projects/proj1/vpcs/vpc1/subnets/subnet1
 	SubnetID string `json:"subnetID,omitempty"`
-	// ID of the SubnetPortSetting. e.g. /projects/proj1/vpcs/vpc1/subnets/subnet1/port-settings/port-setting1
+	// ID of the SubnetPortSetting. e.g. /orgs/default/projects/proj1/vpcs/vpc1/subnets/subnet1/port-configs/port-setting1
 	PortSettingID string                      `json:"portSettingID,omitempty"`
 	IPAddresses   []NetworkInterfaceIPAddress `json:"ipAddresses,omitempty"`
 	// The MAC address.
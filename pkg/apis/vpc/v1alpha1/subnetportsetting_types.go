@@ -10,7 +10,120 @@ import (
 // SubnetPortSettingSpec defines the desired state of SubnetPortSetting.
 type SubnetPortSettingSpec struct {
 	// SubnetName defines the Subnet name of the SubnetPortSetting.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="subnetName is immutable"
 	SubnetName string `json:"subnetName,omitempty"`
+	// MACDiscovery defines the MAC discovery settings of the SubnetPorts.
+	MACDiscovery *MACDiscoverySetting `json:"macDiscovery,omitempty"`
+	// IPDiscovery defines the IP discovery settings of the SubnetPorts.
+	IPDiscovery *IPDiscoverySetting `json:"ipDiscovery,omitempty"`
+	// SpoofGuard defines the SpoofGuard settings of the SubnetPorts.
+	SpoofGuard *SpoofGuardSetting `json:"spoofGuard,omitempty"`
+	// QoS defines the QoS settings of the SubnetPorts.
+	QoS *QoSSetting `json:"qos,omitempty"`
+	// SegmentSecurity defines the segment security settings of the SubnetPorts.
+	SegmentSecurity *SegmentSecuritySetting `json:"segmentSecurity,omitempty"`
+}
+
+type MACLimitPolicy string
+
+const (
+	MACLimitPolicyAllow MACLimitPolicy = "Allow"
+	MACLimitPolicyDrop  MACLimitPolicy = "Drop"
+)
+
+// MACDiscoverySetting defines the MAC discovery settings.
+type MACDiscoverySetting struct {
+	// MACChangeEnabled allows the guest to change the MAC address of the port.
+	MACChangeEnabled *bool `json:"macChangeEnabled,omitempty"`
+	// MACLearningEnabled activates source MAC address learning.
+	MACLearningEnabled *bool `json:"macLearningEnabled,omitempty"`
+	// MACLearningAgingTime indicates how long in seconds the learned MAC addresses remain.
+	// +kubebuilder:validation:Minimum=0
+	MACLearningAgingTime *int32 `json:"macLearningAgingTime,omitempty"`
+	// MACLimit is the maximum number of MAC addresses learned on the port.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4096
+	MACLimit *int32 `json:"macLimit,omitempty"`
+	// MACLimitPolicy defines the policy after the MAC limit is exceeded.
+	// +kubebuilder:validation:Enum=Allow;Drop
+	MACLimitPolicy MACLimitPolicy `json:"macLimitPolicy,omitempty"`
+	// UnknownUnicastFloodingEnabled allows flooding the unknown unicast traffic.
+	UnknownUnicastFloodingEnabled *bool `json:"unknownUnicastFloodingEnabled,omitempty"`
+}
+
+// IPDiscoverySetting defines the IP discovery settings.
+type IPDiscoverySetting struct {
+	// ARPNDBindingTimeout is the timeout in minutes of the ARP and ND cache entries.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=120
+	ARPNDBindingTimeout *int32 `json:"arpNDBindingTimeout,omitempty"`
+	// TOFUEnabled activates Trust-On-First-Use for the discovered IP addresses.
+	TOFUEnabled *bool `json:"tofuEnabled,omitempty"`
+	// ARPSnoopingEnabled activates IPv4 discovery with ARP snooping.
+	ARPSnoopingEnabled *bool `json:"arpSnoopingEnabled,omitempty"`
+	// ARPBindingLimit is the maximum number of IPv4 addresses bound with ARP snooping.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=256
+	ARPBindingLimit *int32 `json:"arpBindingLimit,omitempty"`
+	// DHCPSnoopingEnabled activates IPv4 discovery with DHCP snooping.
+	DHCPSnoopingEnabled *bool `json:"dhcpSnoopingEnabled,omitempty"`
+	// VMToolsEnabled activates IPv4 discovery with VMware Tools.
+	VMToolsEnabled *bool `json:"vmToolsEnabled,omitempty"`
+	// DHCPv6SnoopingEnabled activates IPv6 discovery with DHCPv6 snooping.
+	DHCPv6SnoopingEnabled *bool `json:"dhcpv6SnoopingEnabled,omitempty"`
+	// NDSnoopingEnabled activates IPv6 discovery with ND snooping.
+	NDSnoopingEnabled *bool `json:"ndSnoopingEnabled,omitempty"`
+	// NDSnoopingLimit is the maximum number of IPv6 addresses bound with ND snooping.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=15
+	NDSnoopingLimit *int32 `json:"ndSnoopingLimit,omitempty"`
+	// VMToolsV6Enabled activates IPv6 discovery with VMware Tools.
+	VMToolsV6Enabled *bool `json:"vmToolsV6Enabled,omitempty"`
+}
+
+// SpoofGuardSetting defines the SpoofGuard settings.
+type SpoofGuardSetting struct {
+	// AddressBindingAllowlist restricts the traffic to the IP addresses bound to the port.
+	AddressBindingAllowlist *bool `json:"addressBindingAllowlist,omitempty"`
+}
+
+type DSCPMode string
+
+const (
+	DSCPModeTrusted   DSCPMode = "Trusted"
+	DSCPModeUntrusted DSCPMode = "Untrusted"
+)
+
+// QoSSetting defines the QoS settings.
+// +kubebuilder:validation:XValidation:rule="!has(self.dscpPriority) || (has(self.dscpMode) && self.dscpMode == 'Untrusted')",message="dscpPriority can only be set with Untrusted dscpMode"
+type QoSSetting struct {
+	// ClassOfService is the CoS value of the traffic.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=7
+	ClassOfService *int32 `json:"classOfService,omitempty"`
+	// DSCPMode defines whether the DSCP value of the inner header is trusted.
+	// +kubebuilder:validation:Enum=Trusted;Untrusted
+	DSCPMode DSCPMode `json:"dscpMode,omitempty"`
+	// DSCPPriority is the DSCP value set on the traffic with Untrusted DSCPMode.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=63
+	DSCPPriority *int32 `json:"dscpPriority,omitempty"`
+}
+
+// SegmentSecuritySetting defines the segment security settings.
+type SegmentSecuritySetting struct {
+	// BPDUFilterEnabled filters the BPDU traffic.
+	BPDUFilterEnabled *bool `json:"bpduFilterEnabled,omitempty"`
+	// DHCPClientBlockEnabled blocks the DHCP client traffic.
+	DHCPClientBlockEnabled *bool `json:"dhcpClientBlockEnabled,omitempty"`
+	// DHCPServerBlockEnabled blocks the DHCP server traffic.
+	DHCPServerBlockEnabled *bool `json:"dhcpServerBlockEnabled,omitempty"`
+	// NonIPTrafficBlockEnabled blocks the non-IP traffic.
+	NonIPTrafficBlockEnabled *bool `json:"nonIPTrafficBlockEnabled,omitempty"`
+	// RAGuardEnabled filters the IPv6 router advertisements.
+	RAGuardEnabled *bool `json:"raGuardEnabled,omitempty"`
+	// RateLimitsEnabled activates the traffic rate limits.
+	RateLimitsEnabled *bool `json:"rateLimitsEnabled,omitempty"`
 }
 
 // SubnetPortSettingStatus defines the observed state of SubnetPortSetting.
@@ -40,6 +40,14 @@ type SubnetSetSpec struct {
 	// It is mutually exclusive with the other fields like IPv4SubnetSize, AccessMode, and SubnetDHCPConfig.
 	// Once this field is set, the other fields cannot be set.
 	SubnetNames *[]string `json:"subnetNames,omitempty"`
+	// Maximum number of Subnets created for the SubnetSet. Not limited if not set.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxSubnets int `json:"maxSubnets,omitempty"`
+	// Maximum number of IPv4 addresses of the Subnets created for the SubnetSet. Not limited if not set.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxIPs int `json:"maxIPs,omitempty"`
 }
 
 // SubnetInfo defines the observed state of a single Subnet of a SubnetSet.
@@ -52,10 +60,20 @@ type SubnetInfo struct {
 	DHCPServerAddresses []string `json:"DHCPServerAddresses,omitempty"`
 }
 
+// SubnetSetUsage defines the Subnets and IPv4 addresses used by a SubnetSet.
+type SubnetSetUsage struct {
+	// Number of Subnets of the SubnetSet.
+	Subnets int `json:"subnets"`
+	// Number of IPv4 addresses of the Subnets of the SubnetSet.
+	IPs int `json:"ips"`
+}
+
 // SubnetSetStatus defines the observed state of SubnetSet.
 type SubnetSetStatus struct {
 	Conditions []Condition  `json:"conditions,omitempty"`
 	Subnets    []SubnetInfo `json:"subnets,omitempty"`
+	// Usage of the SubnetSet, counted against maxSubnets and maxIPs.
+	Usage *SubnetSetUsage `json:"usage,omitempty"`
 }
 
 // +genclient
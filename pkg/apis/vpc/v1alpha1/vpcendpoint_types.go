@@ -10,6 +10,8 @@ import (
 // VPCEndpointSpec defines the desired state of VPCEndpoint.
 type VPCEndpointSpec struct {
 	// ServiceEndpointName is the VPC service endpoint name being consumed.
+	// It is in the format "<namespace>/<name>", or "<name>" for the ServiceEndpoint in the same Namespace as the VPCEndpoint.
+	// A ServiceEndpoint in another Namespace must list the Namespace of the VPCEndpoint in its allowedNamespaces.
 	// +kubebuilder:validation:Required
 	ServiceEndpointName string `json:"serviceEndpointName"`
 
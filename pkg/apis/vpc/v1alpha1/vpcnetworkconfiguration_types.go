@@ -51,6 +51,24 @@ type VPCNetworkConfigurationSpec struct {
 	// NSX Policy path of the Load Balancer VPC. If set, load balancer resources (such as virtual servers and LB pools) of the Namespace will be created on this VPC's load balancer, instead of the Namespace's primary VPC.
 	// +optional
 	LoadBalancerVPC string `json:"loadBalancerVPC,omitempty"`
+
+	// Quota of the Subnets of the Namespace. It is enforced when a Subnet is created for a Subnet or
+	// SubnetSet, or when CIDRs are appended to a Subnet.
+	// +optional
+	SubnetQuota *SubnetQuota `json:"subnetQuota,omitempty"`
+}
+
+// SubnetQuota defines the limits of the Subnets of a Namespace.
+// The Subnets created for both the Subnet and SubnetSet CRs of the Namespace are counted.
+type SubnetQuota struct {
+	// Maximum number of Subnets of the Namespace. Not limited if not set.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxSubnets int `json:"maxSubnets,omitempty"`
+	// Maximum number of IPv4 addresses of the Subnets of the Namespace. Not limited if not set.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxIPs int `json:"maxIPs,omitempty"`
 }
 
 // SharedSubnet defines the information for a Subnet shared with vSphere Namespace.
@@ -73,6 +91,18 @@ type VPCNetworkConfigurationStatus struct {
 	VPCs []VPCInfo `json:"vpcs,omitempty"`
 	// Conditions describe current state of VPCNetworkConfiguration.
 	Conditions []Condition `json:"conditions,omitempty"`
+	// SubnetQuotaUsage describes the usage of the Subnet quota by each Namespace which has Subnets.
+	SubnetQuotaUsage []NamespaceSubnetUsage `json:"subnetQuotaUsage,omitempty"`
+}
+
+// NamespaceSubnetUsage defines the Subnets and IPv4 addresses used by a Namespace.
+type NamespaceSubnetUsage struct {
+	// Namespace name.
+	Namespace string `json:"namespace"`
+	// Number of Subnets of the Namespace.
+	Subnets int `json:"subnets"`
+	// Number of IPv4 addresses of the Subnets of the Namespace.
+	IPs int `json:"ips"`
 }
 
 // VPCInfo defines VPC info needed by tenant admin.
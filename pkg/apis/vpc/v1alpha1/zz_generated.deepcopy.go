@@ -241,6 +241,11 @@ func (in *IPAddressAllocationSpec) DeepCopy() *IPAddressAllocationSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAddressAllocationStatus) DeepCopyInto(out *IPAddressAllocationStatus) {
 	*out = *in
+	if in.AdditionalAllocationIPs != nil {
+		in, out := &in.AdditionalAllocationIPs, &out.AdditionalAllocationIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]Condition, len(*in))
@@ -352,6 +357,71 @@ func (in *IPBlocksInfoList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPDiscoverySetting) DeepCopyInto(out *IPDiscoverySetting) {
+	*out = *in
+	if in.ARPNDBindingTimeout != nil {
+		in, out := &in.ARPNDBindingTimeout, &out.ARPNDBindingTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TOFUEnabled != nil {
+		in, out := &in.TOFUEnabled, &out.TOFUEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ARPSnoopingEnabled != nil {
+		in, out := &in.ARPSnoopingEnabled, &out.ARPSnoopingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ARPBindingLimit != nil {
+		in, out := &in.ARPBindingLimit, &out.ARPBindingLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DHCPSnoopingEnabled != nil {
+		in, out := &in.DHCPSnoopingEnabled, &out.DHCPSnoopingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VMToolsEnabled != nil {
+		in, out := &in.VMToolsEnabled, &out.VMToolsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DHCPv6SnoopingEnabled != nil {
+		in, out := &in.DHCPv6SnoopingEnabled, &out.DHCPv6SnoopingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NDSnoopingEnabled != nil {
+		in, out := &in.NDSnoopingEnabled, &out.NDSnoopingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NDSnoopingLimit != nil {
+		in, out := &in.NDSnoopingLimit, &out.NDSnoopingLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VMToolsV6Enabled != nil {
+		in, out := &in.VMToolsV6Enabled, &out.VMToolsV6Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPDiscoverySetting.
+func (in *IPDiscoverySetting) DeepCopy() *IPDiscoverySetting {
+	if in == nil {
+		return nil
+	}
+	out := new(IPDiscoverySetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPoolRange) DeepCopyInto(out *IPPoolRange) {
 	*out = *in
@@ -367,6 +437,61 @@ func (in *IPPoolRange) DeepCopy() *IPPoolRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MACDiscoverySetting) DeepCopyInto(out *MACDiscoverySetting) {
+	*out = *in
+	if in.MACChangeEnabled != nil {
+		in, out := &in.MACChangeEnabled, &out.MACChangeEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MACLearningEnabled != nil {
+		in, out := &in.MACLearningEnabled, &out.MACLearningEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MACLearningAgingTime != nil {
+		in, out := &in.MACLearningAgingTime, &out.MACLearningAgingTime
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MACLimit != nil {
+		in, out := &in.MACLimit, &out.MACLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnknownUnicastFloodingEnabled != nil {
+		in, out := &in.UnknownUnicastFloodingEnabled, &out.UnknownUnicastFloodingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACDiscoverySetting.
+func (in *MACDiscoverySetting) DeepCopy() *MACDiscoverySetting {
+	if in == nil {
+		return nil
+	}
+	out := new(MACDiscoverySetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceSubnetUsage) DeepCopyInto(out *NamespaceSubnetUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceSubnetUsage.
+func (in *NamespaceSubnetUsage) DeepCopy() *NamespaceSubnetUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceSubnetUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInfo) DeepCopyInto(out *NetworkInfo) {
 	*out = *in
@@ -474,6 +599,11 @@ func (in *NetworkInterfaceIPAddress) DeepCopy() *NetworkInterfaceIPAddress {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NextHop) DeepCopyInto(out *NextHop) {
 	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NextHop.
@@ -486,6 +616,21 @@ func (in *NextHop) DeepCopy() *NextHop {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NextHopStatus) DeepCopyInto(out *NextHopStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NextHopStatus.
+func (in *NextHopStatus) DeepCopy() *NextHopStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NextHopStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortAddressBinding) DeepCopyInto(out *PortAddressBinding) {
 	*out = *in
@@ -516,6 +661,31 @@ func (in *PortAttachment) DeepCopy() *PortAttachment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSSetting) DeepCopyInto(out *QoSSetting) {
+	*out = *in
+	if in.ClassOfService != nil {
+		in, out := &in.ClassOfService, &out.ClassOfService
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DSCPPriority != nil {
+		in, out := &in.DSCPPriority, &out.DSCPPriority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSSetting.
+func (in *QoSSetting) DeepCopy() *QoSSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityPolicy) DeepCopyInto(out *SecurityPolicy) {
 	*out = *in
@@ -575,6 +745,26 @@ func (in *SecurityPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicyNSXRule) DeepCopyInto(out *SecurityPolicyNSXRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyNSXRule.
+func (in *SecurityPolicyNSXRule) DeepCopy() *SecurityPolicyNSXRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicyNSXRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityPolicyPeer) DeepCopyInto(out *SecurityPolicyPeer) {
 	*out = *in
@@ -598,6 +788,11 @@ func (in *SecurityPolicyPeer) DeepCopyInto(out *SecurityPolicyPeer) {
 		*out = make([]IPBlock, len(*in))
 		copy(*out, *in)
 	}
+	if in.FQDNs != nil {
+		in, out := &in.FQDNs, &out.FQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyPeer.
@@ -679,6 +874,11 @@ func (in *SecurityPolicyRule) DeepCopyInto(out *SecurityPolicyRule) {
 		*out = make([]SecurityPolicyPort, len(*in))
 		copy(*out, *in)
 	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyRule.
@@ -691,6 +891,28 @@ func (in *SecurityPolicyRule) DeepCopy() *SecurityPolicyRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicyRuleStatus) DeepCopyInto(out *SecurityPolicyRuleStatus) {
+	*out = *in
+	if in.NSXRules != nil {
+		in, out := &in.NSXRules, &out.NSXRules
+		*out = make([]SecurityPolicyNSXRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyRuleStatus.
+func (in *SecurityPolicyRuleStatus) DeepCopy() *SecurityPolicyRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicyRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityPolicySpec) DeepCopyInto(out *SecurityPolicySpec) {
 	*out = *in
@@ -730,6 +952,13 @@ func (in *SecurityPolicyStatus) DeepCopyInto(out *SecurityPolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]SecurityPolicyRuleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyStatus.
@@ -767,12 +996,57 @@ func (in *SecurityPolicyTarget) DeepCopy() *SecurityPolicyTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SegmentSecuritySetting) DeepCopyInto(out *SegmentSecuritySetting) {
+	*out = *in
+	if in.BPDUFilterEnabled != nil {
+		in, out := &in.BPDUFilterEnabled, &out.BPDUFilterEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DHCPClientBlockEnabled != nil {
+		in, out := &in.DHCPClientBlockEnabled, &out.DHCPClientBlockEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DHCPServerBlockEnabled != nil {
+		in, out := &in.DHCPServerBlockEnabled, &out.DHCPServerBlockEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NonIPTrafficBlockEnabled != nil {
+		in, out := &in.NonIPTrafficBlockEnabled, &out.NonIPTrafficBlockEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RAGuardEnabled != nil {
+		in, out := &in.RAGuardEnabled, &out.RAGuardEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RateLimitsEnabled != nil {
+		in, out := &in.RateLimitsEnabled, &out.RateLimitsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SegmentSecuritySetting.
+func (in *SegmentSecuritySetting) DeepCopy() *SegmentSecuritySetting {
+	if in == nil {
+		return nil
+	}
+	out := new(SegmentSecuritySetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceEndpoint) DeepCopyInto(out *ServiceEndpoint) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -829,6 +1103,11 @@ func (in *ServiceEndpointList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceEndpointSpec) DeepCopyInto(out *ServiceEndpointSpec) {
 	*out = *in
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEndpointSpec.
@@ -878,6 +1157,26 @@ func (in *SharedSubnet) DeepCopy() *SharedSubnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpoofGuardSetting) DeepCopyInto(out *SpoofGuardSetting) {
+	*out = *in
+	if in.AddressBindingAllowlist != nil {
+		in, out := &in.AddressBindingAllowlist, &out.AddressBindingAllowlist
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpoofGuardSetting.
+func (in *SpoofGuardSetting) DeepCopy() *SpoofGuardSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(SpoofGuardSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticIPAllocation) DeepCopyInto(out *StaticIPAllocation) {
 	*out = *in
@@ -984,7 +1283,9 @@ func (in *StaticRouteSpec) DeepCopyInto(out *StaticRouteSpec) {
 	if in.NextHops != nil {
 		in, out := &in.NextHops, &out.NextHops
 		*out = make([]NextHop, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -1008,6 +1309,11 @@ func (in *StaticRouteStatus) DeepCopyInto(out *StaticRouteStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextHops != nil {
+		in, out := &in.NextHops, &out.NextHops
+		*out = make([]NextHopStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRouteStatus.
@@ -1433,7 +1739,7 @@ func (in *SubnetPortSetting) DeepCopyInto(out *SubnetPortSetting) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1490,6 +1796,31 @@ func (in *SubnetPortSettingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetPortSettingSpec) DeepCopyInto(out *SubnetPortSettingSpec) {
 	*out = *in
+	if in.MACDiscovery != nil {
+		in, out := &in.MACDiscovery, &out.MACDiscovery
+		*out = new(MACDiscoverySetting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPDiscovery != nil {
+		in, out := &in.IPDiscovery, &out.IPDiscovery
+		*out = new(IPDiscoverySetting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SpoofGuard != nil {
+		in, out := &in.SpoofGuard, &out.SpoofGuard
+		*out = new(SpoofGuardSetting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QoS != nil {
+		in, out := &in.QoS, &out.QoS
+		*out = new(QoSSetting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SegmentSecurity != nil {
+		in, out := &in.SegmentSecurity, &out.SegmentSecurity
+		*out = new(SegmentSecuritySetting)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPortSettingSpec.
@@ -1568,6 +1899,21 @@ func (in *SubnetPortStatus) DeepCopy() *SubnetPortStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetQuota) DeepCopyInto(out *SubnetQuota) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetQuota.
+func (in *SubnetQuota) DeepCopy() *SubnetQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSet) DeepCopyInto(out *SubnetSet) {
 	*out = *in
@@ -1670,6 +2016,11 @@ func (in *SubnetSetStatus) DeepCopyInto(out *SubnetSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(SubnetSetUsage)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSetStatus.
@@ -1682,6 +2033,21 @@ func (in *SubnetSetStatus) DeepCopy() *SubnetSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetSetUsage) DeepCopyInto(out *SubnetSetUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSetUsage.
+func (in *SubnetSetUsage) DeepCopy() *SubnetSetUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetSetUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
@@ -1946,6 +2312,11 @@ func (in *VPCNetworkConfigurationSpec) DeepCopyInto(out *VPCNetworkConfiguration
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SubnetQuota != nil {
+		in, out := &in.SubnetQuota, &out.SubnetQuota
+		*out = new(SubnetQuota)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkConfigurationSpec.
@@ -1973,6 +2344,11 @@ func (in *VPCNetworkConfigurationStatus) DeepCopyInto(out *VPCNetworkConfigurati
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SubnetQuotaUsage != nil {
+		in, out := &in.SubnetQuotaUsage, &out.SubnetQuotaUsage
+		*out = make([]NamespaceSubnetUsage, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkConfigurationStatus.
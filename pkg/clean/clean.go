@@ -6,6 +6,7 @@ package clean
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
@@ -22,11 +23,14 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetipreservation"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetportsetting"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpcendpoint"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
@@ -41,6 +45,7 @@ var Backoff = wait.Backoff{
 // including security policy, static route, subnet, subnet port, subnet set, vpc, ip pool, nsx service account
 // besides, it also cleans up DLB resources, which was previously implemented in nsx-ncp,
 // it is usually used when nsx-operator is uninstalled and remove all the resources created by nsx-operator
+// the cleanup can be limited to some Namespaces, VPCs or resource kinds with a CleanupFilter in ctx
 // return error if any, return nil if no error
 // the error type include followings:
 // ValidationFailed 			indicate that the config is incorrect and failed to pass validation
@@ -58,6 +63,10 @@ func Clean(ctx context.Context, cf *config.NSXOperatorConfig, log *logr.Logger,
 	if err := cf.ValidateConfigFromCmd(); err != nil {
 		return errors.Join(nsxutil.ValidationFailed, err)
 	}
+	filter := common.CleanupFilterFromContext(ctx)
+	if err := validateCleanupFilter(filter); err != nil {
+		return errors.Join(nsxutil.ValidationFailed, err)
+	}
 	cf.LibMode = true
 	nsxClient := nsx.GetClient(cf)
 	if nsxClient == nil {
@@ -86,6 +95,10 @@ func Clean(ctx context.Context, cf *config.NSXOperatorConfig, log *logr.Logger,
 	}
 
 	cleanupService.log = log
+	if filter != nil {
+		log.Info("Limiting the cleanup with filter", "namespaces", sets.List(filter.Namespaces), "vpcPaths", sets.List(filter.VPCPaths), "kinds", sets.List(filter.Kinds))
+		cleanupService.selectCleaners(filter)
+	}
 
 	if err := cleanupService.cleanupVPCResources(ctx); err != nil {
 		return errors.Join(nsxutil.CleanupResourceFailed, err)
@@ -103,6 +116,21 @@ func Clean(ctx context.Context, cf *config.NSXOperatorConfig, log *logr.Logger,
 	return nil
 }
 
+func validateCleanupFilter(filter *common.CleanupFilter) error {
+	if filter == nil {
+		return nil
+	}
+	if unknownKinds := filter.Kinds.Difference(CleanupKinds); unknownKinds.Len() > 0 {
+		return fmt.Errorf("unknown cleanup kinds %v, supported kinds are %v", sets.List(unknownKinds), sets.List(CleanupKinds))
+	}
+	for vpcPath := range filter.VPCPaths {
+		if !common.IsVPCPath(vpcPath) {
+			return fmt.Errorf("invalid VPC path %q, it should be like /orgs/<org>/projects/<project>/vpcs/<vpc>", vpcPath)
+		}
+	}
+	return nil
+}
+
 // InitializeCleanupService initializes all the CR services
 func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Client, log *logr.Logger) (*CleanupService, error) {
 	cleanupService := NewCleanupService()
@@ -120,7 +148,7 @@ func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Clien
 	if err != nil {
 		return nil, err
 	}
-	subnetPortService, err := subnetport.InitializeSubnetPort(commonService, vpcService, ipAddressAllocationService)
+	subnetPortService, err := subnetport.InitializeSubnetPort(commonService, vpcService, ipAddressAllocationService, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +183,11 @@ func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Clien
 			return subnetPortService, nil
 		}
 	}
+	wrapInitializeSubnetPortSetting := func(service common.Service) cleanupFunc {
+		return func() (interface{}, error) {
+			return subnetportsetting.InitializeService(service)
+		}
+	}
 	wrapInitializeIPAddressAllocation := func(service common.Service) cleanupFunc {
 		return func() (interface{}, error) {
 			return ipAddressAllocationService, nil
@@ -175,6 +208,11 @@ func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Clien
 			return subnetipreservation.InitializeService(service, subnetPortService)
 		}
 	}
+	wrapInitializeVPCEndpoint := func(service common.Service) cleanupFunc {
+		return func() (interface{}, error) {
+			return vpcendpoint.InitializeVPCEndpointService(service, vpcService, ipAddressAllocationService)
+		}
+	}
 
 	wrapInitializeInventory := func(service common.Service) cleanupFunc {
 		return func() (interface{}, error) {
@@ -201,13 +239,13 @@ func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Clien
 
 	cleanupService.vpcService = vpcService
 	// TODO: initialize other CR services
-	loggedAdd := func(name string, f cleanupFunc) {
+	loggedAdd := func(name string, kind string, f cleanupFunc) {
 		if cleanupService.svcErr != nil {
 			log.Info("Skipping service initialization due to previous error", "service", name, "error", cleanupService.svcErr)
 			return
 		}
 		log.Info("Initializing cleanup service", "service", name)
-		cleanupService = cleanupService.AddCleanupService(f)
+		cleanupService = cleanupService.AddCleanupServiceWithKind(kind, f)
 		if cleanupService.svcErr != nil {
 			log.Error(cleanupService.svcErr, "Service initialization FAILED", "service", name)
 		} else {
@@ -215,19 +253,21 @@ func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Clien
 		}
 	}
 
-	loggedAdd("SubnetPort", wrapInitializeSubnetPort(commonService))
-	loggedAdd("SubnetBinding", wrapInitializeSubnetBinding(commonService))
-	loggedAdd("SubnetIPReservation", wrapInitializeSubnetIPReservation(commonService))
-	loggedAdd("SubnetService", wrapInitializeSubnetService(commonService))
-	loggedAdd("SecurityPolicy", wrapInitializeSecurityPolicy(commonService))
-	loggedAdd("StaticRoute", wrapInitializeStaticRoute(commonService))
-	loggedAdd("VPC", wrapInitializeVPC(commonService))
-	loggedAdd("IPAddressAllocation", wrapInitializeIPAddressAllocation(commonService))
-	loggedAdd("DNSRecord", wrapInitializeDNSRecordService(commonService))
-	loggedAdd("Inventory", wrapInitializeInventory(commonService))
-	loggedAdd("LBInfraCleaner", wrapInitializeLBInfraCleaner(commonService))
-	loggedAdd("HealthCleaner", wrapInitializeHealthCleaner(commonService))
-	loggedAdd("NSXServiceAccount", wrapInitializeNSXServiceAccount(commonService))
+	loggedAdd("SubnetPort", KindSubnetPort, wrapInitializeSubnetPort(commonService))
+	loggedAdd("SubnetPortSetting", KindSubnetPortSetting, wrapInitializeSubnetPortSetting(commonService))
+	loggedAdd("SubnetBinding", KindSubnetBinding, wrapInitializeSubnetBinding(commonService))
+	loggedAdd("SubnetIPReservation", KindSubnetIPReservation, wrapInitializeSubnetIPReservation(commonService))
+	loggedAdd("VPCEndpoint", KindVPCEndpoint, wrapInitializeVPCEndpoint(commonService))
+	loggedAdd("SubnetService", KindSubnet, wrapInitializeSubnetService(commonService))
+	loggedAdd("SecurityPolicy", KindSecurityPolicy, wrapInitializeSecurityPolicy(commonService))
+	loggedAdd("StaticRoute", KindStaticRoute, wrapInitializeStaticRoute(commonService))
+	loggedAdd("VPC", KindVPC, wrapInitializeVPC(commonService))
+	loggedAdd("IPAddressAllocation", KindIPAddressAllocation, wrapInitializeIPAddressAllocation(commonService))
+	loggedAdd("DNSRecord", KindDNSRecord, wrapInitializeDNSRecordService(commonService))
+	loggedAdd("Inventory", KindInventory, wrapInitializeInventory(commonService))
+	loggedAdd("LBInfraCleaner", KindLBInfra, wrapInitializeLBInfraCleaner(commonService))
+	loggedAdd("HealthCleaner", KindHealth, wrapInitializeHealthCleaner(commonService))
+	loggedAdd("NSXServiceAccount", KindNSXServiceAccount, wrapInitializeNSXServiceAccount(commonService))
 
 	log.Info("Cleanup service initialization summary",
 		"vpcPreCleaners", len(cleanupService.vpcPreCleaners),
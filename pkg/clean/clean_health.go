@@ -10,6 +10,8 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
+const healthStatusResourceType = "ContainerClusterStatus"
+
 // HealthCleaner is responsible for cleaning up health checker resources
 type HealthCleaner struct {
 	Service   common.Service
@@ -29,11 +31,24 @@ func NewHealthCleaner(service common.Service, log *logr.Logger, nsxClient *nsx.C
 }
 
 // CleanupHealthResources deletes the health status resource from NSX
-func (h *HealthCleaner) CleanupHealthResources(_ context.Context) error {
+func (h *HealthCleaner) CleanupHealthResources(ctx context.Context) error {
 	// Delete the health status resource from NSX
 	if h.nsxClient != nil && h.clusterID != "" {
 		url := fmt.Sprintf("api/v1/systemhealth/container-cluster/%s/ncp/status", h.clusterID)
-		if err := h.nsxClient.Cluster.HttpDelete(url); err != nil {
+		// The health status is for the whole cluster, so it is kept in a scoped cleanup.
+		if common.CleanupFilterFromContext(ctx).IsScoped() {
+			h.log.Info("Skipping health status resource cleanup in scoped cleanup", "clusterID", h.clusterID)
+			return nil
+		}
+		report := common.CleanupReportFromContext(ctx)
+		if report.IsDryRun() {
+			h.log.Info("Skipping deletion of health status resource in dry-run mode", "clusterID", h.clusterID)
+			report.Record(healthStatusResourceType, h.clusterID, "", nil)
+			return nil
+		}
+		err := h.nsxClient.Cluster.HttpDelete(url)
+		report.Record(healthStatusResourceType, h.clusterID, "", err)
+		if err != nil {
 			h.log.Error(err, "Failed to delete health status resource from NSX", "clusterID", h.clusterID, "url", url)
 			return err
 		}
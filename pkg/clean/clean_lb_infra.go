@@ -316,6 +316,8 @@ func (s *LBInfraCleaner) cleanupLBAppProfiles(ctx context.Context) error {
 		return err
 	}
 	s.log.Info("Cleaning up lbAppProfiles", "Count", len(lbAppProfiles))
+	report := common.CleanupReportFromContext(ctx)
+	filter := common.CleanupFilterFromContext(ctx)
 	var delErr error
 	successCount := 0
 	failedCount := 0
@@ -332,7 +334,21 @@ func (s *LBInfraCleaner) cleanupLBAppProfiles(ctx context.Context) error {
 			}
 			profileType := lbAppProfile.ResourceType
 			s.log.Info("Attempting to delete LB app profile", "profileID", id, "profileName", name, "profileType", profileType)
-			if err := s.NSXClient.LbAppProfileClient.Delete(id, &forceDelete); err != nil {
+			path := ""
+			if lbAppProfile.Path != nil {
+				path = *lbAppProfile.Path
+			}
+			if !filter.MatchResource(path, lbAppProfile.Tags) {
+				continue
+			}
+			if report.IsDryRun() {
+				s.log.Info("Skipping deletion of LB app profile in dry-run mode", "profileID", id, "profileName", name, "profileType", profileType)
+				report.Record(profileType, id, path, nil)
+				continue
+			}
+			err := s.NSXClient.LbAppProfileClient.Delete(id, &forceDelete)
+			report.Record(profileType, id, path, err)
+			if err != nil {
 				s.log.Error(err, "Failed to delete LB app profile", "profileID", id, "profileName", name, "profileType", profileType)
 				failedCount++
 				delErr = err
@@ -359,6 +375,8 @@ func (s *LBInfraCleaner) cleanupLBPersistenceProfiles(ctx context.Context) error
 		return err
 	}
 	s.log.Info("Cleaning up lbPersistenceProfiles", "Count", len(lbPersistenceProfiles))
+	report := common.CleanupReportFromContext(ctx)
+	filter := common.CleanupFilterFromContext(ctx)
 	var delErr error
 	successCount := 0
 	failedCount := 0
@@ -374,7 +392,21 @@ func (s *LBInfraCleaner) cleanupLBPersistenceProfiles(ctx context.Context) error
 				name = *lbPersistenceProfile.DisplayName
 			}
 			profileType := lbPersistenceProfile.ResourceType
-			if err := s.NSXClient.LbPersistenceProfilesClient.Delete(*lbPersistenceProfile.Id, &forceDelete); err != nil {
+			path := ""
+			if lbPersistenceProfile.Path != nil {
+				path = *lbPersistenceProfile.Path
+			}
+			if !filter.MatchResource(path, lbPersistenceProfile.Tags) {
+				continue
+			}
+			if report.IsDryRun() {
+				s.log.Info("Skipping deletion of LB persistence profile in dry-run mode", "profileID", id, "profileName", name, "profileType", profileType)
+				report.Record(profileType, id, path, nil)
+				continue
+			}
+			err := s.NSXClient.LbPersistenceProfilesClient.Delete(id, &forceDelete)
+			report.Record(profileType, id, path, err)
+			if err != nil {
 				s.log.Error(err, "Failed to delete LB persistence profile", "profileID", id, "profileName", name, "profileType", profileType)
 				failedCount++
 				delErr = err
@@ -401,6 +433,8 @@ func (s *LBInfraCleaner) cleanupLBMonitorProfiles(ctx context.Context) error {
 		return err
 	}
 	s.log.Info("Cleaning up lbMonitorProfiles", "Count", len(lbMonitorProfiles))
+	report := common.CleanupReportFromContext(ctx)
+	filter := common.CleanupFilterFromContext(ctx)
 	var delErr error
 	successCount := 0
 	failedCount := 0
@@ -416,7 +450,21 @@ func (s *LBInfraCleaner) cleanupLBMonitorProfiles(ctx context.Context) error {
 				name = *lbMonitorProfile.DisplayName
 			}
 			profileType := lbMonitorProfile.ResourceType
-			if err := s.NSXClient.LbMonitorProfilesClient.Delete(id, &forceDelete); err != nil {
+			path := ""
+			if lbMonitorProfile.Path != nil {
+				path = *lbMonitorProfile.Path
+			}
+			if !filter.MatchResource(path, lbMonitorProfile.Tags) {
+				continue
+			}
+			if report.IsDryRun() {
+				s.log.Info("Skipping deletion of LB monitor profile in dry-run mode", "profileID", id, "profileName", name, "profileType", profileType)
+				report.Record(profileType, id, path, nil)
+				continue
+			}
+			err := s.NSXClient.LbMonitorProfilesClient.Delete(id, &forceDelete)
+			report.Record(profileType, id, path, err)
+			if err != nil {
 				s.log.Error(err, "Failed to delete LB monitor profile", "profileID", id, "profileName", name, "profileType", profileType)
 				failedCount++
 				delErr = err
@@ -160,6 +160,7 @@ func TestCleanupInfraDLBResources(t *testing.T) {
 	validCtx := context.Background()
 	invalidCtx, cancelFn := context.WithCancel(validCtx)
 	cancelFn()
+	dryRunCtx := common.WithCleanupReport(validCtx, common.NewCleanupReport(true))
 
 	for _, tc := range []struct {
 		name           string
@@ -530,6 +531,23 @@ func TestCleanupInfraDLBResources(t *testing.T) {
 				return patches
 			},
 		},
+		{
+			name: "dry-run with cleanupLBAppProfiles",
+			ctx:  dryRunCtx,
+			cleanupFn: func(svc *LBInfraCleaner) func(ctx context.Context) error {
+				return svc.cleanupLBAppProfiles
+			},
+			queriedObjects: []*model.LBHttpProfile{appProfile},
+			mockFn: func(svc *LBInfraCleaner, queryResponse model.SearchResponse, mockQueryClient *mocks.MockQueryClient) *gomonkey.Patches {
+				query := "resource_type:(LBHttpProfile OR LBFastTcpProfile OR LBFastUdpProfile) AND tags.scope:ncp\\/cluster AND tags.tag:k8scl-one\\:test"
+				mockQueryClient.EXPECT().List(query, gomock.Any(), nil, gomock.Any(), nil, nil).Return(queryResponse, nil)
+				patches := gomonkey.ApplyMethod(svc.NSXClient.LbAppProfileClient, "Delete", func(_ interface{}, _ string, _ *bool) error {
+					require.Fail(t, "LB app profile should not be deleted in dry-run mode")
+					return nil
+				})
+				return patches
+			},
+		},
 		{
 			name: "timed out with cleanupLBAppProfiles",
 			ctx:  invalidCtx,
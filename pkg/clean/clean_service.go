@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
@@ -24,7 +25,9 @@ type CleanupService struct {
 	vpcChildrenCleaners []vpcChildrenCleaner
 	infraCleaners       []infraCleaner
 	healthCleaners      []healthCleaner
-	svcErr              error
+	// cleanerKinds is the kind of the registered cleaners, it is used to select the cleaners with the CleanupFilter.
+	cleanerKinds map[interface{}]string
+	svcErr       error
 }
 
 func NewCleanupService() *CleanupService {
@@ -32,6 +35,12 @@ func NewCleanupService() *CleanupService {
 }
 
 func (c *CleanupService) AddCleanupService(f cleanupFunc) *CleanupService {
+	return c.AddCleanupServiceWithKind("", f)
+}
+
+// AddCleanupServiceWithKind adds the cleaners with the kind, the cleaners without kind are only selected if the cleanup
+// is not limited to some kinds.
+func (c *CleanupService) AddCleanupServiceWithKind(kind string, f cleanupFunc) *CleanupService {
 	if c.svcErr != nil {
 		return c
 	}
@@ -41,6 +50,12 @@ func (c *CleanupService) AddCleanupService(f cleanupFunc) *CleanupService {
 	if c.svcErr != nil {
 		return c
 	}
+	if kind != "" {
+		if c.cleanerKinds == nil {
+			c.cleanerKinds = make(map[interface{}]string)
+		}
+		c.cleanerKinds[clean] = kind
+	}
 
 	if svc, ok := clean.(vpcPreCleaner); ok {
 		c.vpcPreCleaners = append(c.vpcPreCleaners, svc)
@@ -58,6 +73,27 @@ func (c *CleanupService) AddCleanupService(f cleanupFunc) *CleanupService {
 	return c
 }
 
+// selectCleaners removes the cleaners whose kind is not selected by the CleanupFilter.
+func (c *CleanupService) selectCleaners(filter *common.CleanupFilter) {
+	selected := func(cleaner interface{}) bool {
+		return filter.MatchKind(c.cleanerKinds[cleaner])
+	}
+	c.vpcPreCleaners = filterCleaners(c.vpcPreCleaners, selected)
+	c.vpcChildrenCleaners = filterCleaners(c.vpcChildrenCleaners, selected)
+	c.infraCleaners = filterCleaners(c.infraCleaners, selected)
+	c.healthCleaners = filterCleaners(c.healthCleaners, selected)
+}
+
+func filterCleaners[T any](cleaners []T, selected func(cleaner interface{}) bool) []T {
+	var result []T
+	for _, cleaner := range cleaners {
+		if selected(cleaner) {
+			result = append(result, cleaner)
+		}
+	}
+	return result
+}
+
 func (c *CleanupService) retriable(err error) bool {
 	if err != nil && !errors.As(err, &nsxutil.TimeoutFailed) {
 		c.log.Info("Retrying to clean up NSX resources", "error", err)
@@ -99,11 +135,20 @@ func (c *CleanupService) cleanupVPCResourcesByVPCPath(ctx context.Context, vpcPa
 		// Extract VPC ID from path for better logging
 		vpcID := extractIDFromPath(vpcPath)
 		c.log.Info("Attempting to delete VPC", "vpcPath", vpcPath, "vpcID", vpcID)
-		if err := c.vpcService.DeleteVPC(vpcPath); err != nil {
-			c.log.Error(err, "Failed to delete VPC on NSX", "vpcPath", vpcPath, "vpcID", vpcID)
-			return err
+		report := common.CleanupReportFromContext(ctx)
+		if report.IsDryRun() {
+			// The VPC children are deleted recursively with the VPC, so only the VPC is reported in dry-run mode.
+			c.log.Info("Skipping deletion of VPC in dry-run mode", "vpcPath", vpcPath, "vpcID", vpcID)
+			report.Record(common.ResourceTypeVpc, vpcID, vpcPath, nil)
+		} else {
+			err := c.vpcService.DeleteVPC(ctx, vpcPath)
+			report.Record(common.ResourceTypeVpc, vpcID, vpcPath, err)
+			if err != nil {
+				c.log.Error(err, "Failed to delete VPC on NSX", "vpcPath", vpcPath, "vpcID", vpcID)
+				return err
+			}
+			c.log.Info("Successfully deleted VPC", "vpcPath", vpcPath, "vpcID", vpcID)
 		}
-		c.log.Info("Successfully deleted VPC", "vpcPath", vpcPath, "vpcID", vpcID)
 	}
 
 	cleanersCount := len(c.vpcChildrenCleaners)
@@ -180,7 +225,7 @@ func (c *CleanupService) cleanupAutoCreatedVPCs(ctx context.Context) error {
 	queue := workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]())
 	defer queue.ShutDown()
 
-	autoCreatedVPCs := c.vpcService.ListAutoCreatedVPCPaths()
+	autoCreatedVPCs := c.listAutoCreatedVPCPathsToClean(ctx)
 	if autoCreatedVPCs.Len() == 0 {
 		return nil
 	}
@@ -211,6 +256,26 @@ func (c *CleanupService) cleanupAutoCreatedVPCs(ctx context.Context) error {
 	return nil
 }
 
+// listAutoCreatedVPCPathsToClean returns the paths of the auto-created VPCs which are deleted recursively. If the cleanup
+// is scoped, only the VPCs matching the CleanupFilter are deleted, and the children resources in the other VPCs are
+// deleted with the pre-created VPCs.
+func (c *CleanupService) listAutoCreatedVPCPathsToClean(ctx context.Context) sets.Set[string] {
+	filter := common.CleanupFilterFromContext(ctx)
+	if !filter.MatchKind(KindVPC) {
+		return sets.New[string]()
+	}
+	if !filter.IsScoped() {
+		return c.vpcService.ListAutoCreatedVPCPaths()
+	}
+	vpcPaths := sets.New[string]()
+	for _, vpc := range c.vpcService.ListVPC() {
+		if vpc.Path != nil && filter.MatchResource(*vpc.Path, vpc.Tags) {
+			vpcPaths.Insert(*vpc.Path)
+		}
+	}
+	return vpcPaths
+}
+
 // cleanupVPCResources cleans up the VPCs and their children resources created by nsx-operator.
 func (c *CleanupService) cleanupVPCResources(ctx context.Context) error {
 	// Clean up the indirect VPC children resources before deleting the VPCs, otherwise, it may block VPC deletion request
@@ -222,7 +287,7 @@ func (c *CleanupService) cleanupVPCResources(ctx context.Context) error {
 	c.log.Info("Successfully deleted resources before deleting VPCs", "resourceCount", resourceCount)
 
 	// Clean up the auto-created VPC and its children resources
-	autoCreatedVPCCount := c.vpcService.ListAutoCreatedVPCPaths().Len()
+	autoCreatedVPCCount := c.listAutoCreatedVPCPathsToClean(ctx).Len()
 	if err := c.cleanupAutoCreatedVPCs(ctx); err != nil {
 		c.log.Error(err, "Failed to delete the auto created VPCs and their child resources", "vpcCount", autoCreatedVPCCount)
 		return err
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
 func mockCleanupFunc() (interface{}, error) {
@@ -40,3 +42,19 @@ func TestAddCleanupService_Error(t *testing.T) {
 	assert.Len(t, service.vpcChildrenCleaners, 0)
 	assert.Len(t, service.infraCleaners, 0)
 }
+
+func TestSelectCleaners(t *testing.T) {
+	service := NewCleanupService()
+	service.AddCleanupServiceWithKind(KindSubnetPort, mockCleanupFunc)
+	service.AddCleanupServiceWithKind(KindDNSRecord, mockCleanupFunc)
+	service.AddCleanupService(mockCleanupFunc)
+
+	service.selectCleaners(common.NewCleanupFilter([]string{"ns-1"}, nil, nil))
+	assert.Len(t, service.vpcPreCleaners, 3)
+
+	service.selectCleaners(common.NewCleanupFilter(nil, nil, []string{"SubnetPort"}))
+	assert.Len(t, service.vpcPreCleaners, 1)
+	assert.Len(t, service.vpcChildrenCleaners, 1)
+	assert.Len(t, service.infraCleaners, 1)
+	assert.Equal(t, KindSubnetPort, service.cleanerKinds[service.vpcPreCleaners[0]])
+}
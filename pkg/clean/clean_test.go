@@ -9,6 +9,7 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
@@ -24,7 +25,9 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetipreservation"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetportsetting"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpcendpoint"
 )
 
 var (
@@ -119,7 +122,7 @@ func TestClean_Cleanup(t *testing.T) {
 	patches.ApplyMethod(reflect.TypeOf(cleanupService.vpcService), "ListAutoCreatedVPCPaths", func(_ *vpc.VPCService) sets.Set[string] {
 		return sets.New[string]("/orgs/default/projects/p1/vpcs/vpc-1")
 	})
-	patches.ApplyMethod(reflect.TypeOf(cleanupService.vpcService), "DeleteVPC", func(_ *vpc.VPCService, path string) error {
+	patches.ApplyMethod(reflect.TypeOf(cleanupService.vpcService), "DeleteVPC", func(_ *vpc.VPCService, _ context.Context, path string) error {
 		return nil
 	})
 
@@ -131,6 +134,122 @@ func TestClean_Cleanup(t *testing.T) {
 	assert.ElementsMatch(t, []string{"/orgs/default/projects/p1/vpcs/vpc-1", ""}, clean.cleanedVPCs)
 }
 
+func TestClean_InvalidFilter(t *testing.T) {
+	log := logr.Discard()
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(cf.NsxConfig), "ValidateConfigFromCmd", func(_ *config.NsxConfig) error {
+		return nil
+	})
+	defer patches.Reset()
+
+	ctx := common.WithCleanupFilter(context.Background(), common.NewCleanupFilter(nil, nil, []string{"subnetport", "unknown"}))
+	err := Clean(ctx, cf, &log, false, 0)
+	assert.ErrorContains(t, err, "unknown cleanup kinds [unknown]")
+
+	ctx = common.WithCleanupFilter(context.Background(), common.NewCleanupFilter(nil, []string{"/orgs/default/projects/p1"}, nil))
+	err = Clean(ctx, cf, &log, false, 0)
+	assert.ErrorContains(t, err, "invalid VPC path \"/orgs/default/projects/p1\"")
+}
+
+func TestClean_CleanupWithFilter(t *testing.T) {
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(cf.NsxConfig), "ValidateConfigFromCmd", func(_ *config.NsxConfig) error {
+		return nil
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(nsx.GetClient, func(_ *config.NSXOperatorConfig) *nsx.Client {
+		return &nsx.Client{}
+	})
+
+	cleanupService := &CleanupService{
+		vpcService: &vpc.VPCService{},
+	}
+	subnetClean := &MockCleanup{}
+	securityPolicyClean := &MockCleanup{}
+	cleanupService.AddCleanupServiceWithKind(KindSubnet, func() (interface{}, error) {
+		return subnetClean, nil
+	})
+	cleanupService.AddCleanupServiceWithKind(KindSecurityPolicy, func() (interface{}, error) {
+		return securityPolicyClean, nil
+	})
+
+	patches.ApplyFunc(InitializeCleanupService, func(_ *config.NSXOperatorConfig, _ *nsx.Client, _ *logr.Logger) (*CleanupService, error) {
+		return cleanupService, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(cleanupService.vpcService), "ListAutoCreatedVPCPaths", func(_ *vpc.VPCService) sets.Set[string] {
+		return sets.New[string]("/orgs/default/projects/p1/vpcs/vpc-1")
+	})
+	patches.ApplyMethod(reflect.TypeOf(cleanupService.vpcService), "DeleteVPC", func(_ *vpc.VPCService, _ context.Context, path string) error {
+		assert.Fail(t, "VPC should not be deleted if kind vpc is not selected")
+		return nil
+	})
+
+	ctx := common.WithCleanupFilter(context.Background(), common.NewCleanupFilter(nil, nil, []string{KindSubnet}))
+	err := Clean(ctx, cf, nil, false, 0)
+	assert.Nil(t, err)
+	assert.True(t, subnetClean.vpcPreCleanupCalled)
+	assert.True(t, subnetClean.infraCleanupCalled)
+	// The children resources in the auto-created VPCs are deleted on NSX with the pre-created VPCs.
+	assert.Equal(t, []string{""}, subnetClean.cleanedVPCs)
+	assert.False(t, securityPolicyClean.vpcPreCleanupCalled)
+	assert.False(t, securityPolicyClean.vpcChildrenCleanupCalled)
+	assert.False(t, securityPolicyClean.infraCleanupCalled)
+}
+
+func TestListAutoCreatedVPCPathsToClean(t *testing.T) {
+	vpcService := &vpc.VPCService{}
+	cleanupService := &CleanupService{vpcService: vpcService}
+	allVPCPaths := sets.New[string]("/orgs/default/projects/p1/vpcs/vpc-1", "/orgs/default/projects/p1/vpcs/vpc-2")
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(vpcService), "ListAutoCreatedVPCPaths", func(_ *vpc.VPCService) sets.Set[string] {
+		return allVPCPaths
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(vpcService), "ListVPC", func(_ *vpc.VPCService) []model.Vpc {
+		return []model.Vpc{
+			{
+				Path: common.String("/orgs/default/projects/p1/vpcs/vpc-1"),
+				Tags: []model.Tag{{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-1")}},
+			},
+			{
+				Path: common.String("/orgs/default/projects/p1/vpcs/vpc-2"),
+				Tags: []model.Tag{{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-2")}},
+			},
+		}
+	})
+
+	for _, tc := range []struct {
+		name     string
+		filter   *common.CleanupFilter
+		expPaths sets.Set[string]
+	}{
+		{
+			name:     "no filter",
+			expPaths: allVPCPaths,
+		},
+		{
+			name:     "kind vpc not selected",
+			filter:   common.NewCleanupFilter(nil, nil, []string{KindSubnetPort}),
+			expPaths: sets.New[string](),
+		},
+		{
+			name:     "filter with Namespace",
+			filter:   common.NewCleanupFilter([]string{"ns-2"}, nil, nil),
+			expPaths: sets.New[string]("/orgs/default/projects/p1/vpcs/vpc-2"),
+		},
+		{
+			name:     "filter with VPC path",
+			filter:   common.NewCleanupFilter(nil, []string{"/orgs/default/projects/p1/vpcs/vpc-1"}, []string{KindVPC}),
+			expPaths: sets.New[string]("/orgs/default/projects/p1/vpcs/vpc-1"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.filter != nil {
+				ctx = common.WithCleanupFilter(ctx, tc.filter)
+			}
+			assert.Equal(t, tc.expPaths, cleanupService.listAutoCreatedVPCPathsToClean(ctx))
+		})
+	}
+}
+
 type MockCleanup struct {
 	CleanupFunc              func(ctx context.Context) error
 	vpcPreCleanupCalled      bool
@@ -197,6 +316,12 @@ func TestInitializeCleanupService_Success(t *testing.T) {
 	patches.ApplyFunc(subnetipreservation.InitializeService, func(service common.Service) (*subnetipreservation.IPReservationService, error) {
 		return &subnetipreservation.IPReservationService{}, nil
 	})
+	patches.ApplyFunc(subnetportsetting.InitializeService, func(service common.Service) (*subnetportsetting.SubnetPortSettingService, error) {
+		return &subnetportsetting.SubnetPortSettingService{}, nil
+	})
+	patches.ApplyFunc(vpcendpoint.InitializeVPCEndpointService, func(service common.Service, vpcService common.VPCServiceProvider, ipAllocationService common.IPAddressAllocationServiceProvider) (*vpcendpoint.VPCEndpointService, error) {
+		return &vpcendpoint.VPCEndpointService{}, nil
+	})
 	patches.ApplyFunc(inventory.InitializeService, func(service common.Service, _ bool) (*inventory.InventoryService, error) {
 		return &inventory.InventoryService{}, nil
 	})
@@ -217,8 +342,8 @@ func TestInitializeCleanupService_Success(t *testing.T) {
 	cleanupService, err := InitializeCleanupService(cf, nsxClient, &log)
 	assert.NoError(t, err)
 	assert.NotNil(t, cleanupService)
-	// vpcPreCleaners: SubnetPort, SubnetBinding, SubnetIPReservation, Inventory, SecurityPolicy, LBInfraCleaner, NSXServiceAccount, HealthCleaner = 8
-	assert.Len(t, cleanupService.vpcPreCleaners, 7)
+	// vpcPreCleaners: SubnetPort, SubnetPortSetting, SubnetBinding, SubnetIPReservation, VPCEndpoint, Inventory, SecurityPolicy, NSXServiceAccount, HealthCleaner = 9
+	assert.Len(t, cleanupService.vpcPreCleaners, 9)
 	assert.Len(t, cleanupService.vpcChildrenCleaners, 5)
 	assert.Len(t, cleanupService.infraCleaners, 3)
 }
@@ -258,14 +383,20 @@ func TestInitializeCleanupService_VPCError(t *testing.T) {
 	patches.ApplyFunc(subnetipreservation.InitializeService, func(service common.Service) (*subnetipreservation.IPReservationService, error) {
 		return &subnetipreservation.IPReservationService{}, nil
 	})
+	patches.ApplyFunc(subnetportsetting.InitializeService, func(service common.Service) (*subnetportsetting.SubnetPortSettingService, error) {
+		return &subnetportsetting.SubnetPortSettingService{}, nil
+	})
+	patches.ApplyFunc(vpcendpoint.InitializeVPCEndpointService, func(service common.Service, vpcService common.VPCServiceProvider, ipAllocationService common.IPAddressAllocationServiceProvider) (*vpcendpoint.VPCEndpointService, error) {
+		return &vpcendpoint.VPCEndpointService{}, nil
+	})
 
 	cleanupService, err := InitializeCleanupService(cf, nsxClient, &log)
 	assert.NoError(t, err)
 	assert.NotNil(t, cleanupService)
 	// Note, the services added after VPCService should fail because of the error returned in `InitializeVPC`.
 	assert.Len(t, cleanupService.vpcChildrenCleaners, 3)
-	// vpcPreCleaners: SubnetPort, SubnetBinding, SubnetIPReservation, SecurityPolicy = 4 (services initialized before VPC error)
-	assert.Len(t, cleanupService.vpcPreCleaners, 4)
+	// vpcPreCleaners: SubnetPort, SubnetPortSetting, SubnetBinding, SubnetIPReservation, VPCEndpoint, SecurityPolicy = 6 (services initialized before VPC error)
+	assert.Len(t, cleanupService.vpcPreCleaners, 6)
 	assert.Len(t, cleanupService.infraCleaners, 1)
 	assert.Equal(t, expectedError, cleanupService.svcErr)
 }
@@ -0,0 +1,39 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package clean
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	ReportFormatJSON = "json"
+	ReportFormatYAML = "yaml"
+)
+
+// WriteReport writes the cleanup report to w in the given format, json or yaml.
+func WriteReport(w io.Writer, report *common.CleanupReport, format string) error {
+	var data []byte
+	var err error
+	switch format {
+	case ReportFormatJSON:
+		data, err = json.MarshalIndent(report.Result(), "", "  ")
+		data = append(data, '\n')
+	case ReportFormatYAML:
+		data, err = yaml.Marshal(report.Result())
+	default:
+		return fmt.Errorf("unsupported report format %q, it should be %s or %s", format, ReportFormatJSON, ReportFormatYAML)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
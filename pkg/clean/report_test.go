@@ -0,0 +1,70 @@
+package clean
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestWriteReport(t *testing.T) {
+	report := common.NewCleanupReport(false)
+	report.Record(common.ResourceTypeVpc, "vpc1", "/orgs/default/projects/p1/vpcs/vpc1", nil)
+	report.Record(common.ResourceTypeVpc, "vpc2", "/orgs/default/projects/p1/vpcs/vpc2", fmt.Errorf("NSX returned an error"))
+
+	t.Run("json", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, WriteReport(buf, report, ReportFormatJSON))
+		expected := `{
+  "dryRun": false,
+  "summary": {
+    "planned": 0,
+    "deleted": 1,
+    "failed": 1
+  },
+  "resources": {
+    "Vpc": {
+      "/orgs/default/projects/p1/vpcs/vpc1": [
+        {
+          "id": "vpc1",
+          "path": "/orgs/default/projects/p1/vpcs/vpc1",
+          "status": "Deleted"
+        }
+      ],
+      "/orgs/default/projects/p1/vpcs/vpc2": [
+        {
+          "id": "vpc2",
+          "path": "/orgs/default/projects/p1/vpcs/vpc2",
+          "status": "Failed",
+          "error": "NSX returned an error"
+        }
+      ]
+    }
+  }
+}
+`
+		assert.Equal(t, expected, buf.String())
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, WriteReport(buf, common.NewCleanupReport(true), ReportFormatYAML))
+		expected := `dryRun: true
+resources: {}
+summary:
+  deleted: 0
+  failed: 0
+  planned: 0
+`
+		assert.Equal(t, expected, buf.String())
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		err := WriteReport(&bytes.Buffer{}, report, "xml")
+		assert.ErrorContains(t, err, "unsupported report format")
+	})
+}
@@ -2,6 +2,8 @@ package clean
 
 import (
 	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 const (
@@ -9,6 +11,33 @@ const (
 	maxRetries        = 12
 )
 
+// The kinds of the cleaners, which are used to limit the cleanup to some resource types.
+const (
+	KindSubnetPort          = "subnetport"
+	KindSubnetPortSetting   = "subnetportsetting"
+	KindSubnetBinding       = "subnetbinding"
+	KindSubnetIPReservation = "subnetipreservation"
+	KindVPCEndpoint         = "vpcendpoint"
+	KindSubnet              = "subnet"
+	KindSecurityPolicy      = "securitypolicy"
+	KindStaticRoute         = "staticroute"
+	// KindVPC is for the auto-created VPCs which are deleted recursively with all the children, and the SLB resources
+	// in the pre-created VPCs.
+	KindVPC                 = "vpc"
+	KindIPAddressAllocation = "ipaddressallocation"
+	KindDNSRecord           = "dnsrecord"
+	KindInventory           = "inventory"
+	KindLBInfra             = "lbinfra"
+	KindHealth              = "health"
+	KindNSXServiceAccount   = "nsxserviceaccount"
+)
+
+var CleanupKinds = sets.New[string](
+	KindSubnetPort, KindSubnetPortSetting, KindSubnetBinding, KindSubnetIPReservation, KindVPCEndpoint, KindSubnet,
+	KindSecurityPolicy, KindStaticRoute, KindVPC, KindIPAddressAllocation, KindDNSRecord, KindInventory, KindLBInfra,
+	KindHealth, KindNSXServiceAccount,
+)
+
 type vpcPreCleaner interface {
 	// CleanupBeforeVPCDeletion is called to clean up the VPC resources which may block the VPC recursive deletion, or
 	// break the parallel deletion with other resource types, e.g., VpcSubnetPort, SubnetConnectionBindingMap, LBVirtualServer.
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/zap"
@@ -21,7 +22,6 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/auth/jwt"
 )
 
-// TODO replace to yaml
 const (
 	nsxOperatorDefaultConf = "/etc/nsx-operator/nsxop.ini"
 	vcHostCACertPath       = "/etc/vmware/wcp/tls/vmca.pem"
@@ -52,6 +52,7 @@ type NSXOperatorConfig struct {
 	*K8sConfig
 	*VCConfig
 	*HAConfig
+	*TracingConfig
 	configCache configCache
 	LibMode     bool
 }
@@ -69,6 +70,8 @@ func (operatorConfig *NSXOperatorConfig) HAEnabled() bool {
 }
 
 func (operatorConfig *NSXOperatorConfig) GetCACert() []byte {
+	operatorConfig.configCache.lock.Lock()
+	defer operatorConfig.configCache.lock.Unlock()
 	ca := operatorConfig.configCache.nsxCA
 	if ca == nil {
 		ca = []byte{}
@@ -93,65 +96,84 @@ func (operatorConfig *NSXOperatorConfig) GetCACert() []byte {
 type configCache struct {
 	// nsxCA stores all file contents of NsxConfig.CaFile in a byte slice
 	nsxCA []byte
+	// lock guards nsxCA and the options which are changed when the configuration file is reloaded.
+	lock sync.RWMutex
 }
 
 type DefaultConfig struct {
-	Debug bool `ini:"debug"`
+	Debug bool `ini:"debug" json:"debug,omitempty"`
 }
 
 type CoeConfig struct {
-	Cluster          string `ini:"cluster"`
-	EnableVPCNetwork bool   `ini:"enable_vpc_network"`
-	EnableSha        bool   `ini:"enable_sha"`
+	Cluster          string `ini:"cluster" json:"cluster,omitempty"`
+	EnableVPCNetwork bool   `ini:"enable_vpc_network" json:"enable_vpc_network,omitempty"`
+	EnableSha        bool   `ini:"enable_sha" json:"enable_sha"`
 }
 
 type NsxConfig struct {
-	NsxApiUser                string   `ini:"nsx_api_user"`
-	NsxApiPassword            string   `ini:"nsx_api_password"`
-	NsxApiCertFile            string   `ini:"nsx_api_cert_file"`
-	NsxApiPrivateKeyFile      string   `ini:"nsx_api_private_key_file"`
-	NsxApiManagers            []string `ini:"nsx_api_managers"`
-	CaFile                    []string `ini:"ca_file"`
-	LeafCertFile              []string `ini:"nsx_leaf_cert_file"`
-	Thumbprint                []string `ini:"thumbprint"`
-	Insecure                  bool     `ini:"insecure"`
-	SingleTierSrTopology      bool     `ini:"single_tier_sr_topology"`
-	EnforcementPoint          string   `ini:"enforcement_point"`
-	DefaultProject            string   `ini:"default_project"`
-	DefaultSubnetSize         int      `ini:"default_subnet_size"`
-	HttpTimeout               int      `ini:"http_timeout"`
-	EnvoyHost                 string   `ini:"envoy_host"`
-	EnvoyPort                 int      `ini:"envoy_port"`
-	LicenseValidationInterval int      `ini:"license_validation_interval"`
-	UseAVILoadBalancer        bool     `ini:"use_avi_lb"`
-	UseNSXLoadBalancer        *bool    `ini:"use_native_loadbalancer"`
-	RelaxNSXLBScaleValication bool     `ini:"relax_scale_validation"`
-	NSXLBSize                 string   `ini:"service_size"`
-	InventoryBatchPeriod      int      `ini:"inventory_batch_period"`
-	InventoryBatchSize        int      `ini:"inventory_batch_size"`
-	EnableInventory           bool     `ini:"enable_inventory"`
+	NsxApiUser                string   `ini:"nsx_api_user" json:"nsx_api_user,omitempty"`
+	NsxApiPassword            string   `ini:"nsx_api_password" json:"nsx_api_password,omitempty"`
+	NsxApiCertFile            string   `ini:"nsx_api_cert_file" json:"nsx_api_cert_file,omitempty"`
+	NsxApiPrivateKeyFile      string   `ini:"nsx_api_private_key_file" json:"nsx_api_private_key_file,omitempty"`
+	NsxApiManagers            []string `ini:"nsx_api_managers" json:"nsx_api_managers,omitempty"`
+	CaFile                    []string `ini:"ca_file" json:"ca_file,omitempty"`
+	LeafCertFile              []string `ini:"nsx_leaf_cert_file" json:"nsx_leaf_cert_file,omitempty"`
+	Thumbprint                []string `ini:"thumbprint" json:"thumbprint,omitempty"`
+	Insecure                  bool     `ini:"insecure" json:"insecure,omitempty"`
+	SingleTierSrTopology      bool     `ini:"single_tier_sr_topology" json:"single_tier_sr_topology,omitempty"`
+	EnforcementPoint          string   `ini:"enforcement_point" json:"enforcement_point,omitempty"`
+	DefaultProject            string   `ini:"default_project" json:"default_project,omitempty"`
+	DefaultSubnetSize         int      `ini:"default_subnet_size" json:"default_subnet_size,omitempty"`
+	HttpTimeout               int      `ini:"http_timeout" json:"http_timeout,omitempty"`
+	EnvoyHost                 string   `ini:"envoy_host" json:"envoy_host,omitempty"`
+	EnvoyPort                 int      `ini:"envoy_port" json:"envoy_port,omitempty"`
+	LicenseValidationInterval int      `ini:"license_validation_interval" json:"license_validation_interval,omitempty"`
+	UseAVILoadBalancer        bool     `ini:"use_avi_lb" json:"use_avi_lb,omitempty"`
+	UseNSXLoadBalancer        *bool    `ini:"use_native_loadbalancer" json:"use_native_loadbalancer,omitempty"`
+	RelaxNSXLBScaleValication bool     `ini:"relax_scale_validation" json:"relax_scale_validation,omitempty"`
+	NSXLBSize                 string   `ini:"service_size" json:"service_size,omitempty"`
+	InventoryBatchPeriod      int      `ini:"inventory_batch_period" json:"inventory_batch_period"`
+	InventoryBatchSize        int      `ini:"inventory_batch_size" json:"inventory_batch_size"`
+	EnableInventory           bool     `ini:"enable_inventory" json:"enable_inventory,omitempty"`
 	// VpcWcpEnhance controls StatefulSet pod SubnetPort behavior together with NSX version.
 	// When omitted (nil), treated as false; only an explicit true enables the enhancement path.
-	VpcWcpEnhance *bool `ini:"vpc_wcp_enhance"`
+	VpcWcpEnhance *bool `ini:"vpc_wcp_enhance" json:"vpc_wcp_enhance,omitempty"`
 	// RestoreVif controls whether SubnetPort vif should be restored during restore mode.
-	RestoreVif *bool `ini:"restore_vif"`
+	RestoreVif *bool `ini:"restore_vif" json:"restore_vif,omitempty"`
 	// TnIdCheckInterval is the interval in seconds to check TN ID for node.
-	TnIdCheckInterval int `ini:"tn_id_check_interval"`
+	TnIdCheckInterval int `ini:"tn_id_check_interval" json:"tn_id_check_interval"`
+	// APIRateMode is the rate limiter of the NSX API requests per endpoint, "aimd" (default) or "fixrate".
+	APIRateMode string `ini:"api_rate_mode" json:"api_rate_mode,omitempty"`
+	// APIRateLimit is the max NSX API requests per second per endpoint, 0 means the max of 100.
+	APIRateLimit int `ini:"api_rate_limit" json:"api_rate_limit,omitempty"`
+	// APIRateClasses are the budgets of the API classes sharing the endpoint rate, as
+	// "<class>:<rate>:<weight>" with the class interactive, background or search. When set,
+	// the classes are served by weighted fair queuing and each class is capped by its rate, 0 for no cap.
+	APIRateClasses []string `ini:"api_rate_classes" json:"api_rate_classes,omitempty"`
+	// SubnetScaleInGracePeriod is the time in seconds an NSX Subnet of an auto-managed SubnetSet
+	// must have no SubnetPort before it is deleted.
+	SubnetScaleInGracePeriod int `ini:"subnet_scale_in_grace_period" json:"subnet_scale_in_grace_period"`
+	// SubnetSetMinSubnets is the number of NSX Subnets an auto-managed SubnetSet keeps when scaling in.
+	SubnetSetMinSubnets int `ini:"subnetset_min_subnets" json:"subnetset_min_subnets"`
+	// FQDNDNSServers are the IPs or CIDRs of the DNS servers the workloads resolve the domain names of
+	// the SecurityPolicy FQDN peers with. When set, an allow rule with FQDN peers is preceded by a rule
+	// allowing the DNS traffic to these servers, from which NSX learns the IPs of the domain names.
+	FQDNDNSServers []string `ini:"fqdn_dns_servers" json:"fqdn_dns_servers,omitempty"`
 }
 
 type K8sConfig struct {
-	BaseLinePolicyType string `ini:"baseline_policy_type"`
-	EnableNCPEvent     bool   `ini:"enable_ncp_event"`
-	EnableVNetCRD      bool   `ini:"enable_vnet_crd"`
-	EnableRestore      bool   `ini:"enable_restore"`
-	EnablePromMetrics  bool   `ini:"enable_prometheus_metrics"`
-	KubeConfigFile     string `ini:"kubeconfig"`
+	BaseLinePolicyType string `ini:"baseline_policy_type" json:"baseline_policy_type,omitempty"`
+	EnableNCPEvent     bool   `ini:"enable_ncp_event" json:"enable_ncp_event,omitempty"`
+	EnableVNetCRD      bool   `ini:"enable_vnet_crd" json:"enable_vnet_crd,omitempty"`
+	EnableRestore      bool   `ini:"enable_restore" json:"enable_restore,omitempty"`
+	EnablePromMetrics  bool   `ini:"enable_prometheus_metrics" json:"enable_prometheus_metrics,omitempty"`
+	KubeConfigFile     string `ini:"kubeconfig" json:"kubeconfig,omitempty"`
 	// Controlled by FSS
-	EnableAntreaNSXInterworking bool `ini:"enable_antrea_nsx_interworking"`
+	EnableAntreaNSXInterworking bool `ini:"enable_antrea_nsx_interworking" json:"enable_antrea_nsx_interworking,omitempty"`
 	// IPFamily is the raw ip_family value from the [k8s] ini section.
 	// Expected values: "IPv4" (default), "IPv6", or "DualStack".
 	// Use GetIPAddressType() to obtain the canonical v1alpha1.IPAddressType.
-	IPFamily string `ini:"ip_family"`
+	IPFamily string `ini:"ip_family" json:"ip_family,omitempty"`
 }
 
 // GetIPAddressType parses the raw IPFamily string and returns the canonical
@@ -177,16 +199,28 @@ func ParseIPFamily(s string) v1alpha1.IPAddressType {
 }
 
 type VCConfig struct {
-	VCEndPoint string `ini:"vc_endpoint"`
-	SsoDomain  string `ini:"sso_domain"`
-	HttpsPort  int    `ini:"https_port"`
-	VCUser     string `ini:"user"`
-	VCPassword string `ini:"password"`
-	VCCAFile   string `ini:"ca_file"`
+	VCEndPoint string `ini:"vc_endpoint" json:"vc_endpoint,omitempty"`
+	SsoDomain  string `ini:"sso_domain" json:"sso_domain,omitempty"`
+	HttpsPort  int    `ini:"https_port" json:"https_port,omitempty"`
+	VCUser     string `ini:"user" json:"user,omitempty"`
+	VCPassword string `ini:"password" json:"password,omitempty"`
+	VCCAFile   string `ini:"ca_file" json:"ca_file,omitempty"`
 }
 
 type HAConfig struct {
-	EnableHA *bool `ini:"enable"`
+	EnableHA *bool `ini:"enable" json:"enable,omitempty"`
+}
+
+// TracingConfig configures the OpenTelemetry traces exported over OTLP.
+type TracingConfig struct {
+	EnableTracing bool `ini:"enable" json:"enable,omitempty"`
+	// OTLPEndpoint is the host:port of the OTLP collector.
+	OTLPEndpoint string `ini:"otlp_endpoint" json:"otlp_endpoint,omitempty"`
+	// OTLPProtocol is "grpc" (default) or "http".
+	OTLPProtocol string `ini:"otlp_protocol" json:"otlp_protocol,omitempty"`
+	OTLPInsecure bool   `ini:"otlp_insecure" json:"otlp_insecure,omitempty"`
+	// SampleRatio is the ratio of the traces which are sampled, from 0 to 1.
+	SampleRatio float64 `ini:"sample_ratio" json:"sample_ratio"`
 }
 
 type Validate interface {
@@ -208,6 +242,43 @@ func UpdateConfigFilePath(configFile string) {
 
 func LoadConfigFromFile() (*NSXOperatorConfig, error) {
 	configLog.Infof("Loading NSX Operator configuration file: %s", configFilePath)
+	nsxOperatorConfig, err := parseConfigFile(configFilePath)
+	if err != nil {
+		return nil, err
+	}
+	// The YAML configuration is validated against the full schema. The INI configuration keeps
+	// the validation it has always had so that existing files are still accepted, the other
+	// errors reported by validate-config are only logged.
+	if isYAMLConfigFile(configFilePath) {
+		if err = nsxOperatorConfig.ValidateAll(); err != nil {
+			return nil, err
+		}
+		return nsxOperatorConfig, nil
+	}
+	if err = nsxOperatorConfig.validate(); err != nil {
+		return nil, err
+	}
+	for _, err = range nsxOperatorConfig.validationErrors() {
+		configLog.Warnf("Invalid NSX Operator configuration, run validate-config for details: %v", err)
+	}
+
+	return nsxOperatorConfig, nil
+}
+
+// parseConfigFile parses the INI or YAML configuration file into a defaulted NSXOperatorConfig
+// without validating it.
+func parseConfigFile(path string) (*NSXOperatorConfig, error) {
+	if isYAMLConfigFile(path) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return parseYAMLConfig(data)
+	}
+	return parseINIConfig(path)
+}
+
+func parseINIConfig(path string) (*NSXOperatorConfig, error) {
 	nsxOperatorConfig := NewNSXOpertorConfig()
 
 	cfg := ini.Empty()
@@ -215,7 +286,7 @@ func LoadConfigFromFile() (*NSXOperatorConfig, error) {
 	if err != nil {
 		return nil, err
 	}
-	cfg, err = ini.Load(configFilePath)
+	cfg, err = ini.Load(path)
 	if err != nil {
 		return nil, err
 	}
@@ -243,11 +314,10 @@ func LoadConfigFromFile() (*NSXOperatorConfig, error) {
 	if err != nil {
 		return nil, err
 	}
-
-	if err := nsxOperatorConfig.validate(); err != nil {
+	err = cfg.Section("tracing").MapTo(nsxOperatorConfig.TracingConfig)
+	if err != nil {
 		return nil, err
 	}
-
 	return nsxOperatorConfig, nil
 }
 
@@ -265,13 +335,16 @@ func NewNSXOpertorConfig() *NSXOperatorConfig {
 		&DefaultConfig{},
 		&CoeConfig{EnableSha: true},
 		&NsxConfig{
-			InventoryBatchPeriod: 5,
-			InventoryBatchSize:   50,
-			TnIdCheckInterval:    300,
+			InventoryBatchPeriod:     5,
+			InventoryBatchSize:       50,
+			TnIdCheckInterval:        300,
+			SubnetScaleInGracePeriod: 300,
+			SubnetSetMinSubnets:      1,
 		},
 		&K8sConfig{},
 		&VCConfig{},
 		&HAConfig{},
+		&TracingConfig{SampleRatio: 1},
 		configCache{},
 		false,
 	}
@@ -285,6 +358,9 @@ func (operatorConfig *NSXOperatorConfig) validate() error {
 	if err := operatorConfig.NsxConfig.validate(operatorConfig.CoeConfig.EnableVPCNetwork); err != nil {
 		return err
 	}
+	if errs := operatorConfig.TracingConfig.validateSchema(); len(errs) > 0 {
+		return errs[0]
+	}
 	// TODO, verify if user&pwd, cert, jwt has any of them provided
 	return nil
 }
@@ -0,0 +1,109 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package config
+
+import (
+	"reflect"
+	"sort"
+)
+
+// reloadableFields are the options, as "<section>.<key>", which are applied at runtime
+// when the configuration file is changed. Changing any other option requires a restart.
+// The value is true for the options of the NSX managers the NSX client connects to and of its
+// HTTP requests, changing them rebuilds the NSX cluster endpoints.
+var reloadableFields = map[string]bool{
+	"DEFAULT.debug":                 false,
+	"nsx_v3.nsx_api_managers":       true,
+	"nsx_v3.nsx_api_user":           true,
+	"nsx_v3.nsx_api_password":       true,
+	"nsx_v3.ca_file":                true,
+	"nsx_v3.nsx_leaf_cert_file":     true,
+	"nsx_v3.thumbprint":             true,
+	"nsx_v3.http_timeout":           true,
+	"nsx_v3.inventory_batch_period": false,
+	"nsx_v3.inventory_batch_size":   false,
+}
+
+// IsNSXEndpointField returns true if the option, as "<section>.<key>", changes the
+// NSX managers, credentials, CA files/thumbprints or HTTP timeout the NSX client connects with.
+func IsNSXEndpointField(field string) bool {
+	return reloadableFields[field]
+}
+
+// GetConfigFilePath returns the path of the configuration file.
+func GetConfigFilePath() string {
+	return configFilePath
+}
+
+// DiffConfig compares the options of operatorConfig with newConfig and returns the
+// changed options, as "<section>.<key>", split into the ones which can be applied at
+// runtime and the ones which require a restart.
+func (operatorConfig *NSXOperatorConfig) DiffConfig(newConfig *NSXOperatorConfig) (reloadable []string, restartRequired []string) {
+	operatorConfig.configCache.lock.RLock()
+	defer operatorConfig.configCache.lock.RUnlock()
+	sections := []struct {
+		name          string
+		current, next interface{}
+	}{
+		{"DEFAULT", operatorConfig.DefaultConfig, newConfig.DefaultConfig},
+		{"coe", operatorConfig.CoeConfig, newConfig.CoeConfig},
+		{"nsx_v3", operatorConfig.NsxConfig, newConfig.NsxConfig},
+		{"k8s", operatorConfig.K8sConfig, newConfig.K8sConfig},
+		{"vc", operatorConfig.VCConfig, newConfig.VCConfig},
+		{"ha", operatorConfig.HAConfig, newConfig.HAConfig},
+		{"tracing", operatorConfig.TracingConfig, newConfig.TracingConfig},
+	}
+	for _, section := range sections {
+		current := reflect.ValueOf(section.current).Elem()
+		next := reflect.ValueOf(section.next).Elem()
+		for i := 0; i < current.NumField(); i++ {
+			key := current.Type().Field(i).Tag.Get("ini")
+			if key == "" || reflect.DeepEqual(current.Field(i).Interface(), next.Field(i).Interface()) {
+				continue
+			}
+			field := section.name + "." + key
+			if _, ok := reloadableFields[field]; ok {
+				reloadable = append(reloadable, field)
+			} else {
+				restartRequired = append(restartRequired, field)
+			}
+		}
+	}
+	sort.Strings(reloadable)
+	sort.Strings(restartRequired)
+	return reloadable, restartRequired
+}
+
+// ApplyReloadableConfig copies the options which can be applied at runtime from newConfig
+// and drops the cached CA, so that it is read from the new CA files.
+func (operatorConfig *NSXOperatorConfig) ApplyReloadableConfig(newConfig *NSXOperatorConfig) {
+	operatorConfig.configCache.lock.Lock()
+	defer operatorConfig.configCache.lock.Unlock()
+	operatorConfig.Debug = newConfig.Debug
+	operatorConfig.NsxApiManagers = newConfig.NsxApiManagers
+	operatorConfig.NsxApiUser = newConfig.NsxApiUser
+	operatorConfig.NsxApiPassword = newConfig.NsxApiPassword
+	operatorConfig.CaFile = newConfig.CaFile
+	operatorConfig.LeafCertFile = newConfig.LeafCertFile
+	operatorConfig.Thumbprint = newConfig.Thumbprint
+	operatorConfig.HttpTimeout = newConfig.HttpTimeout
+	operatorConfig.InventoryBatchPeriod = newConfig.InventoryBatchPeriod
+	operatorConfig.InventoryBatchSize = newConfig.InventoryBatchSize
+	operatorConfig.configCache.nsxCA = nil
+}
+
+// GetInventoryBatch returns the inventory batch period in seconds and the batch size,
+// which may be changed by reloading the configuration file.
+func (operatorConfig *NSXOperatorConfig) GetInventoryBatch() (period int, size int) {
+	operatorConfig.configCache.lock.RLock()
+	defer operatorConfig.configCache.lock.RUnlock()
+	return operatorConfig.InventoryBatchPeriod, operatorConfig.InventoryBatchSize
+}
+
+// GetNSXManagers returns the NSX managers, which may be changed by reloading the configuration file.
+func (operatorConfig *NSXOperatorConfig) GetNSXManagers() []string {
+	operatorConfig.configCache.lock.RLock()
+	defer operatorConfig.configCache.lock.RUnlock()
+	return operatorConfig.NsxApiManagers
+}
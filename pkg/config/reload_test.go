@@ -0,0 +1,99 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNSXOperatorConfig_DiffConfig(t *testing.T) {
+	current := NewNSXOpertorConfig()
+	current.NsxApiManagers = []string{"10.0.0.1"}
+	current.NsxApiUser = "admin"
+	current.Cluster = "cluster1"
+
+	tests := []struct {
+		name                    string
+		update                  func(cf *NSXOperatorConfig)
+		expectedReloadable      []string
+		expectedRestartRequired []string
+	}{
+		{
+			name:   "not changed",
+			update: func(cf *NSXOperatorConfig) {},
+		},
+		{
+			name: "reloadable options changed",
+			update: func(cf *NSXOperatorConfig) {
+				cf.Debug = true
+				cf.NsxApiManagers = []string{"10.0.0.1", "10.0.0.2"}
+				cf.NsxApiPassword = "password"
+				cf.Thumbprint = []string{"tp"}
+				cf.InventoryBatchSize = 100
+			},
+			expectedReloadable: []string{"DEFAULT.debug", "nsx_v3.inventory_batch_size", "nsx_v3.nsx_api_managers", "nsx_v3.nsx_api_password", "nsx_v3.thumbprint"},
+		},
+		{
+			name: "restart required options changed",
+			update: func(cf *NSXOperatorConfig) {
+				cf.NsxApiUser = "admin1"
+				cf.HttpTimeout = 30
+				cf.Cluster = "cluster2"
+				cf.EnvoyPort = 1080
+				cf.EnableHA = new(bool)
+			},
+			expectedReloadable:      []string{"nsx_v3.http_timeout", "nsx_v3.nsx_api_user"},
+			expectedRestartRequired: []string{"coe.cluster", "ha.enable", "nsx_v3.envoy_port"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newConfig := NewNSXOpertorConfig()
+			newConfig.NsxApiManagers = []string{"10.0.0.1"}
+			newConfig.NsxApiUser = "admin"
+			newConfig.Cluster = "cluster1"
+			tt.update(newConfig)
+			reloadable, restartRequired := current.DiffConfig(newConfig)
+			assert.Equal(t, tt.expectedReloadable, reloadable)
+			assert.Equal(t, tt.expectedRestartRequired, restartRequired)
+		})
+	}
+}
+
+func TestNSXOperatorConfig_ApplyReloadableConfig(t *testing.T) {
+	current := NewNSXOpertorConfig()
+	current.NsxApiManagers = []string{"10.0.0.1"}
+	current.configCache.nsxCA = []byte("ca")
+
+	newConfig := NewNSXOpertorConfig()
+	newConfig.Debug = true
+	newConfig.NsxApiManagers = []string{"10.0.0.2"}
+	newConfig.NsxApiUser = "admin"
+	newConfig.NsxApiPassword = "password"
+	newConfig.CaFile = []string{"/etc/ca.pem"}
+	newConfig.InventoryBatchPeriod = 10
+	newConfig.InventoryBatchSize = 100
+	newConfig.HttpTimeout = 30
+	newConfig.EnvoyPort = 1080
+
+	current.ApplyReloadableConfig(newConfig)
+	assert.True(t, current.Debug)
+	assert.Equal(t, []string{"10.0.0.2"}, current.GetNSXManagers())
+	assert.Equal(t, "admin", current.NsxApiUser)
+	assert.Equal(t, "password", current.NsxApiPassword)
+	assert.Equal(t, []string{"/etc/ca.pem"}, current.CaFile)
+	assert.Nil(t, current.configCache.nsxCA)
+	period, size := current.GetInventoryBatch()
+	assert.Equal(t, 10, period)
+	assert.Equal(t, 100, size)
+	assert.Equal(t, 30, current.HttpTimeout)
+	// The options which require a restart are not applied.
+	assert.Equal(t, 0, current.EnvoyPort)
+
+	reloadable, restartRequired := current.DiffConfig(newConfig)
+	assert.Empty(t, reloadable)
+	assert.Equal(t, []string{"nsx_v3.envoy_port"}, restartRequired)
+}
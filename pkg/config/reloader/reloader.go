@@ -0,0 +1,167 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package reloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+)
+
+const (
+	ReasonConfigReloaded       = "ConfigReloaded"
+	ReasonConfigReloadFailed   = "ConfigReloadFailed"
+	ReasonConfigReloadRejected = "ConfigReloadRejected"
+
+	ResultSuccess  = "success"
+	ResultFailure  = "failure"
+	ResultRejected = "rejected"
+)
+
+var (
+	log = logger.Log
+	// reloadDelay coalesces the burst of file events of a single update of the configuration file.
+	reloadDelay = 2 * time.Second
+)
+
+// Handler applies the reloaded options to a component, changed is the list of the
+// changed options as "<section>.<key>".
+type Handler func(cf *config.NSXOperatorConfig, changed []string) error
+
+// Reloader watches the configuration file and applies the options which can be changed
+// at runtime, see config.NSXOperatorConfig.DiffConfig. A change of any other option rejects
+// the reload. The reloads are recorded as events on the operator Pod and in the
+// config_reload_total metric.
+type Reloader struct {
+	cf       *config.NSXOperatorConfig
+	path     string
+	recorder record.EventRecorder
+	// object is the operator Pod the events are recorded on.
+	object   runtime.Object
+	handlers []Handler
+	// loadConfig is replaced in tests.
+	loadConfig func() (*config.NSXOperatorConfig, error)
+}
+
+func NewReloader(cf *config.NSXOperatorConfig, recorder record.EventRecorder, namespace, podName string) *Reloader {
+	return &Reloader{
+		cf:         cf,
+		path:       config.GetConfigFilePath(),
+		recorder:   recorder,
+		object:     &v1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: namespace, Name: podName},
+		loadConfig: config.LoadConfigFromFile,
+	}
+}
+
+// AddHandler registers a Handler which is called after the reloaded options are applied to cf.
+func (r *Reloader) AddHandler(handler Handler) {
+	r.handlers = append(r.handlers, handler)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, the standby replicas
+// connect to NSX as well and reload the configuration file too.
+func (r *Reloader) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable, it watches the configuration file until ctx is done.
+func (r *Reloader) Start(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create watcher for configuration file %s: %w", r.path, err)
+	}
+	defer watcher.Close()
+	// The directory is watched rather than the file, since the file mounted from a ConfigMap
+	// is updated by replacing the symlink of the directory content.
+	dir := filepath.Dir(r.path)
+	if err := watcher.Add(dir); err != nil {
+		return fmt.Errorf("failed to watch configuration directory %s: %w", dir, err)
+	}
+	log.Info("Watching configuration file for reload", "path", r.path)
+
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			log.Debug("Configuration directory changed", "event", event.String())
+			reload = time.After(reloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Error(err, "Failed to watch configuration file", "path", r.path)
+		case <-reload:
+			reload = nil
+			r.Reload()
+		}
+	}
+}
+
+// Reload loads and validates the configuration file and applies the changed options.
+// Nothing is applied if the file is invalid or an option which requires a restart is changed.
+func (r *Reloader) Reload() {
+	newConfig, err := r.loadConfig()
+	if err != nil {
+		r.recordResult(v1.EventTypeWarning, ReasonConfigReloadFailed, ResultFailure,
+			fmt.Sprintf("Failed to load configuration file %s: %v", r.path, err))
+		return
+	}
+	changed, restartRequired := r.cf.DiffConfig(newConfig)
+	if len(restartRequired) > 0 {
+		r.recordResult(v1.EventTypeWarning, ReasonConfigReloadRejected, ResultRejected,
+			fmt.Sprintf("Options %s require a restart, configuration file %s is not reloaded", strings.Join(restartRequired, ", "), r.path))
+		return
+	}
+	if len(changed) == 0 {
+		log.Debug("Configuration file is not changed", "path", r.path)
+		return
+	}
+
+	r.cf.ApplyReloadableConfig(newConfig)
+	var errs []error
+	for _, handler := range r.handlers {
+		if err := handler(r.cf, changed); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		r.recordResult(v1.EventTypeWarning, ReasonConfigReloadFailed, ResultFailure,
+			fmt.Sprintf("Failed to apply options %s: %v", strings.Join(changed, ", "), err))
+		return
+	}
+	r.recordResult(v1.EventTypeNormal, ReasonConfigReloaded, ResultSuccess,
+		fmt.Sprintf("Reloaded options %s", strings.Join(changed, ", ")))
+}
+
+func (r *Reloader) recordResult(eventType, reason, result, message string) {
+	if eventType == v1.EventTypeNormal {
+		log.Info(message)
+	} else {
+		log.Error(errors.New(message), "Failed to reload configuration file")
+	}
+	if r.recorder != nil {
+		r.recorder.Event(r.object, eventType, reason, message)
+	}
+	metrics.CounterInc(r.cf, metrics.ConfigReloadTotal, result)
+}
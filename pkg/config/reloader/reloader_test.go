@@ -0,0 +1,106 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package reloader
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+)
+
+func newTestConfig() *config.NSXOperatorConfig {
+	cf := config.NewNSXOpertorConfig()
+	cf.NsxApiManagers = []string{"10.0.0.1"}
+	cf.Cluster = "cluster1"
+	return cf
+}
+
+func TestReloader_Reload(t *testing.T) {
+	tests := []struct {
+		name            string
+		loadConfig      func() (*config.NSXOperatorConfig, error)
+		handlerErr      error
+		expectedEvent   string
+		expectedChanged []string
+		expectedApplied bool
+	}{
+		{
+			name: "invalid configuration file",
+			loadConfig: func() (*config.NSXOperatorConfig, error) {
+				return nil, errors.New("invalid")
+			},
+			expectedEvent: "Warning " + ReasonConfigReloadFailed,
+		},
+		{
+			name: "restart required option changed",
+			loadConfig: func() (*config.NSXOperatorConfig, error) {
+				cf := newTestConfig()
+				cf.NsxApiManagers = []string{"10.0.0.2"}
+				cf.Cluster = "cluster2"
+				return cf, nil
+			},
+			expectedEvent: "Warning " + ReasonConfigReloadRejected,
+		},
+		{
+			name: "not changed",
+			loadConfig: func() (*config.NSXOperatorConfig, error) {
+				return newTestConfig(), nil
+			},
+		},
+		{
+			name: "reloadable options changed",
+			loadConfig: func() (*config.NSXOperatorConfig, error) {
+				cf := newTestConfig()
+				cf.NsxApiManagers = []string{"10.0.0.2"}
+				cf.Debug = true
+				return cf, nil
+			},
+			expectedEvent:   "Normal " + ReasonConfigReloaded,
+			expectedChanged: []string{"DEFAULT.debug", "nsx_v3.nsx_api_managers"},
+			expectedApplied: true,
+		},
+		{
+			name: "handler failed",
+			loadConfig: func() (*config.NSXOperatorConfig, error) {
+				cf := newTestConfig()
+				cf.NsxApiManagers = []string{"10.0.0.2"}
+				return cf, nil
+			},
+			handlerErr:      errors.New("failed"),
+			expectedEvent:   "Warning " + ReasonConfigReloadFailed,
+			expectedChanged: []string{"nsx_v3.nsx_api_managers"},
+			expectedApplied: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cf := newTestConfig()
+			recorder := record.NewFakeRecorder(1)
+			r := NewReloader(cf, recorder, "vmware-system-nsx", "nsx-operator")
+			r.loadConfig = tt.loadConfig
+			var changed []string
+			r.AddHandler(func(_ *config.NSXOperatorConfig, c []string) error {
+				changed = c
+				return tt.handlerErr
+			})
+
+			r.Reload()
+			assert.Equal(t, tt.expectedChanged, changed)
+			if tt.expectedApplied {
+				assert.Equal(t, []string{"10.0.0.2"}, cf.GetNSXManagers())
+			} else {
+				assert.Equal(t, []string{"10.0.0.1"}, cf.GetNSXManagers())
+			}
+			if tt.expectedEvent == "" {
+				assert.Empty(t, recorder.Events)
+			} else {
+				assert.Contains(t, <-recorder.Events, tt.expectedEvent)
+			}
+		})
+	}
+}
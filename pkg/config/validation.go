@@ -0,0 +1,161 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
+)
+
+var (
+	validIPFamilies    = []string{"ipv4", "ipv6", "dualstack"}
+	validOTLPProtocols = []string{"grpc", "http"}
+	validLBSizes       = []string{
+		model.LBService_SIZE_SMALL,
+		model.LBService_SIZE_MEDIUM,
+		model.LBService_SIZE_LARGE,
+		model.LBService_SIZE_XLARGE,
+		model.LBService_SIZE_DLB,
+	}
+)
+
+// ValidateAll validates every section of the configuration and returns all the errors
+// joined. It is run when the operator loads a YAML configuration file and by validate-config.
+func (operatorConfig *NSXOperatorConfig) ValidateAll() error {
+	return errors.Join(operatorConfig.validationErrors()...)
+}
+
+// ValidateConfigFile parses the INI or YAML configuration file at path and returns every
+// error found in it.
+func ValidateConfigFile(path string) []error {
+	nsxOperatorConfig, err := parseConfigFile(path)
+	if err != nil {
+		return []error{err}
+	}
+	return nsxOperatorConfig.validationErrors()
+}
+
+func (operatorConfig *NSXOperatorConfig) validationErrors() []error {
+	var errs []error
+	if err := operatorConfig.CoeConfig.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("coe: %w", err))
+	}
+	if err := operatorConfig.NsxConfig.validate(operatorConfig.EnableVPCNetwork); err != nil {
+		errs = append(errs, fmt.Errorf("nsx_v3: %w", err))
+	}
+	errs = append(errs, operatorConfig.NsxConfig.validateSchema()...)
+	errs = append(errs, operatorConfig.K8sConfig.validateSchema()...)
+	errs = append(errs, operatorConfig.TracingConfig.validateSchema()...)
+	// The vc section is optional, it is only validated if the VC endpoint is set.
+	if operatorConfig.VCEndPoint != "" {
+		if err := operatorConfig.VCConfig.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("vc: %w", err))
+		}
+		if operatorConfig.HttpsPort < 0 || operatorConfig.HttpsPort > 65535 {
+			errs = append(errs, fmt.Errorf("vc.https_port: %d is not a valid port", operatorConfig.HttpsPort))
+		}
+	}
+	return errs
+}
+
+// validateSchema checks the value ranges of the nsx_v3 options which validate does not check.
+func (nsxConfig *NsxConfig) validateSchema() []error {
+	var errs []error
+	if nsxConfig.HttpTimeout < 0 {
+		errs = append(errs, fmt.Errorf("nsx_v3.http_timeout: %d must not be negative", nsxConfig.HttpTimeout))
+	}
+	if nsxConfig.EnvoyPort < 0 || nsxConfig.EnvoyPort > 65535 {
+		errs = append(errs, fmt.Errorf("nsx_v3.envoy_port: %d is not a valid port", nsxConfig.EnvoyPort))
+	}
+	if nsxConfig.EnvoyPort != 0 && nsxConfig.EnvoyHost == "" {
+		errs = append(errs, errors.New("nsx_v3.envoy_host: must be set when envoy_port is set"))
+	}
+	if nsxConfig.LicenseValidationInterval < 0 {
+		errs = append(errs, fmt.Errorf("nsx_v3.license_validation_interval: %d must not be negative", nsxConfig.LicenseValidationInterval))
+	}
+	if nsxConfig.DefaultSubnetSize < 0 {
+		errs = append(errs, fmt.Errorf("nsx_v3.default_subnet_size: %d must not be negative", nsxConfig.DefaultSubnetSize))
+	}
+	if nsxConfig.InventoryBatchPeriod <= 0 {
+		errs = append(errs, fmt.Errorf("nsx_v3.inventory_batch_period: %d must be positive", nsxConfig.InventoryBatchPeriod))
+	}
+	if nsxConfig.InventoryBatchSize <= 0 {
+		errs = append(errs, fmt.Errorf("nsx_v3.inventory_batch_size: %d must be positive", nsxConfig.InventoryBatchSize))
+	}
+	if nsxConfig.TnIdCheckInterval <= 0 {
+		errs = append(errs, fmt.Errorf("nsx_v3.tn_id_check_interval: %d must be positive", nsxConfig.TnIdCheckInterval))
+	}
+	if nsxConfig.SubnetScaleInGracePeriod < 0 {
+		errs = append(errs, fmt.Errorf("nsx_v3.subnet_scale_in_grace_period: %d must not be negative", nsxConfig.SubnetScaleInGracePeriod))
+	}
+	if nsxConfig.SubnetSetMinSubnets < 0 {
+		errs = append(errs, fmt.Errorf("nsx_v3.subnetset_min_subnets: %d must not be negative", nsxConfig.SubnetSetMinSubnets))
+	}
+	for _, server := range nsxConfig.FQDNDNSServers {
+		if net.ParseIP(server) == nil {
+			if _, _, err := net.ParseCIDR(server); err != nil {
+				errs = append(errs, fmt.Errorf("nsx_v3.fqdn_dns_servers: %q is not a valid IP or CIDR", server))
+			}
+		}
+	}
+	if nsxConfig.NSXLBSize != "" && !slices.Contains(validLBSizes, nsxConfig.NSXLBSize) {
+		errs = append(errs, fmt.Errorf("nsx_v3.service_size: %q must be one of %s", nsxConfig.NSXLBSize, strings.Join(validLBSizes, ", ")))
+	}
+	if _, err := ratelimiter.ParseType(nsxConfig.APIRateMode); err != nil {
+		errs = append(errs, fmt.Errorf("nsx_v3.api_rate_mode: %w", err))
+	}
+	if nsxConfig.APIRateLimit < 0 || nsxConfig.APIRateLimit > ratelimiter.MAXRATELIMIT {
+		errs = append(errs, fmt.Errorf("nsx_v3.api_rate_limit: %d must be between 0 and %d", nsxConfig.APIRateLimit, ratelimiter.MAXRATELIMIT))
+	}
+	if _, err := ratelimiter.ParseClassBudgets(nsxConfig.APIRateClasses); err != nil {
+		errs = append(errs, fmt.Errorf("nsx_v3.api_rate_classes: %w", err))
+	}
+	return errs
+}
+
+// validateSchema checks the values of the k8s options.
+func (k *K8sConfig) validateSchema() []error {
+	var errs []error
+	if k.IPFamily != "" && !containsFold(validIPFamilies, strings.TrimSpace(k.IPFamily)) {
+		errs = append(errs, fmt.Errorf("k8s.ip_family: %q must be one of IPv4, IPv6, DualStack", k.IPFamily))
+	}
+	return errs
+}
+
+// validateSchema checks the tracing options, the exporter options are only checked if tracing is enabled.
+func (t *TracingConfig) validateSchema() []error {
+	if t == nil {
+		return nil
+	}
+	var errs []error
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio: %v must be between 0 and 1", t.SampleRatio))
+	}
+	if !t.EnableTracing {
+		return errs
+	}
+	if t.OTLPEndpoint == "" {
+		errs = append(errs, errors.New("tracing.otlp_endpoint: must be set when tracing is enabled"))
+	}
+	if t.OTLPProtocol != "" && !slices.Contains(validOTLPProtocols, t.OTLPProtocol) {
+		errs = append(errs, fmt.Errorf("tracing.otlp_protocol: %q must be one of %s", t.OTLPProtocol, strings.Join(validOTLPProtocols, ", ")))
+	}
+	return errs
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
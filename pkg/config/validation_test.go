@@ -0,0 +1,89 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_ValidateConfigFile(t *testing.T) {
+	assert.Empty(t, ValidateConfigFile("../mock/nsxop.ini"))
+	assert.Empty(t, ValidateConfigFile("../mock/nsxop.yaml"))
+
+	errs := ValidateConfigFile("../mock/nsxop_missing.yaml")
+	assert.Len(t, errs, 1)
+
+	errs = ValidateConfigFile("../mock/nsxop_err.yaml")
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	assert.Equal(t, []string{
+		"coe: invalid field Cluster",
+		"nsx_v3: invalid field NsxApiManagers",
+		"nsx_v3.http_timeout: -1 must not be negative",
+		"nsx_v3.inventory_batch_size: 0 must be positive",
+		`nsx_v3.service_size: "HUGE" must be one of SMALL, MEDIUM, LARGE, XLARGE, DLB`,
+		`k8s.ip_family: "IPv5" must be one of IPv4, IPv6, DualStack`,
+	}, messages)
+}
+
+func TestNSXOperatorConfig_ValidateAll(t *testing.T) {
+	cf := NewNSXOpertorConfig()
+	cf.Cluster = "cluster1"
+	cf.NsxApiManagers = []string{"10.0.0.1"}
+	cf.Thumbprint = []string{"0a:fc"}
+	cf.IPFamily = "DualStack"
+	cf.NSXLBSize = "MEDIUM"
+	assert.NoError(t, cf.ValidateAll())
+
+	cf.EnvoyPort = 70000
+	cf.VCEndPoint = "10.0.0.2"
+	err := cf.ValidateAll()
+	assert.ErrorContains(t, err, "nsx_v3.envoy_port: 70000 is not a valid port")
+	assert.ErrorContains(t, err, "nsx_v3.envoy_host: must be set when envoy_port is set")
+	assert.ErrorContains(t, err, "vc: invalid field SsoDomain")
+
+	cf.EnvoyPort = 0
+	cf.VCEndPoint = ""
+	cf.EnableTracing = true
+	cf.OTLPProtocol = "udp"
+	cf.SampleRatio = 2
+	err = cf.ValidateAll()
+	assert.ErrorContains(t, err, "tracing.sample_ratio: 2 must be between 0 and 1")
+	assert.ErrorContains(t, err, "tracing.otlp_endpoint: must be set when tracing is enabled")
+	assert.ErrorContains(t, err, `tracing.otlp_protocol: "udp" must be one of grpc, http`)
+
+	cf.EnableTracing = false
+	cf.SampleRatio = 1
+	cf.APIRateMode = "fixrate"
+	cf.APIRateClasses = []string{"background:10:1"}
+	assert.NoError(t, cf.ValidateAll())
+
+	cf.APIRateMode = "leaky"
+	cf.APIRateLimit = 200
+	cf.APIRateClasses = []string{"bulk:10:1"}
+	err = cf.ValidateAll()
+	assert.ErrorContains(t, err, `nsx_v3.api_rate_mode: unknown rate limiter type "leaky"`)
+	assert.ErrorContains(t, err, "nsx_v3.api_rate_limit: 200 must be between 0 and 100")
+	assert.ErrorContains(t, err, `nsx_v3.api_rate_classes: unknown class "bulk"`)
+
+	cf.APIRateMode = ""
+	cf.APIRateLimit = 0
+	cf.APIRateClasses = nil
+	cf.SubnetScaleInGracePeriod = -1
+	cf.SubnetSetMinSubnets = -1
+	err = cf.ValidateAll()
+	assert.ErrorContains(t, err, "nsx_v3.subnet_scale_in_grace_period: -1 must not be negative")
+	assert.ErrorContains(t, err, "nsx_v3.subnetset_min_subnets: -1 must not be negative")
+
+	cf.SubnetScaleInGracePeriod = 0
+	cf.SubnetSetMinSubnets = 0
+	cf.FQDNDNSServers = []string{"10.96.0.10", "fd00::/64"}
+	assert.NoError(t, cf.ValidateAll())
+	cf.FQDNDNSServers = []string{"kube-dns"}
+	assert.ErrorContains(t, cf.ValidateAll(), `nsx_v3.fqdn_dns_servers: "kube-dns" is not a valid IP or CIDR`)
+}
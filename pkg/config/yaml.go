@@ -0,0 +1,89 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigAPIVersion is the apiVersion of the YAML configuration file.
+	ConfigAPIVersion = "config.nsx.vmware.com/v1"
+	// ConfigKind is the kind of the YAML configuration file.
+	ConfigKind = "NSXOperatorConfig"
+)
+
+// yamlConfig is the versioned YAML representation of NSXOperatorConfig. The sections and
+// keys are named after the INI sections and keys, lists are YAML sequences and the
+// optional bool options are left unset to keep their default.
+type yamlConfig struct {
+	APIVersion string         `json:"apiVersion"`
+	Kind       string         `json:"kind"`
+	Default    *DefaultConfig `json:"default,omitempty"`
+	Coe        *CoeConfig     `json:"coe,omitempty"`
+	Nsx        *NsxConfig     `json:"nsx_v3,omitempty"`
+	K8s        *K8sConfig     `json:"k8s,omitempty"`
+	VC         *VCConfig      `json:"vc,omitempty"`
+	HA         *HAConfig      `json:"ha,omitempty"`
+	Tracing    *TracingConfig `json:"tracing,omitempty"`
+}
+
+func isYAMLConfigFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// parseYAMLConfig parses the YAML configuration into a defaulted NSXOperatorConfig,
+// the options which are not set keep the defaults of NewNSXOpertorConfig.
+func parseYAMLConfig(data []byte) (*NSXOperatorConfig, error) {
+	nsxOperatorConfig := NewNSXOpertorConfig()
+	cfg := &yamlConfig{
+		Default: nsxOperatorConfig.DefaultConfig,
+		Coe:     nsxOperatorConfig.CoeConfig,
+		Nsx:     nsxOperatorConfig.NsxConfig,
+		K8s:     nsxOperatorConfig.K8sConfig,
+		VC:      nsxOperatorConfig.VCConfig,
+		HA:      nsxOperatorConfig.HAConfig,
+		Tracing: nsxOperatorConfig.TracingConfig,
+	}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML configuration: %w", err)
+	}
+	if cfg.APIVersion != ConfigAPIVersion {
+		return nil, fmt.Errorf("unsupported apiVersion %q, expected %q", cfg.APIVersion, ConfigAPIVersion)
+	}
+	if cfg.Kind != ConfigKind {
+		return nil, fmt.Errorf("unsupported kind %q, expected %q", cfg.Kind, ConfigKind)
+	}
+	return nsxOperatorConfig, nil
+}
+
+// ToYAML returns the versioned YAML configuration of operatorConfig.
+func (operatorConfig *NSXOperatorConfig) ToYAML() ([]byte, error) {
+	return yaml.Marshal(&yamlConfig{
+		APIVersion: ConfigAPIVersion,
+		Kind:       ConfigKind,
+		Default:    operatorConfig.DefaultConfig,
+		Coe:        operatorConfig.CoeConfig,
+		Nsx:        operatorConfig.NsxConfig,
+		K8s:        operatorConfig.K8sConfig,
+		VC:         operatorConfig.VCConfig,
+		HA:         operatorConfig.HAConfig,
+		Tracing:    operatorConfig.TracingConfig,
+	})
+}
+
+// ConvertConfigFileToYAML converts the INI or YAML configuration file at path to the
+// versioned YAML configuration. The configuration is not validated.
+func ConvertConfigFileToYAML(path string) ([]byte, error) {
+	nsxOperatorConfig, err := parseConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return nsxOperatorConfig.ToYAML()
+}
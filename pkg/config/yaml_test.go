@@ -0,0 +1,149 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_LoadYAMLConfigFromFile(t *testing.T) {
+	defer UpdateConfigFilePath(configFilePath)
+
+	UpdateConfigFilePath("../mock/nsxop.yaml")
+	cf, err := LoadConfigFromFile()
+	require.NoError(t, err)
+	assert.Equal(t, "k8scl-one", cf.Cluster)
+	assert.Equal(t, []string{"127.0.0.1"}, cf.NsxApiManagers)
+	assert.Equal(t, []string{"81:49:DD:B7:E8:79:55:5D:9E:75:A9:FA:A6:7D:CB:EA:A4:CA:12:C6"}, cf.Thumbprint)
+	// The options which are not set keep the defaults.
+	assert.True(t, cf.EnableSha)
+	assert.Equal(t, 5, cf.InventoryBatchPeriod)
+	assert.Equal(t, 50, cf.InventoryBatchSize)
+	assert.Equal(t, 300, cf.TnIdCheckInterval)
+	assert.Equal(t, 300, cf.SubnetScaleInGracePeriod)
+	assert.Equal(t, 1, cf.SubnetSetMinSubnets)
+	assert.Nil(t, cf.EnableHA)
+	assert.True(t, cf.HAEnabled())
+
+	UpdateConfigFilePath("../mock/nsxop_err.yaml")
+	_, err = LoadConfigFromFile()
+	assert.Error(t, err)
+}
+
+func TestConfig_LoadINIConfigFromFile(t *testing.T) {
+	defer UpdateConfigFilePath(configFilePath)
+
+	UpdateConfigFilePath("../mock/nsxop.ini")
+	_, err := LoadConfigFromFile()
+	require.NoError(t, err)
+
+	// An INI configuration accepted before the YAML schema is still loaded, the schema errors
+	// are only reported by validate-config.
+	path := filepath.Join(t.TempDir(), "nsxop.ini")
+	data, err := os.ReadFile("../mock/nsxop.ini")
+	require.NoError(t, err)
+	data = append(data, []byte(`
+vc_endpoint = vc.example.com
+[nsx_v3]
+envoy_port = 1080
+service_size = HUGE
+tn_id_check_interval = 0
+inventory_batch_period = 0
+inventory_batch_size = -1
+[k8s]
+ip_family = IPv5
+`)...)
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	UpdateConfigFilePath(path)
+	cf, err := LoadConfigFromFile()
+	require.NoError(t, err)
+	assert.Equal(t, "vc.example.com", cf.VCEndPoint)
+	assert.Equal(t, 1080, cf.EnvoyPort)
+	assert.Equal(t, "HUGE", cf.NSXLBSize)
+	assert.Equal(t, 0, cf.TnIdCheckInterval)
+	assert.Equal(t, "IPv5", cf.IPFamily)
+
+	err = errors.Join(ValidateConfigFile(path)...)
+	assert.ErrorContains(t, err, "vc: invalid field SsoDomain")
+	assert.ErrorContains(t, err, "nsx_v3.envoy_host: must be set when envoy_port is set")
+	assert.ErrorContains(t, err, `nsx_v3.service_size: "HUGE" must be one of`)
+	assert.ErrorContains(t, err, "nsx_v3.tn_id_check_interval: 0 must be positive")
+	assert.ErrorContains(t, err, "nsx_v3.inventory_batch_period: 0 must be positive")
+	assert.ErrorContains(t, err, "nsx_v3.inventory_batch_size: -1 must be positive")
+	assert.ErrorContains(t, err, `k8s.ip_family: "IPv5" must be one of`)
+
+	// The legacy validation still rejects an INI configuration.
+	data = append(data, []byte("\n[coe]\ncluster =\n")...)
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	_, err = LoadConfigFromFile()
+	assert.Error(t, err)
+}
+
+func TestConfig_ParseYAMLConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			data: `apiVersion: config.nsx.vmware.com/v1
+kind: NSXOperatorConfig
+ha:
+  enable: false
+`,
+		},
+		{
+			name:        "unsupported apiVersion",
+			data:        "apiVersion: config.nsx.vmware.com/v2\nkind: NSXOperatorConfig\n",
+			expectedErr: `unsupported apiVersion "config.nsx.vmware.com/v2"`,
+		},
+		{
+			name:        "unsupported kind",
+			data:        "apiVersion: config.nsx.vmware.com/v1\nkind: Config\n",
+			expectedErr: `unsupported kind "Config"`,
+		},
+		{
+			name:        "unknown field",
+			data:        "apiVersion: config.nsx.vmware.com/v1\nkind: NSXOperatorConfig\nnsx_v3:\n  nsx_api_manager: 10.0.0.1\n",
+			expectedErr: `unknown field "nsx_api_manager"`,
+		},
+		{
+			name:        "invalid type",
+			data:        "apiVersion: config.nsx.vmware.com/v1\nkind: NSXOperatorConfig\nnsx_v3:\n  http_timeout: ten\n",
+			expectedErr: "failed to parse YAML configuration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cf, err := parseYAMLConfig([]byte(tt.data))
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, cf.HAEnabled())
+		})
+	}
+}
+
+func TestConfig_ConvertConfigFileToYAML(t *testing.T) {
+	data, err := ConvertConfigFileToYAML("../mock/nsxop.ini")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "nsxop.yaml")
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	converted, err := parseConfigFile(path)
+	require.NoError(t, err)
+	expected, err := parseConfigFile("../mock/nsxop.ini")
+	require.NoError(t, err)
+	assert.Equal(t, expected, converted)
+	assert.NoError(t, converted.ValidateAll())
+}
@@ -0,0 +1,61 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+// AdminNetworkPolicyReconciler reconciles an AdminNetworkPolicy object
+type AdminNetworkPolicyReconciler struct {
+	Client          client.Client
+	Scheme          *apimachineryruntime.Scheme
+	Service         *securitypolicy.SecurityPolicyService
+	Recorder        record.EventRecorder
+	StatusUpdater   common.StatusUpdater
+	discoveryClient discovery.DiscoveryInterface
+}
+
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=adminnetworkpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=adminnetworkpolicies/status,verbs=get;update;patch
+func (r *AdminNetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return reconcilePolicy(ctx, r.Client, r.Service, &r.StatusUpdater, req, servicecommon.ResourceTypeAdminNetworkPolicy)
+}
+
+// CollectGarbage collects the NSX SecurityPolicies whose AdminNetworkPolicy has been removed from K8s.
+// It implements the interface GarbageCollector method.
+func (r *AdminNetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
+	return collectPolicyGarbage(ctx, r.Client, r.Service, &r.StatusUpdater, servicecommon.ResourceTypeAdminNetworkPolicy)
+}
+
+func (r *AdminNetworkPolicyReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func (r *AdminNetworkPolicyReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	return startPolicyController(mgr, r.discoveryClient, r, r, "adminnetworkpolicies", servicecommon.ResourceTypeAdminNetworkPolicy)
+}
+
+func NewAdminNetworkPolicyReconciler(mgr ctrl.Manager, commonService servicecommon.Service, vpcService servicecommon.VPCServiceProvider) *AdminNetworkPolicyReconciler {
+	anpReconciler := &AdminNetworkPolicyReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("adminnetworkpolicy-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	}
+	anpReconciler.Service = securitypolicy.GetSecurityService(commonService, vpcService)
+	anpReconciler.StatusUpdater = common.NewStatusUpdater(anpReconciler.Client, anpReconciler.Service.NSXConfig, anpReconciler.Recorder,
+		common.MetricResTypeAdminNetworkPolicy, "SecurityPolicy", "AdminNetworkPolicy")
+	return anpReconciler
+}
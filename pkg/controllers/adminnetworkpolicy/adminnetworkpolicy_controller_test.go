@@ -0,0 +1,259 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+type fakeRecorder struct{}
+
+func (recorder fakeRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+}
+
+func (recorder fakeRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (recorder fakeRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func fakeService() *securitypolicy.SecurityPolicyService {
+	return &securitypolicy.SecurityPolicyService{
+		Service: servicecommon.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster:          "k8scl-one:test",
+					EnableVPCNetwork: true,
+				},
+			},
+		},
+	}
+}
+
+func newFakeClient(objs ...client.Object) client.Client {
+	newScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(newScheme))
+	utilruntime.Must(policyv1alpha1.Install(newScheme))
+	return fake.NewClientBuilder().WithScheme(newScheme).WithObjects(objs...).
+		WithStatusSubresource(&policyv1alpha1.AdminNetworkPolicy{}, &policyv1alpha1.BaselineAdminNetworkPolicy{}).Build()
+}
+
+func createFakeAdminNetworkPolicyReconciler(objs ...client.Object) *AdminNetworkPolicyReconciler {
+	r := &AdminNetworkPolicyReconciler{
+		Client:   newFakeClient(objs...),
+		Service:  fakeService(),
+		Recorder: fakeRecorder{},
+	}
+	r.Scheme = r.Client.Scheme()
+	r.StatusUpdater = common.NewStatusUpdater(r.Client, r.Service.NSXConfig, r.Recorder, common.MetricResTypeAdminNetworkPolicy, "SecurityPolicy", "AdminNetworkPolicy")
+	return r
+}
+
+func TestAdminNetworkPolicyReconciler_Reconcile(t *testing.T) {
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "anp1"}}
+	newANP := func() *policyv1alpha1.AdminNetworkPolicy {
+		return &policyv1alpha1.AdminNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "anp1", UID: "uid1", Generation: 1},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		objs            []client.Object
+		createErr       error
+		skipped         []string
+		skippedErr      error
+		wantResult      ctrl.Result
+		wantErr         bool
+		wantDeleted     bool
+		wantReadyStatus metav1.ConditionStatus
+		wantReason      string
+	}{
+		{
+			name:        "CR not found",
+			wantResult:  ResultNormal,
+			wantDeleted: true,
+		},
+		{
+			name: "CR is being deleted",
+			objs: []client.Object{func() client.Object {
+				anp := newANP()
+				anp.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				anp.Finalizers = []string{"test"}
+				return anp
+			}()},
+			wantResult:  ResultNormal,
+			wantDeleted: true,
+		},
+		{
+			name:            "create succeeded",
+			objs:            []client.Object{newANP()},
+			wantResult:      ResultNormal,
+			wantReadyStatus: metav1.ConditionTrue,
+			wantReason:      reasonNSXRealized,
+		},
+		{
+			name:            "create succeeded with system Namespaces skipped",
+			objs:            []client.Object{newANP()},
+			skipped:         []string{"kube-system"},
+			wantResult:      ResultNormal,
+			wantReadyStatus: metav1.ConditionTrue,
+			wantReason:      reasonSystemNamespacesSkipped,
+		},
+		{
+			name:            "list skipped Namespaces error",
+			objs:            []client.Object{newANP()},
+			skippedErr:      errors.New("failed to list Namespaces"),
+			wantResult:      ResultRequeue,
+			wantErr:         true,
+			wantReadyStatus: metav1.ConditionFalse,
+			wantReason:      reasonNSXFailed,
+		},
+		{
+			name:            "validation error",
+			objs:            []client.Object{newANP()},
+			createErr:       &nsxutil.ValidationError{Desc: "nodes peer is not supported"},
+			wantResult:      ResultNormal,
+			wantReadyStatus: metav1.ConditionFalse,
+			wantReason:      reasonInvalidPolicy,
+		},
+		{
+			name:            "restriction error",
+			objs:            []client.Object{newANP()},
+			createErr:       nsxutil.RestrictionError{Desc: "no DFW license"},
+			wantResult:      ResultNormal,
+			wantReadyStatus: metav1.ConditionFalse,
+			wantReason:      reasonNoDFWLicense,
+		},
+		{
+			name:            "other error",
+			objs:            []client.Object{newANP()},
+			createErr:       errors.New("VPC is not ready"),
+			wantResult:      ResultRequeue,
+			wantErr:         true,
+			wantReadyStatus: metav1.ConditionFalse,
+			wantReason:      reasonNSXFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := createFakeAdminNetworkPolicyReconciler(tt.objs...)
+			deleted := false
+			patches := gomonkey.ApplyFunc(deleteAdminNetworkPolicyByName, func(_ context.Context, _ *securitypolicy.SecurityPolicyService, name, createdFor string) error {
+				assert.Equal(t, "anp1", name)
+				assert.Equal(t, servicecommon.ResourceTypeAdminNetworkPolicy, createdFor)
+				deleted = true
+				return nil
+			})
+			patches.ApplyMethod(r.Service, "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, _ interface{}) error {
+				return tt.createErr
+			})
+			patches.ApplyMethod(r.Service, "ListSkippedSubjectNamespaces", func(_ *securitypolicy.SecurityPolicyService, _ *policyv1alpha1.AdminNetworkPolicySubject) ([]string, error) {
+				return tt.skipped, tt.skippedErr
+			})
+			defer patches.Reset()
+
+			result, err := r.Reconcile(context.TODO(), req)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantDeleted, deleted)
+			if tt.wantReadyStatus != "" {
+				anp := &policyv1alpha1.AdminNetworkPolicy{}
+				assert.NoError(t, r.Client.Get(context.TODO(), req.NamespacedName, anp))
+				condition := meta.FindStatusCondition(anp.Status.Conditions, conditionTypeReady)
+				if assert.NotNil(t, condition) {
+					assert.Equal(t, tt.wantReadyStatus, condition.Status)
+					assert.Equal(t, tt.wantReason, condition.Reason)
+					assert.Equal(t, int64(1), condition.ObservedGeneration)
+				}
+			}
+		})
+	}
+}
+
+func TestAdminNetworkPolicyReconciler_CollectGarbage(t *testing.T) {
+	r := createFakeAdminNetworkPolicyReconciler(&policyv1alpha1.AdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "anp1", UID: "uid1"},
+	})
+	deletedIDs := sets.New[string]()
+	patches := gomonkey.ApplyMethod(r.Service, "ListAdminNetworkPolicyID", func(_ *securitypolicy.SecurityPolicyService, createdFor string) sets.Set[string] {
+		assert.Equal(t, servicecommon.ResourceTypeAdminNetworkPolicy, createdFor)
+		return sets.New[string]("uid1.ns1_anp", "uid1.ns2_anp", "uid2.ns1_anp")
+	})
+	patches.ApplyMethod(r.Service, "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, uid types.UID, isGC bool, createdFor string) error {
+		assert.True(t, isGC)
+		deletedIDs.Insert(string(uid))
+		return nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, r.CollectGarbage(context.TODO()))
+	assert.Equal(t, sets.New[string]("uid2.ns1_anp"), deletedIDs)
+}
+
+func TestListPolicyRequests(t *testing.T) {
+	c := newFakeClient(
+		&policyv1alpha1.AdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "anp1"}},
+		&policyv1alpha1.AdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "anp2"}},
+		&policyv1alpha1.BaselineAdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+	)
+	requests, err := listPolicyRequests(context.TODO(), c, servicecommon.ResourceTypeAdminNetworkPolicy)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []ctrl.Request{
+		{NamespacedName: types.NamespacedName{Name: "anp1"}},
+		{NamespacedName: types.NamespacedName{Name: "anp2"}},
+	}, requests)
+
+	requests, err = listPolicyRequests(context.TODO(), c, servicecommon.ResourceTypeBaselineAdminNetworkPolicy)
+	assert.NoError(t, err)
+	assert.Equal(t, []ctrl.Request{{NamespacedName: types.NamespacedName{Name: "default"}}}, requests)
+}
+
+func TestDeleteAdminNetworkPolicyByName(t *testing.T) {
+	service := fakeService()
+	deletedUIDs := sets.New[string]()
+	patches := gomonkey.ApplyMethod(service, "ListAdminNetworkPolicyByName", func(_ *securitypolicy.SecurityPolicyService, name, createdFor string) []*model.SecurityPolicy {
+		return []*model.SecurityPolicy{
+			{
+				Id: servicecommon.String("sp1"),
+				Tags: []model.Tag{
+					{Scope: servicecommon.String(servicecommon.TagScopeAdminNetworkPolicyUID), Tag: servicecommon.String("uid1.ns1_anp")},
+				},
+			},
+		}
+	})
+	patches.ApplyMethod(service, "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, uid types.UID, isGC bool, createdFor string) error {
+		assert.False(t, isGC)
+		deletedUIDs.Insert(string(uid))
+		return nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, deleteAdminNetworkPolicyByName(context.TODO(), service, "anp1", servicecommon.ResourceTypeAdminNetworkPolicy))
+	assert.Equal(t, sets.New[string]("uid1.ns1_anp"), deletedUIDs)
+}
@@ -0,0 +1,61 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+// BaselineAdminNetworkPolicyReconciler reconciles a BaselineAdminNetworkPolicy object
+type BaselineAdminNetworkPolicyReconciler struct {
+	Client          client.Client
+	Scheme          *apimachineryruntime.Scheme
+	Service         *securitypolicy.SecurityPolicyService
+	Recorder        record.EventRecorder
+	StatusUpdater   common.StatusUpdater
+	discoveryClient discovery.DiscoveryInterface
+}
+
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=baselineadminnetworkpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=baselineadminnetworkpolicies/status,verbs=get;update;patch
+func (r *BaselineAdminNetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return reconcilePolicy(ctx, r.Client, r.Service, &r.StatusUpdater, req, servicecommon.ResourceTypeBaselineAdminNetworkPolicy)
+}
+
+// CollectGarbage collects the NSX SecurityPolicies whose BaselineAdminNetworkPolicy has been removed from K8s.
+// It implements the interface GarbageCollector method.
+func (r *BaselineAdminNetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
+	return collectPolicyGarbage(ctx, r.Client, r.Service, &r.StatusUpdater, servicecommon.ResourceTypeBaselineAdminNetworkPolicy)
+}
+
+func (r *BaselineAdminNetworkPolicyReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func (r *BaselineAdminNetworkPolicyReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	return startPolicyController(mgr, r.discoveryClient, r, r, "baselineadminnetworkpolicies", servicecommon.ResourceTypeBaselineAdminNetworkPolicy)
+}
+
+func NewBaselineAdminNetworkPolicyReconciler(mgr ctrl.Manager, commonService servicecommon.Service, vpcService servicecommon.VPCServiceProvider) *BaselineAdminNetworkPolicyReconciler {
+	banpReconciler := &BaselineAdminNetworkPolicyReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("baselineadminnetworkpolicy-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	}
+	banpReconciler.Service = securitypolicy.GetSecurityService(commonService, vpcService)
+	banpReconciler.StatusUpdater = common.NewStatusUpdater(banpReconciler.Client, banpReconciler.Service.NSXConfig, banpReconciler.Recorder,
+		common.MetricResTypeBaselineAdminNetworkPolicy, "SecurityPolicy", "BaselineAdminNetworkPolicy")
+	return banpReconciler
+}
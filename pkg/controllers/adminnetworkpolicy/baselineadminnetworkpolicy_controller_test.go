@@ -0,0 +1,99 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+func createFakeBaselineAdminNetworkPolicyReconciler(objs ...client.Object) *BaselineAdminNetworkPolicyReconciler {
+	r := &BaselineAdminNetworkPolicyReconciler{
+		Client:   newFakeClient(objs...),
+		Service:  fakeService(),
+		Recorder: fakeRecorder{},
+	}
+	r.Scheme = r.Client.Scheme()
+	r.StatusUpdater = common.NewStatusUpdater(r.Client, r.Service.NSXConfig, r.Recorder, common.MetricResTypeBaselineAdminNetworkPolicy,
+		"SecurityPolicy", "BaselineAdminNetworkPolicy")
+	return r
+}
+
+func TestBaselineAdminNetworkPolicyReconciler_Reconcile(t *testing.T) {
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "default"}}
+
+	// The BaselineAdminNetworkPolicy is not found.
+	r := createFakeBaselineAdminNetworkPolicyReconciler()
+	deleted := false
+	patches := gomonkey.ApplyFunc(deleteAdminNetworkPolicyByName, func(_ context.Context, _ *securitypolicy.SecurityPolicyService, name, createdFor string) error {
+		assert.Equal(t, servicecommon.ResourceTypeBaselineAdminNetworkPolicy, createdFor)
+		deleted = true
+		return nil
+	})
+	defer patches.Reset()
+	result, err := r.Reconcile(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, ResultNormal, result)
+	assert.True(t, deleted)
+
+	// The BaselineAdminNetworkPolicy fails to be realized.
+	r = createFakeBaselineAdminNetworkPolicyReconciler(&policyv1alpha1.BaselineAdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "uid1"},
+	})
+	createErr := errors.New("VPC is not ready")
+	patches.ApplyMethod(r.Service, "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}) error {
+		_, ok := obj.(*policyv1alpha1.BaselineAdminNetworkPolicy)
+		assert.True(t, ok)
+		return createErr
+	})
+	patches.ApplyMethod(r.Service, "ListSkippedSubjectNamespaces", func(_ *securitypolicy.SecurityPolicyService, _ *policyv1alpha1.AdminNetworkPolicySubject) ([]string, error) {
+		return nil, nil
+	})
+	result, err = r.Reconcile(context.TODO(), req)
+	assert.Error(t, err)
+	assert.Equal(t, ResultRequeue, result)
+
+	// The BaselineAdminNetworkPolicy is realized.
+	createErr = nil
+	result, err = r.Reconcile(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, ResultNormal, result)
+	banp := &policyv1alpha1.BaselineAdminNetworkPolicy{}
+	assert.NoError(t, r.Client.Get(context.TODO(), req.NamespacedName, banp))
+	assert.True(t, meta.IsStatusConditionTrue(banp.Status.Conditions, conditionTypeReady))
+}
+
+func TestBaselineAdminNetworkPolicyReconciler_CollectGarbage(t *testing.T) {
+	r := createFakeBaselineAdminNetworkPolicyReconciler(&policyv1alpha1.BaselineAdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "uid1"},
+	})
+	deletedIDs := sets.New[string]()
+	patches := gomonkey.ApplyMethod(r.Service, "ListAdminNetworkPolicyID", func(_ *securitypolicy.SecurityPolicyService, createdFor string) sets.Set[string] {
+		assert.Equal(t, servicecommon.ResourceTypeBaselineAdminNetworkPolicy, createdFor)
+		return sets.New[string]("uid1.ns1_banp", "uid0.ns1_banp")
+	})
+	patches.ApplyMethod(r.Service, "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, uid types.UID, isGC bool, createdFor string) error {
+		deletedIDs.Insert(string(uid))
+		return nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, r.CollectGarbage(context.TODO()))
+	assert.Equal(t, sets.New[string]("uid0.ns1_banp"), deletedIDs)
+}
@@ -0,0 +1,70 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"reflect"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// EnqueueRequestForNamespace handles Namespace events and triggers the reconciliation of all the AdminNetworkPolicies
+// or BaselineAdminNetworkPolicies, because the Namespace may be selected or unselected by their subjects.
+type EnqueueRequestForNamespace struct {
+	ListRequests func(ctx context.Context) ([]reconcile.Request, error)
+}
+
+func (e *EnqueueRequestForNamespace) Create(ctx context.Context, _ event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	e.enqueue(ctx, q)
+}
+
+func (e *EnqueueRequestForNamespace) Update(ctx context.Context, _ event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	e.enqueue(ctx, q)
+}
+
+func (e *EnqueueRequestForNamespace) Delete(ctx context.Context, _ event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	e.enqueue(ctx, q)
+}
+
+func (e *EnqueueRequestForNamespace) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	log.Debug("Namespace generic event, do nothing")
+}
+
+func (e *EnqueueRequestForNamespace) enqueue(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	requests, err := e.ListRequests(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list policies for Namespace event")
+		return
+	}
+	for _, req := range requests {
+		q.Add(req)
+	}
+}
+
+// PredicateFuncsNs filters Namespace events for AdminNetworkPolicy and BaselineAdminNetworkPolicy controllers.
+var PredicateFuncsNs = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return true
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldObj := e.ObjectOld.(*v1.Namespace)
+		newObj := e.ObjectNew.(*v1.Namespace)
+		if reflect.DeepEqual(oldObj.ObjectMeta.Labels, newObj.ObjectMeta.Labels) {
+			log.Debug("Label of namespace is not changed, ignore it", "name", oldObj.Name)
+			return false
+		}
+		return true
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return true
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
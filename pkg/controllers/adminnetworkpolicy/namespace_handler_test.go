@@ -0,0 +1,76 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func newTestQueue() workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+}
+
+func TestEnqueueRequestForNamespace(t *testing.T) {
+	c := newFakeClient(
+		&policyv1alpha1.AdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "anp1"}},
+		&policyv1alpha1.AdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "anp2"}},
+	)
+	e := &EnqueueRequestForNamespace{ListRequests: func(ctx context.Context) ([]reconcile.Request, error) {
+		return listPolicyRequests(ctx, c, servicecommon.ResourceTypeAdminNetworkPolicy)
+	}}
+	ns := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}}
+
+	q := newTestQueue()
+	defer q.ShutDown()
+	e.Create(context.TODO(), event.CreateEvent{Object: ns}, q)
+	assert.Equal(t, 2, q.Len())
+
+	q = newTestQueue()
+	defer q.ShutDown()
+	e.Update(context.TODO(), event.UpdateEvent{ObjectOld: ns, ObjectNew: ns}, q)
+	assert.Equal(t, 2, q.Len())
+
+	q = newTestQueue()
+	defer q.ShutDown()
+	e.Delete(context.TODO(), event.DeleteEvent{Object: ns}, q)
+	assert.Equal(t, 2, q.Len())
+
+	q = newTestQueue()
+	defer q.ShutDown()
+	e.Generic(context.TODO(), event.GenericEvent{Object: ns}, q)
+	assert.Equal(t, 0, q.Len())
+
+	e = &EnqueueRequestForNamespace{ListRequests: func(_ context.Context) ([]reconcile.Request, error) {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "anp1"}}}, errors.New("list failed")
+	}}
+	e.Create(context.TODO(), event.CreateEvent{Object: ns}, q)
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestPredicateFuncsNs(t *testing.T) {
+	oldNs := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: map[string]string{"team": "a"}}}
+	newNs := oldNs.DeepCopy()
+	newNs.Annotations = map[string]string{"foo": "bar"}
+
+	assert.True(t, PredicateFuncsNs.Create(event.CreateEvent{Object: oldNs}))
+	assert.True(t, PredicateFuncsNs.Delete(event.DeleteEvent{Object: oldNs}))
+	assert.False(t, PredicateFuncsNs.Generic(event.GenericEvent{Object: oldNs}))
+	assert.False(t, PredicateFuncsNs.Update(event.UpdateEvent{ObjectOld: oldNs, ObjectNew: newNs}))
+
+	newNs.Labels = map[string]string{"team": "b"}
+	assert.True(t, PredicateFuncsNs.Update(event.UpdateEvent{ObjectOld: oldNs, ObjectNew: newNs}))
+}
@@ -0,0 +1,355 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+)
+
+var (
+	log           = logger.Log
+	ResultNormal  = common.ResultNormal
+	ResultRequeue = common.ResultRequeue
+)
+
+const (
+	conditionTypeReady            = "Ready"
+	reasonNSXRealized             = "NSXSecurityPolicyRealized"
+	reasonNSXFailed               = "NSXSecurityPolicyFailed"
+	reasonNoDFWLicense            = "NoDFWLicense"
+	reasonInvalidPolicy           = "InvalidPolicy"
+	reasonSystemNamespacesSkipped = "SystemNamespacesSkipped"
+)
+
+// isAPIResourceInstalled checks if the resource of sig-network policy API, i.e., adminnetworkpolicies or
+// baselineadminnetworkpolicies, is installed in the cluster.
+func isAPIResourceInstalled(discoveryClient discovery.DiscoveryInterface, mgr ctrl.Manager, resource string) (bool, error) {
+	if discoveryClient == nil {
+		var err error
+		discoveryClient, err = discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+		if err != nil {
+			return false, err
+		}
+	}
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(policyv1alpha1.GroupVersion.String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, res := range resourceList.APIResources {
+		if res.Name == resource {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// newPolicyObject returns an empty AdminNetworkPolicy or BaselineAdminNetworkPolicy according to createdFor.
+func newPolicyObject(createdFor string) client.Object {
+	if createdFor == servicecommon.ResourceTypeBaselineAdminNetworkPolicy {
+		return &policyv1alpha1.BaselineAdminNetworkPolicy{}
+	}
+	return &policyv1alpha1.AdminNetworkPolicy{}
+}
+
+// newPolicyList returns an empty AdminNetworkPolicyList or BaselineAdminNetworkPolicyList according to createdFor.
+func newPolicyList(createdFor string) client.ObjectList {
+	if createdFor == servicecommon.ResourceTypeBaselineAdminNetworkPolicy {
+		return &policyv1alpha1.BaselineAdminNetworkPolicyList{}
+	}
+	return &policyv1alpha1.AdminNetworkPolicyList{}
+}
+
+func getSubject(obj client.Object) *policyv1alpha1.AdminNetworkPolicySubject {
+	switch o := obj.(type) {
+	case *policyv1alpha1.AdminNetworkPolicy:
+		return &o.Spec.Subject
+	case *policyv1alpha1.BaselineAdminNetworkPolicy:
+		return &o.Spec.Subject
+	default:
+		return nil
+	}
+}
+
+func getConditions(obj client.Object) *[]metav1.Condition {
+	switch o := obj.(type) {
+	case *policyv1alpha1.AdminNetworkPolicy:
+		return &o.Status.Conditions
+	case *policyv1alpha1.BaselineAdminNetworkPolicy:
+		return &o.Status.Conditions
+	default:
+		return nil
+	}
+}
+
+// setReadyStatusTrue sets the Ready condition to True. The optional argument is the list of the system Namespaces
+// selected by the subject, which are reported in the condition as the policy is not enforced in them.
+func setReadyStatusTrue(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, args ...interface{}) {
+	condition := metav1.Condition{
+		Type:               conditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonNSXRealized,
+		Message:            "NSX SecurityPolicies have been successfully created/updated",
+		LastTransitionTime: transitionTime,
+	}
+	if len(args) > 0 {
+		if skippedNamespaces, ok := args[0].([]string); ok && len(skippedNamespaces) > 0 {
+			condition.Reason = reasonSystemNamespacesSkipped
+			condition.Message = fmt.Sprintf("NSX SecurityPolicies have been successfully created/updated, the policy is not enforced in the system Namespaces %v selected by the subject", skippedNamespaces)
+		}
+	}
+	updateReadyCondition(client, ctx, obj, condition)
+}
+
+func setReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, _ ...interface{}) {
+	condition := metav1.Condition{
+		Type:               conditionTypeReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reasonNSXFailed,
+		Message:            fmt.Sprintf("NSX SecurityPolicies could not be created/updated: %v", err),
+		LastTransitionTime: transitionTime,
+	}
+	var validationErr *nsxutil.ValidationError
+	var restrictionErr nsxutil.RestrictionError
+	if errors.As(err, &restrictionErr) {
+		condition.Reason = reasonNoDFWLicense
+	} else if errors.As(err, &validationErr) {
+		condition.Reason = reasonInvalidPolicy
+	}
+	updateReadyCondition(client, ctx, obj, condition)
+}
+
+func updateReadyCondition(client client.Client, ctx context.Context, obj client.Object, condition metav1.Condition) {
+	conditions := getConditions(obj)
+	if conditions == nil {
+		return
+	}
+	condition.ObservedGeneration = obj.GetGeneration()
+	if !meta.SetStatusCondition(conditions, condition) {
+		log.Trace("Conditions already match", "kind", obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName(), "condition", condition)
+		return
+	}
+	if err := client.Status().Update(ctx, obj); err != nil {
+		log.Error(err, "Failed to update status", "name", obj.GetName())
+		return
+	}
+	log.Info("Updated status", "name", obj.GetName(), "conditions", *conditions)
+}
+
+// deleteAdminNetworkPolicyByName deletes the NSX SecurityPolicies created for the AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy whose CR has been deleted.
+func deleteAdminNetworkPolicyByName(ctx context.Context, service *securitypolicy.SecurityPolicyService, name, createdFor string) error {
+	nsxSecurityPolicies := service.ListAdminNetworkPolicyByName(name, createdFor)
+	for _, item := range nsxSecurityPolicies {
+		uid := nsxutil.FindTag(item.Tags, servicecommon.TagScopeAdminNetworkPolicyUID)
+		log.Info("Deleting NSX SecurityPolicy", "createdFor", createdFor, "nsxSecurityPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
+		if err := service.DeleteSecurityPolicy(ctx, types.UID(uid), false, createdFor); err != nil {
+			log.Error(err, "Failed to delete NSX SecurityPolicy", "createdFor", createdFor, "nsxSecurityPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
+			return err
+		}
+	}
+	return nil
+}
+
+// collectGarbage deletes the NSX resources of the AdminNetworkPolicies or BaselineAdminNetworkPolicies which are not
+// in crUIDs.
+func collectGarbage(ctx context.Context, service *securitypolicy.SecurityPolicyService, statusUpdater *common.StatusUpdater, crUIDs sets.Set[string], createdFor string) error {
+	var errList []error
+	for id := range service.ListAdminNetworkPolicyID(createdFor) {
+		if uid, _, _ := securitypolicy.ParseAdminNetworkPolicyID(id); crUIDs.Has(uid) {
+			continue
+		}
+		log.Debug("GC collected NSX SecurityPolicy", "createdFor", createdFor, "ID", id)
+		statusUpdater.IncreaseDeleteTotal()
+		if err := service.DeleteSecurityPolicy(ctx, types.UID(id), true, createdFor); err != nil {
+			errList = append(errList, err)
+			statusUpdater.IncreaseDeleteFailTotal()
+		} else {
+			statusUpdater.IncreaseDeleteSuccessTotal()
+		}
+	}
+	if len(errList) > 0 {
+		return fmt.Errorf("errors found in %s garbage collection: %s", createdFor, errList)
+	}
+	return nil
+}
+
+// reconcilePolicy realizes the AdminNetworkPolicy or BaselineAdminNetworkPolicy identified by createdFor as the NSX
+// SecurityPolicies in its subject Namespaces, or deletes the NSX SecurityPolicies if the CR has been deleted.
+func reconcilePolicy(ctx context.Context, c client.Client, service *securitypolicy.SecurityPolicyService, statusUpdater *common.StatusUpdater,
+	req ctrl.Request, createdFor string,
+) (ctrl.Result, error) {
+	obj := newPolicyObject(createdFor)
+	log.Info("Reconciling "+createdFor, "name", req.Name)
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling "+createdFor, "name", req.Name, "duration(ms)", time.Since(startTime).Milliseconds())
+		statusUpdater.ObserveReconcileDuration(startTime)
+	}()
+
+	statusUpdater.IncreaseSyncTotal()
+
+	if err := c.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			if err := deleteAdminNetworkPolicyByName(ctx, service, req.Name, createdFor); err != nil {
+				statusUpdater.DeleteFail(req.NamespacedName, nil, err)
+				return ResultRequeue, err
+			}
+			statusUpdater.DeleteSuccess(req.NamespacedName, nil)
+			return ResultNormal, nil
+		}
+		log.Error(err, "Failed to fetch "+createdFor+" CR", "req", req.NamespacedName)
+		return ResultRequeue, err
+	}
+
+	if !obj.GetDeletionTimestamp().IsZero() {
+		log.Info("Reconciling CR to delete "+createdFor, "name", req.Name)
+		statusUpdater.IncreaseDeleteTotal()
+		if err := deleteAdminNetworkPolicyByName(ctx, service, req.Name, createdFor); err != nil {
+			statusUpdater.DeleteFail(req.NamespacedName, nil, err)
+			return ResultRequeue, err
+		}
+		statusUpdater.DeleteSuccess(req.NamespacedName, nil)
+		return ResultNormal, nil
+	}
+
+	statusUpdater.IncreaseUpdateTotal()
+	if err := service.CreateOrUpdateSecurityPolicy(ctx, obj); err != nil {
+		if errors.As(err, &nsxutil.RestrictionError{}) {
+			statusUpdater.UpdateFail(ctx, obj, err, "", setReadyStatusFalse)
+			return ResultNormal, nil
+		}
+		if nsxutil.IsInvalidLicense(err) {
+			log.Error(err, err.Error(), "kind", createdFor, "name", req.Name)
+			os.Exit(1)
+		}
+		statusUpdater.UpdateFail(ctx, obj, err, "", setReadyStatusFalse)
+		var validationErr *nsxutil.ValidationError
+		if errors.As(err, &validationErr) {
+			// The CR needs to be updated to fix the validation error, so there is no need to retry.
+			return ResultNormal, nil
+		}
+		return ResultRequeue, err
+	}
+	skippedNamespaces, err := service.ListSkippedSubjectNamespaces(getSubject(obj))
+	if err != nil {
+		statusUpdater.UpdateFail(ctx, obj, err, "", setReadyStatusFalse)
+		return ResultRequeue, err
+	}
+	statusUpdater.UpdateSuccess(ctx, obj, setReadyStatusTrue, skippedNamespaces)
+	return ResultNormal, nil
+}
+
+// setupPolicyController builds the controller of the AdminNetworkPolicies or BaselineAdminNetworkPolicies, which
+// also watches the Namespaces as they may be selected or unselected by the subjects.
+func setupPolicyController(mgr ctrl.Manager, r reconcile.Reconciler, createdFor string) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(newPolicyObject(createdFor)).
+		Watches(
+			&v1.Namespace{},
+			&EnqueueRequestForNamespace{
+				ListRequests: func(ctx context.Context) ([]reconcile.Request, error) {
+					return listPolicyRequests(ctx, mgr.GetClient(), createdFor)
+				},
+			},
+			builder.WithPredicates(PredicateFuncsNs),
+		).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(),
+			}).
+		Complete(tracing.NewReconciler(createdFor, r))
+}
+
+// startPolicyController starts the controller of the AdminNetworkPolicies or BaselineAdminNetworkPolicies and its
+// garbage collector if the resource of sig-network policy API is installed in the cluster.
+func startPolicyController(mgr ctrl.Manager, discoveryClient discovery.DiscoveryInterface, r reconcile.Reconciler, gc common.GarbageCollector,
+	resource, createdFor string,
+) error {
+	installed, err := isAPIResourceInstalled(discoveryClient, mgr, resource)
+	if err != nil {
+		log.Error(err, "Failed to check "+createdFor+" CRD", "controller", createdFor)
+		return err
+	}
+	if !installed {
+		log.Info(createdFor + " CRD is not installed in the cluster, skipping " + createdFor + " controller start")
+		return nil
+	}
+	if err := setupPolicyController(mgr, r, createdFor); err != nil {
+		log.Error(err, "Failed to create controller", "controller", createdFor)
+		return err
+	}
+	go common.GenericGarbageCollector(make(chan bool), servicecommon.GCInterval, gc.CollectGarbage)
+	return nil
+}
+
+// listPolicyUIDs returns the UIDs of the AdminNetworkPolicies or BaselineAdminNetworkPolicies in the cluster.
+func listPolicyUIDs(ctx context.Context, c client.Client, createdFor string) (sets.Set[string], error) {
+	list := newPolicyList(createdFor)
+	if err := c.List(ctx, list); err != nil {
+		return nil, err
+	}
+	crUIDs := sets.New[string]()
+	err := meta.EachListItem(list, func(o runtime.Object) error {
+		crUIDs.Insert(string(o.(client.Object).GetUID()))
+		return nil
+	})
+	return crUIDs, err
+}
+
+// listPolicyRequests returns the reconcile requests of all the AdminNetworkPolicies or BaselineAdminNetworkPolicies.
+func listPolicyRequests(ctx context.Context, c client.Client, createdFor string) ([]reconcile.Request, error) {
+	list := newPolicyList(createdFor)
+	if err := c.List(ctx, list); err != nil {
+		return nil, err
+	}
+	requests := make([]reconcile.Request, 0, meta.LenList(list))
+	err := meta.EachListItem(list, func(o runtime.Object) error {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: o.(client.Object).GetName()}})
+		return nil
+	})
+	return requests, err
+}
+
+// collectPolicyGarbage deletes the NSX SecurityPolicies whose AdminNetworkPolicy or BaselineAdminNetworkPolicy has
+// been removed from K8s.
+func collectPolicyGarbage(ctx context.Context, c client.Client, service *securitypolicy.SecurityPolicyService, statusUpdater *common.StatusUpdater,
+	createdFor string,
+) error {
+	log.Info(createdFor + " garbage collector started")
+	crUIDs, err := listPolicyUIDs(ctx, c, createdFor)
+	if err != nil {
+		log.Error(err, "Failed to list "+createdFor+" CRs")
+		return err
+	}
+	return collectGarbage(ctx, service, statusUpdater, crUIDs, createdFor)
+}
@@ -0,0 +1,86 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+func TestIsAPIResourceInstalled(t *testing.T) {
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	installed, err := isAPIResourceInstalled(discoveryClient, nil, "adminnetworkpolicies")
+	assert.NoError(t, err)
+	assert.False(t, installed)
+
+	discoveryClient.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: policyv1alpha1.GroupVersion.String(),
+			APIResources: []metav1.APIResource{{Name: "adminnetworkpolicies"}},
+		},
+	}
+	installed, err = isAPIResourceInstalled(discoveryClient, nil, "adminnetworkpolicies")
+	assert.NoError(t, err)
+	assert.True(t, installed)
+	installed, err = isAPIResourceInstalled(discoveryClient, nil, "baselineadminnetworkpolicies")
+	assert.NoError(t, err)
+	assert.False(t, installed)
+}
+
+func TestUpdateReadyCondition(t *testing.T) {
+	banp := &policyv1alpha1.BaselineAdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: 2}}
+	c := newFakeClient(banp)
+
+	setReadyStatusFalse(c, context.TODO(), banp, metav1.Now(), errors.New("VPC is not ready"))
+	got := &policyv1alpha1.BaselineAdminNetworkPolicy{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "default"}, got))
+	assert.Len(t, got.Status.Conditions, 1)
+	assert.Equal(t, metav1.ConditionFalse, got.Status.Conditions[0].Status)
+	assert.Equal(t, reasonNSXFailed, got.Status.Conditions[0].Reason)
+	assert.Equal(t, int64(2), got.Status.Conditions[0].ObservedGeneration)
+
+	setReadyStatusTrue(c, context.TODO(), got, metav1.Now())
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "default"}, got))
+	assert.Len(t, got.Status.Conditions, 1)
+	assert.Equal(t, metav1.ConditionTrue, got.Status.Conditions[0].Status)
+	assert.Equal(t, reasonNSXRealized, got.Status.Conditions[0].Reason)
+
+	setReadyStatusTrue(c, context.TODO(), got, metav1.Now(), []string{"kube-system", "vmware-system-nsx"})
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "default"}, got))
+	assert.Len(t, got.Status.Conditions, 1)
+	assert.Equal(t, metav1.ConditionTrue, got.Status.Conditions[0].Status)
+	assert.Equal(t, reasonSystemNamespacesSkipped, got.Status.Conditions[0].Reason)
+	assert.Contains(t, got.Status.Conditions[0].Message, "[kube-system vmware-system-nsx]")
+}
+
+func TestCollectGarbage(t *testing.T) {
+	service := fakeService()
+	statusUpdater := common.NewStatusUpdater(newFakeClient(), service.NSXConfig, fakeRecorder{}, common.MetricResTypeBaselineAdminNetworkPolicy,
+		"SecurityPolicy", "BaselineAdminNetworkPolicy")
+	patches := gomonkey.ApplyMethod(service, "ListAdminNetworkPolicyID", func(_ *securitypolicy.SecurityPolicyService, createdFor string) sets.Set[string] {
+		return sets.New[string]("uid1.ns1_banp", "uid2.ns1_banp")
+	})
+	patches.ApplyMethod(service, "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, uid types.UID, isGC bool, createdFor string) error {
+		return errors.New("NSX is not available")
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, collectGarbage(context.TODO(), service, &statusUpdater, sets.New[string]("uid1", "uid2"), servicecommon.ResourceTypeBaselineAdminNetworkPolicy))
+	err := collectGarbage(context.TODO(), service, &statusUpdater, sets.New[string]("uid1"), servicecommon.ResourceTypeBaselineAdminNetworkPolicy)
+	assert.ErrorContains(t, err, "NSX is not available")
+}
@@ -0,0 +1,299 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+const ReasonSubnetQuotaExceeded = "SubnetQuotaExceeded"
+
+// SubnetQuotaExceededError is returned when creating a Subnet for a SubnetSet exceeds the limits
+// of the SubnetSet or the Subnet quota of its Namespace, or when creating or growing a Subnet for
+// a Subnet CR exceeds the Subnet quota of its Namespace.
+type SubnetQuotaExceededError struct {
+	Message string
+}
+
+func (e *SubnetQuotaExceededError) Error() string {
+	return e.Message
+}
+
+// checkSubnetQuota checks if a new Subnet can be created for the auto-managed SubnetSet
+// which already has the Subnets in subnetList.
+func checkSubnetQuota(subnetSet *v1alpha1.SubnetSet, subnetList []*model.VpcSubnet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider) error {
+	newIPs := 0
+	if util.IPAddressTypeIncludesIPv4(subnetSet.Spec.IPAddressType) {
+		newIPs = subnetSet.Spec.IPv4SubnetSize
+	}
+	if limit := subnetSet.Spec.MaxSubnets; limit > 0 && len(subnetList)+1 > limit {
+		return &SubnetQuotaExceededError{Message: fmt.Sprintf("SubnetSet %s/%s has reached maxSubnets %d", subnetSet.Namespace, subnetSet.Name, limit)}
+	}
+	if limit := subnetSet.Spec.MaxIPs; limit > 0 && servicecommon.CountIPv4Addresses(subnetList)+newIPs > limit {
+		return &SubnetQuotaExceededError{Message: fmt.Sprintf("a new Subnet of size %d exceeds maxIPs %d of SubnetSet %s/%s, %d IPs are used",
+			newIPs, limit, subnetSet.Namespace, subnetSet.Name, servicecommon.CountIPv4Addresses(subnetList))}
+	}
+
+	return checkNamespaceSubnetQuota(subnetSet.Namespace, 1, newIPs, vpcService, subnetService)
+}
+
+// CheckSubnetQuotaForSubnet checks if the Subnet quota of the Namespace allows creating the NSX Subnet of the
+// Subnet CR, or growing it with the CIDRs appended to spec.ipAddresses. nsxSubnets are the existing NSX Subnets
+// of the Subnet CR.
+func CheckSubnetQuotaForSubnet(subnet *v1alpha1.Subnet, nsxSubnets []*model.VpcSubnet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider) error {
+	if len(nsxSubnets) == 0 {
+		newIPs := 0
+		if util.ContainsIPv4CIDR(subnet.Spec.IPAddresses) {
+			newIPs = servicecommon.CountIPv4Addresses([]*model.VpcSubnet{{IpAddresses: subnet.Spec.IPAddresses}})
+		} else if util.IPAddressTypeIncludesIPv4(subnet.Spec.IPAddressType) {
+			newIPs = subnet.Spec.IPv4SubnetSize
+		}
+		return checkNamespaceSubnetQuota(subnet.Namespace, 1, newIPs, vpcService, subnetService)
+	}
+	existing := sets.New(nsxSubnets[0].IpAddresses...)
+	var added []string
+	for _, ipAddress := range subnet.Spec.IPAddresses {
+		if !existing.Has(ipAddress) {
+			added = append(added, ipAddress)
+		}
+	}
+	if len(existing) == 0 || len(added) == 0 {
+		return nil
+	}
+	return checkNamespaceSubnetQuota(subnet.Namespace, 0, servicecommon.CountIPv4Addresses([]*model.VpcSubnet{{IpAddresses: added}}), vpcService, subnetService)
+}
+
+// checkNamespaceSubnetQuota checks if newSubnets Subnets with newIPs IPv4 addresses can be added to the Subnets of
+// the Namespace without exceeding the Subnet quota of its VPCNetworkConfiguration.
+func checkNamespaceSubnetQuota(namespace string, newSubnets, newIPs int, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider) error {
+	vpcNetworkConfig, err := vpcService.GetVPCNetworkConfigByNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	if vpcNetworkConfig == nil || vpcNetworkConfig.Spec.SubnetQuota == nil {
+		return nil
+	}
+	quota := vpcNetworkConfig.Spec.SubnetQuota
+	nsSubnets := namespaceSubnets(namespace, subnetService)
+	if quota.MaxSubnets > 0 && len(nsSubnets)+newSubnets > quota.MaxSubnets {
+		return &SubnetQuotaExceededError{Message: fmt.Sprintf("Namespace %s has reached the Subnet quota maxSubnets %d of VPCNetworkConfiguration %s",
+			namespace, quota.MaxSubnets, vpcNetworkConfig.Name)}
+	}
+	if used := servicecommon.CountIPv4Addresses(nsSubnets); quota.MaxIPs > 0 && used+newIPs > quota.MaxIPs {
+		allocation := fmt.Sprintf("a new Subnet of size %d", newIPs)
+		if newSubnets == 0 {
+			allocation = fmt.Sprintf("growing a Subnet by %d IPs", newIPs)
+		}
+		return &SubnetQuotaExceededError{Message: fmt.Sprintf("%s exceeds the Subnet quota maxIPs %d of VPCNetworkConfiguration %s for Namespace %s, %d IPs are used",
+			allocation, quota.MaxIPs, vpcNetworkConfig.Name, namespace, used)}
+	}
+	return nil
+}
+
+// namespaceSubnets returns the NSX Subnets created for the Subnets and SubnetSets of the Namespace.
+func namespaceSubnets(namespace string, subnetService servicecommon.SubnetServiceProvider) []*model.VpcSubnet {
+	// The Subnets of the default Pod SubnetSet are tagged with the Namespace, the others with the VM Namespace.
+	nsSubnets := subnetService.GetSubnetsByIndex(servicecommon.TagScopeNamespace, namespace)
+	return append(nsSubnets, subnetService.GetSubnetsByIndex(servicecommon.TagScopeVMNamespace, namespace)...)
+}
+
+// subnetUsageUpdateDelay is the time the Subnet quota usage updates of the Namespaces sharing a
+// VPCNetworkConfiguration are batched for before the status of the VPCNetworkConfiguration is written.
+var subnetUsageUpdateDelay = time.Second
+
+// namespaceSubnetQuotaLocks are the locks serializing the Subnet quota checks of each Namespace.
+var namespaceSubnetQuotaLocks sync.Map
+
+// LockNamespaceSubnetQuota locks the Subnet quota of the Namespace. It must be held from the quota check
+// to the creation or growth of the NSX Subnet, so that concurrent reconciles can't exceed the quota.
+func LockNamespaceSubnetQuota(namespace string) *sync.Mutex {
+	lock, _ := namespaceSubnetQuotaLocks.LoadOrStore(namespace, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex)
+}
+
+// subnetUsageUpdates are the Subnet quota usages of a VPCNetworkConfiguration not written yet.
+type subnetUsageUpdates struct {
+	client k8sclient.Client
+	// usages are the usages by Namespace, nil removes the usage of the Namespace.
+	usages map[string]*v1alpha1.NamespaceSubnetUsage
+}
+
+// subnetUsageUpdater batches the Subnet quota usage updates by VPCNetworkConfiguration, so that the
+// reconciles of the Namespaces sharing a VPCNetworkConfiguration don't contend on writing its status.
+type subnetUsageUpdater struct {
+	lock    sync.Mutex
+	pending map[string]*subnetUsageUpdates
+	// namespaceConfigs is the VPCNetworkConfiguration the usage of each Namespace is reported in.
+	namespaceConfigs map[string]string
+}
+
+var subnetUsage = &subnetUsageUpdater{
+	pending:          map[string]*subnetUsageUpdates{},
+	namespaceConfigs: map[string]string{},
+}
+
+// UpdateNamespaceSubnetUsage reports the Subnets and IPv4 addresses used by the Namespace in the status of its
+// VPCNetworkConfiguration if the VPCNetworkConfiguration sets a Subnet quota and the Namespace has Subnets,
+// otherwise it removes the usage of the Namespace from the status. The usage is also removed from the
+// VPCNetworkConfiguration the Namespace used before. The status is written asynchronously.
+func UpdateNamespaceSubnetUsage(client k8sclient.Client, namespace string, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider) {
+	vpcNetworkConfig, err := vpcService.GetVPCNetworkConfigByNamespace(namespace)
+	if err != nil {
+		log.Error(err, "Failed to get VPCNetworkConfiguration to update the Subnet quota usage", "Namespace", namespace)
+		return
+	}
+	configName := ""
+	var usage *v1alpha1.NamespaceSubnetUsage
+	if vpcNetworkConfig != nil {
+		configName = vpcNetworkConfig.Name
+		if nsSubnets := namespaceSubnets(namespace, subnetService); vpcNetworkConfig.Spec.SubnetQuota != nil && len(nsSubnets) > 0 {
+			usage = &v1alpha1.NamespaceSubnetUsage{Namespace: namespace, Subnets: len(nsSubnets), IPs: servicecommon.CountIPv4Addresses(nsSubnets)}
+		}
+	}
+	subnetUsage.update(client, namespace, configName, usage)
+}
+
+func (u *subnetUsageUpdater) update(client k8sclient.Client, namespace, configName string, usage *v1alpha1.NamespaceSubnetUsage) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	if oldConfigName, ok := u.namespaceConfigs[namespace]; ok && oldConfigName != configName {
+		u.enqueue(client, oldConfigName, namespace, nil)
+	}
+	if configName == "" || usage == nil {
+		delete(u.namespaceConfigs, namespace)
+	} else {
+		u.namespaceConfigs[namespace] = configName
+	}
+	if configName != "" {
+		u.enqueue(client, configName, namespace, usage)
+	}
+}
+
+func (u *subnetUsageUpdater) enqueue(client k8sclient.Client, configName, namespace string, usage *v1alpha1.NamespaceSubnetUsage) {
+	updates, ok := u.pending[configName]
+	if !ok {
+		updates = &subnetUsageUpdates{usages: map[string]*v1alpha1.NamespaceSubnetUsage{}}
+		u.pending[configName] = updates
+		time.AfterFunc(subnetUsageUpdateDelay, func() { u.flush(configName) })
+	}
+	updates.client = client
+	updates.usages[namespace] = usage
+}
+
+func (u *subnetUsageUpdater) flush(configName string) {
+	u.lock.Lock()
+	updates := u.pending[configName]
+	delete(u.pending, configName)
+	u.lock.Unlock()
+	if updates != nil {
+		writeSubnetQuotaUsage(updates.client, configName, updates.usages)
+	}
+}
+
+// writeSubnetQuotaUsage writes the usages in the status of the VPCNetworkConfiguration, and prunes the usages of
+// the deleted Namespaces. All the usages are removed if the VPCNetworkConfiguration sets no Subnet quota.
+func writeSubnetQuotaUsage(client k8sclient.Client, configName string, usages map[string]*v1alpha1.NamespaceSubnetUsage) {
+	ctx := context.Background()
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.VPCNetworkConfiguration{}
+		if err := client.Get(ctx, types.NamespacedName{Name: configName}, latest); err != nil {
+			return k8sclient.IgnoreNotFound(err)
+		}
+		var newUsages []v1alpha1.NamespaceSubnetUsage
+		if latest.Spec.SubnetQuota != nil {
+			for _, usage := range latest.Status.SubnetQuotaUsage {
+				if _, ok := usages[usage.Namespace]; ok {
+					continue
+				}
+				if err := client.Get(ctx, types.NamespacedName{Name: usage.Namespace}, &v1.Namespace{}); apierrors.IsNotFound(err) {
+					continue
+				}
+				newUsages = append(newUsages, usage)
+			}
+			for _, usage := range usages {
+				if usage != nil {
+					newUsages = append(newUsages, *usage)
+				}
+			}
+			sort.Slice(newUsages, func(i, j int) bool { return newUsages[i].Namespace < newUsages[j].Namespace })
+		}
+		if slices.Equal(newUsages, latest.Status.SubnetQuotaUsage) {
+			return nil
+		}
+		latest.Status.SubnetQuotaUsage = newUsages
+		return client.Status().Update(ctx, latest)
+	})
+	if retryErr != nil {
+		log.Error(retryErr, "Failed to update the Subnet quota usage", "VPCNetworkConfiguration", configName)
+	}
+}
+
+// updateSubnetQuotaCondition sets the SubnetQuotaExceeded condition of the SubnetSet if quotaErr is not nil,
+// otherwise it removes the condition.
+func updateSubnetQuotaCondition(client k8sclient.Client, subnetSet *v1alpha1.SubnetSet, quotaErr error) {
+	ctx := context.Background()
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.SubnetSet{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: subnetSet.Namespace, Name: subnetSet.Name}, latest); err != nil {
+			return err
+		}
+		var conditions []v1alpha1.Condition
+		var existing *v1alpha1.Condition
+		for i, cond := range latest.Status.Conditions {
+			if cond.Type == v1alpha1.SubnetQuotaExceeded {
+				existing = &latest.Status.Conditions[i]
+			} else {
+				conditions = append(conditions, cond)
+			}
+		}
+		if quotaErr == nil && existing == nil {
+			return nil
+		}
+		if quotaErr != nil {
+			if existing != nil && existing.Message == quotaErr.Error() {
+				return nil
+			}
+			conditions = append(conditions, v1alpha1.Condition{
+				Type:               v1alpha1.SubnetQuotaExceeded,
+				Status:             v1.ConditionTrue,
+				Reason:             ReasonSubnetQuotaExceeded,
+				Message:            quotaErr.Error(),
+				LastTransitionTime: metav1.Now(),
+			})
+		}
+		latest.Status.Conditions = conditions
+		return client.Status().Update(ctx, latest)
+	})
+	if retryErr != nil {
+		log.Error(retryErr, "Failed to update SubnetQuotaExceeded condition", "SubnetSet", subnetSet.Name, "Namespace", subnetSet.Namespace)
+	}
+}
+
+func hasSubnetQuotaExceededCondition(subnetSet *v1alpha1.SubnetSet) bool {
+	for _, cond := range subnetSet.Status.Conditions {
+		if cond.Type == v1alpha1.SubnetQuotaExceeded && cond.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,300 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	pkg_mock "github.com/vmware-tanzu/nsx-operator/pkg/mock"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestCheckSubnetQuota(t *testing.T) {
+	size16 := int64(16)
+	subnets := []*model.VpcSubnet{
+		{Id: servicecommon.String("subnet-1"), Ipv4SubnetSize: &size16},
+		{Id: servicecommon.String("subnet-2"), IpAddresses: []string{"10.0.0.0/28", "fd00::/64"}},
+	}
+	tests := []struct {
+		name        string
+		spec        v1alpha1.SubnetSetSpec
+		quota       *v1alpha1.SubnetQuota
+		nsSubnets   []*model.VpcSubnet
+		expectedErr string
+	}{
+		{
+			name: "NoLimit",
+			spec: v1alpha1.SubnetSetSpec{IPv4SubnetSize: 16},
+		},
+		{
+			name: "UnderSubnetSetLimits",
+			spec: v1alpha1.SubnetSetSpec{IPv4SubnetSize: 16, MaxSubnets: 3, MaxIPs: 48},
+		},
+		{
+			name:        "MaxSubnets",
+			spec:        v1alpha1.SubnetSetSpec{IPv4SubnetSize: 16, MaxSubnets: 2},
+			expectedErr: "SubnetSet ns-1/subnetset-1 has reached maxSubnets 2",
+		},
+		{
+			name:        "MaxIPs",
+			spec:        v1alpha1.SubnetSetSpec{IPv4SubnetSize: 16, MaxIPs: 40},
+			expectedErr: "a new Subnet of size 16 exceeds maxIPs 40 of SubnetSet ns-1/subnetset-1, 32 IPs are used",
+		},
+		{
+			name: "IPv6SubnetSetNotLimitedByMaxIPs",
+			spec: v1alpha1.SubnetSetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv6, MaxIPs: 32},
+		},
+		{
+			name:        "NamespaceMaxSubnets",
+			spec:        v1alpha1.SubnetSetSpec{IPv4SubnetSize: 16},
+			quota:       &v1alpha1.SubnetQuota{MaxSubnets: 2},
+			nsSubnets:   subnets,
+			expectedErr: "Namespace ns-1 has reached the Subnet quota maxSubnets 2 of VPCNetworkConfiguration nc-1",
+		},
+		{
+			name:        "NamespaceMaxIPs",
+			spec:        v1alpha1.SubnetSetSpec{IPv4SubnetSize: 16},
+			quota:       &v1alpha1.SubnetQuota{MaxSubnets: 3, MaxIPs: 32},
+			nsSubnets:   subnets,
+			expectedErr: "a new Subnet of size 16 exceeds the Subnet quota maxIPs 32 of VPCNetworkConfiguration nc-1 for Namespace ns-1, 32 IPs are used",
+		},
+		{
+			name:      "UnderNamespaceQuota",
+			spec:      v1alpha1.SubnetSetSpec{IPv4SubnetSize: 16},
+			quota:     &v1alpha1.SubnetQuota{MaxSubnets: 3, MaxIPs: 48},
+			nsSubnets: subnets,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnetSet := &v1alpha1.SubnetSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "subnetset-1", Namespace: "ns-1"},
+				Spec:       tt.spec,
+			}
+			vps := &pkg_mock.MockVPCServiceProvider{}
+			ssp := &pkg_mock.MockSubnetServiceProvider{}
+			vps.On("GetVPCNetworkConfigByNamespace", "ns-1").Return(&v1alpha1.VPCNetworkConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "nc-1"},
+				Spec:       v1alpha1.VPCNetworkConfigurationSpec{SubnetQuota: tt.quota},
+			}, nil)
+			ssp.On("GetSubnetsByIndex", servicecommon.TagScopeNamespace, "ns-1").Return(tt.nsSubnets[:len(tt.nsSubnets)/2])
+			ssp.On("GetSubnetsByIndex", servicecommon.TagScopeVMNamespace, "ns-1").Return(tt.nsSubnets[len(tt.nsSubnets)/2:])
+
+			err := checkSubnetQuota(subnetSet, subnets, vps, ssp)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var quotaErr *SubnetQuotaExceededError
+			require.ErrorAs(t, err, &quotaErr)
+			assert.Equal(t, tt.expectedErr, quotaErr.Error())
+		})
+	}
+}
+
+func TestUpdateSubnetQuotaCondition(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	subnetSet := &v1alpha1.SubnetSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "subnetset-1", Namespace: "ns-1"},
+		Status: v1alpha1.SubnetSetStatus{
+			Conditions: []v1alpha1.Condition{{Type: v1alpha1.Ready, Status: v1.ConditionTrue}},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(subnetSet).WithStatusSubresource(subnetSet).Build()
+	getConditions := func() []v1alpha1.Condition {
+		latest := &v1alpha1.SubnetSet{}
+		require.NoError(t, client.Get(context.TODO(), types.NamespacedName{Name: "subnetset-1", Namespace: "ns-1"}, latest))
+		return latest.Status.Conditions
+	}
+
+	updateSubnetQuotaCondition(client, subnetSet, &SubnetQuotaExceededError{Message: "quota exceeded"})
+	conditions := getConditions()
+	require.Len(t, conditions, 2)
+	assert.Equal(t, v1alpha1.Ready, conditions[0].Type)
+	assert.Equal(t, v1alpha1.SubnetQuotaExceeded, conditions[1].Type)
+	assert.Equal(t, "quota exceeded", conditions[1].Message)
+	assert.True(t, hasSubnetQuotaExceededCondition(&v1alpha1.SubnetSet{Status: v1alpha1.SubnetSetStatus{Conditions: conditions}}))
+
+	updateSubnetQuotaCondition(client, subnetSet, nil)
+	conditions = getConditions()
+	require.Len(t, conditions, 1)
+	assert.Equal(t, v1alpha1.Ready, conditions[0].Type)
+}
+
+func TestCheckSubnetQuotaForSubnet(t *testing.T) {
+	size16 := int64(16)
+	nsSubnets := []*model.VpcSubnet{
+		{Id: servicecommon.String("subnet-1"), Ipv4SubnetSize: &size16},
+		{Id: servicecommon.String("subnet-2"), IpAddresses: []string{"10.0.0.0/28", "fd00::/64"}},
+	}
+	tests := []struct {
+		name        string
+		spec        v1alpha1.SubnetSpec
+		nsxSubnets  []*model.VpcSubnet
+		quota       *v1alpha1.SubnetQuota
+		expectedErr string
+	}{
+		{
+			name: "NoQuota",
+			spec: v1alpha1.SubnetSpec{IPv4SubnetSize: 16},
+		},
+		{
+			name:        "NewSubnetMaxSubnets",
+			spec:        v1alpha1.SubnetSpec{IPv4SubnetSize: 16},
+			quota:       &v1alpha1.SubnetQuota{MaxSubnets: 2},
+			expectedErr: "Namespace ns-1 has reached the Subnet quota maxSubnets 2 of VPCNetworkConfiguration nc-1",
+		},
+		{
+			name:        "NewSubnetWithIPAddressesMaxIPs",
+			spec:        v1alpha1.SubnetSpec{IPAddresses: []string{"10.0.1.0/28", "10.0.2.0/28"}},
+			quota:       &v1alpha1.SubnetQuota{MaxIPs: 48},
+			expectedErr: "a new Subnet of size 32 exceeds the Subnet quota maxIPs 48 of VPCNetworkConfiguration nc-1 for Namespace ns-1, 32 IPs are used",
+		},
+		{
+			name:  "NewSubnetUnderQuota",
+			spec:  v1alpha1.SubnetSpec{IPv4SubnetSize: 16},
+			quota: &v1alpha1.SubnetQuota{MaxSubnets: 3, MaxIPs: 48},
+		},
+		{
+			name:       "ExistingSubnetNotGrown",
+			spec:       v1alpha1.SubnetSpec{IPAddresses: []string{"10.0.0.0/28"}},
+			nsxSubnets: []*model.VpcSubnet{nsSubnets[1]},
+			quota:      &v1alpha1.SubnetQuota{MaxSubnets: 2, MaxIPs: 32},
+		},
+		{
+			name:        "ExistingSubnetGrownMaxIPs",
+			spec:        v1alpha1.SubnetSpec{IPAddresses: []string{"10.0.0.0/28", "10.0.1.0/28"}},
+			nsxSubnets:  []*model.VpcSubnet{nsSubnets[1]},
+			quota:       &v1alpha1.SubnetQuota{MaxSubnets: 2, MaxIPs: 40},
+			expectedErr: "growing a Subnet by 16 IPs exceeds the Subnet quota maxIPs 40 of VPCNetworkConfiguration nc-1 for Namespace ns-1, 32 IPs are used",
+		},
+		{
+			name:       "ExistingSubnetGrownUnderQuota",
+			spec:       v1alpha1.SubnetSpec{IPAddresses: []string{"10.0.0.0/28", "10.0.1.0/28"}},
+			nsxSubnets: []*model.VpcSubnet{nsSubnets[1]},
+			quota:      &v1alpha1.SubnetQuota{MaxSubnets: 2, MaxIPs: 48},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnet := &v1alpha1.Subnet{
+				ObjectMeta: metav1.ObjectMeta{Name: "subnet-1", Namespace: "ns-1"},
+				Spec:       tt.spec,
+			}
+			vps := &pkg_mock.MockVPCServiceProvider{}
+			ssp := &pkg_mock.MockSubnetServiceProvider{}
+			vps.On("GetVPCNetworkConfigByNamespace", "ns-1").Return(&v1alpha1.VPCNetworkConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "nc-1"},
+				Spec:       v1alpha1.VPCNetworkConfigurationSpec{SubnetQuota: tt.quota},
+			}, nil)
+			ssp.On("GetSubnetsByIndex", servicecommon.TagScopeNamespace, "ns-1").Return([]*model.VpcSubnet{})
+			ssp.On("GetSubnetsByIndex", servicecommon.TagScopeVMNamespace, "ns-1").Return(nsSubnets)
+
+			err := CheckSubnetQuotaForSubnet(subnet, tt.nsxSubnets, vps, ssp)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var quotaErr *SubnetQuotaExceededError
+			require.ErrorAs(t, err, &quotaErr)
+			assert.Equal(t, tt.expectedErr, quotaErr.Error())
+		})
+	}
+}
+
+func TestUpdateNamespaceSubnetUsage(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	require.NoError(t, v1.AddToScheme(scheme))
+	size16 := int64(16)
+	nc := &v1alpha1.VPCNetworkConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "nc-1"},
+		Spec:       v1alpha1.VPCNetworkConfigurationSpec{SubnetQuota: &v1alpha1.SubnetQuota{MaxSubnets: 4}},
+		Status: v1alpha1.VPCNetworkConfigurationStatus{
+			SubnetQuotaUsage: []v1alpha1.NamespaceSubnetUsage{
+				{Namespace: "ns-2", Subnets: 1, IPs: 16},
+				{Namespace: "ns-deleted", Subnets: 1, IPs: 16},
+			},
+		},
+	}
+	nc2 := &v1alpha1.VPCNetworkConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "nc-2"},
+		Spec:       v1alpha1.VPCNetworkConfigurationSpec{SubnetQuota: &v1alpha1.SubnetQuota{MaxSubnets: 4}},
+	}
+	namespaces := []k8sclient.Object{
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns-1"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns-2"}},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(nc, nc2).WithObjects(namespaces...).WithStatusSubresource(nc, nc2).Build()
+	vps := &pkg_mock.MockVPCServiceProvider{}
+	ssp := &pkg_mock.MockSubnetServiceProvider{}
+	ncCall := vps.On("GetVPCNetworkConfigByNamespace", "ns-1").Return(nc, nil)
+	ssp.On("GetSubnetsByIndex", servicecommon.TagScopeNamespace, "ns-1").Return([]*model.VpcSubnet{{Ipv4SubnetSize: &size16}})
+	ssp.On("GetSubnetsByIndex", servicecommon.TagScopeVMNamespace, "ns-1").Return([]*model.VpcSubnet{{IpAddresses: []string{"10.0.0.0/28", "10.0.1.0/28"}}})
+	getUsage := func(name string) []v1alpha1.NamespaceSubnetUsage {
+		latest := &v1alpha1.VPCNetworkConfiguration{}
+		require.NoError(t, client.Get(context.TODO(), types.NamespacedName{Name: name}, latest))
+		return latest.Status.SubnetQuotaUsage
+	}
+	defer func(delay time.Duration) { subnetUsageUpdateDelay = delay }(subnetUsageUpdateDelay)
+	subnetUsageUpdateDelay = 10 * time.Millisecond
+
+	// The usage is written asynchronously, and the usage of the deleted Namespace is pruned.
+	UpdateNamespaceSubnetUsage(client, "ns-1", vps, ssp)
+	expected := []v1alpha1.NamespaceSubnetUsage{
+		{Namespace: "ns-1", Subnets: 2, IPs: 48},
+		{Namespace: "ns-2", Subnets: 1, IPs: 16},
+	}
+	assert.Eventually(t, func() bool { return reflect.DeepEqual(expected, getUsage("nc-1")) }, time.Second, 10*time.Millisecond)
+
+	// The usage of the Namespace is moved when the Namespace switches to another VPCNetworkConfiguration.
+	ncCall.Unset()
+	vps.On("GetVPCNetworkConfigByNamespace", "ns-1").Return(nc2, nil)
+	UpdateNamespaceSubnetUsage(client, "ns-1", vps, ssp)
+	expected = []v1alpha1.NamespaceSubnetUsage{{Namespace: "ns-2", Subnets: 1, IPs: 16}}
+	assert.Eventually(t, func() bool {
+		return reflect.DeepEqual(expected, getUsage("nc-1")) && reflect.DeepEqual([]v1alpha1.NamespaceSubnetUsage{{Namespace: "ns-1", Subnets: 2, IPs: 48}}, getUsage("nc-2"))
+	}, time.Second, 10*time.Millisecond)
+
+	// All the usages are removed when the Subnet quota is unset.
+	latest := &v1alpha1.VPCNetworkConfiguration{}
+	require.NoError(t, client.Get(context.TODO(), types.NamespacedName{Name: "nc-2"}, latest))
+	latest.Spec.SubnetQuota = nil
+	require.NoError(t, client.Update(context.TODO(), latest))
+	UpdateNamespaceSubnetUsage(client, "ns-1", vps, ssp)
+	assert.Eventually(t, func() bool { return len(getUsage("nc-2")) == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestLockNamespaceSubnetQuota(t *testing.T) {
+	lock := LockNamespaceSubnetQuota("ns-1")
+	locked := make(chan struct{})
+	go func() {
+		LockNamespaceSubnetQuota("ns-1").Unlock()
+		close(locked)
+	}()
+	// The lock of another Namespace is independent.
+	LockNamespaceSubnetQuota("ns-2").Unlock()
+	select {
+	case <-locked:
+		t.Fatal("the Subnet quota of the Namespace is locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+	lock.Unlock()
+	<-locked
+}
@@ -10,6 +10,8 @@ import (
 const (
 	MetricResTypeSecurityPolicy             = "securitypolicy"
 	MetricResTypeNetworkPolicy              = "networkpolicy"
+	MetricResTypeAdminNetworkPolicy         = "adminnetworkpolicy"
+	MetricResTypeBaselineAdminNetworkPolicy = "baselineadminnetworkpolicy"
 	MetricResTypeIPPool                     = "ippool"
 	MetricResTypeIPAddressAllocation        = "ipaddressallocation"
 	MetricResTypeNSXServiceAccount          = "nsxserviceaccount"
@@ -26,6 +28,11 @@ const (
 	MetricResTypeServiceLb                  = "servicelb"
 	MetricResTypeStatefulSet                = "statefulset"
 	MetricResTypeGateway                    = "gateway"
+	MetricResTypeServiceEndpoint            = "serviceendpoint"
+	MetricResTypeVPCEndpoint                = "vpcendpoint"
+	MetricResTypeSubnetPortSetting          = "subnetportsetting"
+	MetricResTypeIngress                    = "ingress"
+	MetricResTypeVirtualMachine             = "virtualmachine"
 	MaxConcurrentReconciles                 = 8
 	NSXOperatorError                        = "nsx-op/error"
 	//sync the error with NCP side
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -21,7 +22,9 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -186,7 +189,10 @@ func IsNamespaceInTepLessMode(client k8sclient.Client, namespace string) (bool,
 	return networkInfo.VPCs[0].NetworkStack == v1alpha1.VLANBackedVPC, nil
 }
 
-func AllocateSubnetFromSubnetSet(client k8sclient.Client, apiReader k8sclient.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceIPType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
+// AllocateSubnetFromSubnetSet returns the path of a Subnet of subnetSet with a free IP for a new port,
+// a new Subnet is created if all the Subnets are full. The wait for the SubnetSet lock and the NSX
+// requests are traced in child spans of ctx.
+func AllocateSubnetFromSubnetSet(ctx context.Context, client k8sclient.Client, apiReader k8sclient.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceIPType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
 	if subnetSet.Spec.SubnetDHCPConfig.Mode == v1alpha1.DHCPConfigMode(v1alpha1.DHCPConfigModeRelay) {
 		// From NSX Operator 9.1.1, DHCPRelay SubnetSet is no longer supported.
 		return "", nil, nil, fmt.Errorf("Creating SubnetPort on DHCPRelay SubnetSet is not supported")
@@ -194,50 +200,80 @@ func AllocateSubnetFromSubnetSet(client k8sclient.Client, apiReader k8sclient.Re
 	if subnetSet.Spec.SubnetNames != nil {
 		// Use Read lock to allow SubnetPorts created parallelly on the pre-created SubnetSet
 		// and block the SubnetPort creation when the SubnetSet is updated
-		subnetSetLock := RLockSubnetSet(subnetSet.UID)
+		subnetSetLock := lockSubnetSet(ctx, subnetSet, false)
 		// Retrieve the SubnetSet again to avoid it being updated before acquiring the lock
-		if err := apiReader.Get(context.Background(), types.NamespacedName{Namespace: subnetSet.Namespace, Name: subnetSet.Name}, subnetSet); err != nil {
+		if err := apiReader.Get(ctx, types.NamespacedName{Namespace: subnetSet.Namespace, Name: subnetSet.Name}, subnetSet); err != nil {
 			return "", &subnetSet.UID, subnetSetLock, err
 		}
 		nsxSubnet, err := GetSubnetFromSubnetSet(client, subnetSet, vpcService, subnetService, subnetPortService, interfaceIPType)
 		return nsxSubnet, &subnetSet.UID, subnetSetLock, err
 	}
 	// Use SubnetSet uuid lock to make sure when multiple ports are created on the same SubnetSet, only one Subnet will be created
-	subnetSetLock := WLockSubnetSet(subnetSet.GetUID())
-	defer WUnlockSubnetSet(subnetSet.GetUID(), subnetSetLock)
+	subnetSetLock := lockSubnetSet(ctx, subnetSet, true)
+	subnetPath, created, err := allocateSubnetFromAutoSubnetSet(ctx, subnetSet, vpcService, subnetService, subnetPortService, interfaceIPType)
+	WUnlockSubnetSet(subnetSet.GetUID(), subnetSetLock)
+	// The statuses are updated without holding the SubnetSet lock.
+	if created {
+		UpdateNamespaceSubnetUsage(client, subnetSet.Namespace, vpcService, subnetService)
+	}
+	var quotaErr *SubnetQuotaExceededError
+	if errors.As(err, &quotaErr) {
+		log.Info("Subnet quota exceeded, not creating new Subnet", "subnetSet.Name", subnetSet.Name, "subnetSet.Namespace", subnetSet.Namespace, "reason", err.Error())
+		updateSubnetQuotaCondition(client, subnetSet, err)
+	} else if (subnetPath != "" || created) && hasSubnetQuotaExceededCondition(subnetSet) {
+		updateSubnetQuotaCondition(client, subnetSet, nil)
+	}
+	return subnetPath, nil, nil, err
+}
+
+// allocateSubnetFromAutoSubnetSet returns the path of a Subnet of the auto-managed subnetSet with a free IP for a
+// new port, creating a new Subnet if none is available, and whether a Subnet is created. It must be called with
+// the write lock of the SubnetSet held.
+func allocateSubnetFromAutoSubnetSet(ctx context.Context, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceIPType v1alpha1.IPAddressType) (string, bool, error) {
 	subnetList := subnetService.GetSubnetsByIndex(servicecommon.TagScopeSubnetSetCRUID, string(subnetSet.GetUID()))
 	for _, nsxSubnet := range subnetList {
 		canAllocate, err := subnetPortService.AllocatePortFromSubnet(nsxSubnet, false, interfaceIPType)
 		if err != nil {
-			return "", nil, nil, err
+			return "", false, err
 		}
 		if canAllocate {
-			return *nsxSubnet.Path, nil, nil, nil
+			return *nsxSubnet.Path, false, nil
 		}
 	}
+	nsxSubnet, err := createSubnetForSubnetSet(ctx, subnetSet, subnetList, vpcService, subnetService)
+	if err != nil {
+		return "", false, err
+	}
+	canAllocate, err := subnetPortService.AllocatePortFromSubnet(nsxSubnet, false, interfaceIPType)
+	if err != nil {
+		return "", true, err
+	}
+	if canAllocate {
+		return *nsxSubnet.Path, true, nil
+	}
+	return "", true, fmt.Errorf("cannot allocate Port from SubnetSet %s", subnetSet.Name)
+}
+
+// createSubnetForSubnetSet creates a new Subnet for the auto-managed subnetSet which has the Subnets in subnetList.
+// The Subnet quota of the Namespace is locked from the quota check to the Subnet creation.
+func createSubnetForSubnetSet(ctx context.Context, subnetSet *v1alpha1.SubnetSet, subnetList []*model.VpcSubnet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider) (*model.VpcSubnet, error) {
+	quotaLock := LockNamespaceSubnetQuota(subnetSet.Namespace)
+	defer quotaLock.Unlock()
+	if err := checkSubnetQuota(subnetSet, subnetList, vpcService, subnetService); err != nil {
+		return nil, err
+	}
 	tags := subnetService.GenerateSubnetNSTags(subnetSet)
 	if tags == nil {
-		return "", nil, nil, errors.New("failed to generate subnet tags")
+		return nil, errors.New("failed to generate subnet tags")
 	}
 	log.Info("The existing subnets are not available, creating new subnet", "subnetList", subnetList, "subnetSet.Name", subnetSet.Name, "subnetSet.Namespace", subnetSet.Namespace)
 	vpcInfoList := vpcService.ListVPCInfo(subnetSet.Namespace)
 	if len(vpcInfoList) == 0 {
 		err := errors.New("no VPC found")
 		log.Error(err, "Failed to allocate Subnet")
-		return "", nil, nil, err
-	}
-	nsxSubnet, err := subnetService.CreateOrUpdateSubnet(subnetSet, vpcInfoList[0], tags)
-	if err != nil {
-		return "", nil, nil, err
-	}
-	canAllocate, err := subnetPortService.AllocatePortFromSubnet(nsxSubnet, false, interfaceIPType)
-	if err != nil {
-		return "", nil, nil, err
-	}
-	if canAllocate {
-		return *nsxSubnet.Path, nil, nil, nil
+		return nil, err
 	}
-	return "", nil, nil, fmt.Errorf("cannot allocate Port from SubnetSet %s", subnetSet.Name)
+	return subnetService.CreateOrUpdateSubnet(ctx, subnetSet, vpcInfoList[0], tags)
 }
 
 func GetDefaultSubnetSetByNamespace(client k8sclient.Client, namespace string, resourceType string) (*v1alpha1.SubnetSet, error) {
@@ -299,7 +335,8 @@ func NumReconcile() int {
 }
 
 func GenericGarbageCollector(cancel chan bool, timeout time.Duration, f func(ctx context.Context) error) {
-	ctx := context.Background()
+	// The NSX API requests of the garbage collection are background requests for the rate limiter.
+	ctx := ratelimiter.WithClass(context.Background(), ratelimiter.ClassBackground)
 
 	if GCStartupDelay > 0 {
 		select {
@@ -394,6 +431,10 @@ func (u *StatusUpdater) IncreaseDeleteFailTotal() {
 	metrics.CounterInc(u.NSXConfig, metrics.ControllerDeleteFailTotal, u.MetricResType)
 }
 
+func (u *StatusUpdater) ObserveReconcileDuration(startTime time.Time) {
+	metrics.HistogramObserveSince(u.NSXConfig, metrics.ControllerReconcileDuration, u.MetricResType, startTime)
+}
+
 func NewStatusUpdater(client k8sclient.Client, nsxConfig *config.NSXOperatorConfig, recorder record.EventRecorder, metricResType string, nsxResourceType string, resourceType string) StatusUpdater {
 	return StatusUpdater{
 		Client:          client,
@@ -405,6 +446,20 @@ func NewStatusUpdater(client k8sclient.Client, nsxConfig *config.NSXOperatorConf
 	}
 }
 
+// lockSubnetSet acquires the write or read lock of subnetSet, the wait is traced in a child span of ctx.
+func lockSubnetSet(ctx context.Context, subnetSet *v1alpha1.SubnetSet, write bool) *sync.RWMutex {
+	_, span := tracing.Start(ctx, "SubnetSet.LockWait",
+		attribute.String("k8s.namespace", subnetSet.Namespace),
+		attribute.String("k8s.name", subnetSet.Name),
+		attribute.Bool("subnetset.write_lock", write),
+	)
+	defer span.End()
+	if write {
+		return WLockSubnetSet(subnetSet.GetUID())
+	}
+	return RLockSubnetSet(subnetSet.GetUID())
+}
+
 func WLockSubnetSet(uuid types.UID) *sync.RWMutex {
 	lock := sync.RWMutex{}
 	subnetSetLock, _ := SubnetSetLocks.LoadOrStore(uuid, &lock)
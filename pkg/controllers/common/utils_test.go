@@ -132,6 +132,7 @@ func TestAllocateSubnetFromSubnetSet(t *testing.T) {
 			prepareFunc: func(t *testing.T, vsp servicecommon.VPCServiceProvider, ssp servicecommon.SubnetServiceProvider, spsp servicecommon.SubnetPortServiceProvider) {
 				ssp.(*pkg_mock.MockSubnetServiceProvider).On("GetSubnetsByIndex", mock.Anything, mock.Anything).
 					Return([]*model.VpcSubnet{})
+				vsp.(*pkg_mock.MockVPCServiceProvider).On("GetVPCNetworkConfigByNamespace", mock.Anything).Return(nil, nil)
 				ssp.(*pkg_mock.MockSubnetServiceProvider).On("GenerateSubnetNSTags", mock.Anything)
 				vsp.(*pkg_mock.MockVPCServiceProvider).On("ListVPCInfo", mock.Anything).Return([]servicecommon.VPCResourceInfo{})
 			},
@@ -143,6 +144,7 @@ func TestAllocateSubnetFromSubnetSet(t *testing.T) {
 			prepareFunc: func(t *testing.T, vsp servicecommon.VPCServiceProvider, ssp servicecommon.SubnetServiceProvider, spsp servicecommon.SubnetPortServiceProvider) {
 				ssp.(*pkg_mock.MockSubnetServiceProvider).On("GetSubnetsByIndex", mock.Anything, mock.Anything).
 					Return([]*model.VpcSubnet{})
+				vsp.(*pkg_mock.MockVPCServiceProvider).On("GetVPCNetworkConfigByNamespace", mock.Anything).Return(nil, nil)
 				ssp.(*pkg_mock.MockSubnetServiceProvider).On("GenerateSubnetNSTags", mock.Anything)
 				vsp.(*pkg_mock.MockVPCServiceProvider).On("ListVPCInfo", mock.Anything).Return([]servicecommon.VPCResourceInfo{{}})
 				ssp.(*pkg_mock.MockSubnetServiceProvider).On("CreateOrUpdateSubnet", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.VpcSubnet{Path: &expectedSubnetPath}, nil)
@@ -156,6 +158,30 @@ func TestAllocateSubnetFromSubnetSet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "SubnetQuotaExceeded",
+			prepareFunc: func(t *testing.T, vsp servicecommon.VPCServiceProvider, ssp servicecommon.SubnetServiceProvider, spsp servicecommon.SubnetPortServiceProvider) {
+				ssp.(*pkg_mock.MockSubnetServiceProvider).On("GetSubnetsByIndex", mock.Anything, mock.Anything).
+					Return([]*model.VpcSubnet{
+						{
+							Id:             servicecommon.String("id-1"),
+							Path:           &expectedSubnetPath,
+							Ipv4SubnetSize: &subnetSize,
+						},
+					})
+				spsp.(*pkg_mock.MockSubnetPortServiceProvider).On("AllocatePortFromSubnet", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+			},
+			expectedErr: "SubnetSet ns-1/subnetset-1 has reached maxSubnets 1",
+			subnetSet: &v1alpha1.SubnetSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "subnetset-1",
+					Namespace: "ns-1",
+				},
+				Spec: v1alpha1.SubnetSetSpec{
+					MaxSubnets: 1,
+				},
+			},
+		},
 		{
 			name: "PrecreatedSubnetSet",
 			prepareFunc: func(t *testing.T, vsp servicecommon.VPCServiceProvider, ssp servicecommon.SubnetServiceProvider, spsp servicecommon.SubnetPortServiceProvider) {
@@ -197,7 +223,7 @@ func TestAllocateSubnetFromSubnetSet(t *testing.T) {
 			ssp := &pkg_mock.MockSubnetServiceProvider{}
 			spsp := &pkg_mock.MockSubnetPortServiceProvider{}
 			tt.prepareFunc(t, vps, ssp, spsp)
-			subnetPath, subnetSetUID, subnetSetLock, err := AllocateSubnetFromSubnetSet(k8sclient, k8sclient, tt.subnetSet, vps, ssp, spsp, "")
+			subnetPath, subnetSetUID, subnetSetLock, err := AllocateSubnetFromSubnetSet(context.TODO(), k8sclient, k8sclient, tt.subnetSet, vps, ssp, spsp, "")
 			if subnetSetLock != nil {
 				RUnlockSubnetSet(*subnetSetUID, subnetSetLock)
 			}
@@ -39,6 +39,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
 	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
 	extdnssrc "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/source"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 const (
@@ -83,6 +84,7 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	startTime := time.Now()
 	defer func() {
 		log.Debug("Finished reconciling Gateway", "Gateway", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	log.Debug("Reconcile started", "Gateway", req.NamespacedName)
@@ -469,7 +471,7 @@ func (r *GatewayReconciler) setupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
-	return b.WithOptions(controller.Options{MaxConcurrentReconciles: common.NumReconcile()}).Complete(r)
+	return b.WithOptions(controller.Options{MaxConcurrentReconciles: common.NumReconcile()}).Complete(tracing.NewReconciler("Gateway", r))
 }
 
 // warmGatewayIPCacheOnStartup loads ipCache from API, sets ipCacheWarmedOnStartup, enqueues Route resyncs; returns err.
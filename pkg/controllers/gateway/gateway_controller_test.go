@@ -471,6 +471,7 @@ func TestGatewayReconciler_Reconcile(t *testing.T) {
 				d.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "default", "gw1").Return(true, nil).AnyTimes()
 				c.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				s.EXPECT().IncreaseSyncTotal().AnyTimes()
+				s.EXPECT().ObserveReconcileDuration(gomock.Any()).AnyTimes()
 				s.EXPECT().IncreaseDeleteTotal().AnyTimes()
 				s.EXPECT().DeleteSuccess(gomock.Any(), gomock.Any()).AnyTimes()
 			},
@@ -489,6 +490,7 @@ func TestGatewayReconciler_Reconcile(t *testing.T) {
 				d.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "default", "gw1").Return(true, nil).Times(1)
 				c.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				s.EXPECT().IncreaseSyncTotal().AnyTimes()
+				s.EXPECT().ObserveReconcileDuration(gomock.Any()).AnyTimes()
 				s.EXPECT().IncreaseDeleteTotal().AnyTimes()
 				s.EXPECT().DeleteSuccess(gomock.Any(), gomock.Any()).AnyTimes()
 			},
@@ -513,6 +515,7 @@ func TestGatewayReconciler_Reconcile(t *testing.T) {
 				d.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
 				d.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "default", "gw1").Return(true, nil).AnyTimes()
 				s.EXPECT().IncreaseSyncTotal().AnyTimes()
+				s.EXPECT().ObserveReconcileDuration(gomock.Any()).AnyTimes()
 				s.EXPECT().IncreaseUpdateTotal().AnyTimes()
 				s.EXPECT().UpdateSuccess(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 				s.EXPECT().DeleteSuccess(gomock.Any(), gomock.Any()).AnyTimes()
@@ -524,6 +527,7 @@ func TestGatewayReconciler_Reconcile(t *testing.T) {
 			setupMock: func(c *mockclient.MockClient, d *mockdns.MockDNSRecordProvider, s *mockgateway.MockStatusUpdater) {
 				c.EXPECT().Get(gomock.Any(), gwReq.NamespacedName, gomock.Any()).Return(errors.New("get error"))
 				s.EXPECT().IncreaseSyncTotal().AnyTimes()
+				s.EXPECT().ObserveReconcileDuration(gomock.Any()).AnyTimes()
 			},
 			wantRes: common.ResultRequeueAfter10sec,
 		},
@@ -533,6 +537,7 @@ func TestGatewayReconciler_Reconcile(t *testing.T) {
 				c.EXPECT().Get(gomock.Any(), gwReq.NamespacedName, gomock.Any()).Return(apierrors.NewNotFound(schema.GroupResource{}, ""))
 				d.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "default", "gw1").Return(false, errors.New("delete error"))
 				s.EXPECT().IncreaseSyncTotal().AnyTimes()
+				s.EXPECT().ObserveReconcileDuration(gomock.Any()).AnyTimes()
 				s.EXPECT().IncreaseDeleteTotal().AnyTimes()
 				s.EXPECT().DeleteFail(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			},
@@ -550,6 +555,7 @@ func TestGatewayReconciler_Reconcile(t *testing.T) {
 				})
 				d.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "default", "gw1").Return(false, errors.New("delete error"))
 				s.EXPECT().IncreaseSyncTotal().AnyTimes()
+				s.EXPECT().ObserveReconcileDuration(gomock.Any()).AnyTimes()
 				s.EXPECT().IncreaseDeleteTotal().AnyTimes()
 				s.EXPECT().DeleteFail(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			},
@@ -567,6 +573,7 @@ func TestGatewayReconciler_Reconcile(t *testing.T) {
 				})
 				d.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "default", "gw1").Return(false, errors.New("delete error"))
 				s.EXPECT().IncreaseSyncTotal().AnyTimes()
+				s.EXPECT().ObserveReconcileDuration(gomock.Any()).AnyTimes()
 				s.EXPECT().IncreaseDeleteTotal().AnyTimes()
 				s.EXPECT().DeleteFail(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			},
@@ -585,6 +592,7 @@ func TestGatewayReconciler_Reconcile(t *testing.T) {
 				})
 				d.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindGateway, "default", "gw1").Return(false, errors.New("delete error"))
 				s.EXPECT().IncreaseSyncTotal().AnyTimes()
+				s.EXPECT().ObserveReconcileDuration(gomock.Any()).AnyTimes()
 				s.EXPECT().IncreaseDeleteTotal().AnyTimes()
 				s.EXPECT().DeleteFail(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			},
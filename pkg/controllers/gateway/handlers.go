@@ -24,6 +24,7 @@ import (
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
 	extdnssrc "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/source"
 )
 
@@ -243,7 +244,8 @@ func (r *genericRouteReconciler[PT, T, PI]) predicateFuncsRouteDNS() predicate.F
 			oldAnnos := e.ObjectOld.GetAnnotations()
 			newAnnos := e.ObjectNew.GetAnnotations()
 			if oldAnnos[servicecommon.AnnotationDNSHostnameKey] != newAnnos[servicecommon.AnnotationDNSHostnameKey] ||
-				oldAnnos[servicecommon.AnnotationDNSHostnameSourceKey] != newAnnos[servicecommon.AnnotationDNSHostnameSourceKey] {
+				oldAnnos[servicecommon.AnnotationDNSHostnameSourceKey] != newAnnos[servicecommon.AnnotationDNSHostnameSourceKey] ||
+				dns.RecordAnnotationsChanged(oldAnnos, newAnnos) {
 				return true
 			}
 			oldParent := parentRefKeys(oldRoute.GetNamespace(), oldRoute.GetParentRefs())
@@ -298,7 +300,8 @@ var predicateFuncsGateway = predicate.Funcs{
 		oldAnnos := oldObj.GetAnnotations()
 		newAnnos := newObj.GetAnnotations()
 		if oldAnnos[servicecommon.AnnotationDNSHostnameKey] != newAnnos[servicecommon.AnnotationDNSHostnameKey] ||
-			oldAnnos[servicecommon.AnnotationsDNSSkip] != newAnnos[servicecommon.AnnotationsDNSSkip] {
+			oldAnnos[servicecommon.AnnotationsDNSSkip] != newAnnos[servicecommon.AnnotationsDNSSkip] ||
+			dns.RecordAnnotationsChanged(oldAnnos, newAnnos) {
 			return true
 		}
 
@@ -37,6 +37,11 @@ func TestPredicateFuncsRouteDNS(t *testing.T) {
 		{"update-unchanged", func(t *testing.T) {
 			assert.False(t, p.Update(event.UpdateEvent{ObjectOld: httpObj1, ObjectNew: httpObj1}))
 		}},
+		{"update-ttl-annotation", func(t *testing.T) {
+			withTTL := httpObj1.DeepCopy()
+			withTTL.Annotations = map[string]string{servicecommon.AnnotationDNSTTLKey: "60"}
+			assert.True(t, p.Update(event.UpdateEvent{ObjectOld: httpObj1, ObjectNew: withTTL}))
+		}},
 		{"delete", func(t *testing.T) { assert.True(t, p.Delete(event.DeleteEvent{Object: httpObj1})) }},
 	}
 	for _, tc := range tests {
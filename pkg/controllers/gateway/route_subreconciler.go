@@ -36,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
 	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
 	extdnssrc "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/source"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 const (
@@ -101,6 +102,11 @@ type genericRouteReconciler[PT RouteObject[T], T any, PI Object[T]] struct {
 
 // Reconcile upserts or deletes DNS store rows for one Route; returns ctrl.Result, err.
 func (r *genericRouteReconciler[PT, T, PI]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		log.Debug("Finished reconciling Route", "kind", r.kind, "Route", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.statusUpdater.ObserveReconcileDuration(startTime)
+	}()
 	wrapper := r.objCreator(nil)
 	routeKey := req.NamespacedName.String()
 	route := wrapper.GetObject()
@@ -642,7 +648,7 @@ func (r *genericRouteReconciler[PT, T, PI]) registerWatcher(mgr ctrl.Manager, op
 		builder.WithPredicates(predicateNetworkInfoAllowedDNSDomainsChanged()),
 	)
 
-	return b.Complete(r)
+	return b.Complete(tracing.NewReconciler(r.kind, r))
 }
 
 func (r *genericRouteReconciler[PT, T, PI]) getKind() string {
@@ -786,6 +786,7 @@ func TestGenericRouteReconciler_Reconcile(t *testing.T) {
 				func() client.ObjectList { return &gatewayv1.HTTPRouteList{} },
 			)
 
+			s.EXPECT().ObserveReconcileDuration(gomock.Any()).Times(1)
 			if tc.setupMock != nil {
 				tc.setupMock(c, d, s, ipCache, sub)
 			}
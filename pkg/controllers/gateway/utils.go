@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -44,6 +45,7 @@ type StatusUpdater interface {
 	IncreaseDeleteTotal()
 	IncreaseDeleteSuccessTotal()
 	IncreaseDeleteFailTotal()
+	ObserveReconcileDuration(startTime time.Time)
 	DeleteFail(namespacedName types.NamespacedName, obj client.Object, err error)
 }
 
@@ -0,0 +1,153 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+)
+
+var (
+	log           = logger.Log
+	ResultNormal  = common.ResultNormal
+	MetricResType = common.MetricResTypeIngress
+)
+
+// IngressReconciler publishes NSX DNS records for the rule hosts of networking.k8s.io/v1 Ingresses.
+type IngressReconciler struct {
+	Client   client.Client
+	Scheme   *apimachineryruntime.Scheme
+	Service  *servicecommon.Service
+	DNS      dns.DNSRecordProvider
+	Recorder record.EventRecorder
+}
+
+func (r *IngressReconciler) deleteDNSForIngress(ctx context.Context, namespace, name string, op string) error {
+	if _, err := r.DNS.DeleteRecordByOwnerNN(ctx, dns.ResourceKindIngress, namespace, name); err != nil {
+		log.Error(err, "Failed to delete DNS records for Ingress", "Namespace", namespace, "Name", name, "Operation", op)
+		return fmt.Errorf("deleting DNS records for %s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *IngressReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ingress := &networkingv1.Ingress{}
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling Ingress", "Ingress", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		if r.Service != nil {
+			metrics.HistogramObserveSince(r.Service.NSXConfig, metrics.ControllerReconcileDuration, MetricResType, startTime)
+		}
+	}()
+
+	if err := r.Client.Get(ctx, req.NamespacedName, ingress); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Not found Ingress", "req", req.NamespacedName)
+			if err := r.deleteDNSForIngress(ctx, req.Namespace, req.Name, "deleted Ingress"); err != nil {
+				return common.ResultRequeueAfter10sec, nil
+			}
+			return ResultNormal, nil
+		}
+		log.Error(err, "Failed to fetch Ingress", "req", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+
+	if !ingress.ObjectMeta.DeletionTimestamp.IsZero() {
+		if err := r.deleteDNSForIngress(ctx, req.Namespace, req.Name, "terminating Ingress"); err != nil {
+			return common.ResultRequeueAfter10sec, nil
+		}
+		return ResultNormal, nil
+	}
+
+	log.Info("Reconciling Ingress", "Ingress", req.NamespacedName)
+	if r.Service != nil {
+		metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerSyncTotal, MetricResType)
+	}
+	if err := r.reconcileIngressDNS(ctx, ingress); err != nil {
+		log.Error(err, "Failed to reconcile DNS for Ingress", "Ingress", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	return ResultNormal, nil
+}
+
+func (r *IngressReconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1.Ingress{}).
+		Watches(
+			&v1alpha1.NetworkInfo{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueIngressRequestsFromNetworkInfo),
+			builder.WithPredicates(predicateNetworkInfoAllowedDNSDomainsChanged()),
+		).
+		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(),
+			}).
+		Complete(tracing.NewReconciler("Ingress", r))
+}
+
+func (r *IngressReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func (r *IngressReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "Ingress")
+		return err
+	}
+	err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		stop := make(chan bool)
+		go func() {
+			<-ctx.Done()
+			close(stop)
+		}()
+		common.GenericGarbageCollector(stop, servicecommon.GCInterval, r.CollectGarbage)
+		return nil
+	}))
+	if err != nil {
+		log.Error(err, "Failed to add Ingress GC to manager")
+		return err
+	}
+	return nil
+}
+
+func (r *IngressReconciler) CollectGarbage(ctx context.Context) error {
+	return r.collectDNSGarbage(ctx)
+}
+
+// NewIngressReconciler returns nil if the DNS record service is not available, Ingresses are only reconciled for DNS.
+func NewIngressReconciler(mgr ctrl.Manager, commonService servicecommon.Service, dnsRecordService *dns.DNSRecordService) *IngressReconciler {
+	if dnsRecordService == nil {
+		log.Info("Ingress controller isn't started since DNS record service is not available")
+		return nil
+	}
+	return &IngressReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Service:  &commonService,
+		DNS:      dnsRecordService,
+		Recorder: mgr.GetEventRecorderFor("ingress-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	}
+}
@@ -0,0 +1,238 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ingress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+const (
+	// Ingress has no status conditions, the DNS publish state is reported with Events using the DNSRecordReady condition reasons.
+	reasonDNSRecordConfigured = "DNSRecordConfigured"
+	reasonDNSRecordFailed     = "DNSRecordFailed"
+)
+
+// hostnamesFromIngressRules returns the unique non-empty spec.rules[].host values, or nil if the Ingress
+// has the nsx.vmware.com/skip annotation.
+func hostnamesFromIngressRules(ingress *networkingv1.Ingress) []string {
+	if _, ok := ingress.GetAnnotations()[servicecommon.AnnotationsDNSSkip]; ok {
+		return nil
+	}
+	var hostnames []string
+	for _, rule := range ingress.Spec.Rules {
+		host := strings.TrimSpace(rule.Host)
+		if host == "" || slices.Contains(hostnames, host) {
+			continue
+		}
+		hostnames = append(hostnames, host)
+	}
+	return hostnames
+}
+
+// targetsFromIngressLoadBalancer collects IP and Hostname values from Ingress.Status.LoadBalancer.Ingress.
+func targetsFromIngressLoadBalancer(ingress []networkingv1.IngressLoadBalancerIngress) extdns.Targets {
+	vals := make([]string, 0, len(ingress)*2)
+	for i := range ingress {
+		ing := ingress[i]
+		if ip := strings.TrimSpace(ing.IP); ip != "" {
+			vals = append(vals, ip)
+		}
+		if hn := strings.TrimSpace(ing.Hostname); hn != "" {
+			vals = append(vals, hn)
+		}
+	}
+	return extdns.NewTargets(vals...)
+}
+
+// buildIngressDNSBatch builds owner-scoped DNS rows for an Ingress: rule hosts, targets from the Ingress
+// load balancer status, then ValidateEndpointsByZone for namespace VPC policy.
+func buildIngressDNSBatch(ingress *networkingv1.Ingress, w dns.DNSRecordProvider) (*dns.AggregatedDNSEndpoints, error) {
+	hostnames := hostnamesFromIngressRules(ingress)
+	if len(hostnames) == 0 {
+		return nil, nil
+	}
+	targets := targetsFromIngressLoadBalancer(ingress.Status.LoadBalancer.Ingress)
+	if len(targets) == 0 {
+		log.Debug("Ingress has rule hosts but no load balancer targets yet", "namespace", ingress.Namespace, "name", ingress.Name)
+		return nil, nil
+	}
+	log.Debug("Building DNS batch for Ingress", "namespace", ingress.Namespace, "name", ingress.Name,
+		"hostnames", len(hostnames), "targets", len(targets))
+	ttl := extdns.TTL(0)
+	var eps []*extdns.Endpoint
+	for _, h := range hostnames {
+		for _, ep := range extdns.EndpointsForHostname(h, targets, ttl) {
+			if ep == nil {
+				log.Info("Skipping invalid DNS hostname", "hostname", h, "namespace", ingress.Namespace, "name", ingress.Name)
+				continue
+			}
+			eps = append(eps, ep)
+		}
+	}
+	if len(eps) == 0 {
+		return nil, nil
+	}
+	owner := &dns.ResourceRef{Kind: dns.ResourceKindIngress, Object: ingress.GetObjectMeta()}
+	rows, _, err := w.ValidateEndpointsByZone(ingress.Namespace, owner, eps)
+	if len(rows) == 0 {
+		return nil, err
+	}
+	log.Info("DNS batch built for Ingress", "namespace", ingress.Namespace, "name", ingress.Name, "rows", len(rows))
+	return dns.NewOwnerScopedAggregatedRouteDNS(owner, rows), err
+}
+
+// recordDNSEvent reports the DNS reconcile outcome on the Ingress; a Normal event is only sent when records changed.
+func (r *IngressReconciler) recordDNSEvent(ingress *networkingv1.Ingress, changed bool, err error) {
+	if r.Recorder == nil {
+		return
+	}
+	if err != nil {
+		r.Recorder.Event(ingress, v1.EventTypeWarning, reasonDNSRecordFailed, err.Error())
+	} else if changed {
+		r.Recorder.Event(ingress, v1.EventTypeNormal, reasonDNSRecordConfigured, "DNS records have been successfully configured")
+	}
+}
+
+// reconcileIngressDNS applies DNS rows for the Ingress.
+func (r *IngressReconciler) reconcileIngressDNS(ctx context.Context, ingress *networkingv1.Ingress) error {
+	ingressNN := types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}
+	log.Info("Reconciling DNS for Ingress", "Ingress", ingressNN)
+	batch, err := buildIngressDNSBatch(ingress, r.DNS)
+	if err != nil {
+		r.recordDNSEvent(ingress, false, err)
+		var zoneValErr *dns.DNSZoneValidationError
+		if errors.As(err, &zoneValErr) {
+			log.Error(err, "Failed to validate DNS records for Ingress with the allowed DNS zones", "Ingress", ingressNN)
+			// If there are valid rows, we should still apply them
+			if batch != nil && len(batch.Rows) > 0 {
+				if _, uErr := r.DNS.CreateOrUpdateRecords(ctx, batch); uErr != nil {
+					log.Error(uErr, "Failed to reconcile valid DNS records despite validation errors", "Ingress", ingressNN)
+					return uErr
+				}
+			} else if dErr := r.deleteDNSForIngress(ctx, ingress.Namespace, ingress.Name, "stale DNS records"); dErr != nil {
+				return dErr
+			}
+			// For validation errors, we do not automatically requeue. We wait for the user to update the Ingress.
+			return nil
+		}
+		log.Error(err, "Failed to build DNS endpoints for Ingress", "Ingress", ingressNN)
+		return err
+	}
+
+	if batch == nil || len(batch.Rows) == 0 {
+		return r.deleteDNSForIngress(ctx, ingress.Namespace, ingress.Name, "stale DNS records")
+	}
+
+	changed, uErr := r.DNS.CreateOrUpdateRecords(ctx, batch)
+	if uErr != nil {
+		log.Error(uErr, "Failed to reconcile DNS records", "Ingress", ingressNN)
+	}
+	r.recordDNSEvent(ingress, changed, uErr)
+	return uErr
+}
+
+// getIngressesWithDNS returns Ingresses that should have DNS records.
+func getIngressesWithDNS(ctx context.Context, c client.Client, listOpts ...client.ListOption) ([]networkingv1.Ingress, error) {
+	ingressList := &networkingv1.IngressList{}
+	if err := c.List(ctx, ingressList, listOpts...); err != nil {
+		return nil, err
+	}
+	var filtered []networkingv1.Ingress
+	for i := range ingressList.Items {
+		ingress := ingressList.Items[i]
+		if !ingress.ObjectMeta.DeletionTimestamp.IsZero() || len(hostnamesFromIngressRules(&ingress)) == 0 {
+			continue
+		}
+		filtered = append(filtered, ingress)
+	}
+	return filtered, nil
+}
+
+// enqueueIngressRequestsFromNetworkInfo requeues Ingresses that publish DNS when namespace AllowedDNSDomains change.
+func (r *IngressReconciler) enqueueIngressRequestsFromNetworkInfo(ctx context.Context, obj client.Object) []reconcile.Request {
+	ni, ok := obj.(*v1alpha1.NetworkInfo)
+	if !ok || ni == nil {
+		return nil
+	}
+	ingresses, err := getIngressesWithDNS(ctx, r.Client, client.InNamespace(ni.Namespace))
+	if err != nil {
+		log.Error(err, "Failed to list Ingresses for NetworkInfo DNS domain change", "Namespace", ni.Namespace)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, ingress := range ingresses {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}})
+	}
+	return reqs
+}
+
+func predicateNetworkInfoAllowedDNSDomainsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNI, ok1 := e.ObjectOld.(*v1alpha1.NetworkInfo)
+			newNI, ok2 := e.ObjectNew.(*v1alpha1.NetworkInfo)
+			if !ok1 || !ok2 {
+				return false
+			}
+			return !slices.Equal(oldNI.AllowedDNSDomains, newNI.AllowedDNSDomains)
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// collectDNSGarbage deletes DNS records whose Ingress owner is gone or no longer has rule hosts.
+func (r *IngressReconciler) collectDNSGarbage(ctx context.Context) error {
+	if r.DNS == nil {
+		return nil
+	}
+	apiSet := sets.New[types.NamespacedName]()
+	ingresses, err := getIngressesWithDNS(ctx, r.Client)
+	if err != nil {
+		log.Error(err, "Ingress GC: failed to list Ingresses")
+		return err
+	}
+	for _, ingress := range ingresses {
+		apiSet.Insert(types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name})
+	}
+	ownersByKind := r.DNS.ListRecordOwnerResource()
+	var errs []error
+	for nn := range ownersByKind[dns.ResourceKindIngress] {
+		if apiSet.Has(nn) {
+			continue
+		}
+		if err := r.deleteDNSForIngress(ctx, nn.Namespace, nn.Name, "GC: missing or ineligible Ingress owner"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("ingress garbage collection encountered %d error(s): %w", len(errs), errors.Join(errs...))
+	}
+	return nil
+}
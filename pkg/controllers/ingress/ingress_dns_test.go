@@ -0,0 +1,223 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	mockdns "github.com/vmware-tanzu/nsx-operator/pkg/mock/dnsrecordprovider"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+// stubValidatedRows mimics ValidateEndpointsByZone for *.example.com under /zones/t.
+func stubValidatedRows(eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+	const zpath = "/orgs/org1/projects/proj1/dns-services/dns1/zones/t"
+	var rows []dns.EndpointRow
+	for _, ep := range eps {
+		dn := strings.ToLower(strings.TrimSpace(ep.DNSName))
+		if !strings.HasSuffix(dn, ".example.com") {
+			return nil, nil, fmt.Errorf("hostname %q does not match stub allowed domain", ep.DNSName)
+		}
+		rows = append(rows, *dns.NewEndpointRow(ep, zpath, strings.TrimSuffix(dn, ".example.com")))
+	}
+	return rows, map[string]string{zpath: "example.com"}, nil
+}
+
+func ingressTestScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+func makeIngress(name string, ip string, hosts ...string) *networkingv1.Ingress {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: name, UID: types.UID(name + "-uid")},
+	}
+	for _, host := range hosts {
+		ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{Host: host})
+	}
+	if ip != "" {
+		ingress.Status.LoadBalancer.Ingress = []networkingv1.IngressLoadBalancerIngress{{IP: ip}}
+	}
+	return ingress
+}
+
+func TestHostnamesFromIngressRules(t *testing.T) {
+	ingress := makeIngress("ing", "", "a.example.com", "", " b.example.com ", "a.example.com")
+	assert.Equal(t, []string{"a.example.com", "b.example.com"}, hostnamesFromIngressRules(ingress))
+
+	ingress.Annotations = map[string]string{servicecommon.AnnotationsDNSSkip: "true"}
+	assert.Nil(t, hostnamesFromIngressRules(ingress))
+}
+
+func TestTargetsFromIngressLoadBalancer(t *testing.T) {
+	got := targetsFromIngressLoadBalancer([]networkingv1.IngressLoadBalancerIngress{{IP: "10.0.0.1"}, {Hostname: "lb.vendor.example"}, {}})
+	assert.ElementsMatch(t, []string{"10.0.0.1", "lb.vendor.example"}, []string(got))
+	assert.Empty(t, targetsFromIngressLoadBalancer(nil))
+}
+
+func TestReconcileIngressDNS(t *testing.T) {
+	ctx := context.Background()
+	scheme := ingressTestScheme(t)
+	tests := []struct {
+		name       string
+		ingress    *networkingv1.Ingress
+		setupMock  func(m *mockdns.MockDNSRecordProvider)
+		wantErr    bool
+		wantEvents int
+	}{
+		{
+			name:    "hosts_with_ip_publishes",
+			ingress: makeIngress("ing", "203.0.113.5", "a.example.com", "b.example.com"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns1", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ string, owner *dns.ResourceRef, eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+						require.Equal(t, dns.ResourceKindIngress, owner.Kind)
+						require.Len(t, eps, 2)
+						return stubValidatedRows(eps)
+					}).Times(1)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
+			},
+			wantEvents: 1,
+		},
+		{
+			name:    "no_ip_deletes",
+			ingress: makeIngress("ing", "", "a.example.com"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "ing").Return(false, nil).Times(1)
+			},
+		},
+		{
+			name:    "no_hosts_deletes",
+			ingress: makeIngress("ing", "203.0.113.5"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "ing").Return(true, nil).Times(1)
+			},
+		},
+		{
+			name:    "zone_validation_error_deletes",
+			ingress: makeIngress("ing", "203.0.113.5", "a.other.com"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					nil, nil, &dns.DNSZoneValidationError{Msg: "zone mismatch"}).Times(1)
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "ing").Return(false, nil).Times(1)
+			},
+			wantEvents: 1,
+		},
+		{
+			name:    "create_error",
+			ingress: makeIngress("ing", "203.0.113.5", "a.example.com"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ string, _ *dns.ResourceRef, eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+						return stubValidatedRows(eps)
+					}).Times(1)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("mock create error")).Times(1)
+			},
+			wantErr:    true,
+			wantEvents: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtl := gomock.NewController(t)
+			m := mockdns.NewMockDNSRecordProvider(mockCtl)
+			tt.setupMock(m)
+			recorder := record.NewFakeRecorder(10)
+			r := &IngressReconciler{
+				Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.ingress).Build(),
+				DNS:      m,
+				Recorder: recorder,
+			}
+			err := r.reconcileIngressDNS(ctx, tt.ingress)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Len(t, recorder.Events, tt.wantEvents)
+		})
+	}
+}
+
+func TestIngressReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := ingressTestScheme(t)
+	mockCtl := gomock.NewController(t)
+	m := mockdns.NewMockDNSRecordProvider(mockCtl)
+
+	t.Run("deleted_ingress_deletes_records", func(t *testing.T) {
+		m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "gone").Return(true, nil).Times(1)
+		r := &IngressReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), DNS: m}
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "gone"}})
+		require.NoError(t, err)
+		assert.Equal(t, ResultNormal, result)
+	})
+
+	t.Run("delete_error_requeues", func(t *testing.T) {
+		m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "gone").Return(false, fmt.Errorf("mock error")).Times(1)
+		r := &IngressReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), DNS: m}
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "gone"}})
+		require.NoError(t, err)
+		assert.NotZero(t, result.RequeueAfter)
+	})
+}
+
+func TestIngressReconciler_CollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	scheme := ingressTestScheme(t)
+	mockCtl := gomock.NewController(t)
+	m := mockdns.NewMockDNSRecordProvider(mockCtl)
+	m.EXPECT().ListRecordOwnerResource().Return(map[string]sets.Set[types.NamespacedName]{
+		dns.ResourceKindIngress: sets.New(
+			types.NamespacedName{Namespace: "ns1", Name: "live"},
+			types.NamespacedName{Namespace: "ns1", Name: "no-hosts"},
+			types.NamespacedName{Namespace: "ns1", Name: "gone"},
+		),
+		dns.ResourceKindService: sets.New(types.NamespacedName{Namespace: "ns1", Name: "svc"}),
+	}).Times(1)
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "no-hosts").Return(true, nil).Times(1)
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "gone").Return(true, nil).Times(1)
+
+	objs := []client.Object{makeIngress("live", "203.0.113.5", "a.example.com"), makeIngress("no-hosts", "203.0.113.5")}
+	r := &IngressReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(), DNS: m}
+	require.NoError(t, r.CollectGarbage(ctx))
+}
+
+func TestEnqueueIngressRequestsFromNetworkInfo(t *testing.T) {
+	scheme := ingressTestScheme(t)
+	objs := []client.Object{makeIngress("live", "203.0.113.5", "a.example.com"), makeIngress("no-hosts", "203.0.113.5")}
+	r := &IngressReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()}
+	reqs := r.enqueueIngressRequestsFromNetworkInfo(context.Background(), &v1alpha1.NetworkInfo{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ns1"}})
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "live", reqs[0].Name)
+
+	p := predicateNetworkInfoAllowedDNSDomainsChanged()
+	oldNI := &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"example.com"}}
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: oldNI, ObjectNew: oldNI.DeepCopy()}))
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: oldNI, ObjectNew: &v1alpha1.NetworkInfo{AllowedDNSDomains: []string{"other.com"}}}))
+}
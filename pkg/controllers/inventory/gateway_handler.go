@@ -0,0 +1,117 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func watchGateway(c *InventoryController, mgr ctrl.Manager) error {
+	return c.watchGatewayAPIResource(mgr, inventory.ContainerGateway)
+}
+
+func watchHTTPRoute(c *InventoryController, mgr ctrl.Manager) error {
+	return c.watchGatewayAPIResource(mgr, inventory.ContainerHTTPRoute)
+}
+
+func watchGRPCRoute(c *InventoryController, mgr ctrl.Manager) error {
+	return c.watchGatewayAPIResource(mgr, inventory.ContainerGRPCRoute)
+}
+
+func watchTLSRoute(c *InventoryController, mgr ctrl.Manager) error {
+	return c.watchGatewayAPIResource(mgr, inventory.ContainerTLSRoute)
+}
+
+// watchGatewayAPIResource watches the Gateway API resource of the inventory type, it is skipped
+// if the resource CRD is not installed in the cluster.
+func (c *InventoryController) watchGatewayAPIResource(mgr ctrl.Manager, inventoryType inventory.InventoryType) error {
+	kind := inventory.GatewayAPIKind(inventoryType)
+	gk := schema.GroupKind{Group: gatewayv1.GroupName, Kind: kind}
+	if _, err := mgr.GetRESTMapper().RESTMapping(gk, gatewayv1.GroupVersion.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			log.Info("Gateway API CRD is not installed, skipping inventory watch", "kind", kind)
+			return nil
+		}
+		log.Error(err, "Failed to check Gateway API CRD", "kind", kind)
+		return err
+	}
+
+	informer, err := mgr.GetCache().GetInformer(context.Background(), inventory.NewGatewayAPIObject(inventoryType))
+	if err != nil {
+		log.Error(err, "Failed to create informer", "kind", kind)
+		return err
+	}
+
+	_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.handleGatewayAPIObject(obj, inventoryType)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Enqueue the Gateways the route was attached to before the update as well.
+			if inventoryType != inventory.ContainerGateway {
+				c.handleRouteParentGateways(oldObj)
+			}
+			c.handleGatewayAPIObject(newObj, inventoryType)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.handleGatewayAPIObject(obj, inventoryType)
+		},
+	})
+	if err != nil {
+		log.Error(err, "Failed to add event handler", "kind", kind)
+		return err
+	}
+	return nil
+}
+
+func gatewayAPIObjectFrom(obj interface{}) (client.Object, error) {
+	switch obj1 := obj.(type) {
+	case cache.DeletedFinalStateUnknown:
+		return gatewayAPIObjectFrom(obj1.Obj)
+	case *gatewayv1.Gateway, *gatewayv1.HTTPRoute, *gatewayv1.GRPCRoute, *gatewayv1.TLSRoute:
+		return obj1.(client.Object), nil
+	}
+	return nil, fmt.Errorf("obj %T is not a valid Gateway API object", obj)
+}
+
+func (c *InventoryController) handleGatewayAPIObject(obj interface{}, inventoryType inventory.InventoryType) {
+	gatewayObj, err := gatewayAPIObjectFrom(obj)
+	if err != nil {
+		log.Error(err, "Unexpected object in Gateway API event", "kind", inventory.GatewayAPIKind(inventoryType))
+		return
+	}
+	log.Debug("Inventory processing Gateway API object", "kind", inventory.GatewayAPIKind(inventoryType), "Namespace", gatewayObj.GetNamespace(), "Name", gatewayObj.GetName())
+	key, _ := keyFunc(gatewayObj)
+	c.inventoryObjectQueue.Add(inventory.InventoryKey{InventoryType: inventoryType, ExternalId: string(gatewayObj.GetUID()), Key: key})
+	if inventoryType != inventory.ContainerGateway {
+		// The attached routes and backend Services of the parent Gateways change with the route.
+		c.handleRouteParentGateways(gatewayObj)
+	}
+}
+
+func (c *InventoryController) handleRouteParentGateways(obj interface{}) {
+	route, err := gatewayAPIObjectFrom(obj)
+	if err != nil {
+		return
+	}
+	for _, gatewayName := range inventory.GetRouteParentGateways(route) {
+		gateway := &gatewayv1.Gateway{}
+		if err := c.Client.Get(context.TODO(), gatewayName, gateway); err != nil {
+			log.Debug("Skip parent Gateway of route", "Gateway", gatewayName, "error", err)
+			continue
+		}
+		key, _ := keyFunc(gateway)
+		c.inventoryObjectQueue.Add(inventory.InventoryKey{InventoryType: inventory.ContainerGateway, ExternalId: string(gateway.UID), Key: key})
+	}
+}
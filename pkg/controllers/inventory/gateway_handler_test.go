@@ -0,0 +1,94 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func (m *MockMgr) GetRESTMapper() meta.RESTMapper {
+	args := m.Called()
+	return args.Get(0).(meta.RESTMapper)
+}
+
+func TestWatchGatewayAPIResource(t *testing.T) {
+	t.Run("CRDInstalled", func(t *testing.T) {
+		restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{gatewayv1.SchemeGroupVersion})
+		restMapper.Add(gatewayv1.SchemeGroupVersion.WithKind("Gateway"), meta.RESTScopeNamespace)
+		mockCache := new(MockCache)
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.Gateway{}).Return(&MockInformer{}, nil)
+		mgr := new(MockMgr)
+		mgr.On("GetRESTMapper").Return(restMapper)
+		mgr.On("GetCache").Return(mockCache)
+		err := watchGateway(&InventoryController{}, mgr)
+		assert.NoError(t, err)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("CRDNotInstalled", func(t *testing.T) {
+		restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{gatewayv1.SchemeGroupVersion})
+		mockCache := new(MockCache)
+		mgr := new(MockMgr)
+		mgr.On("GetRESTMapper").Return(restMapper)
+		err := watchTLSRoute(&InventoryController{}, mgr)
+		assert.NoError(t, err)
+		mgr.AssertNotCalled(t, "GetCache")
+		mockCache.AssertNotCalled(t, "GetInformer", mock.Anything, mock.Anything)
+	})
+}
+
+func TestHandleGatewayAPIObject(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, gatewayv1.Install(scheme))
+	gateway := &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "gw1", UID: "gw1-uid"}}
+	route := &gatewayv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "route1", UID: "route1-uid"},
+		Spec: gatewayv1.GRPCRouteSpec{CommonRouteSpec: gatewayv1.CommonRouteSpec{
+			ParentRefs: []gatewayv1.ParentReference{{Name: "gw1"}, {Name: "gw-missing"}},
+		}},
+	}
+	newController := func(queue *MockObjectQueue[any]) *InventoryController {
+		return &InventoryController{
+			Client:               fake.NewClientBuilder().WithScheme(scheme).WithObjects(gateway).Build(),
+			keyBuffer:            sets.New[inventory.InventoryKey](),
+			inventoryObjectQueue: queue,
+		}
+	}
+
+	t.Run("Gateway", func(t *testing.T) {
+		queue := &MockObjectQueue[any]{}
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.ContainerGateway, ExternalId: "gw1-uid", Key: "ns1/gw1"}).Return().Once()
+		newController(queue).handleGatewayAPIObject(gateway, inventory.ContainerGateway)
+		queue.AssertExpectations(t)
+	})
+
+	t.Run("DeletedRouteEnqueuesParentGateway", func(t *testing.T) {
+		queue := &MockObjectQueue[any]{}
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.ContainerGRPCRoute, ExternalId: "route1-uid", Key: "ns1/route1"}).Return().Once()
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.ContainerGateway, ExternalId: "gw1-uid", Key: "ns1/gw1"}).Return().Once()
+		newController(queue).handleGatewayAPIObject(cache.DeletedFinalStateUnknown{Key: "ns1/route1", Obj: route}, inventory.ContainerGRPCRoute)
+		queue.AssertExpectations(t)
+	})
+
+	t.Run("InvalidObject", func(t *testing.T) {
+		queue := &MockObjectQueue[any]{}
+		newController(queue).handleGatewayAPIObject(cache.DeletedFinalStateUnknown{Obj: "invalid"}, inventory.ContainerHTTPRoute)
+		queue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+}
@@ -45,6 +45,10 @@ var (
 		watchIngress,
 		watchNode,
 		watchNetworkPolicy,
+		watchGateway,
+		watchHTTPRoute,
+		watchGRPCRoute,
+		watchTLSRoute,
 	}
 )
 
@@ -105,12 +109,26 @@ func (c *InventoryController) Run(stopCh <-chan struct{}) {
 	// Inventory worker will be running in forever loop until inventoryMutex is locked by inventoryTimeWorker.
 	// Only one worker processes and sends request to NSX MP at one time.
 	go wait.Until(c.inventoryWorker, time.Second, stopCh)
-	go wait.Until(c.inventoryTimeWorker, time.Second*time.Duration(c.cf.InventoryBatchPeriod), stopCh)
+	go c.runInventoryTimeWorker(stopCh)
 	go wait.JitterUntil(c.inventoryGCWorker, commonservice.GCInterval, inventoryGCJitterFactor, true, stopCh)
 
 	<-stopCh
 }
 
+// runInventoryTimeWorker runs inventoryTimeWorker every inventory batch period until stopCh is closed.
+// The period is read before each wait, so that a reloaded inventory_batch_period takes effect.
+func (c *InventoryController) runInventoryTimeWorker(stopCh <-chan struct{}) {
+	for {
+		c.inventoryTimeWorker()
+		period, _ := c.cf.GetInventoryBatch()
+		select {
+		case <-stopCh:
+			return
+		case <-time.After(time.Second * time.Duration(period)):
+		}
+	}
+}
+
 func (c *InventoryController) inventoryTimeWorker() {
 	defer c.inventoryMutex.Unlock()
 	c.inventoryMutex.Lock()
@@ -142,7 +160,7 @@ func (c *InventoryController) processNextInventoryWorkItem() bool {
 	defer c.inventoryMutex.Unlock()
 	c.inventoryMutex.Lock()
 	c.keyBuffer.Insert(key.(inventory.InventoryKey))
-	if len(c.keyBuffer) >= c.cf.InventoryBatchSize {
+	if _, batchSize := c.cf.GetInventoryBatch(); len(c.keyBuffer) >= batchSize {
 		c.syncInventoryKeys()
 	}
 	return true
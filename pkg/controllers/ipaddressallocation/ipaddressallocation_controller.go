@@ -6,6 +6,7 @@ package ipaddressallocation
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,6 +26,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipaddressallocation"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -123,10 +125,15 @@ func getExistingConditionOfType(conditionType v1alpha1.ConditionType, existingCo
 func (r *IPAddressAllocationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	obj := &v1alpha1.IPAddressAllocation{}
 	log.Info("Reconciling IPAddressAllocation CR", "IPAddressAllocation", req.NamespacedName)
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling IPAddressAllocation", "IPAddressAllocation", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
+	}()
 	r.StatusUpdater.IncreaseSyncTotal()
 	if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
 		if apierrors.IsNotFound(err) {
-			err = r.Service.DeleteIPAddressAllocationByNamespacedName(req.Namespace, req.Name)
+			err = r.Service.DeleteIPAddressAllocationByNamespacedName(ctx, req.Namespace, req.Name)
 			if err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return resultRequeue, err
@@ -139,7 +146,7 @@ func (r *IPAddressAllocationReconciler) Reconcile(ctx context.Context, req ctrl.
 	if obj.ObjectMeta.DeletionTimestamp.IsZero() {
 		return r.handleUpdate(ctx, obj)
 	}
-	return r.handleDeletion(req, obj)
+	return r.handleDeletion(ctx, req, obj)
 }
 
 func (r *IPAddressAllocationReconciler) handleUpdate(ctx context.Context, obj *v1alpha1.IPAddressAllocation) (ctrl.Result, error) {
@@ -157,9 +164,9 @@ func (r *IPAddressAllocationReconciler) handleUpdate(ctx context.Context, obj *v
 	return resultNormal, nil
 }
 
-func (r *IPAddressAllocationReconciler) handleDeletion(req ctrl.Request, obj *v1alpha1.IPAddressAllocation) (ctrl.Result, error) {
+func (r *IPAddressAllocationReconciler) handleDeletion(ctx context.Context, req ctrl.Request, obj *v1alpha1.IPAddressAllocation) (ctrl.Result, error) {
 	r.StatusUpdater.IncreaseDeleteTotal()
-	if err := r.Service.DeleteIPAddressAllocation(obj); err != nil {
+	if err := r.Service.DeleteIPAddressAllocation(ctx, obj); err != nil {
 		r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 		return resultRequeue, err
 	}
@@ -175,7 +182,7 @@ func (r *IPAddressAllocationReconciler) setupWithManager(mgr ctrl.Manager) error
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
 			}).
-		Complete(r)
+		Complete(tracing.NewReconciler("IPAddressAllocation", r))
 }
 
 func (r *IPAddressAllocationReconciler) CollectGarbage(ctx context.Context) error {
@@ -201,7 +208,7 @@ func (r *IPAddressAllocationReconciler) CollectGarbage(ctx context.Context) erro
 	var errList []error
 	for elem := range diffSet {
 		log.Info("GC collected nsx IPAddressAllocation", "UID", elem)
-		if err := r.Service.DeleteIPAddressAllocation(types.UID(elem)); err != nil {
+		if err := r.Service.DeleteIPAddressAllocation(ctx, types.UID(elem)); err != nil {
 			log.Error(err, "Failed to delete nsx IPAddressAllocation", "UID", elem)
 			errList = append(errList, err)
 		}
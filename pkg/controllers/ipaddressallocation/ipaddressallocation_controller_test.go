@@ -215,7 +215,7 @@ func TestIPAddressAllocationReconciler_Reconcile(t *testing.T) {
 		return nil
 	})
 	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation",
-		func(_ *ipaddressallocation.IPAddressAllocationService, uid interface{}) error {
+		func(_ *ipaddressallocation.IPAddressAllocationService, _ context.Context, uid interface{}) error {
 			return nil
 		})
 	_, ret := r.Reconcile(ctx, req)
@@ -229,7 +229,7 @@ func TestIPAddressAllocationReconciler_Reconcile(t *testing.T) {
 		v1sp.ObjectMeta.DeletionTimestamp = &time
 		return nil
 	})
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation", func(_ *ipaddressallocation.IPAddressAllocationService,
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation", func(_ *ipaddressallocation.IPAddressAllocationService, _ context.Context,
 		uid interface{}) error {
 		return errors.New("delete failed")
 	})
@@ -363,7 +363,7 @@ func TestReconciler_GarbageCollector(t *testing.T) {
 			a.Insert("2345")
 			return a
 		})
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation", func(_ *ipaddressallocation.IPAddressAllocationService, UID interface{}) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation", func(_ *ipaddressallocation.IPAddressAllocationService, _ context.Context, UID interface{}) error {
 		return nil
 	})
 	mockCtl := gomock.NewController(t)
@@ -393,7 +393,7 @@ func TestReconciler_GarbageCollector(t *testing.T) {
 		a.Insert("1234")
 		return a
 	})
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation", func(_ *ipaddressallocation.IPAddressAllocationService, UID interface{}) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation", func(_ *ipaddressallocation.IPAddressAllocationService, _ context.Context, UID interface{}) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
@@ -413,7 +413,7 @@ func TestReconciler_GarbageCollector(t *testing.T) {
 		a := sets.New[string]()
 		return a
 	})
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation", func(_ *ipaddressallocation.IPAddressAllocationService, UID interface{}) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation", func(_ *ipaddressallocation.IPAddressAllocationService, _ context.Context, UID interface{}) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
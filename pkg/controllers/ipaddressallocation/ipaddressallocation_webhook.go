@@ -96,7 +96,7 @@ func (v *IPAddressAllocationValidator) validateServiceVIP(ctx context.Context, r
 	}
 
 	// Parse allocation IPs — assuming comma-separated or space-separated string
-	allocationIPs := ipAlloc.Status.AllocationIPs
+	allocationIPs := append([]string{ipAlloc.Status.AllocationIPs}, ipAlloc.Status.AdditionalAllocationIPs...)
 
 	// List Services in the same namespace
 	var svcList corev1.ServiceList
@@ -107,9 +107,11 @@ func (v *IPAddressAllocationValidator) validateServiceVIP(ctx context.Context, r
 	// Check if any Service uses one of the allocated IPs
 	for _, svc := range svcList.Items {
 		if svc.Spec.LoadBalancerIP != "" {
-			if v.ifIPUsed(svc.Spec.LoadBalancerIP, allocationIPs) { // IP in use — reject delete
-				msg := fmt.Sprintf("cannot delete IPAddressAllocation %s: IP %s is still in use by Service %s", ipAlloc.Name, svc.Spec.LoadBalancerIP, svc.Name)
-				return admission.Denied(msg)
+			for _, ipRange := range allocationIPs {
+				if v.ifIPUsed(svc.Spec.LoadBalancerIP, ipRange) { // IP in use — reject delete
+					msg := fmt.Sprintf("cannot delete IPAddressAllocation %s: IP %s is still in use by Service %s", ipAlloc.Name, svc.Spec.LoadBalancerIP, svc.Name)
+					return admission.Denied(msg)
+				}
 			}
 		}
 	}
@@ -377,6 +377,25 @@ func TestValidateServiceVIP(t *testing.T) {
 			expectDenied:   true,
 			expectedReason: "cannot delete IPAddressAllocation ipa3: IP 10.0.0.5 is still in use by Service svc1",
 		},
+		{
+			name: "ready, service uses additional allocated IP, denies delete",
+			ipAlloc: &v1alpha1.IPAddressAllocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ipa6"},
+				Status: v1alpha1.IPAddressAllocationStatus{
+					Conditions:              []v1alpha1.Condition{{Type: "Ready"}},
+					AllocationIPs:           "10.0.0.0/28",
+					AdditionalAllocationIPs: []string{"10.0.1.0/28"},
+				},
+			},
+			services: []corev1.Service{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "svc3", Namespace: "ns1"},
+					Spec:       corev1.ServiceSpec{LoadBalancerIP: "10.0.1.5"},
+				},
+			},
+			expectDenied:   true,
+			expectedReason: "cannot delete IPAddressAllocation ipa6: IP 10.0.1.5 is still in use by Service svc3",
+		},
 		{
 			name: "ready, service does not use allocated IP, allows delete",
 			ipAlloc: &v1alpha1.IPAddressAllocation{
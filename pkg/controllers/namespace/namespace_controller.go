@@ -29,6 +29,7 @@ import (
 	_ "github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	types "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -250,6 +251,7 @@ func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling Namespace", "Namespace", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		metrics.HistogramObserveSince(r.NSXConfig, metrics.ControllerReconcileDuration, common.MetricResTypeNamespace, startTime)
 	}()
 	metrics.CounterInc(r.NSXConfig, metrics.ControllerSyncTotal, common.MetricResTypeNamespace)
 
@@ -349,7 +351,7 @@ func (r *NamespaceReconciler) setupWithManager(mgr ctrl.Manager) error {
 			&EnqueueRequestForVPCNetworkConfiguration{Reconciler: r},
 			builder.WithPredicates(PredicateFuncsVPCNetworkConfig),
 		).
-		Complete(r)
+		Complete(tracing.NewReconciler("Namespace", r))
 }
 
 // Start setup manager and launch GC
@@ -40,6 +40,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipblocksinfo"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -234,6 +235,7 @@ func (r *NetworkInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling NetworkInfo", "NetworkInfo", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	r.StatusUpdater.IncreaseSyncTotal()
@@ -645,7 +647,7 @@ func (r *NetworkInfoReconciler) setupWithManager(mgr ctrl.Manager) error {
 			&v1alpha1.SubnetSet{},
 			&SubnetSetHandler{Client: mgr.GetClient()},
 			builder.WithPredicates(PredicateFuncsSubnetSet)).
-		Complete(r)
+		Complete(tracing.NewReconciler("NetworkInfo", r))
 }
 
 // Start setup manager and launch GC
@@ -715,7 +717,7 @@ func (r *NetworkInfoReconciler) CollectGarbage(ctx context.Context) error {
 		log.Info("Garbage collecting NSX VPC object", "VPC", nsxVPC.Id, "Namespace", nsxVPCNamespaceName)
 		r.StatusUpdater.IncreaseDeleteTotal()
 
-		if err = r.Service.DeleteVPC(*nsxVPC.Path); err != nil {
+		if err = r.Service.DeleteVPC(ctx, *nsxVPC.Path); err != nil {
 			log.Error(err, "Failed to delete NSX VPC", "VPC", nsxVPC.Id, "Namespace", nsxVPCNamespaceName)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
 			errList = append(errList, err)
@@ -766,7 +768,7 @@ func (r *NetworkInfoReconciler) deleteVPCs(ctx context.Context, staleVPCs []*mod
 			log.Error(nil, "VPC path is nil, skipping", "VPC", nsxVPC)
 			continue
 		}
-		if err := r.Service.DeleteVPC(*nsxVPC.Path); err != nil {
+		if err := r.Service.DeleteVPC(ctx, *nsxVPC.Path); err != nil {
 			log.Error(err, "Failed to delete VPC in NSX", "VPC", nsxVPC.Path)
 			deleteErrs = append(deleteErrs, fmt.Errorf("failed to delete VPC %s: %w", *nsxVPC.Path, err))
 		}
@@ -1664,7 +1664,7 @@ func TestNetworkInfoReconciler_deleteStaleVPCs(t *testing.T) {
 				patches.ApplyPrivateMethod(reflect.TypeOf(r), "listNamespaceCRsNameIDSet", func(_ *NetworkInfoReconciler, _ context.Context) (sets.Set[string], sets.Set[string], error) {
 					return sets.Set[string]{}, sets.Set[string]{}, nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ string) error {
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ context.Context, _ string) error {
 					return fmt.Errorf("delete failed")
 				})
 				return patches
@@ -1687,7 +1687,7 @@ func TestNetworkInfoReconciler_deleteStaleVPCs(t *testing.T) {
 				patches.ApplyPrivateMethod(reflect.TypeOf(r), "listNamespaceCRsNameIDSet", func(_ *NetworkInfoReconciler, _ context.Context) (sets.Set[string], sets.Set[string], error) {
 					return sets.Set[string]{}, sets.Set[string]{}, nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ string) error {
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ context.Context, _ string) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.Service), "GetVPCNetworkConfigByNamespace", func(_ *vpc.VPCService, _ string) (*v1alpha1.VPCNetworkConfiguration, error) {
@@ -1728,7 +1728,7 @@ func TestNetworkInfoReconciler_deleteStaleVPCs(t *testing.T) {
 				patches.ApplyPrivateMethod(reflect.TypeOf(r), "listNamespaceCRsNameIDSet", func(_ *NetworkInfoReconciler, _ context.Context) (sets.Set[string], sets.Set[string], error) {
 					return sets.Set[string]{}, sets.Set[string]{}, nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ string) error {
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ context.Context, _ string) error {
 					return nil
 				})
 				patches.ApplyPrivateMethod(reflect.TypeOf(r), "listVPCsByNetworkConfigName", func(_ *NetworkInfoReconciler, _ string) ([]*model.Vpc, error) {
@@ -1820,7 +1820,7 @@ func TestNetworkInfoReconciler_DeleteNetworkInfo(t *testing.T) {
 						},
 					}
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ string) error {
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ context.Context, _ string) error {
 					return fmt.Errorf("delete failed")
 				})
 				return patches
@@ -1954,7 +1954,7 @@ func TestNetworkInfoReconciler_Create(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.Service), "IsSharedVPCNamespaceByNS", func(_ *vpc.VPCService, ctx context.Context, _ string) (bool, error) {
 					return false, nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ string) error {
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ context.Context, _ string) error {
 					return fmt.Errorf("delete failed")
 				})
 				return patches
@@ -2019,7 +2019,7 @@ func TestNetworkInfoReconciler_CollectGarbage(t *testing.T) {
 			vpcPath2 := "/vpc/2"
 			return []model.Vpc{{Path: &vpcPath1}, {Path: &vpcPath2}}
 		})
-		patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ string) error {
+		patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ context.Context, _ string) error {
 			return nil
 		})
 		defer patches.Reset()
@@ -2033,7 +2033,7 @@ func TestNetworkInfoReconciler_CollectGarbage(t *testing.T) {
 			vpcPath2 := "/vpc/2"
 			return []model.Vpc{{Path: &vpcPath1}, {Path: &vpcPath2}}
 		})
-		patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ string) error {
+		patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ context.Context, _ string) error {
 			return errors.New("deletion error")
 		})
 		defer patches.Reset()
@@ -34,6 +34,7 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -89,13 +90,14 @@ func (r *NetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling NetworkPolicy", "networkpolicy", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	r.StatusUpdater.IncreaseSyncTotal()
 
 	if err := r.Client.Get(ctx, req.NamespacedName, networkPolicy); err != nil {
 		if apierrors.IsNotFound(err) {
-			if err := r.deleteNetworkPolicyByName(req.Namespace, req.Name); err != nil {
+			if err := r.deleteNetworkPolicyByName(ctx, req.Namespace, req.Name); err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return ResultRequeue, err
 			}
@@ -111,7 +113,7 @@ func (r *NetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		r.StatusUpdater.IncreaseUpdateTotal()
 		log.Info("Reconciling CR to create or update networkPolicy", "networkPolicy", req.NamespacedName)
 
-		if err := r.Service.CreateOrUpdateSecurityPolicy(networkPolicy); err != nil {
+		if err := r.Service.CreateOrUpdateSecurityPolicy(ctx, networkPolicy); err != nil {
 			if errors.As(err, &nsxutil.RestrictionError{}) {
 				setNetworkPolicyErrorAnnotation(ctx, networkPolicy, r.Client, common.ErrorNoDFWLicense)
 				r.StatusUpdater.UpdateFail(ctx, networkPolicy, err, "", nil)
@@ -129,7 +131,7 @@ func (r *NetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	} else {
 		log.Info("Reconciling CR to delete networkPolicy", "networkPolicy", req.NamespacedName)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		if err := r.deleteNetworkPolicyByName(req.Namespace, req.Name); err != nil {
+		if err := r.deleteNetworkPolicyByName(ctx, req.Namespace, req.Name); err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 			return ResultRequeue, err
 		}
@@ -163,7 +165,7 @@ func (r *NetworkPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
 			}).
-		Complete(r)
+		Complete(tracing.NewReconciler("NetworkPolicy", r))
 }
 
 // Start setup manager and launch GC
@@ -194,7 +196,7 @@ func (r *NetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
 	for elem := range diffSet {
 		log.Debug("GC collected NetworkPolicy", "ID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		err = r.Service.DeleteSecurityPolicy(types.UID(elem), true, servicecommon.ResourceTypeNetworkPolicy)
+		err = r.Service.DeleteSecurityPolicy(ctx, types.UID(elem), true, servicecommon.ResourceTypeNetworkPolicy)
 		if err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -208,12 +210,12 @@ func (r *NetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
 	return nil
 }
 
-func (r *NetworkPolicyReconciler) deleteNetworkPolicyByName(ns, name string) error {
+func (r *NetworkPolicyReconciler) deleteNetworkPolicyByName(ctx context.Context, ns, name string) error {
 	nsxSecurityPolicies := r.Service.ListNetworkPolicyByName(ns, name)
 	for _, item := range nsxSecurityPolicies {
 		uid := nsxutil.FindTag(item.Tags, servicecommon.TagScopeNetworkPolicyUID)
 		log.Info("Deleting NetworkPolicy", "networkPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
-		if err := r.Service.DeleteSecurityPolicy(types.UID(uid), false, servicecommon.ResourceTypeNetworkPolicy); err != nil {
+		if err := r.Service.DeleteSecurityPolicy(ctx, types.UID(uid), false, servicecommon.ResourceTypeNetworkPolicy); err != nil {
 			log.Error(err, "Failed to delete NetworkPolicy", "networkPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
 			return err
 		}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,6 +24,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/node"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -41,6 +43,8 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	node := &v1.Node{}
 	deleted := false
 	log.Info("reconciling node", "node", req.NamespacedName)
+	startTime := time.Now()
+	defer metrics.HistogramObserveSince(r.Service.NSXConfig, metrics.ControllerReconcileDuration, MetricResTypeNode, startTime)
 
 	metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerSyncTotal, MetricResTypeNode)
 
@@ -79,7 +83,7 @@ func (r *NodeReconciler) setupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1.Node{}).
 		WithEventFilter(PredicateFuncsNode).
-		Complete(r)
+		Complete(tracing.NewReconciler("Node", r))
 }
 
 func StartNodeController(mgr ctrl.Manager, nodeService *node.NodeService) {
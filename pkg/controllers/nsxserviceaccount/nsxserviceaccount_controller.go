@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +35,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/nsxserviceaccount"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 const proxyLabelKey = "mgmt-proxy.antrea-nsx.vmware.com"
@@ -141,6 +143,11 @@ type NSXServiceAccountReconciler struct {
 func (r *NSXServiceAccountReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	obj := &nsxvmwarecomv1alpha1.NSXServiceAccount{}
 	log.Info("reconciling CR", "nsxserviceaccount", req.NamespacedName)
+	startTime := time.Now()
+	defer func() {
+		log.Info("finished reconciling CR", "nsxserviceaccount", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
+	}()
 
 	r.StatusUpdater.IncreaseSyncTotal()
 
@@ -236,7 +243,7 @@ func (r *NSXServiceAccountReconciler) setupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.serviceMapFunc),
 			builder.WithPredicates(proxyServicePred),
 		).
-		Complete(r)
+		Complete(tracing.NewReconciler("NSXServiceAccount", r))
 }
 
 func (r *NSXServiceAccountReconciler) serviceMapFunc(ctx context.Context, _ client.Object) []reconcile.Request {
@@ -291,7 +298,7 @@ func (r *NSXServiceAccountReconciler) CollectGarbage(ctx context.Context) error
 		log.Error(err, "failed to list NSXServiceAccount CR")
 		return err
 	}
-	gcSuccessCount, gcErrorCount = r.garbageCollector(nsxServiceAccountUIDSet, nsxServiceAccountList)
+	gcSuccessCount, gcErrorCount = r.garbageCollector(ctx, nsxServiceAccountUIDSet, nsxServiceAccountList)
 	log.Debug("gc collects NSXServiceAccount CR", "success", gcSuccessCount, "error", gcErrorCount)
 	count, ca = r.validateRealized(count, ca, nsxServiceAccountList)
 	if gcErrorCount > 0 {
@@ -363,7 +370,7 @@ func (r *NSXServiceAccountReconciler) validateRealized(count uint16, ca []byte,
 	return count, ca
 }
 
-func (r *NSXServiceAccountReconciler) garbageCollector(nsxServiceAccountUIDSet sets.Set[string], nsxServiceAccountList *nsxvmwarecomv1alpha1.NSXServiceAccountList) (gcSuccessCount, gcErrorCount uint32) {
+func (r *NSXServiceAccountReconciler) garbageCollector(ctx context.Context, nsxServiceAccountUIDSet sets.Set[string], nsxServiceAccountList *nsxvmwarecomv1alpha1.NSXServiceAccountList) (gcSuccessCount, gcErrorCount uint32) {
 	nsxServiceAccountCRUIDMap := map[string]types.NamespacedName{}
 	for _, nsxServiceAccount := range nsxServiceAccountList.Items {
 		nsxServiceAccountCRUIDMap[string(nsxServiceAccount.UID)] = types.NamespacedName{
@@ -383,7 +390,7 @@ func (r *NSXServiceAccountReconciler) garbageCollector(nsxServiceAccountUIDSet s
 			continue
 		}
 		r.StatusUpdater.IncreaseDeleteTotal()
-		err := r.Service.DeleteNSXServiceAccount(context.TODO(), namespacedName, types.UID(nsxServiceAccountUID))
+		err := r.Service.DeleteNSXServiceAccount(ctx, namespacedName, types.UID(nsxServiceAccountUID))
 		if err != nil {
 			gcErrorCount++
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -934,7 +934,7 @@ func TestNSXServiceAccountReconciler_garbageCollector(t *testing.T) {
 				defer patches.Reset()
 			}
 
-			gotGcSuccessCount, gotGcErrorCount := r.garbageCollector(tt.args.nsxServiceAccountUIDSet, tt.args.nsxServiceAccountList)
+			gotGcSuccessCount, gotGcErrorCount := r.garbageCollector(context.TODO(), tt.args.nsxServiceAccountUIDSet, tt.args.nsxServiceAccountList)
 			if gotGcSuccessCount != tt.wantGcSuccessCount {
 				t.Errorf("garbageCollector() gotGcSuccessCount = %v, want %v", gotGcSuccessCount, tt.wantGcSuccessCount)
 			}
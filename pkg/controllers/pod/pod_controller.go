@@ -34,6 +34,7 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -61,6 +62,7 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling Pod", "Pod", req.NamespacedName, "duration", time.Since(startTime))
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	r.StatusUpdater.IncreaseSyncTotal()
@@ -115,7 +117,7 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 			r.StatusUpdater.UpdateFail(ctx, pod, err, "", nil)
 			return common.ResultRequeue, err
 		}
-		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(pod, nsxSubnet, contextID, &pod.ObjectMeta.Labels, false, r.restoreMode, interfaceIPType)
+		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(ctx, pod, nsxSubnet, contextID, &pod.ObjectMeta.Labels, false, r.restoreMode, interfaceIPType)
 		if err != nil {
 			r.StatusUpdater.UpdateFail(ctx, pod, err, "", nil)
 			return common.ResultRequeue, err
@@ -150,7 +152,7 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 					"pod", subnetPort.DisplayName, "statefulset-uid", r.getStsUID(subnetPort))
 				return common.ResultNormal, nil
 			}
-			if err := r.SubnetPortService.DeleteSubnetPort(subnetPort); err != nil {
+			if err := r.SubnetPortService.DeleteSubnetPort(ctx, subnetPort); err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, pod, err)
 				return common.ResultRequeue, err
 			}
@@ -273,7 +275,7 @@ func (r *PodReconciler) setupWithManager(mgr ctrl.Manager) error {
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
 			}).
-		Complete(r)
+		Complete(tracing.NewReconciler("Pod", r))
 }
 
 func (r *PodReconciler) RestoreReconcile() error {
@@ -387,7 +389,7 @@ func (r *PodReconciler) CollectGarbage(ctx context.Context) error {
 		}
 		log.Debug("GC collected Pod", "NSXSubnetPortID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		err = r.SubnetPortService.DeleteSubnetPortById(elem)
+		err = r.SubnetPortService.DeleteSubnetPortById(ctx, elem)
 		if err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -428,6 +430,16 @@ func (r *PodReconciler) GetSubnetPathForPod(ctx context.Context, pod *v1.Pod) (b
 		log.Debug("NSX SubnetPort had been created, returning the existing NSX Subnet path", "pod.UID", pod.UID, "subnetPath", subnetPath)
 		return true, subnetPath, subnetSetUID, subnetSetLock, interfacetype, nil
 	}
+	// The NSX port config of a SubnetPortSetting is realized under a specific Subnet, so a Pod with the port-setting
+	// annotation is allocated from the SubnetPortSetting's Subnet instead of the default SubnetSet.
+	if portSettingName := pod.GetAnnotations()[servicecommon.AnnotationPortSetting]; portSettingName != "" {
+		subnetPath, interfacetype, err = r.getSubnetPathForPortSetting(ctx, pod, portSettingName)
+		if err != nil {
+			return false, "", subnetSetUID, subnetSetLock, "", err
+		}
+		log.Info("Allocated NSX Subnet of SubnetPortSetting for Pod", "nsxSubnetPath", subnetPath, "subnetPortSetting", portSettingName, "pod.Name", pod.Name, "pod.UID", pod.UID)
+		return r.restoreMode && pod.Status.PodIP != "", subnetPath, subnetSetUID, subnetSetLock, interfacetype, nil
+	}
 	log.Info("Got default SubnetSet for Pod, allocating the NSX Subnet", "subnetSet.Name", subnetSet.Name, "subnetSet.UID", subnetSet.UID, "pod.Name", pod.Name, "pod.UID", pod.UID)
 	if r.restoreMode {
 		// For restore case, Pod will be created on the Subnet with matching CIDR
@@ -445,7 +457,7 @@ func (r *PodReconciler) GetSubnetPathForPod(ctx context.Context, pod *v1.Pod) (b
 	if subnetSet.Spec.IPAddressType == "" {
 		return false, "", subnetSetUID, subnetSetLock, "", fmt.Errorf("default Pod SubnetSet IPAddressType is under calculation")
 	}
-	subnetPath, subnetSetUID, subnetSetLock, err = common.AllocateSubnetFromSubnetSet(r.Client, r.APIReader, subnetSet, r.VPCService, r.SubnetService, r.SubnetPortService, interfacetype)
+	subnetPath, subnetSetUID, subnetSetLock, err = common.AllocateSubnetFromSubnetSet(ctx, r.Client, r.APIReader, subnetSet, r.VPCService, r.SubnetService, r.SubnetPortService, interfacetype)
 	if err != nil {
 		return false, subnetPath, subnetSetUID, subnetSetLock, interfacetype, err
 	}
@@ -453,6 +465,40 @@ func (r *PodReconciler) GetSubnetPathForPod(ctx context.Context, pod *v1.Pod) (b
 	return false, subnetPath, subnetSetUID, subnetSetLock, interfacetype, nil
 }
 
+// getSubnetPathForPortSetting returns the path of the NSX Subnet which the SubnetPortSetting refers to, and allocates
+// a port from it.
+func (r *PodReconciler) getSubnetPathForPortSetting(ctx context.Context, pod *v1.Pod, portSettingName string) (string, v1alpha1.IPAddressType, error) {
+	portSetting := &v1alpha1.SubnetPortSetting{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: portSettingName}, portSetting); err != nil {
+		log.Error(err, "Failed to get SubnetPortSetting for Pod", "SubnetPortSetting", portSettingName, "Pod", pod.Name)
+		return "", "", fmt.Errorf("failed to get SubnetPortSetting %s/%s: %w", pod.Namespace, portSettingName, err)
+	}
+	subnetCR := &v1alpha1.Subnet{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: portSetting.Spec.SubnetName}, subnetCR); err != nil {
+		log.Error(err, "Failed to get Subnet of SubnetPortSetting", "SubnetPortSetting", portSettingName, "Subnet", portSetting.Spec.SubnetName)
+		return "", "", fmt.Errorf("failed to get Subnet %s/%s of SubnetPortSetting %s: %w", pod.Namespace, portSetting.Spec.SubnetName, portSettingName, err)
+	}
+	if !subnetCR.DeletionTimestamp.IsZero() {
+		return "", "", fmt.Errorf("Subnet %s/%s of SubnetPortSetting %s is being deleted", pod.Namespace, subnetCR.Name, portSettingName)
+	}
+	nsxSubnet, err := r.SubnetService.GetSubnetByCR(subnetCR)
+	if err != nil {
+		return "", "", err
+	}
+	interfaceType := subnetport.GetDefaultInterfaceIPType("", subnetCR.Spec.IPAddressType)
+	if r.restoreMode && pod.Status.PodIP != "" {
+		return *nsxSubnet.Path, interfaceType, nil
+	}
+	canAllocate, err := r.SubnetPortService.AllocatePortFromSubnet(nsxSubnet, servicecommon.IsSharedSubnet(subnetCR), interfaceType)
+	if err != nil {
+		return "", "", err
+	}
+	if !canAllocate {
+		return "", "", fmt.Errorf("Subnet %s is exhausted", *nsxSubnet.Id)
+	}
+	return *nsxSubnet.Path, interfaceType, nil
+}
+
 func (r *PodReconciler) deleteSubnetPortByPodName(ctx context.Context, ns string, name string) error {
 	// NamespacedName is a unique identity in store as only one worker can deal with the NamespacedName at a time
 	nsxSubnetPorts := r.SubnetPortService.ListSubnetPortByPodName(ns, name)
@@ -467,7 +513,7 @@ func (r *PodReconciler) deleteSubnetPortByPodName(ctx context.Context, ns string
 		}
 
 		// Normal pod: delete the subnet port
-		if err := r.SubnetPortService.DeleteSubnetPort(nsxSubnetPort); err != nil {
+		if err := r.SubnetPortService.DeleteSubnetPort(ctx, nsxSubnetPort); err != nil {
 			return err
 		}
 	}
@@ -223,7 +223,7 @@ func TestPodReconciler_Reconcile(t *testing.T) {
 						return &model.VpcSubnet{}, nil
 					})
 				patches.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-					func(r *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+					func(r *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 						return nil, errors.New("failed to create subnetport")
 					})
 				return patches
@@ -256,7 +256,7 @@ func TestPodReconciler_Reconcile(t *testing.T) {
 						return &model.VpcSubnet{}, nil
 					})
 				patches.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-					func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+					func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 						return &model.SegmentPortState{
 							RealizedBindings: []model.AddressBindingEntry{
 								{
@@ -306,7 +306,7 @@ func TestPodReconciler_Reconcile(t *testing.T) {
 						return &model.VpcSubnet{}, nil
 					})
 				patches.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-					func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+					func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 						return &model.SegmentPortState{
 							RealizedBindings: []model.AddressBindingEntry{
 								{
@@ -337,7 +337,7 @@ func TestPodReconciler_Reconcile(t *testing.T) {
 					return nil
 				})
 				patchesDeleteSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).DeleteSubnetPort,
-					func(s *subnetport.SubnetPortService, port *model.VpcSubnetPort) error {
+					func(s *subnetport.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 						return nil
 					})
 				patchesDeleteSubnetPort.ApplyFunc((*subnetport.SubnetPortStore).GetVpcSubnetPortByUID,
@@ -364,7 +364,7 @@ func TestPodReconciler_Reconcile(t *testing.T) {
 					return nil
 				})
 				patchesDeleteSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).DeleteSubnetPort,
-					func(s *subnetport.SubnetPortService, port *model.VpcSubnetPort) error {
+					func(s *subnetport.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 						return errors.New("failed to delete subnetport")
 					})
 				patchesDeleteSubnetPort.ApplyFunc((*subnetport.SubnetPortStore).GetVpcSubnetPortByUID,
@@ -471,7 +471,7 @@ func TestPodReconciler_CollectGarbage(t *testing.T) {
 		})
 	defer patchesGetVpsSubnetPortByUID.Reset()
 	patchesDeleteSubnetPortById := gomonkey.ApplyFunc((*subnetport.SubnetPortService).DeleteSubnetPortById,
-		func(s *subnetport.SubnetPortService, uid string) error {
+		func(s *subnetport.SubnetPortService, _ context.Context, uid string) error {
 			return nil
 		})
 	defer patchesDeleteSubnetPortById.Reset()
@@ -640,7 +640,7 @@ func TestPodReconciler_GetSubnetPathForPod(t *testing.T) {
 						}, nil
 					})
 				patches.ApplyFunc(common.AllocateSubnetFromSubnetSet,
-					func(client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
+					func(_ context.Context, client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
 						return "", nil, nil, errors.New("failed to create subnet")
 					})
 				return patches
@@ -668,7 +668,7 @@ func TestPodReconciler_GetSubnetPathForPod(t *testing.T) {
 						}, nil
 					})
 				patches.ApplyFunc(common.AllocateSubnetFromSubnetSet,
-					func(client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
+					func(_ context.Context, client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
 						return subnetPath, nil, nil, nil
 					})
 				return patches
@@ -697,7 +697,7 @@ func TestPodReconciler_GetSubnetPathForPod(t *testing.T) {
 						}, nil
 					})
 				patches.ApplyFunc(common.AllocateSubnetFromSubnetSet,
-					func(client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
+					func(_ context.Context, client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
 						return subnetPath, nil, nil, nil
 					})
 				return patches
@@ -763,6 +763,98 @@ func TestPodReconciler_GetSubnetPathForPod(t *testing.T) {
 	}
 }
 
+func TestPodReconciler_GetSubnetPathForPodWithPortSetting(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1alpha1.AddToScheme(scheme))
+	portSetting := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: "setting-1", Namespace: "ns-1"},
+		Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+	}
+	subnetCR := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: "subnet-1", Namespace: "ns-1"},
+		Spec:       v1alpha1.SubnetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv4},
+	}
+	settingSubnetPath := "/orgs/default/projects/p1/vpcs/vpc1/subnets/subnet-1"
+	r := &PodReconciler{
+		Client:            fake.NewClientBuilder().WithScheme(scheme).WithObjects(portSetting, subnetCR).Build(),
+		SubnetPortService: &subnetport.SubnetPortService{},
+		SubnetService:     &subnet.SubnetService{},
+	}
+
+	tests := []struct {
+		name               string
+		annotation         string
+		canAllocate        bool
+		expectedErr        string
+		expectedSubnetPath string
+	}{
+		{
+			name:               "AllocateFromPortSettingSubnet",
+			annotation:         "setting-1",
+			canAllocate:        true,
+			expectedSubnetPath: settingSubnetPath,
+		},
+		{
+			name:        "PortSettingSubnetExhausted",
+			annotation:  "setting-1",
+			canAllocate: false,
+			expectedErr: "Subnet subnet-1 is exhausted",
+		},
+		{
+			name:        "PortSettingNotFound",
+			annotation:  "setting-2",
+			expectedErr: "failed to get SubnetPortSetting ns-1/setting-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc((*subnetport.SubnetPortService).GetSubnetPathForSubnetPortFromStore,
+				func(s *subnetport.SubnetPortService, uid types.UID) string {
+					return ""
+				})
+			defer patches.Reset()
+			patches.ApplyFunc(common.GetDefaultSubnetSetByNamespace,
+				func(client client.Client, namespace string, resourceType string) (*v1alpha1.SubnetSet, error) {
+					return &v1alpha1.SubnetSet{
+						ObjectMeta: metav1.ObjectMeta{Name: "pod-default", UID: "uid-1"},
+						Spec:       v1alpha1.SubnetSetSpec{IPAddressType: v1alpha1.IPAddressTypeIPv4},
+					}, nil
+				})
+			patches.ApplyFunc(common.AllocateSubnetFromSubnetSet,
+				func(_ context.Context, client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
+					t.Error("Pod with port-setting annotation should not be allocated from the default SubnetSet")
+					return "subnetset-subnet-path", nil, nil, nil
+				})
+			patches.ApplyFunc((*subnet.SubnetService).GetSubnetByCR,
+				func(s *subnet.SubnetService, subnet *v1alpha1.Subnet) (*model.VpcSubnet, error) {
+					assert.Equal(t, "subnet-1", subnet.Name)
+					return &model.VpcSubnet{Id: servicecommon.String("subnet-1"), Path: &settingSubnetPath}, nil
+				})
+			patches.ApplyFunc((*subnetport.SubnetPortService).AllocatePortFromSubnet,
+				func(s *subnetport.SubnetPortService, subnet *model.VpcSubnet, sharedSubnet bool, interfaceIPType v1alpha1.IPAddressType) (bool, error) {
+					return tt.canAllocate, nil
+				})
+
+			isExisting, path, _, subnetSetLock, interfaceType, err := r.GetSubnetPathForPod(context.TODO(), &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pod-1",
+					Namespace:   "ns-1",
+					Annotations: map[string]string{servicecommon.AnnotationPortSetting: tt.annotation},
+				},
+			})
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.False(t, isExisting)
+			assert.Nil(t, subnetSetLock)
+			assert.Equal(t, tt.expectedSubnetPath, path)
+			assert.Equal(t, v1alpha1.IPAddressTypeIPv4, interfaceType)
+		})
+	}
+}
+
 func TestPodReconciler_deleteSubnetPortByPodName(t *testing.T) {
 	subnetportId1 := "subnetport-1"
 	subnetportId2 := "subnetport-2"
@@ -808,7 +900,7 @@ func TestPodReconciler_deleteSubnetPortByPodName(t *testing.T) {
 		})
 	defer patchesGetByIndex.Reset()
 	patchesDeleteSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetport.SubnetPortService, sp *model.VpcSubnetPort) error {
+		func(s *subnetport.SubnetPortService, _ context.Context, sp *model.VpcSubnetPort) error {
 			assert.Equal(t, sp2, sp)
 			return nil
 		})
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
@@ -37,6 +38,7 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -118,13 +120,14 @@ func (r *SecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling SecurityPolicy CR", "securitypolicy", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	r.StatusUpdater.IncreaseSyncTotal()
 
 	if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
 		if apierrors.IsNotFound(err) {
-			if err := r.deleteSecurityPolicyByName(req.Namespace, req.Name); err != nil {
+			if err := r.deleteSecurityPolicyByName(ctx, req.Namespace, req.Name); err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return ResultRequeue, err
 			}
@@ -172,7 +175,7 @@ func (r *SecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 
 		log.Info("Reconciling CR to create or update securitypolicy", "securitypolicy", req.NamespacedName)
-		if err := r.Service.CreateOrUpdateSecurityPolicy(realObj); err != nil {
+		if err := r.Service.CreateOrUpdateSecurityPolicy(ctx, realObj); err != nil {
 			if errors.As(err, &nsxutil.RestrictionError{}) {
 				setSecurityPolicyErrorAnnotation(ctx, realObj, securitypolicy.IsVPCEnabled(r.Service), r.Client, common.ErrorNoDFWLicense)
 				r.StatusUpdater.UpdateFail(ctx, realObj, err, "", setSecurityPolicyReadyStatusFalse, r.Service)
@@ -202,7 +205,7 @@ func (r *SecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			}
 			log.Debug("Removed finalizer", "securitypolicy", req.NamespacedName)
 		}
-		if err := r.Service.DeleteSecurityPolicy(realObj.UID, false, servicecommon.ResourceTypeSecurityPolicy); err != nil {
+		if err := r.Service.DeleteSecurityPolicy(ctx, realObj.UID, false, servicecommon.ResourceTypeSecurityPolicy); err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, realObj, err)
 			return ResultRequeue, err
 		}
@@ -228,7 +231,8 @@ func setSecurityPolicyReadyStatusTrue(client client.Client, ctx context.Context,
 			LastTransitionTime: transitionTime,
 		},
 	}
-	updateSecurityPolicyStatusConditions(client, ctx, secPolicy, newConditions, service)
+	ruleStatuses := service.GetRuleStatuses(secPolicy, nil)
+	updateSecurityPolicyStatusConditions(client, ctx, secPolicy, newConditions, ruleStatuses, service)
 }
 
 func setSecurityPolicyReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, args ...interface{}) {
@@ -238,6 +242,12 @@ func setSecurityPolicyReadyStatusFalse(client client.Client, ctx context.Context
 	}
 	service := args[0].(*securitypolicy.SecurityPolicyService)
 	secPolicy := obj.(*v1alpha1.SecurityPolicy)
+	reason := "SecurityPolicyNotReady"
+	// The spec is rejected by the validation, e.g. an unsupported wildcard FQDN, so it won't be realized until it is fixed.
+	var validationErr *nsxutil.ValidationError
+	if errors.As(err, &validationErr) {
+		reason = "SecurityPolicyValidationFailed"
+	}
 	newConditions := []v1alpha1.Condition{
 		{
 			Type:   v1alpha1.Ready,
@@ -246,20 +256,27 @@ func setSecurityPolicyReadyStatusFalse(client client.Client, ctx context.Context
 				"error occurred while processing the SecurityPolicy CR. Error: %v",
 				err,
 			),
-			Reason:             "SecurityPolicyNotReady",
+			Reason:             reason,
 			LastTransitionTime: transitionTime,
 		},
 	}
-	updateSecurityPolicyStatusConditions(client, ctx, secPolicy, newConditions, service)
+	ruleStatuses := service.GetRuleStatuses(secPolicy, err)
+	updateSecurityPolicyStatusConditions(client, ctx, secPolicy, newConditions, ruleStatuses, service)
 }
 
-func updateSecurityPolicyStatusConditions(client client.Client, ctx context.Context, secPolicy *v1alpha1.SecurityPolicy, newConditions []v1alpha1.Condition, service *securitypolicy.SecurityPolicyService) {
+func updateSecurityPolicyStatusConditions(client client.Client, ctx context.Context, secPolicy *v1alpha1.SecurityPolicy, newConditions []v1alpha1.Condition,
+	ruleStatuses []v1alpha1.SecurityPolicyRuleStatus, service *securitypolicy.SecurityPolicyService,
+) {
 	conditionsUpdated := false
 	for i := range newConditions {
 		if mergeSecurityPolicyStatusCondition(secPolicy, &newConditions[i]) {
 			conditionsUpdated = true
 		}
 	}
+	if !equality.Semantic.DeepEqual(secPolicy.Status.Rules, ruleStatuses) {
+		secPolicy.Status.Rules = ruleStatuses
+		conditionsUpdated = true
+	}
 	if conditionsUpdated {
 		if securitypolicy.IsVPCEnabled(service) {
 			finalObj := securitypolicy.T1ToVPC(secPolicy)
@@ -274,7 +291,7 @@ func updateSecurityPolicyStatusConditions(client client.Client, ctx context.Cont
 			}
 		}
 		log.Debug("Updated SecurityPolicy", "Name", secPolicy.Name, "Namespace", secPolicy.Namespace,
-			"New Conditions", newConditions)
+			"New Conditions", newConditions, "Rules", ruleStatuses)
 	}
 }
 
@@ -333,7 +350,7 @@ func (r *SecurityPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 			&EnqueueRequestForPod{Client: k8sClient(mgr), SecurityPolicyReconciler: r},
 			builder.WithPredicates(PredicateFuncsPod),
 		).
-		Complete(r)
+		Complete(tracing.NewReconciler("SecurityPolicy", r))
 }
 
 // Start setup manager and launch GC
@@ -364,7 +381,7 @@ func (r *SecurityPolicyReconciler) CollectGarbage(ctx context.Context) error {
 	for elem := range diffSet {
 		log.Debug("GC collected SecurityPolicy CR", "securityPolicyUID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		err = r.Service.DeleteSecurityPolicy(types.UID(elem), true, servicecommon.ResourceTypeSecurityPolicy)
+		err = r.Service.DeleteSecurityPolicy(ctx, types.UID(elem), true, servicecommon.ResourceTypeSecurityPolicy)
 		if err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -378,12 +395,12 @@ func (r *SecurityPolicyReconciler) CollectGarbage(ctx context.Context) error {
 	return nil
 }
 
-func (r *SecurityPolicyReconciler) deleteSecurityPolicyByName(ns, name string) error {
+func (r *SecurityPolicyReconciler) deleteSecurityPolicyByName(ctx context.Context, ns, name string) error {
 	nsxSecurityPolicies := r.Service.ListSecurityPolicyByName(ns, name)
 	for _, item := range nsxSecurityPolicies {
 		uid := nsxutil.FindTag(item.Tags, servicecommon.TagValueScopeSecurityPolicyUID)
 		log.Info("Deleting SecurityPolicy", "securityPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
-		if err := r.Service.DeleteSecurityPolicy(types.UID(uid), false, servicecommon.ResourceTypeSecurityPolicy); err != nil {
+		if err := r.Service.DeleteSecurityPolicy(ctx, types.UID(uid), false, servicecommon.ResourceTypeSecurityPolicy); err != nil {
 			log.Error(err, "Failed to delete SecurityPolicy", "securityPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
 			return err
 		}
@@ -45,6 +45,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
 func fakeService() *securitypolicy.SecurityPolicyService {
@@ -101,7 +102,7 @@ func TestSecurityPolicyController_updateSecurityPolicyStatusConditions(t *testin
 			Reason:  "Error occurred while processing the Security Policy CRD. Please check the config and try again",
 		},
 	}
-	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, r.Service)
+	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, nil, r.Service)
 
 	if !reflect.DeepEqual(dummySP.Status.Conditions, newConditions) {
 		t.Fatalf("Failed to correctly update Status Conditions when conditions haven't changed")
@@ -127,7 +128,7 @@ func TestSecurityPolicyController_updateSecurityPolicyStatusConditions(t *testin
 		},
 	}
 
-	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, r.Service)
+	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, nil, r.Service)
 
 	if !reflect.DeepEqual(dummySP.Status.Conditions, newConditions) {
 		t.Fatalf("Failed to correctly update Status Conditions when conditions haven't changed")
@@ -143,7 +144,7 @@ func TestSecurityPolicyController_updateSecurityPolicyStatusConditions(t *testin
 		},
 	}
 
-	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, r.Service)
+	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, nil, r.Service)
 
 	if !reflect.DeepEqual(dummySP.Status.Conditions, newConditions) {
 		t.Fatalf("Failed to correctly update Status Conditions when conditions haven't changed")
@@ -159,13 +160,50 @@ func TestSecurityPolicyController_updateSecurityPolicyStatusConditions(t *testin
 		},
 	}
 
-	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, r.Service)
+	updateSecurityPolicyStatusConditions(r.Client, ctx, dummySP, newConditions, nil, r.Service)
 
 	if !reflect.DeepEqual(dummySP.Status.Conditions, newConditions) {
 		t.Fatalf("Failed to correctly update Status Conditions when conditions haven't changed")
 	}
 }
 
+func TestSetSecurityPolicyReadyStatusFalse(t *testing.T) {
+	r := NewFakeSecurityPolicyReconciler()
+	ctx := context.TODO()
+	dummySP := &v1alpha1.SecurityPolicy{}
+
+	setSecurityPolicyReadyStatusFalse(r.Client, ctx, dummySP, metav1.Now(), errors.New("NSX API error"), r.Service)
+	assert.Equal(t, "SecurityPolicyNotReady", dummySP.Status.Conditions[0].Reason)
+
+	validationErr := &nsxutil.ValidationError{Desc: "unsupported wildcard FQDN \"*.com\""}
+	setSecurityPolicyReadyStatusFalse(r.Client, ctx, dummySP, metav1.Now(), fmt.Errorf("failed to build rule: %w", validationErr), r.Service)
+	assert.Equal(t, v1.ConditionFalse, dummySP.Status.Conditions[0].Status)
+	assert.Equal(t, "SecurityPolicyValidationFailed", dummySP.Status.Conditions[0].Reason)
+	assert.Contains(t, dummySP.Status.Conditions[0].Message, "unsupported wildcard FQDN")
+}
+
+func TestSetSecurityPolicyReadyStatusRules(t *testing.T) {
+	r := NewFakeSecurityPolicyReconciler()
+	ctx := context.TODO()
+	dummySP := &v1alpha1.SecurityPolicy{}
+
+	ruleErr := &securitypolicy.RuleError{Index: 0, Err: errors.New("invalid peer")}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "GetRuleStatuses", func(_ *securitypolicy.SecurityPolicyService, _ *v1alpha1.SecurityPolicy, err error) []v1alpha1.SecurityPolicyRuleStatus {
+		if err != nil {
+			return []v1alpha1.SecurityPolicyRuleStatus{{Index: 0, RealizationState: model.GenericPolicyRealizedResource_STATE_ERROR, Message: err.Error()}}
+		}
+		return []v1alpha1.SecurityPolicyRuleStatus{{Index: 0, RealizationState: model.GenericPolicyRealizedResource_STATE_REALIZED}}
+	})
+	defer patches.Reset()
+
+	setSecurityPolicyReadyStatusFalse(r.Client, ctx, dummySP, metav1.Now(), ruleErr, r.Service)
+	assert.Equal(t, []v1alpha1.SecurityPolicyRuleStatus{{Index: 0, RealizationState: model.GenericPolicyRealizedResource_STATE_ERROR, Message: "invalid peer"}}, dummySP.Status.Rules)
+
+	setSecurityPolicyReadyStatusTrue(r.Client, ctx, dummySP, metav1.Now(), r.Service)
+	assert.Equal(t, v1.ConditionTrue, dummySP.Status.Conditions[0].Status)
+	assert.Equal(t, []v1alpha1.SecurityPolicyRuleStatus{{Index: 0, RealizationState: model.GenericPolicyRealizedResource_STATE_REALIZED}}, dummySP.Status.Rules)
+}
+
 type fakeStatusWriter struct{}
 
 func (writer fakeStatusWriter) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
@@ -310,7 +348,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 	// not found and deletion success
 	errNotFound := apierrors.NewNotFound(v1alpha1.Resource("SecurityPolicy"), "")
 	k8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).Return(errNotFound)
-	deleteSecurityPolicyByNamePatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, name, ns string) error {
+	deleteSecurityPolicyByNamePatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, _ context.Context, name, ns string) error {
 		return nil
 	})
 	defer deleteSecurityPolicyByNamePatch.Reset()
@@ -356,7 +394,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		return false, nil
 	})
 	err = errors.New("create or update security policy failed")
-	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}) error {
 		return errors.New("create or update security policy failed")
 	})
 	k8sClient.EXPECT().Status().Times(1).Return(fakewriter)
@@ -367,7 +405,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 
 	// DeletionTimestamp.IsZero = true, Finalizers include util.SecurityPolicyFinalizerName and update success
 	k8sClient.EXPECT().Get(ctx, gomock.Any(), sp).Return(nil)
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}) error {
 		return nil
 	})
 	k8sClient.EXPECT().Status().Times(1).Return(fakewriter)
@@ -385,7 +423,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		v1sp.Finalizers = []string{common.T1SecurityPolicyFinalizerName}
 		return nil
 	})
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
@@ -403,7 +441,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		v1sp.ObjectMeta.DeletionTimestamp = &time
 		return nil
 	})
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		return nil
 	})
 	result, retErr = r.Reconcile(ctx, req)
@@ -420,7 +458,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		return nil
 	})
 	err = errors.New("delete security policy failed")
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, UID interface{}, isGc bool, createdFor string) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, UID interface{}, isGc bool, createdFor string) error {
 		return errors.New("delete security policy failed")
 	})
 	k8sClient.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil)
@@ -437,7 +475,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		v1sp.Finalizers = []string{common.T1SecurityPolicyFinalizerName}
 		return nil
 	})
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		return nil
 	})
 	k8sClient.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil)
@@ -473,7 +511,7 @@ func TestSecurityPolicyReconciler_GarbageCollector(t *testing.T) {
 	policyList := &v1alpha1.SecurityPolicyList{}
 
 	// gc collect item "2345", local store has more item than k8s cache
-	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		return nil
 	})
 	patch.ApplyMethod(reflect.TypeOf(service), "ListSecurityPolicyID", func(_ *securitypolicy.SecurityPolicyService) sets.Set[string] {
@@ -498,7 +536,7 @@ func TestSecurityPolicyReconciler_GarbageCollector(t *testing.T) {
 		a.Insert("1234")
 		return a
 	})
-	patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
@@ -517,7 +555,7 @@ func TestSecurityPolicyReconciler_GarbageCollector(t *testing.T) {
 		a := sets.New[string]()
 		return a
 	})
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj types.UID, isGc bool, createdFor string) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj types.UID, isGc bool, createdFor string) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
@@ -709,14 +747,14 @@ func TestSecurityPolicyReconciler_deleteSecuritypolicyByName(t *testing.T) {
 		}
 	})
 
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj types.UID, isGc bool, createdFor string) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj types.UID, isGc bool, createdFor string) error {
 		if obj == "uid2" {
 			return errors.New("delete failed")
 		}
 		return nil
 	})
 
-	err := r.deleteSecurityPolicyByName("dummy-ns", "dummy-name")
+	err := r.deleteSecurityPolicyByName(context.TODO(), "dummy-ns", "dummy-name")
 	assert.Error(t, err)
 	patch.Reset()
 }
@@ -760,7 +798,7 @@ func TestSecurityPolicyReconcilerForVPC_Reconcile(t *testing.T) {
 	errNotFound := apierrors.NewNotFound(crdv1alpha1.Resource("SecurityPolicy"), "")
 	k8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).Return(errNotFound)
 	err := errors.New("delete security policy failed")
-	deleteSecurityPolicyByNamePatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, name, ns string) error {
+	deleteSecurityPolicyByNamePatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, _ context.Context, name, ns string) error {
 		return errors.New("delete security policy failed")
 	})
 	result, retErr = r.Reconcile(ctx, req)
@@ -770,7 +808,7 @@ func TestSecurityPolicyReconcilerForVPC_Reconcile(t *testing.T) {
 	// not found and deletion success
 	deleteSecurityPolicyByNamePatch.Reset()
 	k8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).Return(errNotFound)
-	deleteSecurityPolicyByNamePatch = gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, name, ns string) error {
+	deleteSecurityPolicyByNamePatch = gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, _ context.Context, name, ns string) error {
 		return nil
 	})
 	defer deleteSecurityPolicyByNamePatch.Reset()
@@ -33,6 +33,7 @@ import (
 	_ "github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -76,6 +77,9 @@ func (r *ServiceLbReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling LB service", "LBService", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		if r.Service != nil {
+			metrics.HistogramObserveSince(r.Service.NSXConfig, metrics.ControllerReconcileDuration, MetricResType, startTime)
+		}
 	}()
 
 	if err := r.Client.Get(ctx, req.NamespacedName, service); err != nil {
@@ -176,7 +180,7 @@ func (r *ServiceLbReconciler) setupWithManager(mgr ctrl.Manager) error {
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
 			})
-	return b.Complete(r)
+	return b.Complete(tracing.NewReconciler("Service", r))
 }
 
 // Start setup manager
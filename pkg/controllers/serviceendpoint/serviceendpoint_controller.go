@@ -0,0 +1,265 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package serviceendpoint
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpcendpoint"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+)
+
+var (
+	log = logger.Log
+)
+
+const (
+	conditionTypeReady          = "Ready"
+	reasonServiceEndpointReady  = "ServiceEndpointReady"
+	reasonServiceEndpointFailed = "ServiceEndpointFailed"
+)
+
+// Reconciler reconciles a ServiceEndpoint object
+type Reconciler struct {
+	Client             client.Client
+	Scheme             *runtime.Scheme
+	VPCEndpointService *vpcendpoint.VPCEndpointService
+	StatusUpdater      common.StatusUpdater
+}
+
+func NewReconciler(mgr ctrl.Manager, vpcEndpointService *vpcendpoint.VPCEndpointService) *Reconciler {
+	recorder := mgr.GetEventRecorderFor("serviceendpoint-controller") //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	return &Reconciler{
+		Client:             mgr.GetClient(),
+		Scheme:             mgr.GetScheme(),
+		VPCEndpointService: vpcEndpointService,
+		StatusUpdater:      common.NewStatusUpdater(mgr.GetClient(), vpcEndpointService.NSXConfig, recorder, common.MetricResTypeServiceEndpoint, "VpcServiceEndpoint", "ServiceEndpoint"),
+	}
+}
+
+func (r *Reconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "ServiceEndpoint")
+		return err
+	}
+	go common.GenericGarbageCollector(make(chan bool), servicecommon.GCInterval, r.CollectGarbage)
+	return nil
+}
+
+func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ServiceEndpoint{}).
+		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.NumReconcile(),
+		}).
+		Complete(tracing.NewReconciler("ServiceEndpoint", r))
+}
+
+// RestoreReconcile recreates the NSX VpcServiceEndpoints for the realized ServiceEndpoint CRs after NSX is restored.
+func (r *Reconciler) RestoreReconcile() error {
+	if !r.VPCEndpointService.NSXClient.NSXCheckVersion(nsx.VPCEndpoint) {
+		return nil
+	}
+	restoreList, err := r.getRestoreList()
+	if err != nil {
+		return fmt.Errorf("failed to get ServiceEndpoint restore list: %w", err)
+	}
+	var errorList []error
+	for _, key := range restoreList {
+		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+		if err != nil || common.IsReconcileResultRequeue(result) {
+			errorList = append(errorList, fmt.Errorf("failed to restore ServiceEndpoint %s, error: %w", key, err))
+		}
+	}
+	if len(errorList) > 0 {
+		return fmt.Errorf("errors found in ServiceEndpoint restore: %v", errorList)
+	}
+	return nil
+}
+
+func (r *Reconciler) getRestoreList() ([]types.NamespacedName, error) {
+	restoreList := []types.NamespacedName{}
+	serviceEndpointList := &v1alpha1.ServiceEndpointList{}
+	if err := r.Client.List(context.TODO(), serviceEndpointList); err != nil {
+		return restoreList, err
+	}
+	nsxServiceEndpointCRUIDs := r.VPCEndpointService.ListServiceEndpointCRUIDsInStore()
+	for _, sep := range serviceEndpointList.Items {
+		// Restore the ServiceEndpoint which has been realized before but is missing on NSX
+		if meta.IsStatusConditionTrue(sep.Status.Conditions, conditionTypeReady) && !nsxServiceEndpointCRUIDs.Has(string(sep.UID)) {
+			restoreList = append(restoreList, types.NamespacedName{Namespace: sep.Namespace, Name: sep.Name})
+		}
+	}
+	return restoreList, nil
+}
+
+func (r *Reconciler) setNotSupported(ctx context.Context, req ctrl.Request) error {
+	serviceEndpointCR := &v1alpha1.ServiceEndpoint{}
+	if err := r.Client.Get(ctx, req.NamespacedName, serviceEndpointCR); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Error(err, "Unable to fetch ServiceEndpoint CR", "ServiceEndpoint", req.NamespacedName)
+		return err
+	}
+	r.StatusUpdater.UpdateFail(ctx, serviceEndpointCR, fmt.Errorf("NSX VPC service endpoint is not supported"), "NSX VPC service endpoint is not supported", setReadyStatusFalse)
+	return nil
+}
+
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=serviceendpoints,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=serviceendpoints/status,verbs=get;update;patch
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling ServiceEndpoint", "ServiceEndpoint", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
+	}()
+	r.StatusUpdater.IncreaseSyncTotal()
+
+	if !r.VPCEndpointService.NSXClient.NSXCheckVersion(nsx.VPCEndpoint) {
+		log.Warn("NSX VPC service endpoint is not supported", "ServiceEndpoint", req.NamespacedName)
+		if err := r.setNotSupported(ctx, req); err != nil {
+			return common.ResultRequeue, nil
+		}
+		return common.ResultNormal, nil
+	}
+
+	serviceEndpointCR := &v1alpha1.ServiceEndpoint{}
+	if err := r.Client.Get(ctx, req.NamespacedName, serviceEndpointCR); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.StatusUpdater.IncreaseDeleteTotal()
+			if err := r.VPCEndpointService.DeleteServiceEndpointByCRName(ctx, req.Namespace, req.Name); err != nil {
+				log.Error(err, "Failed to delete NSX VpcServiceEndpoint", "ServiceEndpoint", req.NamespacedName)
+				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
+				return common.ResultRequeue, nil
+			}
+			r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
+			return common.ResultNormal, nil
+		}
+		log.Error(err, "Unable to fetch ServiceEndpoint CR", "ServiceEndpoint", req.NamespacedName)
+		return common.ResultRequeue, nil
+	}
+
+	if !serviceEndpointCR.ObjectMeta.DeletionTimestamp.IsZero() {
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err := r.VPCEndpointService.DeleteServiceEndpointByCRId(ctx, string(serviceEndpointCR.UID)); err != nil {
+			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
+			return common.ResultRequeue, nil
+		}
+		r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
+		return common.ResultNormal, nil
+	}
+
+	r.StatusUpdater.IncreaseUpdateTotal()
+	if _, err := r.VPCEndpointService.CreateOrUpdateServiceEndpoint(ctx, serviceEndpointCR); err != nil {
+		r.StatusUpdater.UpdateFail(ctx, serviceEndpointCR, err, "Failed to create or update NSX VpcServiceEndpoint", setReadyStatusFalse)
+		return common.ResultRequeue, nil
+	}
+	r.StatusUpdater.UpdateSuccess(ctx, serviceEndpointCR, setReadyStatusTrue)
+	return common.ResultNormal, nil
+}
+
+func setReadyStatusTrue(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, _ ...interface{}) {
+	serviceEndpointCR := obj.(*v1alpha1.ServiceEndpoint)
+	updateReadyCondition(client, ctx, serviceEndpointCR, metav1.Condition{
+		Type:               conditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonServiceEndpointReady,
+		Message:            "NSX VpcServiceEndpoint has been successfully created/updated",
+		LastTransitionTime: transitionTime,
+	})
+}
+
+func setReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, args ...interface{}) {
+	serviceEndpointCR := obj.(*v1alpha1.ServiceEndpoint)
+	condition := metav1.Condition{
+		Type:               conditionTypeReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reasonServiceEndpointFailed,
+		Message:            "NSX VpcServiceEndpoint could not be created/updated",
+		LastTransitionTime: transitionTime,
+	}
+	if len(args) > 0 {
+		condition.Message = args[0].(string)
+	} else if err != nil {
+		condition.Message = fmt.Sprintf("Error occurred while processing the ServiceEndpoint CR. Please check the config and try again. Error: %v", err)
+	}
+	updateReadyCondition(client, ctx, serviceEndpointCR, condition)
+}
+
+func updateReadyCondition(client client.Client, ctx context.Context, serviceEndpoint *v1alpha1.ServiceEndpoint, condition metav1.Condition) {
+	condition.ObservedGeneration = serviceEndpoint.Generation
+	if !meta.SetStatusCondition(&serviceEndpoint.Status.Conditions, condition) {
+		log.Trace("Conditions already match", "ServiceEndpoint", serviceEndpoint.Name, "Condition", condition)
+		return
+	}
+	if err := client.Status().Update(ctx, serviceEndpoint); err != nil {
+		log.Error(err, "Failed to update ServiceEndpoint status", "Name", serviceEndpoint.Name, "Namespace", serviceEndpoint.Namespace)
+		return
+	}
+	log.Info("Updated ServiceEndpoint", "Name", serviceEndpoint.Name, "Namespace", serviceEndpoint.Namespace, "Status", serviceEndpoint.Status)
+}
+
+// CollectGarbage collects the stale NSX VpcServiceEndpoints whose ServiceEndpoint CRs have been removed from K8s.
+// It implements the interface GarbageCollector method.
+func (r *Reconciler) CollectGarbage(ctx context.Context) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("ServiceEndpoint garbage collection completed", "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	crUIDs, err := r.listServiceEndpointUIDsFromCRs(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list ServiceEndpoint CRs")
+		return err
+	}
+	nsxCRUIDs := r.VPCEndpointService.ListServiceEndpointCRUIDsInStore()
+
+	var errList []error
+	for uid := range nsxCRUIDs.Difference(crUIDs) {
+		log.Info("GC collected ServiceEndpoint CR", "UID", uid)
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err = r.VPCEndpointService.DeleteServiceEndpointByCRId(ctx, uid); err != nil {
+			errList = append(errList, err)
+			r.StatusUpdater.IncreaseDeleteFailTotal()
+		} else {
+			r.StatusUpdater.IncreaseDeleteSuccessTotal()
+		}
+	}
+	if len(errList) > 0 {
+		return fmt.Errorf("errors found in ServiceEndpoint garbage collection: %s", errList)
+	}
+	return nil
+}
+
+func (r *Reconciler) listServiceEndpointUIDsFromCRs(ctx context.Context) (sets.Set[string], error) {
+	crUIDs := sets.New[string]()
+	serviceEndpointList := &v1alpha1.ServiceEndpointList{}
+	if err := r.Client.List(ctx, serviceEndpointList); err != nil {
+		return nil, err
+	}
+	for _, sep := range serviceEndpointList.Items {
+		crUIDs.Insert(string(sep.UID))
+	}
+	return crUIDs, nil
+}
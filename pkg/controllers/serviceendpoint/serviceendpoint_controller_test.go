@@ -0,0 +1,306 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package serviceendpoint
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpcendpoint"
+)
+
+func readyServiceEndpoint(name, uid string) *v1alpha1.ServiceEndpoint {
+	return &v1alpha1.ServiceEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns-1", UID: types.UID(uid)},
+		Spec:       v1alpha1.ServiceEndpointSpec{ServiceEndpointIP: "10.0.0.10"},
+		Status: v1alpha1.ServiceEndpointStatus{
+			Conditions: []metav1.Condition{{Type: conditionTypeReady, Status: metav1.ConditionTrue, Reason: reasonServiceEndpointReady}},
+		},
+	}
+}
+
+func TestReconciler_GetRestoreList(t *testing.T) {
+	notReady := readyServiceEndpoint("sep-2", "sep-2-uid")
+	notReady.Status.Conditions = nil
+	r := createFakeReconciler(readyServiceEndpoint("sep-1", "sep-1-uid"), notReady, readyServiceEndpoint("sep-3", "sep-3-uid"))
+	require.NoError(t, r.VPCEndpointService.ServiceEndpointStore.Apply(&model.VpcServiceEndpoint{
+		Id:   servicecommon.String("sep-3-id"),
+		Tags: []model.Tag{{Scope: servicecommon.String(servicecommon.TagScopeServiceEndpointCRUID), Tag: servicecommon.String("sep-3-uid")}},
+	}))
+
+	list, err := r.getRestoreList()
+	require.NoError(t, err)
+	assert.Equal(t, []types.NamespacedName{{Namespace: "ns-1", Name: "sep-1"}}, list)
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "List", func(_ client.Client, _ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+		return fmt.Errorf("mocked list error")
+	})
+	defer patches.Reset()
+	_, err = r.getRestoreList()
+	assert.ErrorContains(t, err, "mocked list error")
+}
+
+func TestReconciler_RestoreReconcile(t *testing.T) {
+	r := createFakeReconciler(readyServiceEndpoint("sep-1", "sep-1-uid"))
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+		return false
+	})
+	assert.NoError(t, r.RestoreReconcile())
+	patches.Reset()
+
+	patches = gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+		return true
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "CreateOrUpdateServiceEndpoint", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, _ *v1alpha1.ServiceEndpoint) (*model.VpcServiceEndpoint, error) {
+		return nil, fmt.Errorf("mocked create error")
+	})
+	defer patches.Reset()
+	err := r.RestoreReconcile()
+	assert.ErrorContains(t, err, "errors found in ServiceEndpoint restore")
+}
+
+func TestReconciler_StartController(t *testing.T) {
+	mockMgr := &MockManager{scheme: runtime.NewScheme()}
+	patches := gomonkey.ApplyFunc((*Reconciler).setupWithManager, func(r *Reconciler, mgr manager.Manager) error {
+		return nil
+	})
+	patches.ApplyFunc(common.GenericGarbageCollector, func(cancel chan bool, timeout time.Duration, f func(ctx context.Context) error) {})
+	defer patches.Reset()
+	r := createFakeReconciler()
+	assert.NoError(t, r.StartController(mockMgr, nil))
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns-1", Name: "sep-1"}}
+	sep := &v1alpha1.ServiceEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "sep-1", Namespace: "ns-1", UID: "sep-1-uid", Generation: 2},
+		Spec:       v1alpha1.ServiceEndpointSpec{ServiceEndpointIP: "10.0.0.10"},
+	}
+	deletingSep := sep.DeepCopy()
+	deletingSep.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deletingSep.Finalizers = []string{"test"}
+
+	tests := []struct {
+		name           string
+		objects        []client.Object
+		supported      bool
+		preparedFunc   func(r *Reconciler) *gomonkey.Patches
+		expectedResult ctrl.Result
+		expectedStatus metav1.ConditionStatus
+	}{
+		{
+			name:           "ServiceEndpoint not supported",
+			objects:        []client.Object{sep},
+			expectedResult: common.ResultNormal,
+			expectedStatus: metav1.ConditionFalse,
+		},
+		{
+			name:      "ServiceEndpoint get error",
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "Get", func(_ client.Client, _ context.Context, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+					return fmt.Errorf("mocked get error")
+				})
+			},
+			expectedResult: common.ResultRequeue,
+		},
+		{
+			name:      "ServiceEndpoint deleted with NSX deletion error",
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteServiceEndpointByCRName", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, ns, name string) error {
+					assert.Equal(t, "ns-1", ns)
+					assert.Equal(t, "sep-1", name)
+					return fmt.Errorf("mocked delete error")
+				})
+			},
+			expectedResult: common.ResultRequeue,
+		},
+		{
+			name:      "ServiceEndpoint deleted",
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteServiceEndpointByCRName", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, _, _ string) error {
+					return nil
+				})
+			},
+			expectedResult: common.ResultNormal,
+		},
+		{
+			name:      "ServiceEndpoint being deleted",
+			objects:   []client.Object{deletingSep},
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteServiceEndpointByCRId", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, id string) error {
+					assert.Equal(t, "sep-1-uid", id)
+					return nil
+				})
+			},
+			expectedResult: common.ResultNormal,
+		},
+		{
+			name:      "ServiceEndpoint creation error",
+			objects:   []client.Object{sep},
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "CreateOrUpdateServiceEndpoint", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, _ *v1alpha1.ServiceEndpoint) (*model.VpcServiceEndpoint, error) {
+					return nil, fmt.Errorf("mocked create error")
+				})
+			},
+			expectedResult: common.ResultRequeue,
+			expectedStatus: metav1.ConditionFalse,
+		},
+		{
+			name:      "ServiceEndpoint created",
+			objects:   []client.Object{sep},
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "CreateOrUpdateServiceEndpoint", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, _ *v1alpha1.ServiceEndpoint) (*model.VpcServiceEndpoint, error) {
+					return &model.VpcServiceEndpoint{}, nil
+				})
+			},
+			expectedResult: common.ResultNormal,
+			expectedStatus: metav1.ConditionTrue,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := createFakeReconciler(tc.objects...)
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+				return tc.supported
+			})
+			defer patches.Reset()
+			if tc.preparedFunc != nil {
+				defer tc.preparedFunc(r).Reset()
+			}
+			result, err := r.Reconcile(context.TODO(), request)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+			if tc.expectedStatus != "" {
+				updated := &v1alpha1.ServiceEndpoint{}
+				require.NoError(t, r.Client.Get(context.TODO(), request.NamespacedName, updated))
+				cond := meta.FindStatusCondition(updated.Status.Conditions, conditionTypeReady)
+				require.NotNil(t, cond)
+				assert.Equal(t, tc.expectedStatus, cond.Status)
+				assert.Equal(t, int64(2), cond.ObservedGeneration)
+			}
+		})
+	}
+}
+
+func TestReconciler_CollectGarbage(t *testing.T) {
+	r := createFakeReconciler(readyServiceEndpoint("sep-1", "sep-1-uid"))
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "ListServiceEndpointCRUIDsInStore", func(_ *vpcendpoint.VPCEndpointService) sets.Set[string] {
+		return sets.New("sep-1-uid", "sep-2-uid")
+	})
+	defer patches.Reset()
+	deleteErr := fmt.Errorf("mocked deletion error")
+	patches.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteServiceEndpointByCRId", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, id string) error {
+		assert.Equal(t, "sep-2-uid", id)
+		return deleteErr
+	})
+	assert.ErrorContains(t, r.CollectGarbage(context.TODO()), "mocked deletion error")
+
+	deleteErr = nil
+	assert.NoError(t, r.CollectGarbage(context.TODO()))
+
+	patches.ApplyMethod(reflect.TypeOf(r.Client), "List", func(_ client.Client, _ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+		return fmt.Errorf("mocked list error")
+	})
+	assert.ErrorContains(t, r.CollectGarbage(context.TODO()), "mocked list error")
+}
+
+func createFakeReconciler(objs ...client.Object) *Reconciler {
+	mgr := newMockManager(objs...)
+	svc := servicecommon.Service{
+		Client:    mgr.GetClient(),
+		NSXClient: &nsx.Client{},
+		NSXConfig: &config.NSXOperatorConfig{
+			NsxConfig: &config.NsxConfig{
+				EnforcementPoint: "vmc-enforcementpoint",
+			},
+		},
+	}
+	vpcEndpointService := &vpcendpoint.VPCEndpointService{
+		Service:              svc,
+		VPCEndpointStore:     vpcendpoint.SetupVPCEndpointStore(),
+		ServiceEndpointStore: vpcendpoint.SetupServiceEndpointStore(),
+	}
+	return NewReconciler(mgr, vpcEndpointService)
+}
+
+func newMockManager(objs ...client.Object) ctrl.Manager {
+	newScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(newScheme))
+	utilruntime.Must(v1alpha1.AddToScheme(newScheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(newScheme).WithObjects(objs...).WithStatusSubresource(&v1alpha1.ServiceEndpoint{}).Build()
+	return &MockManager{
+		client:   fakeClient,
+		scheme:   newScheme,
+		recorder: &fakeRecorder{},
+	}
+}
+
+type MockManager struct {
+	ctrl.Manager
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+func (m *MockManager) GetClient() client.Client {
+	return m.client
+}
+
+func (m *MockManager) GetScheme() *runtime.Scheme {
+	return m.scheme
+}
+
+func (m *MockManager) GetEventRecorderFor(name string) record.EventRecorder {
+	return m.recorder
+}
+
+func (m *MockManager) Add(runnable manager.Runnable) error {
+	return nil
+}
+
+func (m *MockManager) Start(context.Context) error {
+	return nil
+}
+
+type fakeRecorder struct{}
+
+func (recorder fakeRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+}
+
+func (recorder fakeRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (recorder fakeRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+}
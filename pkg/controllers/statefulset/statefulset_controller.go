@@ -31,6 +31,7 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	subnetportservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -70,6 +71,7 @@ func (r *StatefulSetReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling StatefulSet", "StatefulSet", req.NamespacedName, "duration", time.Since(startTime))
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	r.StatusUpdater.IncreaseSyncTotal()
@@ -188,7 +190,7 @@ func (r *StatefulSetReconciler) releaseSubnetPortsForStatefulSet(ctx context.Con
 			}
 		}
 
-		if err := r.SubnetPortService.DeleteSubnetPort(subnetPort); err != nil {
+		if err := r.SubnetPortService.DeleteSubnetPort(ctx, subnetPort); err != nil {
 			log.Error(err, "Failed to delete subnet port",
 				"subnetPort", *subnetPort.Id,
 				"StatefulSet", namespace+"/"+name)
@@ -230,7 +232,7 @@ func (r *StatefulSetReconciler) releaseSubnetPortForPod(ctx context.Context, nam
 	}
 
 	for _, targetPort := range targetPorts {
-		if err := r.SubnetPortService.DeleteSubnetPort(targetPort); err != nil {
+		if err := r.SubnetPortService.DeleteSubnetPort(ctx, targetPort); err != nil {
 			log.Error(err, "Failed to delete subnet port for pod", "pod", podName)
 			return releaseSubnetPortNoop, err
 		}
@@ -312,7 +314,7 @@ func (r *StatefulSetReconciler) CollectGarbage(ctx context.Context) error {
 					}
 				}
 				log.Info("StatefulSet garbage collector: found out-of-range port", "index", idx, "stsUID", sts.UID, "start", start, "end", end, "namespace", sts.Namespace)
-				if err := r.SubnetPortService.DeleteSubnetPort(port); err != nil {
+				if err := r.SubnetPortService.DeleteSubnetPort(ctx, port); err != nil {
 					log.Error(err, "GC: failed to delete out-of-range subnet port", "port", *port.Id, "stsUID", sts.UID, "namespace", sts.Namespace)
 					errList = append(errList, err)
 				}
@@ -337,7 +339,7 @@ func (r *StatefulSetReconciler) CollectGarbage(ctx context.Context) error {
 				}
 			}
 			log.Debug("Found orphaned subnet port for deleted StatefulSet", "port", *port.Id, "stsUID", stsID)
-			if err := r.SubnetPortService.DeleteSubnetPort(port); err != nil {
+			if err := r.SubnetPortService.DeleteSubnetPort(ctx, port); err != nil {
 				log.Error(err, "GC: failed to delete orphaned subnet port", "port", *port.Id, "stsUID", stsID)
 				errList = append(errList, err)
 			}
@@ -451,7 +453,7 @@ func (r *StatefulSetReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: common.NumReconcile(),
 		}).
-		Complete(r)
+		Complete(tracing.NewReconciler("StatefulSet", r))
 }
 
 func (r *StatefulSetReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
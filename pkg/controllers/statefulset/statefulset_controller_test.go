@@ -574,7 +574,7 @@ func TestStatefulSetReconciler_CollectGarbage(t *testing.T) {
 					})
 				patches.ApplyFunc(
 					(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-					func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+					func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 						return nil
 					})
 				return patches
@@ -611,7 +611,7 @@ func TestStatefulSetReconciler_CollectGarbage(t *testing.T) {
 					})
 				patches.ApplyFunc(
 					(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-					func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+					func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 						return nil
 					})
 				return patches
@@ -829,7 +829,7 @@ func TestStatefulSetReconciler_ReleaseSubnetPortsForStatefulSet_withPortsDeleteS
 			})
 		patches.ApplyFunc(
 			(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-			func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+			func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 				return nil
 			})
 		return patches
@@ -846,7 +846,7 @@ func TestStatefulSetReconciler_ReleaseSubnetPortsForStatefulSet_portWithoutPodNa
 			})
 		patches.ApplyFunc(
 			(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-			func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+			func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 				aux.deleteCalls++
 				return nil
 			})
@@ -864,7 +864,7 @@ func TestStatefulSetReconciler_ReleaseSubnetPortsForStatefulSet_deleteSubnetPort
 			})
 		patches.ApplyFunc(
 			(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-			func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+			func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 				return errors.New("nsx delete failed")
 			})
 		return patches
@@ -915,7 +915,7 @@ func TestStatefulSetReconciler_ReleaseSubnetPortForPod_withPortsDeleteSuccess(t
 			})
 		patches.ApplyFunc(
 			(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-			func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+			func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 				return nil
 			})
 		return patches
@@ -1130,7 +1130,7 @@ func TestReleaseSubnetPortsForStatefulSet_PodExists(t *testing.T) {
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			deleteCalls++
 			return nil
 		})
@@ -1177,7 +1177,7 @@ func TestReleaseSubnetPortsForStatefulSet_PodSucceededAllowsDeleteWithMatchingUI
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			deleteCalls++
 			return nil
 		})
@@ -1227,7 +1227,7 @@ func TestReleaseSubnetPortsForStatefulSet_PodTerminatingSkipsDeleteWithMatchingU
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			deleteCalls++
 			return nil
 		})
@@ -1274,7 +1274,7 @@ func TestReleaseSubnetPortsForStatefulSet_PodUIDMismatchDeletesPort(t *testing.T
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			deleteCalls++
 			return nil
 		})
@@ -1308,7 +1308,7 @@ func TestReleaseSubnetPortForPod_DeleteError(t *testing.T) {
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			return errors.New("delete subnet port failed")
 		})
 	defer patches.Reset()
@@ -1354,7 +1354,7 @@ func TestCollectGarbage_OrphanedPortDeleteError(t *testing.T) {
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			return errors.New("delete orphaned port failed")
 		})
 	defer patches.Reset()
@@ -1416,7 +1416,7 @@ func TestCollectGarbage_MixedErrors(t *testing.T) {
 
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			if port.Path == nil {
 				port.Path = servicecommon.String("/orgs/default/projects/default/vpcs/default/subnets/default/ports/default")
 			}
@@ -1650,7 +1650,7 @@ func TestHandleReplicaChange_ReplicasZeroReleasesWhenPodsGone(t *testing.T) {
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			deleteCalls++
 			return nil
 		})
@@ -1713,7 +1713,7 @@ func TestHandleReplicaChange_MixedReleasedAndSkippedRunningPod(t *testing.T) {
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			deleteCalls++
 			return nil
 		})
@@ -1757,7 +1757,7 @@ func TestReleaseSubnetPortForPod_DeleteSubnetPortFails(t *testing.T) {
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			return errors.New("nsx delete failed")
 		})
 	defer patches.Reset()
@@ -1912,7 +1912,7 @@ func TestProcessDelete_ReleaseSubnetPortsError(t *testing.T) {
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			return errors.New("delete failed")
 		})
 	defer patches.Reset()
@@ -2155,7 +2155,7 @@ func TestCollectGarbage_GetPodErrorDoesNotSkipDelete(t *testing.T) {
 		})
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			deleteCalls++
 			return nil
 		})
@@ -2216,7 +2216,7 @@ func TestCollectGarbage_OrphanedPortPodBelongsToSts_SkipsDelete(t *testing.T) {
 	var deleteCalls int
 	patches.ApplyFunc(
 		(*subnetportservice.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetportservice.SubnetPortService, port *model.VpcSubnetPort) error {
+		func(s *subnetportservice.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 			deleteCalls++
 			return nil
 		})
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -29,6 +30,7 @@ import (
 	commonservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/staticroute"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	pkgUtil "github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -49,11 +51,11 @@ type StaticRouteReconciler struct {
 	StatusUpdater common.StatusUpdater
 }
 
-func (r *StaticRouteReconciler) deleteStaticRouteByName(ns, name string) error {
+func (r *StaticRouteReconciler) deleteStaticRouteByName(ctx context.Context, ns, name string) error {
 	nsxStaticRoutes := r.Service.ListStaticRouteByName(ns, name)
 	for _, item := range nsxStaticRoutes {
 		log.Info("Deleting StaticRoute", "Namespace", ns, "Name", name, "nsxStaticRouteId", *item.Id)
-		if err := r.Service.DeleteStaticRoute(item); err != nil {
+		if err := r.Service.DeleteStaticRoute(ctx, item); err != nil {
 			log.Error(err, "Failed to delete StaticRoute", "nsxStaticRouteId", *item.Id)
 			return err
 		}
@@ -65,11 +67,16 @@ func (r *StaticRouteReconciler) deleteStaticRouteByName(ns, name string) error {
 func (r *StaticRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	obj := &v1alpha1.StaticRoute{}
 	log.Info("reconciling staticroute CR", "staticroute", req.NamespacedName)
+	startTime := time.Now()
+	defer func() {
+		log.Info("finished reconciling staticroute CR", "staticroute", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
+	}()
 	r.StatusUpdater.IncreaseSyncTotal()
 
 	if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
 		if apierrors.IsNotFound(err) {
-			if err := r.deleteStaticRouteByName(req.Namespace, req.Name); err != nil {
+			if err := r.deleteStaticRouteByName(ctx, req.Namespace, req.Name); err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return ResultRequeue, err
 			}
@@ -91,10 +98,23 @@ func (r *StaticRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			}
 			return ResultRequeue, err
 		}
-		r.StatusUpdater.UpdateSuccess(ctx, obj, setStaticRouteReadyStatusTrue)
+		nextHops, err := r.Service.GetNextHopsStatus(obj)
+		if err != nil {
+			// The next hops status is informational, the StaticRoute is still ready.
+			log.Error(err, "Failed to get StaticRoute next hops status", "StaticRoute", req.NamespacedName)
+			nextHops = obj.Status.NextHops
+		}
+		r.StatusUpdater.UpdateSuccess(ctx, obj, setStaticRouteReadyStatusTrue, nextHops)
+		// Refresh the status of the inactive next hops until they are recovered. The standby next hops
+		// are not refreshed, they are inactive by design while a next hop with a lower distance is used.
+		for _, nextHop := range nextHops {
+			if nextHop.State == v1alpha1.NextHopStateInactive {
+				return ResultRequeueAfter5mins, nil
+			}
+		}
 	} else {
 		r.StatusUpdater.IncreaseDeleteTotal()
-		if err := r.Service.DeleteStaticRouteByCR(obj); err != nil {
+		if err := r.Service.DeleteStaticRouteByCR(ctx, obj); err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 			return ResultRequeue, err
 		}
@@ -103,8 +123,17 @@ func (r *StaticRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ResultNormal, nil
 }
 
-func setStaticRouteReadyStatusTrue(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, _ ...interface{}) {
+// setStaticRouteReadyStatusTrue sets the Ready condition, and the next hops status if it is
+// passed as the first of args.
+func setStaticRouteReadyStatusTrue(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, args ...interface{}) {
 	staticRoute := obj.(*v1alpha1.StaticRoute)
+	nextHopsUpdated := false
+	if len(args) > 0 {
+		if nextHops, ok := args[0].([]v1alpha1.NextHopStatus); ok && !reflect.DeepEqual(staticRoute.Status.NextHops, nextHops) {
+			staticRoute.Status.NextHops = nextHops
+			nextHopsUpdated = true
+		}
+	}
 	newConditions := []v1alpha1.StaticRouteCondition{
 		{
 			Type:               v1alpha1.Ready,
@@ -114,7 +143,7 @@ func setStaticRouteReadyStatusTrue(client client.Client, ctx context.Context, ob
 			LastTransitionTime: transitionTime,
 		},
 	}
-	updateStaticRouteStatusConditions(client, ctx, staticRoute, newConditions)
+	updateStaticRouteStatus(client, ctx, staticRoute, newConditions, nextHopsUpdated)
 }
 
 func setStaticRouteReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, _ ...interface{}) {
@@ -132,7 +161,12 @@ func setStaticRouteReadyStatusFalse(client client.Client, ctx context.Context, o
 }
 
 func updateStaticRouteStatusConditions(client client.Client, ctx context.Context, staticRoute *v1alpha1.StaticRoute, newConditions []v1alpha1.StaticRouteCondition) {
-	conditionsUpdated := false
+	updateStaticRouteStatus(client, ctx, staticRoute, newConditions, false)
+}
+
+// updateStaticRouteStatus updates the status of the StaticRoute CR if the conditions are changed or statusUpdated is true.
+func updateStaticRouteStatus(client client.Client, ctx context.Context, staticRoute *v1alpha1.StaticRoute, newConditions []v1alpha1.StaticRouteCondition, statusUpdated bool) {
+	conditionsUpdated := statusUpdated
 	for i := range newConditions {
 		if mergeStaticRouteStatusCondition(staticRoute, &newConditions[i]) {
 			conditionsUpdated = true
@@ -185,7 +219,7 @@ func (r *StaticRouteReconciler) setupWithManager(mgr ctrl.Manager) error {
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
 			}).
-		Complete(r)
+		Complete(tracing.NewReconciler("StaticRoute", r))
 }
 
 // Start setup manager and launch GC
@@ -240,7 +274,7 @@ func (r *StaticRouteReconciler) CollectGarbage(ctx context.Context) error {
 
 		log.Debug("GC collected StaticRoute CR", "UID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		err = r.Service.DeleteStaticRoute(elem)
+		err = r.Service.DeleteStaticRoute(ctx, elem)
 		if err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -6,18 +6,28 @@ package staticroute
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	gomonkey "github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,7 +44,7 @@ import (
 	mock_client "github.com/vmware-tanzu/nsx-operator/pkg/mock/controller-runtime/client"
 	mocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/staticrouteclient"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
-	_ "github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/staticroute"
 )
@@ -201,7 +211,7 @@ func TestStaticRouteReconciler_Reconcile(t *testing.T) {
 		return nil
 	})
 
-	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRouteByCR", func(_ *staticroute.StaticRouteService, obj *v1alpha1.StaticRoute) error {
+	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRouteByCR", func(_ *staticroute.StaticRouteService, _ context.Context, obj *v1alpha1.StaticRoute) error {
 		return nil
 	})
 
@@ -216,7 +226,7 @@ func TestStaticRouteReconciler_Reconcile(t *testing.T) {
 		v1sp.ObjectMeta.DeletionTimestamp = &time
 		return nil
 	})
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRouteByCR", func(_ *staticroute.StaticRouteService, obj *v1alpha1.StaticRoute) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRouteByCR", func(_ *staticroute.StaticRouteService, _ context.Context, obj *v1alpha1.StaticRoute) error {
 		return errors.New("delete failed")
 	})
 	k8sClient.EXPECT().Status().Times(1).Return(fakewriter)
@@ -249,9 +259,50 @@ func TestStaticRouteReconciler_Reconcile(t *testing.T) {
 	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateStaticRoute", func(_ *staticroute.StaticRouteService, ctx context.Context, namespace string, obj *v1alpha1.StaticRoute) error {
 		return nil
 	})
+	patch.ApplyMethod(reflect.TypeOf(service), "GetNextHopsStatus", func(_ *staticroute.StaticRouteService, obj *v1alpha1.StaticRoute) ([]v1alpha1.NextHopStatus, error) {
+		return nil, nil
+	})
 	_, ret = r.Reconcile(ctx, req)
 	assert.Equal(t, ret, nil)
 	patch.Reset()
+
+	//  DeletionTimestamp.IsZero = true,  CreateorUpdateStaticRoute succ with an inactive next hop
+	k8sClient.EXPECT().Get(ctx, gomock.Any(), sp).Return(nil).Do(func(_ context.Context, _ client.ObjectKey, obj client.Object, option ...client.GetOption) error {
+		v1sp := obj.(*v1alpha1.StaticRoute)
+		v1sp.ObjectMeta.DeletionTimestamp = nil
+		return nil
+	})
+	nextHops := []v1alpha1.NextHopStatus{
+		{IPAddress: "192.168.1.1", AdminDistance: 1, State: v1alpha1.NextHopStateActive},
+		{IPAddress: "192.168.1.2", AdminDistance: 1, State: v1alpha1.NextHopStateInactive},
+		{IPAddress: "192.168.1.3", AdminDistance: 10, State: v1alpha1.NextHopStateStandby},
+	}
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateStaticRoute", func(_ *staticroute.StaticRouteService, ctx context.Context, namespace string, obj *v1alpha1.StaticRoute) error {
+		return nil
+	})
+	patch.ApplyMethod(reflect.TypeOf(service), "GetNextHopsStatus", func(_ *staticroute.StaticRouteService, obj *v1alpha1.StaticRoute) ([]v1alpha1.NextHopStatus, error) {
+		return nextHops, nil
+	})
+	k8sClient.EXPECT().Status().Times(1).Return(fakewriter)
+	result, ret := r.Reconcile(ctx, req)
+	assert.Equal(t, ret, nil)
+	assert.Equal(t, ResultRequeueAfter5mins, result)
+
+	//  DeletionTimestamp.IsZero = true,  CreateorUpdateStaticRoute succ with a standby next hop, not requeued
+	k8sClient.EXPECT().Get(ctx, gomock.Any(), sp).Return(nil).Do(func(_ context.Context, _ client.ObjectKey, obj client.Object, option ...client.GetOption) error {
+		v1sp := obj.(*v1alpha1.StaticRoute)
+		v1sp.ObjectMeta.DeletionTimestamp = nil
+		return nil
+	})
+	nextHops = []v1alpha1.NextHopStatus{
+		{IPAddress: "192.168.1.1", AdminDistance: 1, State: v1alpha1.NextHopStateActive},
+		{IPAddress: "192.168.1.3", AdminDistance: 10, State: v1alpha1.NextHopStateStandby},
+	}
+	k8sClient.EXPECT().Status().Times(1).Return(fakewriter)
+	result, ret = r.Reconcile(ctx, req)
+	assert.Equal(t, ret, nil)
+	assert.Equal(t, ResultNormal, result)
+	patch.Reset()
 }
 
 func TestStaticRouteReconciler_GarbageCollector(t *testing.T) {
@@ -276,7 +327,7 @@ func TestStaticRouteReconciler_GarbageCollector(t *testing.T) {
 		a = append(a, &model.StaticRoutes{Id: &id2, Path: &path, Tags: tag2})
 		return a
 	})
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *staticroute.StaticRouteService, nsxStaticRoute *model.StaticRoutes) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *staticroute.StaticRouteService, _ context.Context, nsxStaticRoute *model.StaticRoutes) error {
 		return nil
 	})
 	defer patch.Reset()
@@ -309,7 +360,7 @@ func TestStaticRouteReconciler_GarbageCollector(t *testing.T) {
 		a = append(a, &model.StaticRoutes{Id: &id, Tags: tag2})
 		return a
 	})
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRouteByCR", func(_ *staticroute.StaticRouteService, obj *v1alpha1.StaticRoute) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRouteByCR", func(_ *staticroute.StaticRouteService, _ context.Context, obj *v1alpha1.StaticRoute) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
@@ -327,7 +378,7 @@ func TestStaticRouteReconciler_GarbageCollector(t *testing.T) {
 	patch.ApplyMethod(reflect.TypeOf(service), "ListStaticRoute", func(_ *staticroute.StaticRouteService) []*model.StaticRoutes {
 		return []*model.StaticRoutes{}
 	})
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRouteByCR", func(_ *staticroute.StaticRouteService, obj *v1alpha1.StaticRoute) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRouteByCR", func(_ *staticroute.StaticRouteService, _ context.Context, obj *v1alpha1.StaticRoute) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
@@ -335,6 +386,73 @@ func TestStaticRouteReconciler_GarbageCollector(t *testing.T) {
 	r.CollectGarbage(ctx)
 }
 
+func TestStaticRouteReconciler_GarbageCollectorRateLimitClass(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	deleted := make(chan http.Header, 1)
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted <- r.Header.Clone()
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"healthy" : true}`))
+	}))
+	defer ts.Close()
+	nsxConfig := nsx.NewConfig(strings.TrimPrefix(ts.URL, "https://"), "admin", "passw0rd", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.AIMD, nil, nil, []string{})
+	cluster, err := nsx.NewCluster(nsxConfig)
+	assert.NoError(t, err)
+
+	id, path := "route-1", "/orgs/default/projects/project-1/vpcs/vpc-1/static-routes/route-1"
+	store := &staticroute.StaticRouteStore{ResourceStore: common.ResourceStore{
+		Indexer: cache.NewIndexer(func(obj interface{}) (string, error) {
+			return *obj.(*model.StaticRoutes).Id, nil
+		}, cache.Indexers{}),
+		BindingType: model.StaticRoutesBindingType(),
+	}}
+	store.Add(&model.StaticRoutes{Id: &id, Path: &path, Tags: []model.Tag{{Scope: util.Ptr(common.TagScopeStaticRouteCRUID), Tag: util.Ptr("uid-1")}}})
+	service := &staticroute.StaticRouteService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{Cluster: cluster, StaticRouteClient: vpcs.NewStaticRoutesClient(cluster.NewRestConnector())},
+			NSXConfig: &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}},
+		},
+		StaticRouteStore: store,
+	}
+	scheme := runtime.NewScheme()
+	v1alpha1.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &StaticRouteReconciler{
+		Client:        k8sClient,
+		Service:       service,
+		StatusUpdater: ctlcommon.NewStatusUpdater(k8sClient, service.NSXConfig, fakeRecorder{}, MetricResTypeStaticRoute, "StaticRoute", "StaticRoute"),
+	}
+
+	origDelay := ctlcommon.GCStartupDelay
+	ctlcommon.GCStartupDelay = 0
+	defer func() { ctlcommon.GCStartupDelay = origDelay }()
+	cancel := make(chan bool)
+	go ctlcommon.GenericGarbageCollector(cancel, time.Hour, r.CollectGarbage)
+	defer close(cancel)
+
+	select {
+	case header := <-deleted:
+		assert.Empty(t, header.Get(nsx.RateLimitClassHeader))
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "the stale NSX StaticRoute was not deleted")
+	}
+	assert.Eventually(t, func() bool {
+		for _, span := range recorder.Ended() {
+			if span.Name() == "nsx.RoundTrip" {
+				assert.Contains(t, span.Attributes(), attribute.String("url.path", "/policy/api/v1"+path))
+				assert.Contains(t, span.Attributes(), attribute.String("nsx.rate_limit_class", string(ratelimiter.ClassBackground)))
+				return true
+			}
+		}
+		return false
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
 func TestStaticRouteReconciler_Start(t *testing.T) {
 	mockCtl := gomock.NewController(t)
 	k8sClient := mock_client.NewMockClient(mockCtl)
@@ -471,14 +589,14 @@ func TestStaticRouteReconciler_deleteStaticRouteByName(t *testing.T) {
 		}
 	})
 
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *staticroute.StaticRouteService, nsxStaticRoute *model.StaticRoutes) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *staticroute.StaticRouteService, _ context.Context, nsxStaticRoute *model.StaticRoutes) error {
 		if *nsxStaticRoute.Id == "route-id-2" {
 			return errors.New("delete failed")
 		}
 		return nil
 	})
 
-	err := r.deleteStaticRouteByName("dummy-name", "dummy-ns")
+	err := r.deleteStaticRouteByName(context.TODO(), "dummy-name", "dummy-ns")
 	assert.Error(t, err)
 	patch.Reset()
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -41,6 +42,7 @@ var (
 	ResultNormal            = common.ResultNormal
 	ResultRequeue           = common.ResultRequeue
 	ResultRequeueAfter10sec = common.ResultRequeueAfter10sec
+	ResultRequeueAfter60sec = common.ResultRequeueAfter60sec
 	MetricResTypeSubnet     = common.MetricResTypeSubnet
 )
 
@@ -61,6 +63,7 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling Subnet", "Subnet", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	r.StatusUpdater.IncreaseSyncTotal()
@@ -68,10 +71,11 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 	if err := r.Client.Get(ctx, req.NamespacedName, subnetCR); err != nil {
 		if apierrors.IsNotFound(err) {
-			if err := r.deleteSubnetByName(req.Name, req.Namespace); err != nil {
+			if err := r.deleteSubnetByName(ctx, req.Name, req.Namespace); err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return ResultRequeue, err
 			}
+			common.UpdateNamespaceSubnetUsage(r.Client, req.Namespace, r.VPCService, r.SubnetService)
 			r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
 			return ResultNormal, nil
 		}
@@ -114,10 +118,11 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			return ResultRequeue, err
 		}
 
-		if err := r.deleteSubnetByID(string(subnetCR.GetUID())); err != nil {
+		if err := r.deleteSubnetByID(ctx, string(subnetCR.GetUID())); err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 			return ResultRequeue, err
 		}
+		common.UpdateNamespaceSubnetUsage(r.Client, req.Namespace, r.VPCService, r.SubnetService)
 		r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
 		return ResultNormal, nil
 	}
@@ -196,12 +201,34 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ResultRequeue, errors.New("failed to generate Subnet tags")
 	}
 
+	// Check the Subnet quota of the Namespace before creating or growing the NSX Subnet, the quota
+	// is locked until the NSX Subnet is created or updated.
+	quotaLock := common.LockNamespaceSubnetQuota(subnetCR.Namespace)
+	if err := common.CheckSubnetQuotaForSubnet(subnetCR, r.SubnetService.ListSubnetCreatedBySubnet(string(subnetCR.GetUID())), r.VPCService, r.SubnetService); err != nil {
+		quotaLock.Unlock()
+		var quotaErr *common.SubnetQuotaExceededError
+		if errors.As(err, &quotaErr) {
+			r.setSubnetQuotaExceededStatus(ctx, subnetCR, quotaErr)
+			return ResultRequeueAfter60sec, nil
+		}
+		r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Failed to check Subnet quota", setSubnetReadyStatusFalse)
+		return ResultRequeue, err
+	}
+
 	// Create or update the subnet in NSX
-	if _, err := r.SubnetService.CreateOrUpdateSubnet(subnetCR, vpcInfoList[0], tags); err != nil {
+	_, err = r.SubnetService.CreateOrUpdateSubnet(ctx, subnetCR, vpcInfoList[0], tags)
+	quotaLock.Unlock()
+	if err != nil {
 		if errors.As(err, &nsxutil.ExceedTagsError{}) {
 			r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Tags limit exceeded", setSubnetReadyStatusFalse)
 			return ResultNormal, nil
 		}
+		// The CIDRs appended to ipAddresses are not in the VPC IP blocks or overlap with another Subnet.
+		var validationErr *nsxutil.ValidationError
+		if errors.As(err, &validationErr) {
+			r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Invalid ipAddresses", setSubnetReadyStatusFalse)
+			return ResultNormal, nil
+		}
 		var nsxErr *nsxutil.NSXApiError
 		if errors.As(err, &nsxErr) && nsxErr.ApiError != nil && nsxErr.ApiError.ErrorCode != nil {
 			code := *(nsxErr.ApiError.ErrorCode)
@@ -221,6 +248,7 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Failed to create/update Subnet", setSubnetReadyStatusFalse)
 		return ResultRequeue, err
 	}
+	common.UpdateNamespaceSubnetUsage(r.Client, subnetCR.Namespace, r.VPCService, r.SubnetService)
 	// Update status
 	updated, err := r.updateSubnetStatus(subnetCR)
 	if err != nil {
@@ -263,12 +291,12 @@ func (r *SubnetReconciler) setDefaultIPv6PrefixLengthValue(ctx context.Context,
 	return nil
 }
 
-func (r *SubnetReconciler) deleteSubnetByID(subnetID string) error {
+func (r *SubnetReconciler) deleteSubnetByID(ctx context.Context, subnetID string) error {
 	nsxSubnets := r.SubnetService.SubnetStore.GetByIndex(servicecommon.TagScopeSubnetCRUID, subnetID)
-	return r.deleteSubnets(nsxSubnets)
+	return r.deleteSubnets(ctx, nsxSubnets)
 }
 
-func (r *SubnetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet) error {
+func (r *SubnetReconciler) deleteSubnets(ctx context.Context, nsxSubnets []*model.VpcSubnet) error {
 	if len(nsxSubnets) == 0 {
 		return nil
 	}
@@ -279,7 +307,7 @@ func (r *SubnetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet) error {
 			log.Error(err, "Delete Subnet from NSX failed")
 			return err
 		}
-		if err := r.SubnetService.DeleteSubnet(*nsxSubnet); err != nil {
+		if err := r.SubnetService.DeleteSubnet(ctx, *nsxSubnet); err != nil {
 			log.Error(err, "Failed to delete Subnet", "ID", *nsxSubnet.Id)
 			return err
 		}
@@ -290,10 +318,10 @@ func (r *SubnetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet) error {
 	return nil
 }
 
-func (r *SubnetReconciler) deleteSubnetByName(name, ns string) error {
+func (r *SubnetReconciler) deleteSubnetByName(ctx context.Context, name, ns string) error {
 	// Since shared subnets are not in the store, we can enter this function safely
 	nsxSubnets := r.SubnetService.ListSubnetByName(ns, name)
-	return r.deleteSubnets(nsxSubnets)
+	return r.deleteSubnets(ctx, nsxSubnets)
 }
 
 func (r *SubnetReconciler) updateSubnetStatus(obj *v1alpha1.Subnet) (bool, error) {
@@ -416,6 +444,23 @@ func setSubnetReadyStatusFalse(client client.Client, ctx context.Context, obj cl
 	updateSubnetStatusConditions(client, ctx, subnetCR, newConditions)
 }
 
+// setSubnetQuotaExceededStatus sets the Ready condition of the Subnet to false and records an event when creating
+// or growing the NSX Subnet exceeds the Subnet quota of the Namespace.
+func (r *SubnetReconciler) setSubnetQuotaExceededStatus(ctx context.Context, subnet *v1alpha1.Subnet, quotaErr error) {
+	log.Info("Subnet quota exceeded, not creating or updating NSX Subnet", "Subnet", subnet.Name, "Namespace", subnet.Namespace, "reason", quotaErr.Error())
+	newConditions := []v1alpha1.Condition{
+		{
+			Type:               v1alpha1.Ready,
+			Status:             v1.ConditionFalse,
+			Message:            quotaErr.Error(),
+			Reason:             common.ReasonSubnetQuotaExceeded,
+			LastTransitionTime: metav1.Now(),
+		},
+	}
+	updateSubnetStatusConditions(r.Client, ctx, subnet, newConditions)
+	r.Recorder.Event(subnet, v1.EventTypeWarning, common.ReasonSubnetQuotaExceeded, quotaErr.Error())
+}
+
 func (r *SubnetReconciler) setSubnetDeletionFailedStatus(ctx context.Context, subnet *v1alpha1.Subnet, transitionTime metav1.Time, msg string, reason string) {
 	newConditions := []v1alpha1.Condition{
 		{
@@ -609,7 +654,7 @@ func (r *SubnetReconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(common.PredicateFuncsWithSubnetBindings),
 		).
-		Complete(r)
+		Complete(tracing.NewReconciler("Subnet", r))
 }
 
 func (r *SubnetReconciler) getQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
@@ -656,7 +701,7 @@ func (r *SubnetReconciler) CollectGarbage(ctx context.Context) error {
 		r.StatusUpdater.IncreaseDeleteTotal()
 
 		log.Info("Subnet garbage collection, cleaning stale Subnets", "Count", len(nsxSubnets))
-		if err := r.deleteSubnets(nsxSubnets); err != nil {
+		if err := r.deleteSubnets(ctx, nsxSubnets); err != nil {
 			errList = append(errList, err)
 			log.Error(err, "Subnet garbage collection, failed to delete NSX subnet", "SubnetUID", subnetID)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
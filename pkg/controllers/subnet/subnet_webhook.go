@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"slices"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -97,8 +100,8 @@ func (v *SubnetValidator) Handle(ctx context.Context, req admission.Request) adm
 			if oldSubnet.Spec.VLANConnectionName != subnet.Spec.VLANConnectionName {
 				return admission.Denied(fmt.Sprintf("Subnet %s/%s: spec.vlanConnectionName can only be updated by NSX Operator", subnet.Namespace, subnet.Name))
 			}
-			if !nsxutil.CompareArraysWithoutOrder(oldSubnet.Spec.IPAddresses, subnet.Spec.IPAddresses) {
-				return admission.Denied("ipAddresses is immutable")
+			if err := validateIPAddressesUpdate(oldSubnet.Spec.IPAddresses, oldSubnet.Status.NetworkAddresses, subnet.Spec.IPAddresses); err != nil {
+				return admission.Denied(fmt.Sprintf("Subnet %s/%s: %v", subnet.Namespace, subnet.Name, err))
 			}
 		}
 	case admissionv1.Delete:
@@ -151,6 +154,46 @@ func (v *SubnetValidator) Handle(ctx context.Context, req admission.Request) adm
 	return admission.Allowed("")
 }
 
+// validateIPAddressesUpdate checks that CIDRs are only appended to the ipAddresses of a Subnet, and that the
+// appended CIDRs are valid and don't overlap with the other CIDRs. A Subnet whose CIDRs are auto-allocated from the
+// VPC IP blocks has no ipAddresses, its allocated CIDRs in networkAddresses must be kept when ipAddresses are added.
+func validateIPAddressesUpdate(oldIPAddresses []string, networkAddresses []string, ipAddresses []string) error {
+	if nsxutil.CompareArraysWithoutOrder(oldIPAddresses, ipAddresses) {
+		return nil
+	}
+	if len(oldIPAddresses) == 0 {
+		if len(networkAddresses) == 0 {
+			return errors.New("ipAddresses cannot be added to a Subnet whose CIDRs are not allocated yet")
+		}
+		for _, networkAddress := range networkAddresses {
+			if !slices.Contains(ipAddresses, networkAddress) {
+				return fmt.Errorf("allocated CIDR %s must be kept in ipAddresses", networkAddress)
+			}
+		}
+		oldIPAddresses = networkAddresses
+	}
+	newIPAddresses := sets.New(ipAddresses...)
+	for _, ipAddress := range oldIPAddresses {
+		if !newIPAddresses.Has(ipAddress) {
+			return fmt.Errorf("CIDR %s cannot be removed from ipAddresses", ipAddress)
+		}
+	}
+	var ipNets []*net.IPNet
+	for _, ipAddress := range ipAddresses {
+		_, ipNet, err := net.ParseCIDR(ipAddress)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR %s in ipAddresses", ipAddress)
+		}
+		for _, existing := range ipNets {
+			if existing.Contains(ipNet.IP) || ipNet.Contains(existing.IP) {
+				return fmt.Errorf("CIDR %s overlaps with %s in ipAddresses", ipAddress, existing)
+			}
+		}
+		ipNets = append(ipNets, ipNet)
+	}
+	return nil
+}
+
 func (v *SubnetValidator) checkSubnetPort(ctx context.Context, ns string, subnetName string) (bool, error) {
 	crdSubnetPorts := &v1alpha1.SubnetPortList{}
 	err := v.Client.List(ctx, crdSubnetPorts, client.InNamespace(ns), client.MatchingFields{"spec.subnet": subnetName})
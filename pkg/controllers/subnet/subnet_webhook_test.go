@@ -162,6 +162,18 @@ func TestSubnetValidator_Handle(t *testing.T) {
 		},
 	})
 
+	// Updated subnet with appended IPAddresses
+	grownSubnetIP, _ := json.Marshal(&v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-6",
+			Name:      "subnet-to-update",
+		},
+		Spec: v1alpha1.SubnetSpec{
+			IPv4SubnetSize: 16,
+			IPAddresses:    []string{"192.168.1.0/24", "192.168.2.0/24"},
+		},
+	})
+
 	// Old shared subnet for update test
 	oldSharedSubnet, _ := json.Marshal(&v1alpha1.Subnet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -437,7 +449,16 @@ func TestSubnetValidator_Handle(t *testing.T) {
 			object:          updatedSubnetIP,
 			oldObject:       oldSubnet,
 			user:            "non-nsx-operator",
-			want:            admission.Denied("ipAddresses is immutable"),
+			want:            admission.Denied("Subnet ns-6/subnet-to-update: CIDR 192.168.1.0/24 cannot be removed from ipAddresses"),
+			accessModeCheck: true,
+		},
+		{
+			name:            "Update subnet with appended IPAddresses",
+			operation:       admissionv1.Update,
+			object:          grownSubnetIP,
+			oldObject:       oldSubnet,
+			user:            "non-nsx-operator",
+			want:            admission.Allowed(""),
 			accessModeCheck: true,
 		},
 		{
@@ -523,3 +544,34 @@ func TestSubnetValidator_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIPAddressesUpdate(t *testing.T) {
+	tests := []struct {
+		name             string
+		oldIPAddresses   []string
+		networkAddresses []string
+		ipAddresses      []string
+		wantErr          string
+	}{
+		{name: "unchanged", oldIPAddresses: []string{"10.0.0.0/28"}, ipAddresses: []string{"10.0.0.0/28"}},
+		{name: "unchanged without ipAddresses"},
+		{name: "appended", oldIPAddresses: []string{"10.0.0.0/28"}, ipAddresses: []string{"10.0.0.0/28", "10.0.1.0/28", "fd00::/64"}},
+		{name: "appended to auto-allocated Subnet", networkAddresses: []string{"10.0.0.0/28"}, ipAddresses: []string{"10.0.0.0/28", "10.0.1.0/28"}},
+		{name: "allocated CIDR not kept", networkAddresses: []string{"10.0.0.0/28"}, ipAddresses: []string{"10.0.1.0/28"}, wantErr: "allocated CIDR 10.0.0.0/28 must be kept in ipAddresses"},
+		{name: "added to unallocated Subnet", ipAddresses: []string{"10.0.1.0/28"}, wantErr: "ipAddresses cannot be added to a Subnet whose CIDRs are not allocated yet"},
+		{name: "overlapping allocated CIDR", networkAddresses: []string{"10.0.0.0/28"}, ipAddresses: []string{"10.0.0.0/28", "10.0.0.8/29"}, wantErr: "CIDR 10.0.0.8/29 overlaps with 10.0.0.0/28 in ipAddresses"},
+		{name: "removed", oldIPAddresses: []string{"10.0.0.0/28", "10.0.1.0/28"}, ipAddresses: []string{"10.0.0.0/28"}, wantErr: "CIDR 10.0.1.0/28 cannot be removed from ipAddresses"},
+		{name: "invalid CIDR", oldIPAddresses: []string{"10.0.0.0/28"}, ipAddresses: []string{"10.0.0.0/28", "10.0.1.0"}, wantErr: "invalid CIDR 10.0.1.0 in ipAddresses"},
+		{name: "overlapping CIDR", oldIPAddresses: []string{"10.0.0.0/28"}, ipAddresses: []string{"10.0.0.0/28", "10.0.0.0/24"}, wantErr: "CIDR 10.0.0.0/24 overlaps with 10.0.0.0/28 in ipAddresses"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIPAddressesUpdate(tt.oldIPAddresses, tt.networkAddresses, tt.ipAddresses)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
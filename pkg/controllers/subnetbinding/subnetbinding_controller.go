@@ -27,6 +27,7 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -84,6 +85,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling SubnetConnectionBindingMap", "SubnetConnectionBindingMap", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	r.StatusUpdater.IncreaseSyncTotal()
@@ -93,7 +95,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if apierrors.IsNotFound(err) {
 			r.StatusUpdater.IncreaseDeleteTotal()
 			// Try to delete NSX SubnetConnectionBindingMaps if exists
-			if err := r.SubnetBindingService.DeleteSubnetConnectionBindingMapsByCRName(req.Name, req.Namespace); err != nil {
+			if err := r.SubnetBindingService.DeleteSubnetConnectionBindingMapsByCRName(ctx, req.Name, req.Namespace); err != nil {
 				log.Error(err, "Failed to delete NSX SubnetConnectionBindingMap", "SubnetConnectionBindingMap", req.NamespacedName)
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return common.ResultRequeue, nil
@@ -143,7 +145,7 @@ func (r *Reconciler) CollectGarbage(ctx context.Context) error {
 	}
 	bindingMapIdSetInStore := r.SubnetBindingService.ListSubnetConnectionBindingMapCRUIDsInStore()
 
-	if err = r.SubnetBindingService.DeleteMultiSubnetConnectionBindingMapsByCRs(bindingMapIdSetInStore.Difference(bindingMapIdSetByCRs)); err != nil {
+	if err = r.SubnetBindingService.DeleteMultiSubnetConnectionBindingMapsByCRs(ctx, bindingMapIdSetInStore.Difference(bindingMapIdSetByCRs)); err != nil {
 		log.Error(err, "Failed to delete stale SubnetConnectionBindingMaps")
 		return err
 	}
@@ -190,7 +192,7 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 				ResourceType:    "SubnetSet"},
 			builder.WithPredicates(PredicateFuncsForSubnetSets),
 		).
-		Complete(r)
+		Complete(tracing.NewReconciler("SubnetConnectionBindingMap", r))
 }
 
 func (r *Reconciler) listBindingMapIDsFromCRs(ctx context.Context) (sets.Set[string], error) {
@@ -24,6 +24,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetipreservation"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -166,7 +167,7 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(PredicateFuncsForSubnets),
 		).
-		Complete(r)
+		Complete(tracing.NewReconciler("SubnetIPReservation", r))
 }
 
 func (r *Reconciler) setNotSupported(ctx context.Context, req ctrl.Request) error {
@@ -189,6 +190,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling SubnetIPReservation", "SubnetIPReservation", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 	r.StatusUpdater.IncreaseSyncTotal()
 
@@ -206,7 +208,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if err := r.Client.Get(ctx, req.NamespacedName, ipReservationCR); err != nil {
 		if apierrors.IsNotFound(err) {
 			r.StatusUpdater.IncreaseDeleteTotal()
-			if err := r.IPReservationService.DeleteIPReservationByCRName(req.Namespace, req.Name); err != nil {
+			if err := r.IPReservationService.DeleteIPReservationByCRName(ctx, req.Namespace, req.Name); err != nil {
 				log.Error(err, "Failed to delete NSX SubnetIPReservation", "SubnetIPReservation", req.NamespacedName)
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return common.ResultRequeue, nil
@@ -221,7 +223,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// Delete the NSX Subnet IPReservation if the CR is marked for delete
 	if !ipReservationCR.ObjectMeta.DeletionTimestamp.IsZero() {
 		r.StatusUpdater.IncreaseDeleteTotal()
-		if err := r.IPReservationService.DeleteIPReservationByCRId(string(ipReservationCR.UID)); err != nil {
+		if err := r.IPReservationService.DeleteIPReservationByCRId(ctx, string(ipReservationCR.UID)); err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 			return common.ResultRequeue, nil
 		}
@@ -429,7 +431,7 @@ func (r *Reconciler) CollectGarbage(ctx context.Context) error {
 	for uid := range ipReservationIdSetInStore.Difference(ipReservationIdSetByCRs) {
 		log.Trace("GC collected SubnetIPReservation CR", "UID", uid)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		err = r.IPReservationService.DeleteIPReservationByCRId(uid)
+		err = r.IPReservationService.DeleteIPReservationByCRId(ctx, uid)
 		if err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -279,7 +279,7 @@ func TestReconciler_Reconcile(t *testing.T) {
 				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "Get", func(_ client.Client, ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
 					return apierrors.NewNotFound(v1alpha1.Resource("subnetipreservation"), "ipr-1")
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService), "DeleteIPReservationByCRName", func(_ *subnetipreservation.IPReservationService, ns string, name string) error {
+				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService), "DeleteIPReservationByCRName", func(_ *subnetipreservation.IPReservationService, _ context.Context, ns string, name string) error {
 					return fmt.Errorf("mocked delete error")
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
@@ -295,7 +295,7 @@ func TestReconciler_Reconcile(t *testing.T) {
 				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "Get", func(_ client.Client, ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
 					return apierrors.NewNotFound(v1alpha1.Resource("subnetipreservation"), "ipr-1")
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService), "DeleteIPReservationByCRName", func(_ *subnetipreservation.IPReservationService, ns string, name string) error {
+				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService), "DeleteIPReservationByCRName", func(_ *subnetipreservation.IPReservationService, _ context.Context, ns string, name string) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
@@ -717,7 +717,7 @@ func TestReconcile_CollectGarbage(t *testing.T) {
 				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.IPReservationService), "ListSubnetIPReservationCRUIDsInStore", func(_ *subnetipreservation.IPReservationService) sets.Set[string] {
 					return sets.New("ipr-1-uuid", "ipr-2-uuid")
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService), "DeleteIPReservationByCRId", func(_ *subnetipreservation.IPReservationService, id string) error {
+				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService), "DeleteIPReservationByCRId", func(_ *subnetipreservation.IPReservationService, _ context.Context, id string) error {
 					assert.Equal(t, "ipr-2-uuid", id)
 					return fmt.Errorf("mocked deletion error")
 				})
@@ -732,7 +732,7 @@ func TestReconcile_CollectGarbage(t *testing.T) {
 				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.IPReservationService), "ListSubnetIPReservationCRUIDsInStore", func(_ *subnetipreservation.IPReservationService) sets.Set[string] {
 					return sets.New("ipr-1-uuid", "ipr-2-uuid")
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService), "DeleteIPReservationByCRId", func(_ *subnetipreservation.IPReservationService, id string) error {
+				patches.ApplyMethod(reflect.TypeOf(r.IPReservationService), "DeleteIPReservationByCRId", func(_ *subnetipreservation.IPReservationService, _ context.Context, id string) error {
 					assert.Equal(t, "ipr-2-uuid", id)
 					return nil
 				})
@@ -47,6 +47,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -84,6 +85,7 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling SubnetPort", "SubnetPort", req.NamespacedName, "duration", time.Since(startTime))
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	r.StatusUpdater.IncreaseSyncTotal()
@@ -144,7 +146,7 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 				log.Error(searchErr, "failed to use the SubnetPort CR to search VpcSubnetPort", "CR UID", subnetPort.GetUID())
 				err = errors.Join(err, searchErr)
 			} else if vpcSubnetPort != nil {
-				if e := r.SubnetPortService.DeleteSubnetPort(vpcSubnetPort); e != nil {
+				if e := r.SubnetPortService.DeleteSubnetPort(ctx, vpcSubnetPort); e != nil {
 					log.Error(e, "Failed to delete the stale SubnetPort", "subnetPort.UID", subnetPort.UID)
 					err = errors.Join(err, e)
 				}
@@ -181,7 +183,7 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "Failed to create NSX IPAddressAllocation for AddressBinding restore", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
 			return common.ResultRequeue, err
 		}
-		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(subnetPort, nsxSubnet, "", labels, isVmSubnetPort, r.restoreMode, interfaceIPType)
+		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(ctx, subnetPort, nsxSubnet, "", labels, isVmSubnetPort, r.restoreMode, interfaceIPType)
 		if err != nil {
 			r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
 			if nsxutil.IsRealizeStateError(err) {
@@ -191,7 +193,7 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 		if nsxSubnetPortState != nil {
 			if nsxSubnetPortState.ExternalAddressBinding == nil && ab == nil {
-				err = r.IpAddressAllocationService.DeleteIPAddressAllocationForAddressBinding(subnetPort)
+				err = r.IpAddressAllocationService.DeleteIPAddressAllocationForAddressBinding(ctx, subnetPort)
 				if err != nil {
 					log.Error(err, "Failed to cleanup possible NSX IPAddressAllocation", "SubnetPort", subnetPort)
 					r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
@@ -217,6 +219,9 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 				DHCPDeactivatedOnSubnet:   !util.NSXSubnetDHCPEnabled(nsxSubnet),
 				DHCPv6DeactivatedOnSubnet: !util.NSXSubnetDHCPv6Enabled(nsxSubnet),
 			}
+			if subnetPort.Spec.PortSettingName != "" {
+				subnetPort.Status.NetworkInterfaceConfig.PortSettingID = r.SubnetPortService.GetPortConfigPathForSubnetPortFromStore(subnetPort.UID)
+			}
 			// Append one more ipaddress for dual stack SubnetPort
 			if subnetPort.Spec.InterfaceIPType == v1alpha1.IPAddressTypeIPv4IPv6 {
 				subnetPort.Status.NetworkInterfaceConfig.IPAddresses = append(
@@ -328,7 +333,7 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return common.ResultRequeue, err
 		}
 		if vpcSubnetPort != nil {
-			if err = r.SubnetPortService.DeleteSubnetPort(vpcSubnetPort); err != nil {
+			if err = r.SubnetPortService.DeleteSubnetPort(ctx, vpcSubnetPort); err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				setAddressBindingStatusBySubnetPort(r.Client, ctx, subnetPort, r.SubnetPortService, metav1.Now(), subnetPortRealizationError)
 				return common.ResultRequeue, err
@@ -336,7 +341,7 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 
 		ab := r.SubnetPortService.GetAddressBindingBySubnetPort(subnetPort)
-		err = r.IpAddressAllocationService.DeleteIPAddressAllocationForAddressBinding(ab)
+		err = r.IpAddressAllocationService.DeleteIPAddressAllocationForAddressBinding(ctx, ab)
 		if err != nil {
 			log.Error(err, "Failed to delete IPAddressAllocation for AddressBinding", "AddressBinding", ab)
 			return common.ResultRequeue, err
@@ -491,7 +496,7 @@ func (r *SubnetPortReconciler) deleteSubnetPortByName(ctx context.Context, ns st
 		if nsxSubnetPort.ExternalAddressBinding != nil && nsxSubnetPort.ExternalAddressBinding.ExternalIpAddress != nil && *nsxSubnetPort.ExternalAddressBinding.ExternalIpAddress != "" {
 			externalIpAddress = nsxSubnetPort.ExternalAddressBinding.ExternalIpAddress
 		}
-		if err := r.SubnetPortService.DeleteSubnetPort(nsxSubnetPort); err != nil {
+		if err := r.SubnetPortService.DeleteSubnetPort(ctx, nsxSubnetPort); err != nil {
 			if externalIpAddress != nil {
 				r.collectAddressBindingGarbage(ctx, &ns, externalIpAddress)
 			}
@@ -521,8 +526,8 @@ func (r *SubnetPortReconciler) setupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.vmMapFunc),
 			builder.WithPredicates(predicate.LabelChangedPredicate{})).
 		Watches(&v1alpha1.AddressBinding{},
-				handler.EnqueueRequestsFromMapFunc(r.addressBindingMapFunc)).
-		Complete(r) // TODO: watch the virtualmachine event and update the labels on NSX subnet port.
+									handler.EnqueueRequestsFromMapFunc(r.addressBindingMapFunc)).
+		Complete(tracing.NewReconciler("SubnetPort", r)) // TODO: watch the virtualmachine event and update the labels on NSX subnet port.
 }
 
 func (r *SubnetPortReconciler) SetupFieldIndexers(mgr ctrl.Manager) error {
@@ -745,7 +750,7 @@ func (r *SubnetPortReconciler) CollectGarbage(ctx context.Context) error {
 	for elem := range diffSet {
 		log.Debug("GC collected SubnetPort CR", "UID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		err = r.SubnetPortService.DeleteSubnetPortById(elem)
+		err = r.SubnetPortService.DeleteSubnetPortById(ctx, elem)
 		if err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -771,7 +776,7 @@ func (r *SubnetPortReconciler) CollectGarbage(ctx context.Context) error {
 		}
 		// Reclaim the NSX IPAddressAllocation if its AddressBinding CR or SubnetPort CR is removed.
 		log.Info("GC collected NSX IPAddressAllocation", "nsxIPAddressAllocation", nsxIPAddressAllocation, "AddressBinding.UID", abUID, "SubnetPort.UID", spUID)
-		err := r.IpAddressAllocationService.DeleteIPAddressAllocationByNSXResource(nsxIPAddressAllocation)
+		err := r.IpAddressAllocationService.DeleteIPAddressAllocationByNSXResource(ctx, nsxIPAddressAllocation)
 		if err != nil {
 			errList = append(errList, err)
 		}
@@ -1014,7 +1019,7 @@ func (r *SubnetPortReconciler) CheckAndGetSubnetPathForSubnetPort(ctx context.Co
 		}
 		interfaceType = subnetport.GetDefaultInterfaceIPType(subnetPort.Spec.InterfaceIPType, subnetSet.Spec.IPAddressType)
 		log.Info("Got SubnetSet for SubnetPort CR, allocating the NSX subnet", "subnetSet.Name", subnetSet.Name, "subnetSet.UID", subnetSet.UID, "subnetPort.Name", subnetPort.Name, "subnetPort.UID", subnetPort.UID)
-		subnetPath, subnetSetUID, subnetSetLock, err = common.AllocateSubnetFromSubnetSet(r.Client, r.APIReader, subnetSet, r.VPCService, r.SubnetService, r.SubnetPortService, interfaceType)
+		subnetPath, subnetSetUID, subnetSetLock, err = common.AllocateSubnetFromSubnetSet(ctx, r.Client, r.APIReader, subnetSet, r.VPCService, r.SubnetService, r.SubnetPortService, interfaceType)
 		log.Info("Allocated Subnet for SubnetPort", "subnetPath", subnetPath, "subnetPort.Name", subnetPort.Name, "subnetPort.UID", subnetPort.UID)
 		if err != nil {
 			return
@@ -1036,7 +1041,7 @@ func (r *SubnetPortReconciler) CheckAndGetSubnetPathForSubnetPort(ctx context.Co
 		}
 		log.Info("Got default SubnetSet for SubnetPort CR, allocating the NSX Subnet", "subnetSet.Name", subnetSet.Name, "subnetSet.UID", subnetSet.UID, "subnetPort.Name", subnetPort.Name, "subnetPort.UID", subnetPort.UID)
 		interfaceType = subnetport.GetDefaultInterfaceIPType(subnetPort.Spec.InterfaceIPType, subnetSet.Spec.IPAddressType)
-		subnetPath, subnetSetUID, subnetSetLock, err = common.AllocateSubnetFromSubnetSet(r.Client, r.APIReader, subnetSet, r.VPCService, r.SubnetService, r.SubnetPortService, interfaceType)
+		subnetPath, subnetSetUID, subnetSetLock, err = common.AllocateSubnetFromSubnetSet(ctx, r.Client, r.APIReader, subnetSet, r.VPCService, r.SubnetService, r.SubnetPortService, interfaceType)
 		if err != nil {
 			return
 		}
@@ -131,7 +131,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 	defer patchesCreateIPAllocation.Reset()
 
 	patchesDeleteIPAllocation := gomonkey.ApplyFunc((*mock.MockIPAddressAllocationProvider).DeleteIPAddressAllocationForAddressBinding,
-		func(_ *mock.MockIPAddressAllocationProvider, _ interface{}) error {
+		func(_ *mock.MockIPAddressAllocationProvider, _ context.Context, _ interface{}) error {
 			return nil
 		})
 	defer patchesDeleteIPAllocation.Reset()
@@ -285,7 +285,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 		err := errors.New("CreateOrUpdateSubnetPort failed")
 
 		patchesCreateOrUpdateSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				return nil, err
 			})
 		defer patchesCreateOrUpdateSubnetPort.Reset()
@@ -338,7 +338,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 		defer patchesIsSharedSubnetPath.Reset()
 		err := util.NewRealizeStateError("CreateOrUpdateSubnetPort failed", 0)
 		patchesCreateOrUpdateSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				return nil, err
 			})
 		defer patchesCreateOrUpdateSubnetPort.Reset()
@@ -384,7 +384,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 		})
 		defer patchesIsSharedSubnetPath.Reset()
 		patchesCreateOrUpdateSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				return portState, nil
 			})
 		defer patchesCreateOrUpdateSubnetPort.Reset()
@@ -434,7 +434,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 			})
 		defer patchesGetSubnetByPath.Reset()
 		patchesCreateOrUpdateSubnetPort.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				return portState2, nil
 			})
 		k8sClient.EXPECT().Status().Return(fakewriter)
@@ -487,7 +487,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 
 		var capturedIPTypeInCreate v1alpha1.IPAddressType
 		patchesCreateOrUpdateSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				capturedIPTypeInCreate = interfaceIPType
 				return portState, nil
 			})
@@ -553,12 +553,12 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 			})
 		defer patchesGetByUID.Reset()
 		patchesDeleteSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).DeleteSubnetPort,
-			func(s *subnetport.SubnetPortService, port *model.VpcSubnetPort) error {
+			func(s *subnetport.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 				return err
 			})
 		defer patchesDeleteSubnetPort.Reset()
 		patchesCreateOrUpdateSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				assert.FailNow(t, "should not be called")
 				return nil, nil
 			})
@@ -584,7 +584,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 			})
 		defer patchesGetByUID.Reset()
 		patchesDeleteSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).DeleteSubnetPort,
-			func(s *subnetport.SubnetPortService, port *model.VpcSubnetPort) error {
+			func(s *subnetport.SubnetPortService, _ context.Context, port *model.VpcSubnetPort) error {
 				return nil
 			})
 		defer patchesDeleteSubnetPort.Reset()
@@ -671,7 +671,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 		defer patchesIsSharedSubnetPath.Reset()
 
 		patchesCreateOrUpdateSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				return portState, nil
 			})
 		defer patchesCreateOrUpdateSubnetPort.Reset()
@@ -757,7 +757,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 		})
 		defer patchesIsSharedSubnetPath.Reset()
 		patchesCreateOrUpdateSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				return portStateNoIP, nil
 			})
 		defer patchesCreateOrUpdateSubnetPort.Reset()
@@ -874,7 +874,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 		})
 		defer patchesIsSharedSubnetPath.Reset()
 		patchesCreateOrUpdateSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				return dhcpPortState, nil
 			})
 		defer patchesCreateOrUpdateSubnetPort.Reset()
@@ -960,7 +960,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 			})
 		defer patchesGetSubnetByPath.Reset()
 		patchesCreateOrUpdateSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
-			func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+			func(s *subnetport.SubnetPortService, _ context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 				return portState, nil
 			})
 		defer patchesCreateOrUpdateSubnetPort.Reset()
@@ -1010,7 +1010,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 			},
 		}
 
-		patches := gomonkey.ApplyMethod(reflect.TypeOf(&subnetport.SubnetPortService{}), "CreateOrUpdateSubnetPort", func(_ *subnetport.SubnetPortService, _ interface{}, _ *model.VpcSubnet, _ string, _ *map[string]string, _ bool, _ bool, _ v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(&subnetport.SubnetPortService{}), "CreateOrUpdateSubnetPort", func(_ *subnetport.SubnetPortService, _ context.Context, _ interface{}, _ *model.VpcSubnet, _ string, _ *map[string]string, _ bool, _ bool, _ v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 			return portStateLocal, nil
 		})
 		defer patches.Reset()
@@ -1025,7 +1025,7 @@ func TestSubnetPortReconciler_Reconcile(t *testing.T) {
 		})
 		defer patchesIPAllocCreate.Reset()
 
-		patchesIPAllocDelete := gomonkey.ApplyMethod(reflect.TypeOf(r.IpAddressAllocationService), "DeleteIPAddressAllocationForAddressBinding", func(_ *mock.MockIPAddressAllocationProvider, _ metav1.Object) error {
+		patchesIPAllocDelete := gomonkey.ApplyMethod(reflect.TypeOf(r.IpAddressAllocationService), "DeleteIPAddressAllocationForAddressBinding", func(_ *mock.MockIPAddressAllocationProvider, _ context.Context, _ metav1.Object) error {
 			return nil
 		})
 		defer patchesIPAllocDelete.Reset()
@@ -1098,7 +1098,7 @@ func TestSubnetPortReconciler_GarbageCollector(t *testing.T) {
 		})
 	defer patchesGetVpcSubnetPortByUID.Reset()
 	patchesDeleteSubnetPortById := gomonkey.ApplyFunc((*subnetport.SubnetPortService).DeleteSubnetPortById,
-		func(s *subnetport.SubnetPortService, uid string) error {
+		func(s *subnetport.SubnetPortService, _ context.Context, uid string) error {
 			return nil
 		})
 	defer patchesDeleteSubnetPortById.Reset()
@@ -1484,7 +1484,7 @@ func TestSubnetPortReconciler_deleteSubnetPortByName(t *testing.T) {
 		})
 	defer patchesGetByIndex.Reset()
 	patchesDeleteSubnetPort := gomonkey.ApplyFunc((*subnetport.SubnetPortService).DeleteSubnetPort,
-		func(s *subnetport.SubnetPortService, sp *model.VpcSubnetPort) error {
+		func(s *subnetport.SubnetPortService, _ context.Context, sp *model.VpcSubnetPort) error {
 			assert.Equal(t, sp2, sp)
 			return nil
 		})
@@ -1746,7 +1746,7 @@ func TestSubnetPortReconciler_CheckAndGetSubnetPathForSubnetPort(t *testing.T) {
 				})
 
 				patches.ApplyFunc(common.AllocateSubnetFromSubnetSet,
-					func(client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
+					func(_ context.Context, client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
 						return "subnet-path-1", nil, nil, nil
 					})
 				return patches
@@ -1804,7 +1804,7 @@ func TestSubnetPortReconciler_CheckAndGetSubnetPathForSubnetPort(t *testing.T) {
 					})
 
 				patches.ApplyFunc(common.AllocateSubnetFromSubnetSet,
-					func(client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
+					func(_ context.Context, client client.Client, apiReader client.Reader, subnetSet *v1alpha1.SubnetSet, vpcService servicecommon.VPCServiceProvider, subnetService servicecommon.SubnetServiceProvider, subnetPortService servicecommon.SubnetPortServiceProvider, interfaceType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
 						return "subnet-path-1", nil, nil, nil
 					})
 				return patches
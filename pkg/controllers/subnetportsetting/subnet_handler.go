@@ -0,0 +1,57 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetportsetting
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+)
+
+var PredicateFuncsForSubnets = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldObj, oldOK := e.ObjectOld.(*v1alpha1.Subnet)
+		newObj, newOK := e.ObjectNew.(*v1alpha1.Subnet)
+		if !oldOK || !newOK {
+			return false
+		}
+		return common.IsObjectUpdateToReady(oldObj.Status.Conditions, newObj.Status.Conditions)
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+func requeueSubnetPortSettingBySubnet(ctx context.Context, c client.Client, _, objNew client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	subnet := objNew.(*v1alpha1.Subnet)
+	portSettingList := &v1alpha1.SubnetPortSettingList{}
+	err := c.List(ctx, portSettingList, client.InNamespace(subnet.Namespace), client.MatchingFields{subnetNameIndexKey: subnet.Name})
+	if err != nil {
+		log.Error(err, "Failed to list SubnetPortSettings with Subnet for update event", "Namespace", subnet.Namespace, "Subnet", subnet.Name)
+		return
+	}
+	for _, portSetting := range portSettingList.Items {
+		log.Info("Requeue SubnetPortSetting because the Subnet is realized", "Namespace", portSetting.Namespace, "Name", portSetting.Name)
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      portSetting.Name,
+				Namespace: portSetting.Namespace,
+			},
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package subnetportsetting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+)
+
+func TestPredicateFuncsForSubnets(t *testing.T) {
+	readySubnet1 := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "subnet-1",
+			Namespace: "ns-1",
+		},
+		Status: v1alpha1.SubnetStatus{
+			Conditions: []v1alpha1.Condition{
+				{
+					Type:   v1alpha1.Ready,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+	}
+	readySubnet2 := readySubnet1.DeepCopy()
+	readySubnet2.Spec.IPv4SubnetSize = 32
+	unreadySubnet := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "subnet-1",
+			Namespace: "ns-1",
+		},
+		Status: v1alpha1.SubnetStatus{
+			Conditions: []v1alpha1.Condition{
+				{
+					Type:   v1alpha1.Ready,
+					Status: corev1.ConditionFalse,
+				},
+			},
+		},
+	}
+
+	assert.False(t, PredicateFuncsForSubnets.CreateFunc(event.CreateEvent{Object: readySubnet1}))
+	assert.True(t, PredicateFuncsForSubnets.Update(event.UpdateEvent{ObjectOld: unreadySubnet, ObjectNew: readySubnet1}))
+	assert.False(t, PredicateFuncsForSubnets.Update(event.UpdateEvent{ObjectOld: readySubnet1, ObjectNew: readySubnet2}))
+	assert.False(t, PredicateFuncsForSubnets.Update(event.UpdateEvent{ObjectOld: &v1alpha1.SubnetSet{}, ObjectNew: readySubnet1}))
+	assert.False(t, PredicateFuncsForSubnets.Delete(event.DeleteEvent{Object: readySubnet1}))
+	assert.False(t, PredicateFuncsForSubnets.GenericFunc(event.GenericEvent{Object: readySubnet1}))
+}
+
+func TestRequeueSubnetPortSettingBySubnet(t *testing.T) {
+	myQueue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer myQueue.ShutDown()
+
+	portSetting := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ps-1",
+			Namespace: "ns-1",
+		},
+		Spec: v1alpha1.SubnetPortSettingSpec{
+			SubnetName: "subnet-1",
+		},
+	}
+	subnet1 := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "subnet-1",
+			Namespace: "ns-1",
+		},
+	}
+	subnet2 := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "subnet-2",
+			Namespace: "ns-1",
+		},
+	}
+
+	newScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(newScheme))
+	utilruntime.Must(v1alpha1.AddToScheme(newScheme))
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithObjects(portSetting).
+		WithIndex(&v1alpha1.SubnetPortSetting{}, subnetNameIndexKey, subnetPortSettingSubnetNameIndexFunc).
+		Build()
+
+	ctx := context.TODO()
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      "ps-1",
+			Namespace: "ns-1",
+		},
+	}
+	requeueSubnetPortSettingBySubnet(ctx, fakeClient, subnet1, subnet1, myQueue)
+	require.Equal(t, 1, myQueue.Len())
+	item, _ := myQueue.Get()
+	assert.Equal(t, req, item)
+	myQueue.Done(item)
+
+	requeueSubnetPortSettingBySubnet(ctx, fakeClient, subnet2, subnet2, myQueue)
+	require.Equal(t, 0, myQueue.Len())
+}
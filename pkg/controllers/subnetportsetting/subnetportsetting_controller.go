@@ -0,0 +1,432 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetportsetting
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetportsetting"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+)
+
+const (
+	subnetNameIndexKey      = "spec.subnetName"
+	portSettingNameIndexKey = "spec.portSettingName"
+)
+
+var (
+	log = logger.Log
+)
+
+type errorWithRetry struct {
+	error
+	retry   bool
+	message string
+}
+
+// Reconciler reconciles a SubnetPortSetting object
+type Reconciler struct {
+	Client                   client.Client
+	Scheme                   *runtime.Scheme
+	SubnetPortSettingService *subnetportsetting.SubnetPortSettingService
+	SubnetService            servicecommon.SubnetServiceProvider
+	StatusUpdater            common.StatusUpdater
+}
+
+func NewReconciler(mgr ctrl.Manager, subnetPortSettingService *subnetportsetting.SubnetPortSettingService, subnetService servicecommon.SubnetServiceProvider) *Reconciler {
+	recorder := mgr.GetEventRecorderFor("subnetportsetting-controller") //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	return &Reconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SubnetPortSettingService: subnetPortSettingService,
+		SubnetService:            subnetService,
+		StatusUpdater:            common.NewStatusUpdater(mgr.GetClient(), subnetPortSettingService.NSXConfig, recorder, common.MetricResTypeSubnetPortSetting, "VpcSubnetPortConfig", "SubnetPortSetting"),
+	}
+}
+
+// RestoreReconcile realizes the SubnetPortSettings missing on NSX after the NSX restore, it is required before restoring
+// the SubnetPorts referring to them.
+func (r *Reconciler) RestoreReconcile() error {
+	if !r.SubnetPortSettingService.NSXClient.NSXCheckVersion(nsx.SubnetPortSetting) {
+		return nil
+	}
+	restoreList, err := r.getRestoreList()
+	if err != nil {
+		err = fmt.Errorf("failed to get SubnetPortSetting restore list: %w", err)
+		return err
+	}
+	var errorList []error
+	for _, key := range restoreList {
+		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+		if err != nil || common.IsReconcileResultRequeue(result) {
+			errorList = append(errorList, fmt.Errorf("failed to restore SubnetPortSetting %s, error: %w", key, err))
+		}
+	}
+	if len(errorList) > 0 {
+		return fmt.Errorf("errors found in SubnetPortSetting restore: %v", errorList)
+	}
+	return nil
+}
+
+func (r *Reconciler) getRestoreList() ([]types.NamespacedName, error) {
+	restoreList := []types.NamespacedName{}
+	portSettingList := &v1alpha1.SubnetPortSettingList{}
+	if err := r.Client.List(context.TODO(), portSettingList); err != nil {
+		return restoreList, err
+	}
+	nsxPortSettingUIDs := r.SubnetPortSettingService.ListSubnetPortSettingCRUIDsInStore()
+	for _, portSetting := range portSettingList.Items {
+		// Restore the SubnetPortSetting which has been realized but not found on NSX
+		if !nsxPortSettingUIDs.Has(string(portSetting.UID)) && isSubnetPortSettingReady(&portSetting) {
+			restoreList = append(restoreList, types.NamespacedName{Namespace: portSetting.Namespace, Name: portSetting.Name})
+		}
+	}
+	return restoreList, nil
+}
+
+func isSubnetPortSettingReady(portSetting *v1alpha1.SubnetPortSetting) bool {
+	for _, con := range portSetting.Status.Conditions {
+		if con.Type == v1alpha1.Ready && con.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reconciler) StartController(mgr ctrl.Manager, hookServer webhook.Server) error {
+	if err := r.SetupFieldIndexers(mgr); err != nil {
+		log.Error(err, "Failed to setup field indexers", "controller", "SubnetPortSetting")
+		return err
+	}
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "SubnetPortSetting")
+		return err
+	}
+	if hookServer != nil {
+		hookServer.Register("/validate-crd-nsx-vmware-com-v1alpha1-subnetportsetting",
+			&webhook.Admission{
+				Handler: &SubnetPortSettingValidator{
+					Client:  mgr.GetClient(),
+					decoder: admission.NewDecoder(mgr.GetScheme()),
+				},
+			})
+	}
+	go common.GenericGarbageCollector(make(chan bool), servicecommon.GCInterval, r.CollectGarbage)
+	return nil
+}
+
+// SetupFieldIndexers sets up the field indexers for SubnetPortSetting and the SubnetPorts referring to it.
+func (r *Reconciler) SetupFieldIndexers(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &v1alpha1.SubnetPortSetting{}, subnetNameIndexKey, subnetPortSettingSubnetNameIndexFunc); err != nil {
+		return err
+	}
+	return mgr.GetFieldIndexer().IndexField(context.TODO(), &v1alpha1.SubnetPort{}, portSettingNameIndexKey, subnetPortPortSettingNameIndexFunc)
+}
+
+func subnetPortSettingSubnetNameIndexFunc(obj client.Object) []string {
+	portSetting, ok := obj.(*v1alpha1.SubnetPortSetting)
+	if !ok {
+		log.Info("Invalid object", "type", reflect.TypeOf(obj))
+		return []string{}
+	}
+	if portSetting.Spec.SubnetName == "" {
+		return []string{}
+	}
+	return []string{portSetting.Spec.SubnetName}
+}
+
+func subnetPortPortSettingNameIndexFunc(obj client.Object) []string {
+	subnetPort, ok := obj.(*v1alpha1.SubnetPort)
+	if !ok {
+		log.Info("Invalid object", "type", reflect.TypeOf(obj))
+		return []string{}
+	}
+	if subnetPort.Spec.PortSettingName == "" {
+		return []string{}
+	}
+	return []string{subnetPort.Spec.PortSettingName}
+}
+
+func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.SubnetPortSetting{}).
+		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.NumReconcile(),
+		}).
+		Watches(
+			&v1alpha1.Subnet{},
+			&common.EnqueueRequestForDependency{
+				Client:          r.Client,
+				RequeueByUpdate: requeueSubnetPortSettingBySubnet,
+				ResourceType:    "Subnet",
+			},
+			builder.WithPredicates(PredicateFuncsForSubnets),
+		).
+		Complete(tracing.NewReconciler("SubnetPortSetting", r))
+}
+
+func (r *Reconciler) setNotSupported(ctx context.Context, req ctrl.Request) error {
+	portSettingCR := &v1alpha1.SubnetPortSetting{}
+	if err := r.Client.Get(ctx, req.NamespacedName, portSettingCR); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Error(err, "Unable to fetch SubnetPortSetting CR", "SubnetPortSetting", req.NamespacedName)
+		return err
+	}
+	r.StatusUpdater.UpdateFail(ctx, portSettingCR, fmt.Errorf("NSX Subnet port config is not supported"), "NSX Subnet port config is not supported", setReadyStatusFalse, "NSX Subnet port config is not supported")
+	return nil
+}
+
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=subnetportsettings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=subnetportsettings/status,verbs=get;update;patch
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling SubnetPortSetting", "SubnetPortSetting", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
+	}()
+	r.StatusUpdater.IncreaseSyncTotal()
+
+	if !r.SubnetPortSettingService.NSXClient.NSXCheckVersion(nsx.SubnetPortSetting) {
+		log.Warn("NSX Subnet port config is not supported", "SubnetPortSetting", req.NamespacedName)
+		if err := r.setNotSupported(ctx, req); err != nil {
+			return common.ResultRequeue, nil
+		}
+		return common.ResultNormal, nil
+	}
+
+	portSettingCR := &v1alpha1.SubnetPortSetting{}
+	if err := r.Client.Get(ctx, req.NamespacedName, portSettingCR); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.StatusUpdater.IncreaseDeleteTotal()
+			if err := r.SubnetPortSettingService.DeletePortConfigByCRName(ctx, req.Namespace, req.Name); err != nil {
+				log.Error(err, "Failed to delete NSX VpcSubnetPortConfig", "SubnetPortSetting", req.NamespacedName)
+				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
+				return common.ResultRequeue, nil
+			}
+			r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
+			return common.ResultNormal, nil
+		}
+		log.Error(err, "Unable to fetch SubnetPortSetting CR", "SubnetPortSetting", req.NamespacedName)
+		return common.ResultRequeue, nil
+	}
+
+	if !portSettingCR.ObjectMeta.DeletionTimestamp.IsZero() {
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err := r.SubnetPortSettingService.DeletePortConfigByCRId(ctx, string(portSettingCR.UID)); err != nil {
+			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
+			return common.ResultRequeue, nil
+		}
+		r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
+		return common.ResultNormal, nil
+	}
+
+	r.StatusUpdater.IncreaseUpdateTotal()
+	subnetCR, validateErr := r.validateSubnet(ctx, req.Namespace, portSettingCR.Spec.SubnetName)
+	if validateErr != nil {
+		r.StatusUpdater.UpdateFail(ctx, portSettingCR, validateErr.error, validateErr.message, setReadyStatusFalse, validateErr.message)
+		if validateErr.retry {
+			return common.ResultRequeue, nil
+		}
+		return common.ResultNormal, nil
+	}
+
+	nsxSubnet, err := r.SubnetService.GetSubnetByCR(subnetCR)
+	if err != nil {
+		log.Error(err, "Failed to get NSX Subnet", "Namespace", subnetCR.Namespace, "Subnet", subnetCR.Name)
+		r.StatusUpdater.UpdateFail(ctx, portSettingCR, err, "Failed to get NSX Subnet", setReadyStatusFalse)
+		return common.ResultRequeue, nil
+	}
+
+	if _, err := r.SubnetPortSettingService.CreateOrUpdatePortConfig(portSettingCR, *nsxSubnet.Path); err != nil {
+		log.Error(err, "Failed to create or update NSX VpcSubnetPortConfig", "SubnetPortSetting", req.NamespacedName)
+		r.StatusUpdater.UpdateFail(ctx, portSettingCR, err, "Failed to create or update NSX VpcSubnetPortConfig", setReadyStatusFalse)
+		return common.ResultRequeue, nil
+	}
+
+	r.StatusUpdater.UpdateSuccess(ctx, portSettingCR, setReadyStatusTrue)
+	return common.ResultNormal, nil
+}
+
+func (r *Reconciler) validateSubnet(ctx context.Context, ns, name string) (*v1alpha1.Subnet, *errorWithRetry) {
+	if name == "" {
+		return nil, &errorWithRetry{
+			error:   fmt.Errorf("subnetName is not specified"),
+			retry:   false,
+			message: "subnetName is not specified",
+		}
+	}
+	subnetCR := &v1alpha1.Subnet{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, subnetCR); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Not requeue the SubnetPortSetting if Subnet is not created, it is requeued when the Subnet is realized.
+			log.Debug("Subnet CR does not exist", "Namespace", ns, "Subnet", name)
+			return nil, &errorWithRetry{
+				error:   err,
+				retry:   false,
+				message: "Subnet is not created",
+			}
+		}
+		return nil, &errorWithRetry{
+			error:   err,
+			retry:   true,
+			message: "Failed to get Subnet",
+		}
+	}
+	for _, con := range subnetCR.Status.Conditions {
+		if con.Type == v1alpha1.Ready && con.Status == v1.ConditionTrue {
+			return subnetCR, nil
+		}
+	}
+	log.Debug("Subnet is not realized", "Namespace", ns, "Subnet", name)
+	return nil, &errorWithRetry{
+		error:   fmt.Errorf("Subnet is not realized"),
+		retry:   false,
+		message: "Subnet is not realized",
+	}
+}
+
+func setReadyStatusTrue(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, _ ...interface{}) {
+	portSettingCR := obj.(*v1alpha1.SubnetPortSetting)
+	newConditions := []v1alpha1.Condition{
+		{
+			Type:               v1alpha1.Ready,
+			Status:             v1.ConditionTrue,
+			Message:            "NSX VpcSubnetPortConfig has been successfully created/updated",
+			Reason:             "SubnetPortSettingReady",
+			LastTransitionTime: transitionTime,
+		},
+	}
+	updateStatusConditions(client, ctx, portSettingCR, newConditions)
+}
+
+func setReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, args ...interface{}) {
+	portSettingCR := obj.(*v1alpha1.SubnetPortSetting)
+	newConditions := []v1alpha1.Condition{
+		{
+			Type:               v1alpha1.Ready,
+			Status:             v1.ConditionFalse,
+			Message:            "NSX VpcSubnetPortConfig could not be created/updated",
+			Reason:             "SubnetPortSettingFailed",
+			LastTransitionTime: transitionTime,
+		},
+	}
+	if len(args) > 0 && args[0].(string) != "" {
+		newConditions[0].Message = args[0].(string)
+	} else if err != nil {
+		newConditions[0].Message = fmt.Sprintf("Error occurred while processing the SubnetPortSetting CR. Please check the config and try again. Error: %v", err)
+	}
+	updateStatusConditions(client, ctx, portSettingCR, newConditions)
+}
+
+func updateStatusConditions(client client.Client, ctx context.Context, portSetting *v1alpha1.SubnetPortSetting, newConditions []v1alpha1.Condition) {
+	conditionsUpdated := false
+	for i := range newConditions {
+		if mergeStatusCondition(portSetting, &newConditions[i]) {
+			conditionsUpdated = true
+		}
+	}
+	if conditionsUpdated {
+		if err := client.Status().Update(ctx, portSetting); err != nil {
+			log.Error(err, "Failed to update SubnetPortSetting status", "Name", portSetting.Name, "Namespace", portSetting.Namespace)
+		} else {
+			log.Info("Updated SubnetPortSetting", "Name", portSetting.Name, "Namespace", portSetting.Namespace, "Status", portSetting.Status)
+		}
+	}
+}
+
+func mergeStatusCondition(portSetting *v1alpha1.SubnetPortSetting, newCondition *v1alpha1.Condition) bool {
+	matchedCondition := getExistingConditionOfType(newCondition.Type, portSetting.Status.Conditions)
+	if common.IsConditionSemanticEqual(matchedCondition, newCondition) {
+		log.Trace("Conditions already match", "New Condition", newCondition, "Existing Condition", matchedCondition)
+		return false
+	}
+
+	if matchedCondition != nil {
+		matchedCondition.Reason = newCondition.Reason
+		matchedCondition.Message = newCondition.Message
+		matchedCondition.Status = newCondition.Status
+		matchedCondition.LastTransitionTime = newCondition.LastTransitionTime
+	} else {
+		portSetting.Status.Conditions = append(portSetting.Status.Conditions, *newCondition)
+	}
+	return true
+}
+
+func getExistingConditionOfType(conditionType v1alpha1.ConditionType, existingConditions []v1alpha1.Condition) *v1alpha1.Condition {
+	for i := range existingConditions {
+		if existingConditions[i].Type == conditionType {
+			return &existingConditions[i]
+		}
+	}
+	return nil
+}
+
+// CollectGarbage collects the stale VpcSubnetPortConfigs and deletes them on NSX which have been removed from K8s.
+// It implements the interface GarbageCollector method.
+func (r *Reconciler) CollectGarbage(ctx context.Context) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("SubnetPortSetting garbage collection completed", "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	crUIDSet, err := r.listSubnetPortSettingIDsFromCRs(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list SubnetPortSetting CRs")
+		return err
+	}
+	uidSetInStore := r.SubnetPortSettingService.ListSubnetPortSettingCRUIDsInStore()
+
+	var errList []error
+	for uid := range uidSetInStore.Difference(crUIDSet) {
+		log.Trace("GC collected SubnetPortSetting CR", "UID", uid)
+		r.StatusUpdater.IncreaseDeleteTotal()
+		err = r.SubnetPortSettingService.DeletePortConfigByCRId(ctx, uid)
+		if err != nil {
+			errList = append(errList, err)
+			r.StatusUpdater.IncreaseDeleteFailTotal()
+		} else {
+			r.StatusUpdater.IncreaseDeleteSuccessTotal()
+		}
+	}
+	if len(errList) > 0 {
+		return fmt.Errorf("errors found in SubnetPortSetting garbage collection: %s", errList)
+	}
+	return nil
+}
+
+func (r *Reconciler) listSubnetPortSettingIDsFromCRs(ctx context.Context) (sets.Set[string], error) {
+	crUIDs := sets.New[string]()
+	portSettingList := &v1alpha1.SubnetPortSettingList{}
+	if err := r.Client.List(ctx, portSettingList); err != nil {
+		return nil, err
+	}
+	for _, portSetting := range portSettingList.Items {
+		crUIDs.Insert(string(portSetting.UID))
+	}
+	return crUIDs, nil
+}
@@ -0,0 +1,643 @@
+package subnetportsetting
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetportsetting"
+)
+
+func TestReconciler_GetRestoreList(t *testing.T) {
+	readyPortSetting := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: "ps-restore", Namespace: "ns-1", UID: "ps-restore-uid"},
+		Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+		Status: v1alpha1.SubnetPortSettingStatus{
+			Conditions: []v1alpha1.Condition{{Type: v1alpha1.Ready, Status: v1.ConditionTrue}},
+		},
+	}
+	unreadyPortSetting := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: "ps-unready", Namespace: "ns-1", UID: "ps-unready-uid"},
+		Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+	}
+	tests := []struct {
+		name         string
+		objects      []client.Object
+		prepareStore func(r *Reconciler)
+		expectInList bool
+	}{
+		{
+			name:         "Skip when SubnetPortSetting is not ready",
+			objects:      []client.Object{unreadyPortSetting},
+			expectInList: false,
+		},
+		{
+			name:         "Include when SubnetPortSetting is not found in store",
+			objects:      []client.Object{readyPortSetting, unreadyPortSetting},
+			expectInList: true,
+		},
+		{
+			name:    "Skip when SubnetPortSetting is found in store",
+			objects: []client.Object{readyPortSetting},
+			prepareStore: func(r *Reconciler) {
+				r.SubnetPortSettingService.PortConfigStore.Apply(&model.VpcSubnetPortConfig{
+					Id:   servicecommon.String("ps-restore_abcde"),
+					Tags: []model.Tag{{Scope: servicecommon.String(servicecommon.TagScopeSubnetPortSettingCRUID), Tag: servicecommon.String("ps-restore-uid")}},
+				})
+			},
+			expectInList: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := createFakeReconciler(tc.objects...)
+			if tc.prepareStore != nil {
+				tc.prepareStore(r)
+			}
+			list, err := r.getRestoreList()
+			assert.NoError(t, err)
+			if tc.expectInList {
+				assert.Equal(t, []types.NamespacedName{{Namespace: "ns-1", Name: "ps-restore"}}, list)
+			} else {
+				assert.Len(t, list, 0)
+			}
+		})
+	}
+}
+
+func TestReconciler_RestoreReconcile(t *testing.T) {
+	readyPortSetting := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: "ps-restore", Namespace: "ns-1", UID: "ps-restore-uid"},
+		Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+		Status: v1alpha1.SubnetPortSettingStatus{
+			Conditions: []v1alpha1.Condition{{Type: v1alpha1.Ready, Status: v1.ConditionTrue}},
+		},
+	}
+	t.Run("returns nil when SubnetPortSetting not supported", func(t *testing.T) {
+		r := createFakeReconciler(readyPortSetting)
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+			return false
+		})
+		defer patches.Reset()
+		assert.NoError(t, r.RestoreReconcile())
+	})
+	t.Run("returns error when restore fails", func(t *testing.T) {
+		r := createFakeReconciler(readyPortSetting)
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+			return true
+		})
+		patches.ApplyMethod(reflect.TypeOf(r), "Reconcile", func(_ *Reconciler, _ context.Context, _ ctrl.Request) (ctrl.Result, error) {
+			return common.ResultRequeue, nil
+		})
+		defer patches.Reset()
+		err := r.RestoreReconcile()
+		assert.ErrorContains(t, err, "errors found in SubnetPortSetting restore")
+	})
+	t.Run("returns nil when restore succeeds", func(t *testing.T) {
+		r := createFakeReconciler(readyPortSetting)
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+			return true
+		})
+		patches.ApplyMethod(reflect.TypeOf(r), "Reconcile", func(_ *Reconciler, _ context.Context, req ctrl.Request) (ctrl.Result, error) {
+			assert.Equal(t, "ps-restore", req.Name)
+			return common.ResultNormal, nil
+		})
+		defer patches.Reset()
+		assert.NoError(t, r.RestoreReconcile())
+	})
+}
+
+func TestReconciler_StartController(t *testing.T) {
+	mockMgr := &MockManager{scheme: runtime.NewScheme()}
+	patches := gomonkey.ApplyFunc((*Reconciler).setupWithManager, func(r *Reconciler, mgr manager.Manager) error {
+		return nil
+	})
+	patches.ApplyFunc(common.GenericGarbageCollector, func(cancel chan bool, timeout time.Duration, f func(ctx context.Context) error) {})
+	patches.ApplyFunc((*Reconciler).SetupFieldIndexers, func(r *Reconciler, mgr manager.Manager) error {
+		return nil
+	})
+	defer patches.Reset()
+	r := createFakeReconciler()
+	err := r.StartController(mockMgr, nil)
+	assert.Nil(t, err)
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	request := ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      "ps-1",
+			Namespace: "ns-1",
+		},
+	}
+	portSetting := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ps-1",
+			Namespace: "ns-1",
+			UID:       "ps-1-uid",
+		},
+		Spec: v1alpha1.SubnetPortSettingSpec{
+			SubnetName: "subnet-1",
+		},
+	}
+	deletingPortSetting := portSetting.DeepCopy()
+	deletingPortSetting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deletingPortSetting.Finalizers = []string{"test-finalizer"}
+	tests := []struct {
+		name              string
+		objects           []client.Object
+		preparedFunc      func(r *Reconciler) *gomonkey.Patches
+		expectedResult    ctrl.Result
+		expectedCondition *v1alpha1.Condition
+	}{
+		{
+			name:    "SubnetPortSetting not supported",
+			objects: []client.Object{portSetting},
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return false
+				})
+			},
+			expectedResult: common.ResultNormal,
+			expectedCondition: &v1alpha1.Condition{
+				Type:    v1alpha1.Ready,
+				Status:  v1.ConditionFalse,
+				Reason:  "SubnetPortSettingFailed",
+				Message: "NSX Subnet port config is not supported",
+			},
+		},
+		{
+			name: "SubnetPortSetting get error",
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "Get", func(_ client.Client, ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					return fmt.Errorf("mocked get error")
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return true
+				})
+				return patches
+			},
+			expectedResult: common.ResultRequeue,
+		},
+		{
+			name: "SubnetPortSetting deleted with NSX deletion error",
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService), "DeletePortConfigByCRName", func(_ *subnetportsetting.SubnetPortSettingService, _ context.Context, ns string, name string) error {
+					return fmt.Errorf("mocked delete error")
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return true
+				})
+				return patches
+			},
+			expectedResult: common.ResultRequeue,
+		},
+		{
+			name: "SubnetPortSetting deleted",
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService), "DeletePortConfigByCRName", func(_ *subnetportsetting.SubnetPortSettingService, _ context.Context, ns string, name string) error {
+					assert.Equal(t, "ns-1", ns)
+					assert.Equal(t, "ps-1", name)
+					return nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return true
+				})
+				return patches
+			},
+			expectedResult: common.ResultNormal,
+		},
+		{
+			name:    "SubnetPortSetting being deleted",
+			objects: []client.Object{deletingPortSetting},
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService), "DeletePortConfigByCRId", func(_ *subnetportsetting.SubnetPortSettingService, _ context.Context, id string) error {
+					assert.Equal(t, "ps-1-uid", id)
+					return nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return true
+				})
+				return patches
+			},
+			expectedResult: common.ResultNormal,
+		},
+		{
+			name:    "Subnet validation error",
+			objects: []client.Object{portSetting},
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return true
+				})
+			},
+			expectedResult: common.ResultNormal,
+			expectedCondition: &v1alpha1.Condition{
+				Type:    v1alpha1.Ready,
+				Status:  v1.ConditionFalse,
+				Reason:  "SubnetPortSettingFailed",
+				Message: "Subnet is not created",
+			},
+		},
+		{
+			name:    "Subnet validation error with retry",
+			objects: []client.Object{portSetting},
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "validateSubnet", func(_ *Reconciler, ctx context.Context, ns string, name string) (*v1alpha1.Subnet, *errorWithRetry) {
+					return nil, &errorWithRetry{
+						error:   fmt.Errorf("mocked get error"),
+						retry:   true,
+						message: "Failed to get Subnet",
+					}
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return true
+				})
+				return patches
+			},
+			expectedResult: common.ResultRequeue,
+		},
+		{
+			name:    "NSX Subnet get error",
+			objects: []client.Object{portSetting},
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "validateSubnet", func(_ *Reconciler, ctx context.Context, ns string, name string) (*v1alpha1.Subnet, *errorWithRetry) {
+					return &v1alpha1.Subnet{}, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetSubnetByCR", func(_ *subnet.SubnetService, subnet *v1alpha1.Subnet) (*model.VpcSubnet, error) {
+					return nil, fmt.Errorf("mocked get error")
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return true
+				})
+				return patches
+			},
+			expectedResult: common.ResultRequeue,
+		},
+		{
+			name:    "Create VpcSubnetPortConfig error",
+			objects: []client.Object{portSetting},
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "validateSubnet", func(_ *Reconciler, ctx context.Context, ns string, name string) (*v1alpha1.Subnet, *errorWithRetry) {
+					return &v1alpha1.Subnet{}, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetSubnetByCR", func(_ *subnet.SubnetService, subnet *v1alpha1.Subnet) (*model.VpcSubnet, error) {
+					return &model.VpcSubnet{Path: servicecommon.String("/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1")}, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService), "CreateOrUpdatePortConfig", func(_ *subnetportsetting.SubnetPortSettingService, portSetting *v1alpha1.SubnetPortSetting, subnetPath string) (*model.VpcSubnetPortConfig, error) {
+					return nil, fmt.Errorf("mocked creation error")
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return true
+				})
+				return patches
+			},
+			expectedResult: common.ResultRequeue,
+			expectedCondition: &v1alpha1.Condition{
+				Type:    v1alpha1.Ready,
+				Status:  v1.ConditionFalse,
+				Reason:  "SubnetPortSettingFailed",
+				Message: "Error occurred while processing the SubnetPortSetting CR. Please check the config and try again. Error: mocked creation error",
+			},
+		},
+		{
+			name:    "Create VpcSubnetPortConfig success",
+			objects: []client.Object{portSetting},
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "validateSubnet", func(_ *Reconciler, ctx context.Context, ns string, name string) (*v1alpha1.Subnet, *errorWithRetry) {
+					return &v1alpha1.Subnet{}, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetSubnetByCR", func(_ *subnet.SubnetService, subnet *v1alpha1.Subnet) (*model.VpcSubnet, error) {
+					return &model.VpcSubnet{Path: servicecommon.String("/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1")}, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService), "CreateOrUpdatePortConfig", func(_ *subnetportsetting.SubnetPortSettingService, portSetting *v1alpha1.SubnetPortSetting, subnetPath string) (*model.VpcSubnetPortConfig, error) {
+					assert.Equal(t, "/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1", subnetPath)
+					return &model.VpcSubnetPortConfig{}, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, feature int) bool {
+					return true
+				})
+				return patches
+			},
+			expectedResult: common.ResultNormal,
+			expectedCondition: &v1alpha1.Condition{
+				Type:    v1alpha1.Ready,
+				Status:  v1.ConditionTrue,
+				Reason:  "SubnetPortSettingReady",
+				Message: "NSX VpcSubnetPortConfig has been successfully created/updated",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := createFakeReconciler(tc.objects...)
+			patches := tc.preparedFunc(r)
+			result, err := r.Reconcile(context.TODO(), request)
+			patches.Reset()
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+			if tc.expectedCondition != nil {
+				updated := &v1alpha1.SubnetPortSetting{}
+				require.NoError(t, r.Client.Get(context.TODO(), request.NamespacedName, updated))
+				require.Len(t, updated.Status.Conditions, 1)
+				condition := updated.Status.Conditions[0]
+				assert.Equal(t, tc.expectedCondition.Type, condition.Type)
+				assert.Equal(t, tc.expectedCondition.Status, condition.Status)
+				assert.Equal(t, tc.expectedCondition.Reason, condition.Reason)
+				assert.Equal(t, tc.expectedCondition.Message, condition.Message)
+			}
+		})
+	}
+}
+
+func TestReconcile_validateSubnet(t *testing.T) {
+	subnetReady := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "subnet-1",
+			Namespace: "ns-1",
+		},
+		Status: v1alpha1.SubnetStatus{
+			Conditions: []v1alpha1.Condition{
+				{
+					Type:   v1alpha1.Ready,
+					Status: v1.ConditionTrue,
+				},
+			},
+		},
+	}
+	subnetUnready := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "subnet-1",
+			Namespace: "ns-1",
+		},
+		Status: v1alpha1.SubnetStatus{
+			Conditions: []v1alpha1.Condition{
+				{
+					Type:   v1alpha1.Ready,
+					Status: v1.ConditionFalse,
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name           string
+		subnetName     string
+		objects        []client.Object
+		preparedFunc   func(r *Reconciler) *gomonkey.Patches
+		expectedErr    string
+		expectedMsg    string
+		expectedRetry  bool
+		expectedSubnet *v1alpha1.Subnet
+	}{
+		{
+			name:          "Subnet name not specified",
+			expectedErr:   "subnetName is not specified",
+			expectedMsg:   "subnetName is not specified",
+			expectedRetry: false,
+		},
+		{
+			name:       "Subnet CR not created",
+			subnetName: "subnet-1",
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "Get", func(_ client.Client, ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					return apierrors.NewNotFound(v1alpha1.Resource("subnet"), "subnet-1")
+				})
+			},
+			expectedErr:   "not found",
+			expectedMsg:   "Subnet is not created",
+			expectedRetry: false,
+		},
+		{
+			name:       "Subnet CR get error",
+			subnetName: "subnet-1",
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "Get", func(_ client.Client, ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					return fmt.Errorf("mocked error")
+				})
+			},
+			expectedErr:   "mocked error",
+			expectedMsg:   "Failed to get Subnet",
+			expectedRetry: true,
+		},
+		{
+			name:          "Subnet CR not realized",
+			subnetName:    "subnet-1",
+			objects:       []client.Object{subnetUnready},
+			expectedErr:   "Subnet is not realized",
+			expectedMsg:   "Subnet is not realized",
+			expectedRetry: false,
+		},
+		{
+			name:           "Subnet CR ready",
+			subnetName:     "subnet-1",
+			objects:        []client.Object{subnetReady},
+			expectedSubnet: subnetReady,
+		},
+	}
+
+	for _, tc := range tests {
+		r := createFakeReconciler(tc.objects...)
+		var patches *gomonkey.Patches
+		if tc.preparedFunc != nil {
+			patches = tc.preparedFunc(r)
+		}
+		subnet, err := r.validateSubnet(context.TODO(), "ns-1", tc.subnetName)
+		if tc.expectedErr != "" {
+			assert.Equal(t, tc.expectedRetry, err.retry)
+			assert.Contains(t, err.error.Error(), tc.expectedErr)
+			assert.Contains(t, err.message, tc.expectedMsg)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedSubnet.Name, subnet.Name)
+		}
+		if patches != nil {
+			patches.Reset()
+		}
+	}
+}
+
+func TestSubnetPortSettingIndexFuncs(t *testing.T) {
+	assert.Equal(t, []string{"subnet-1"}, subnetPortSettingSubnetNameIndexFunc(&v1alpha1.SubnetPortSetting{
+		Spec: v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+	}))
+	assert.Equal(t, []string{}, subnetPortSettingSubnetNameIndexFunc(&v1alpha1.SubnetPortSetting{}))
+	assert.Equal(t, []string{}, subnetPortSettingSubnetNameIndexFunc(&v1alpha1.SubnetPort{}))
+
+	assert.Equal(t, []string{"ps-1"}, subnetPortPortSettingNameIndexFunc(&v1alpha1.SubnetPort{
+		Spec: v1alpha1.SubnetPortSpec{PortSettingName: "ps-1"},
+	}))
+	assert.Equal(t, []string{}, subnetPortPortSettingNameIndexFunc(&v1alpha1.SubnetPort{}))
+	assert.Equal(t, []string{}, subnetPortPortSettingNameIndexFunc(&v1alpha1.SubnetPortSetting{}))
+}
+
+func TestReconcile_CollectGarbage(t *testing.T) {
+	ps1 := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ps-1",
+			Namespace: "ns-1",
+			UID:       "ps-1-uuid",
+		},
+		Spec: v1alpha1.SubnetPortSettingSpec{
+			SubnetName: "subnet-1",
+		},
+	}
+	tests := []struct {
+		name         string
+		objects      []client.Object
+		preparedFunc func(r *Reconciler) *gomonkey.Patches
+		expectedErr  string
+	}{
+		{
+			name: "List SubnetPortSetting error",
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "List", func(_ client.Client, ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					return fmt.Errorf("mocked error")
+				})
+			},
+			expectedErr: "mocked error",
+		},
+		{
+			name:    "NSX VpcSubnetPortConfig deletion error",
+			objects: []client.Object{ps1},
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService), "ListSubnetPortSettingCRUIDsInStore", func(_ *subnetportsetting.SubnetPortSettingService) sets.Set[string] {
+					return sets.New("ps-1-uuid", "ps-2-uuid")
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService), "DeletePortConfigByCRId", func(_ *subnetportsetting.SubnetPortSettingService, _ context.Context, id string) error {
+					assert.Equal(t, "ps-2-uuid", id)
+					return fmt.Errorf("mocked deletion error")
+				})
+				return patches
+			},
+			expectedErr: "mocked deletion error",
+		},
+		{
+			name:    "Success",
+			objects: []client.Object{ps1},
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService), "ListSubnetPortSettingCRUIDsInStore", func(_ *subnetportsetting.SubnetPortSettingService) sets.Set[string] {
+					return sets.New("ps-1-uuid", "ps-2-uuid")
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortSettingService), "DeletePortConfigByCRId", func(_ *subnetportsetting.SubnetPortSettingService, _ context.Context, id string) error {
+					assert.Equal(t, "ps-2-uuid", id)
+					return nil
+				})
+				return patches
+			},
+		},
+	}
+	for _, tc := range tests {
+		r := createFakeReconciler(tc.objects...)
+		patches := tc.preparedFunc(r)
+		err := r.CollectGarbage(context.TODO())
+		if tc.expectedErr != "" {
+			assert.Contains(t, err.Error(), tc.expectedErr)
+		} else {
+			assert.Nil(t, err)
+		}
+		patches.Reset()
+	}
+}
+
+func createFakeReconciler(objs ...client.Object) *Reconciler {
+	mgr := newMockManager(objs...)
+
+	svc := servicecommon.Service{
+		Client:    mgr.GetClient(),
+		NSXClient: &nsx.Client{},
+
+		NSXConfig: &config.NSXOperatorConfig{
+			NsxConfig: &config.NsxConfig{
+				EnforcementPoint: "vmc-enforcementpoint",
+			},
+		},
+	}
+	subnetService := &subnet.SubnetService{
+		Service:     svc,
+		SubnetStore: &subnet.SubnetStore{},
+	}
+	portSettingService := &subnetportsetting.SubnetPortSettingService{
+		Service:         svc,
+		PortConfigStore: subnetportsetting.SetupPortConfigStore(),
+	}
+
+	return NewReconciler(mgr, portSettingService, subnetService)
+}
+
+func newMockManager(objs ...client.Object) ctrl.Manager {
+	newScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(newScheme))
+	utilruntime.Must(v1alpha1.AddToScheme(newScheme))
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1alpha1.SubnetPortSetting{}).
+		Build()
+	return &MockManager{
+		client:   fakeClient,
+		scheme:   newScheme,
+		recorder: &fakeRecorder{},
+	}
+}
+
+type MockManager struct {
+	ctrl.Manager
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+func (m *MockManager) GetClient() client.Client {
+	return m.client
+}
+
+func (m *MockManager) GetScheme() *runtime.Scheme {
+	return m.scheme
+}
+
+func (m *MockManager) GetEventRecorderFor(name string) record.EventRecorder {
+	return m.recorder
+}
+
+func (m *MockManager) Add(runnable manager.Runnable) error {
+	return nil
+}
+
+func (m *MockManager) Start(context.Context) error {
+	return nil
+}
+
+type fakeRecorder struct{}
+
+func (recorder fakeRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+}
+
+func (recorder fakeRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (recorder fakeRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+}
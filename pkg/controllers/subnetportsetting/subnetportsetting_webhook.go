@@ -0,0 +1,72 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetportsetting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// +kubebuilder:webhook:path=/validate-crd-nsx-vmware-com-v1alpha1-subnetportsetting,mutating=false,failurePolicy=fail,sideEffects=None,
+// groups=crd.nsx.vmware.com,resources=subnetportsettings,verbs=delete,versions=v1alpha1,
+// name=subnetportsetting.validating.crd.nsx.vmware.com,admissionReviewVersions=v1
+
+// SubnetPortSettingValidator denies the deletion of a SubnetPortSetting which is still used by SubnetPorts or Pods.
+type SubnetPortSettingValidator struct {
+	Client  client.Client
+	decoder admission.Decoder
+}
+
+func (v *SubnetPortSettingValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Delete {
+		return admission.Allowed("")
+	}
+	portSetting := &v1alpha1.SubnetPortSetting{}
+	if err := v.decoder.DecodeRaw(req.OldObject, portSetting); err != nil {
+		log.Error(err, "Failed to decode SubnetPortSetting", "SubnetPortSetting", req.Namespace+"/"+req.Name)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	log.Debug("Handling request", "user", req.UserInfo.Username, "operation", req.Operation)
+	consumers, err := v.listConsumers(ctx, portSetting)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(consumers) > 0 {
+		return admission.Denied(fmt.Sprintf("SubnetPortSetting %s/%s used by %v cannot be deleted", portSetting.Namespace, portSetting.Name, consumers))
+	}
+	return admission.Allowed("")
+}
+
+// listConsumers returns the SubnetPorts and Pods referring to the SubnetPortSetting.
+func (v *SubnetPortSettingValidator) listConsumers(ctx context.Context, portSetting *v1alpha1.SubnetPortSetting) ([]string, error) {
+	var consumers []string
+	subnetPortList := &v1alpha1.SubnetPortList{}
+	if err := v.Client.List(ctx, subnetPortList, client.InNamespace(portSetting.Namespace), client.MatchingFields{portSettingNameIndexKey: portSetting.Name}); err != nil {
+		log.Error(err, "Failed to list SubnetPorts", "Namespace", portSetting.Namespace)
+		return nil, err
+	}
+	for _, subnetPort := range subnetPortList.Items {
+		consumers = append(consumers, "SubnetPort "+subnetPort.Name)
+	}
+	podList := &v1.PodList{}
+	if err := v.Client.List(ctx, podList, client.InNamespace(portSetting.Namespace)); err != nil {
+		log.Error(err, "Failed to list Pods", "Namespace", portSetting.Namespace)
+		return nil, err
+	}
+	for _, pod := range podList.Items {
+		if pod.Annotations[common.AnnotationPortSetting] == portSetting.Name {
+			consumers = append(consumers, "Pod "+pod.Name)
+		}
+	}
+	return consumers, nil
+}
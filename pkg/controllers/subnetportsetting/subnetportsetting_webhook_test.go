@@ -0,0 +1,138 @@
+package subnetportsetting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestSubnetPortSettingValidator(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(newScheme))
+	utilruntime.Must(v1alpha1.AddToScheme(newScheme))
+
+	portSetting1 := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: "ps-1", Namespace: "ns-1"},
+		Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+	}
+	portSetting2 := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: "ps-2", Namespace: "ns-1"},
+		Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+	}
+	portSetting3 := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: "ps-3", Namespace: "ns-1"},
+		Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+	}
+	subnetPort := &v1alpha1.SubnetPort{
+		ObjectMeta: metav1.ObjectMeta{Name: "port-1", Namespace: "ns-1"},
+		Spec:       v1alpha1.SubnetPortSpec{Subnet: "subnet-1", PortSettingName: "ps-1"},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pod-1",
+			Namespace:   "ns-1",
+			Annotations: map[string]string{common.AnnotationPortSetting: "ps-2"},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithObjects(subnetPort, pod).
+		WithIndex(&v1alpha1.SubnetPort{}, portSettingNameIndexKey, subnetPortPortSettingNameIndexFunc).
+		Build()
+	validator := &SubnetPortSettingValidator{
+		Client:  fakeClient,
+		decoder: admission.NewDecoder(newScheme),
+	}
+
+	rawPortSetting := func(portSetting *v1alpha1.SubnetPortSetting) runtime.RawExtension {
+		raw, _ := json.Marshal(portSetting)
+		return runtime.RawExtension{Raw: raw}
+	}
+
+	type args struct {
+		req admission.Request
+	}
+	tests := []struct {
+		name        string
+		args        args
+		prepareFunc func() *gomonkey.Patches
+		allowed     bool
+		message     string
+		code        int32
+	}{
+		{
+			name:    "Create",
+			args:    args{req: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Create, Object: rawPortSetting(portSetting1)}}},
+			allowed: true,
+		},
+		{
+			name:    "Delete SubnetPortSetting used by SubnetPort",
+			args:    args{req: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Delete, OldObject: rawPortSetting(portSetting1)}}},
+			allowed: false,
+			message: "SubnetPortSetting ns-1/ps-1 used by [SubnetPort port-1] cannot be deleted",
+		},
+		{
+			name:    "Delete SubnetPortSetting used by Pod",
+			args:    args{req: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Delete, OldObject: rawPortSetting(portSetting2)}}},
+			allowed: false,
+			message: "SubnetPortSetting ns-1/ps-2 used by [Pod pod-1] cannot be deleted",
+		},
+		{
+			name:    "Delete SubnetPortSetting not used",
+			args:    args{req: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Delete, OldObject: rawPortSetting(portSetting3)}}},
+			allowed: true,
+		},
+		{
+			name:    "Delete SubnetPortSetting decode error",
+			args:    args{req: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Delete, OldObject: runtime.RawExtension{Raw: []byte("invalid")}}}},
+			allowed: false,
+			code:    http.StatusBadRequest,
+		},
+		{
+			name: "Delete SubnetPortSetting list error",
+			args: args{req: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Delete, OldObject: rawPortSetting(portSetting3)}}},
+			prepareFunc: func() *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(fakeClient), "List", func(_ client.Client, _ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+					return errors.New("mocked list error")
+				})
+			},
+			allowed: false,
+			message: "mocked list error",
+			code:    http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepareFunc != nil {
+				patches := tt.prepareFunc()
+				defer patches.Reset()
+			}
+			response := validator.Handle(context.TODO(), tt.args.req)
+			assert.Equal(t, tt.allowed, response.Allowed)
+			if tt.message != "" {
+				assert.Contains(t, response.Result.Message, tt.message)
+			}
+			if tt.code != 0 {
+				assert.Equal(t, tt.code, response.Result.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	ReasonSubnetScaledIn      = "SubnetScaledIn"
+	ReasonSubnetScaleInFailed = "SubnetScaleInFailed"
+)
+
+// subnetScaleIn records since when the NSX Subnets of the auto-managed SubnetSets have no SubnetPort.
+// The zero value is ready to use.
+type subnetScaleIn struct {
+	lock sync.Mutex
+	// emptySince is indexed by the SubnetSet UID and the NSX Subnet path.
+	emptySince map[types.UID]map[string]time.Time
+}
+
+// expiredSubnets records the empty NSX Subnets of the SubnetSet and returns the ones which have been empty
+// for at least the grace period, the longest empty first. The Subnets which are not empty any more are forgotten.
+func (s *subnetScaleIn) expiredSubnets(uid types.UID, nsxSubnets []*model.VpcSubnet, isEmpty func(path string) bool, now time.Time, gracePeriod time.Duration) []*model.VpcSubnet {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.emptySince == nil {
+		s.emptySince = map[types.UID]map[string]time.Time{}
+	}
+	previous := s.emptySince[uid]
+	current := map[string]time.Time{}
+	var expired []*model.VpcSubnet
+	for _, nsxSubnet := range nsxSubnets {
+		if nsxSubnet.Path == nil || !isEmpty(*nsxSubnet.Path) {
+			continue
+		}
+		since, ok := previous[*nsxSubnet.Path]
+		if !ok {
+			since = now
+		}
+		current[*nsxSubnet.Path] = since
+		if now.Sub(since) >= gracePeriod {
+			expired = append(expired, nsxSubnet)
+		}
+	}
+	sort.SliceStable(expired, func(i, j int) bool {
+		return current[*expired[i].Path].Before(current[*expired[j].Path])
+	})
+	if len(current) == 0 {
+		delete(s.emptySince, uid)
+	} else {
+		s.emptySince[uid] = current
+	}
+	return expired
+}
+
+func (s *subnetScaleIn) forget(uid types.UID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.emptySince, uid)
+}
+
+// scaleInSubnetSet deletes the NSX Subnets of the auto-managed SubnetSet which have had no SubnetPort for
+// the grace period, keeping at least the minimum number of NSX Subnets, and updates the SubnetSet status.
+func (r *SubnetSetReconciler) scaleInSubnetSet(ctx context.Context, subnetSet *v1alpha1.SubnetSet) error {
+	nsxConfig := r.SubnetService.NSXConfig.NsxConfig
+	gracePeriod := time.Duration(nsxConfig.SubnetScaleInGracePeriod) * time.Second
+
+	// Hold the SubnetSet lock so that no SubnetPort is allocated from the Subnets being deleted.
+	subnetSetLock := common.WLockSubnetSet(subnetSet.GetUID())
+	nsxSubnets := r.SubnetService.SubnetStore.GetByIndex(servicecommon.TagScopeSubnetSetCRUID, string(subnetSet.GetUID()))
+	expired := r.scaleIn.expiredSubnets(subnetSet.GetUID(), nsxSubnets, r.SubnetPortService.IsEmptySubnet, time.Now(), gracePeriod)
+	if deletable := max(len(nsxSubnets)-nsxConfig.SubnetSetMinSubnets, 0); len(expired) > deletable {
+		expired = expired[:deletable]
+	}
+	var deleteErrs []error
+	for _, nsxSubnet := range expired {
+		// The Subnet is skipped by deleteSubnets if a SubnetPort is created on it meanwhile.
+		hasStalePort, err := r.deleteSubnets(ctx, []*model.VpcSubnet{nsxSubnet}, true)
+		if err != nil {
+			deleteErrs = append(deleteErrs, err)
+			r.Recorder.Event(subnetSet, v1.EventTypeWarning, ReasonSubnetScaleInFailed, fmt.Sprintf("Failed to delete empty NSX Subnet %s: %v", *nsxSubnet.Id, err))
+			continue
+		}
+		if !hasStalePort {
+			log.Info("Scaled in SubnetSet", "SubnetSet", subnetSet.Name, "Namespace", subnetSet.Namespace, "nsxSubnet", *nsxSubnet.Id)
+			r.Recorder.Event(subnetSet, v1.EventTypeNormal, ReasonSubnetScaledIn, fmt.Sprintf("Deleted NSX Subnet %s which had no SubnetPort for %s", *nsxSubnet.Id, gracePeriod))
+		}
+	}
+	common.WUnlockSubnetSet(subnetSet.GetUID(), subnetSetLock)
+
+	if err := r.SubnetService.UpdateSubnetSetStatus(subnetSet); err != nil {
+		return err
+	}
+	if len(expired) > 0 {
+		common.UpdateNamespaceSubnetUsage(r.Client, subnetSet.Namespace, r.VPCService, r.SubnetService)
+	}
+	if len(deleteErrs) > 0 {
+		return fmt.Errorf("failed to scale in SubnetSet %s/%s: %v", subnetSet.Namespace, subnetSet.Name, deleteErrs)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetset
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
+)
+
+func newScaleInSubnet(id string) *model.VpcSubnet {
+	return &model.VpcSubnet{
+		Id:   common.String(id),
+		Path: common.String("/orgs/default/projects/default/vpcs/vpc-1/subnets/" + id),
+	}
+}
+
+func TestSubnetScaleIn_expiredSubnets(t *testing.T) {
+	subnet1, subnet2, subnet3 := newScaleInSubnet("subnet-1"), newScaleInSubnet("subnet-2"), newScaleInSubnet("subnet-3")
+	nsxSubnets := []*model.VpcSubnet{subnet1, subnet2, subnet3}
+	empty := sets.New[string](*subnet2.Path)
+	isEmpty := func(path string) bool { return empty.Has(path) }
+
+	s := &subnetScaleIn{}
+	start := time.Now()
+	assert.Empty(t, s.expiredSubnets("uid-1", nsxSubnets, isEmpty, start, time.Minute))
+
+	empty.Insert(*subnet3.Path)
+	assert.Empty(t, s.expiredSubnets("uid-1", nsxSubnets, isEmpty, start.Add(30*time.Second), time.Minute))
+	assert.Equal(t, []*model.VpcSubnet{subnet2}, s.expiredSubnets("uid-1", nsxSubnets, isEmpty, start.Add(time.Minute), time.Minute))
+	// The Subnets are returned the longest empty first.
+	assert.Equal(t, []*model.VpcSubnet{subnet2, subnet3}, s.expiredSubnets("uid-1", []*model.VpcSubnet{subnet3, subnet2}, isEmpty, start.Add(2*time.Minute), time.Minute))
+
+	// A Subnet with a SubnetPort restarts the grace period once it is empty again.
+	empty.Delete(*subnet2.Path)
+	assert.Equal(t, []*model.VpcSubnet{subnet3}, s.expiredSubnets("uid-1", nsxSubnets, isEmpty, start.Add(3*time.Minute), time.Minute))
+	empty.Insert(*subnet2.Path)
+	assert.Equal(t, []*model.VpcSubnet{subnet3}, s.expiredSubnets("uid-1", nsxSubnets, isEmpty, start.Add(3*time.Minute), time.Minute))
+
+	s.forget("uid-1")
+	assert.Empty(t, s.emptySince)
+	assert.Equal(t, nsxSubnets[1:], s.expiredSubnets("uid-1", nsxSubnets, isEmpty, start, 0))
+}
+
+func TestSubnetSetReconciler_scaleInSubnetSet(t *testing.T) {
+	subnetSet := &v1alpha1.SubnetSet{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:       "fake-subnetset-uid",
+			Name:      "test-subnetset",
+			Namespace: "test-namespace",
+		},
+	}
+	subnet1, subnet2, subnet3 := newScaleInSubnet("subnet-1"), newScaleInSubnet("subnet-2"), newScaleInSubnet("subnet-3")
+
+	tests := []struct {
+		name        string
+		gracePeriod int
+		minSubnets  int
+		deleteErr   error
+		wantDeleted []string
+		wantEvents  int
+		wantErr     bool
+	}{
+		{
+			name:        "within grace period",
+			gracePeriod: 300,
+			wantDeleted: nil,
+		},
+		{
+			name:        "keep minimum Subnets",
+			minSubnets:  2,
+			wantDeleted: []string{"subnet-2"},
+			wantEvents:  1,
+		},
+		{
+			name:        "delete all empty Subnets",
+			wantDeleted: []string{"subnet-2", "subnet-3"},
+			wantEvents:  2,
+		},
+		{
+			name:        "delete failure",
+			deleteErr:   errors.New("delete error"),
+			wantDeleted: []string{"subnet-2", "subnet-3"},
+			wantEvents:  2,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := createFakeSubnetSetReconciler(nil)
+			recorder := record.NewFakeRecorder(10)
+			r.Recorder = recorder
+			r.SubnetService.NSXConfig.SubnetScaleInGracePeriod = tt.gracePeriod
+			r.SubnetService.NSXConfig.SubnetSetMinSubnets = tt.minSubnets
+
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetService.SubnetStore), "GetByIndex", func(_ *subnet.SubnetStore, key string, value string) []*model.VpcSubnet {
+				return []*model.VpcSubnet{subnet1, subnet2, subnet3}
+			})
+			defer patches.Reset()
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
+				return path != *subnet1.Path
+			})
+			patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, _ context.Context, parentSubnet *model.VpcSubnet) error {
+				return nil
+			})
+			var deleted []string
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
+				deleted = append(deleted, *nsxSubnet.Id)
+				return tt.deleteErr
+			})
+			statusUpdated := false
+			patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "UpdateSubnetSetStatus", func(_ *subnet.SubnetService, obj *v1alpha1.SubnetSet) error {
+				statusUpdated = true
+				return nil
+			})
+
+			err := r.scaleInSubnetSet(context.TODO(), subnetSet.DeepCopy())
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantDeleted, deleted)
+			assert.True(t, statusUpdated)
+			require.Len(t, recorder.Events, tt.wantEvents)
+			for i := 0; i < tt.wantEvents; i++ {
+				event := <-recorder.Events
+				if tt.wantErr {
+					assert.Contains(t, event, ReasonSubnetScaleInFailed)
+				} else {
+					assert.Contains(t, event, ReasonSubnetScaledIn)
+				}
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -52,6 +53,7 @@ type SubnetSetReconciler struct {
 	Recorder          record.EventRecorder
 	StatusUpdater     common.StatusUpdater
 	restoreMode       bool
+	scaleIn           subnetScaleIn
 }
 
 func (r *SubnetSetReconciler) UpdateSubnetSetForSubnetNames(ctx context.Context, subnetsetCR *v1alpha1.SubnetSet) error {
@@ -136,6 +138,7 @@ func (r *SubnetSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling SubnetSet", "SubnetSet", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
 	}()
 
 	subnetsetCR := &v1alpha1.SubnetSet{}
@@ -147,6 +150,7 @@ func (r *SubnetSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return ResultRequeue, err
 			}
+			common.UpdateNamespaceSubnetUsage(r.Client, req.Namespace, r.VPCService, r.SubnetService)
 			r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
 			return ResultNormal, nil
 		}
@@ -201,11 +205,12 @@ func (r *SubnetSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			return ResultRequeue, err
 		}
 
-		err := r.deleteSubnetForSubnetSet(*subnetsetCR, false, false)
+		err := r.deleteSubnetForSubnetSet(ctx, *subnetsetCR, false, false)
 		if err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 			return ResultRequeue, err
 		}
+		common.UpdateNamespaceSubnetUsage(r.Client, req.Namespace, r.VPCService, r.SubnetService)
 		r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
 		return ResultNormal, nil
 	}
@@ -273,6 +278,11 @@ func (r *SubnetSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 	}
 
+	// The SubnetQuotaExceeded condition is set when a SubnetPort cannot be allocated, it does not block the SubnetSet
+	if cond := getExistingConditionOfType(v1alpha1.SubnetQuotaExceeded, subnetsetCR.Status.Conditions); cond != nil && cond.Status == v1.ConditionTrue {
+		r.Recorder.Event(subnetsetCR, v1.EventTypeWarning, common.ReasonSubnetQuotaExceeded, cond.Message)
+	}
+
 	nsxSubnets := r.SubnetService.SubnetStore.GetByIndex(servicecommon.TagScopeSubnetSetCRUID, string(subnetsetCR.UID))
 	if len(nsxSubnets) > 0 || r.restoreMode {
 		// update SubnetSet tags if labels of namespace changed
@@ -293,14 +303,14 @@ func (r *SubnetSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			if len(vpcInfoList) == 0 {
 				return ResultNormal, fmt.Errorf("failed to find VPC for Namespace %s", req.Namespace)
 			}
-			if err := r.SubnetService.RestoreSubnetSet(subnetsetCR, vpcInfoList[0], tags); err != nil {
+			if err := r.SubnetService.RestoreSubnetSet(ctx, subnetsetCR, vpcInfoList[0], tags); err != nil {
 				r.StatusUpdater.UpdateFail(ctx, subnetsetCR, err, "Failed to restore SubnetSet", setSubnetSetReadyStatusFalse)
 				return ResultNormal, err
 			}
 			r.StatusUpdater.UpdateSuccess(ctx, subnetsetCR, setSubnetSetReadyStatusTrue)
 			return ResultNormal, nil
 		}
-		if err := r.SubnetService.UpdateSubnetSet(subnetsetCR.Namespace, nsxSubnets, tags, subnetsetCR); err != nil {
+		if err := r.SubnetService.UpdateSubnetSet(ctx, subnetsetCR.Namespace, nsxSubnets, tags, subnetsetCR); err != nil {
 			r.StatusUpdater.UpdateFail(ctx, subnetsetCR, err, "Failed to update SubnetSet", setSubnetSetReadyStatusFalse)
 			return ResultNormal, err
 		}
@@ -464,7 +474,7 @@ func (r *SubnetSetReconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(common.PredicateFuncsWithSubnetBindings),
 		).
-		Complete(r)
+		Complete(tracing.NewReconciler("SubnetSet", r))
 }
 
 func (r *SubnetSetReconciler) EnableRestoreMode() {
@@ -472,6 +482,8 @@ func (r *SubnetSetReconciler) EnableRestoreMode() {
 }
 
 // CollectGarbage collect Subnet which there is no port attached on it.
+// Out of restore mode, the SubnetSets are scaled in: the Subnets are only deleted after having no port
+// for the scale-in grace period, and the minimum number of Subnets is kept.
 // it implements the interface GarbageCollector method.
 func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 	startTime := time.Now()
@@ -493,7 +505,13 @@ func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 			continue
 		}
 		crdSubnetSetIDsSet.Insert(string(subnetSet.UID))
-		if err := r.deleteSubnetForSubnetSet(subnetSet, true, true); err != nil {
+		var err error
+		if r.restoreMode {
+			err = r.deleteSubnetForSubnetSet(ctx, subnetSet, true, true)
+		} else {
+			err = r.scaleInSubnetSet(ctx, &subnetSet)
+		}
+		if err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
 		} else {
@@ -506,7 +524,7 @@ func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 	for subnetSetID := range subnetSetIDsToDelete {
 		nsxSubnets := r.SubnetService.ListSubnetCreatedBySubnetSet(subnetSetID)
 		log.Info("SubnetSet garbage collection, cleaning stale Subnets for SubnetSet", "Count", len(nsxSubnets))
-		if _, err := r.deleteSubnets(nsxSubnets, true); err != nil {
+		if _, err := r.deleteSubnets(ctx, nsxSubnets, true); err != nil {
 			errList = append(errList, err)
 			log.Error(err, "SubnetSet garbage collection, failed to delete NSX subnet", "SubnetSetUID", subnetSetID)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -521,6 +539,7 @@ func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 		uuid := key.(types.UID)
 		if !crdSubnetSetIDsSet.Has(string(uuid)) {
 			common.SubnetSetLocks.Delete(key)
+			r.scaleIn.forget(uuid)
 		}
 		return true
 	})
@@ -533,14 +552,14 @@ func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 func (r *SubnetSetReconciler) deleteSubnetBySubnetSetName(ctx context.Context, subnetSetName, ns string) error {
 	nsxSubnets := r.SubnetService.ListSubnetBySubnetSetName(ns, subnetSetName)
 	// We also actively delete the SubnetConnectionBindingMaps associated with the empty NSX Subnet that has no SubnetPort.
-	hasStaleSubnetPort, err := r.deleteSubnets(nsxSubnets, true)
+	hasStaleSubnetPort, err := r.deleteSubnets(ctx, nsxSubnets, true)
 	if err != nil || hasStaleSubnetPort {
 		return fmt.Errorf("failed to delete stale Subnets, error: %v, hasStaleSubnetPort: %t", err, hasStaleSubnetPort)
 	}
 	return nil
 }
 
-func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(subnetSet v1alpha1.SubnetSet, updateStatus, ignoreStaleSubnetPort bool) error {
+func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(ctx context.Context, subnetSet v1alpha1.SubnetSet, updateStatus, ignoreStaleSubnetPort bool) error {
 	subnetSetLock := common.WLockSubnetSet(subnetSet.GetUID())
 	nsxSubnets := r.SubnetService.SubnetStore.GetByIndex(servicecommon.TagScopeSubnetSetCRUID, string(subnetSet.GetUID()))
 
@@ -565,7 +584,7 @@ func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(subnetSet v1alpha1.Subnet
 	// corresponding NSX Subnet. This happens in the GC case to scale-in the NSX Subnet if no SubnetPort exists.
 	// For SubnetSet CR deletion event, we don't delete the existing SubnetConnectionBindingMaps but let the
 	// SubnetConnectionBindingMap controller do it after the binding CR is removed.
-	hasStaleSubnetPort, deleteErr := r.deleteSubnets(nsxSubnets, ignoreStaleSubnetPort)
+	hasStaleSubnetPort, deleteErr := r.deleteSubnets(ctx, nsxSubnets, ignoreStaleSubnetPort)
 	common.WUnlockSubnetSet(subnetSet.GetUID(), subnetSetLock)
 	// Skip SubnetSet status update for restore case, as we need the stale status to restore the NSX Subnet
 	if updateStatus && !r.restoreMode {
@@ -585,7 +604,7 @@ func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(subnetSet v1alpha1.Subnet
 // deleteSubnets deletes all the specified NSX Subnets.
 // If any of the Subnets have stale SubnetPorts, they are skipped. The final result returns true.
 // If there is an error while deleting any NSX Subnet, it is skipped, and the final result returns an error.
-func (r *SubnetSetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet, deleteBindingMaps bool) (hasStalePort bool, err error) {
+func (r *SubnetSetReconciler) deleteSubnets(ctx context.Context, nsxSubnets []*model.VpcSubnet, deleteBindingMaps bool) (hasStalePort bool, err error) {
 	if len(nsxSubnets) == 0 {
 		return
 	}
@@ -599,7 +618,7 @@ func (r *SubnetSetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet, delet
 		}
 
 		if deleteBindingMaps {
-			if err = r.BindingService.DeleteSubnetConnectionBindingMapsByParentSubnet(nsxSubnet); err != nil {
+			if err = r.BindingService.DeleteSubnetConnectionBindingMapsByParentSubnet(ctx, nsxSubnet); err != nil {
 				deleteErr := fmt.Errorf("failed to delete NSX SubnetConnectionBindingMaps connected to NSX Subnet/%s: %+v", *nsxSubnet.Id, err)
 				deleteErrs = append(deleteErrs, deleteErr)
 				log.Error(deleteErr, "Skipping to next Subnet")
@@ -607,7 +626,7 @@ func (r *SubnetSetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet, delet
 			}
 		}
 
-		if err := r.SubnetService.DeleteSubnet(*nsxSubnet); err != nil {
+		if err := r.SubnetService.DeleteSubnet(ctx, *nsxSubnet); err != nil {
 			deleteErr := fmt.Errorf("failed to delete NSX Subnet/%s: %+v", *nsxSubnet.Id, err)
 			deleteErrs = append(deleteErrs, deleteErr)
 			log.Error(deleteErr, "Skipping to next Subnet")
@@ -304,7 +304,7 @@ func TestReconcile(t *testing.T) {
 					vpcSubnet3 := model.VpcSubnet{Id: &id1, Path: &path, Tags: basicTags2}
 					return []*model.VpcSubnet{&vpcSubnet1, &vpcSubnet2, &vpcSubnet3}
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "UpdateSubnetSet", func(_ *subnet.SubnetService, ns string, vpcSubnets []*model.VpcSubnet, tags []model.Tag, subnetsetCR *v1alpha1.SubnetSet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "UpdateSubnetSet", func(_ *subnet.SubnetService, _ context.Context, ns string, vpcSubnets []*model.VpcSubnet, tags []model.Tag, subnetsetCR *v1alpha1.SubnetSet) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "GetNetworkStackFromNC", func(_ *vpc.VPCService, config *v1alpha1.VPCNetworkConfiguration) (v1alpha1.NetworkStackType, error) {
@@ -349,10 +349,10 @@ func TestReconcile(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "ListVPCInfo", func(_ *vpc.VPCService, ns string) []common.VPCResourceInfo {
 					return []common.VPCResourceInfo{{}}
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "RestoreSubnetSet", func(_ *subnet.SubnetService, obj *v1alpha1.SubnetSet, vpcInfo common.VPCResourceInfo, tags []model.Tag) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "RestoreSubnetSet", func(_ *subnet.SubnetService, _ context.Context, obj *v1alpha1.SubnetSet, vpcInfo common.VPCResourceInfo, tags []model.Tag) error {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "UpdateSubnetSet", func(_ *subnet.SubnetService, ns string, vpcSubnets []*model.VpcSubnet, tags []model.Tag, subnetsetCR *v1alpha1.SubnetSet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "UpdateSubnetSet", func(_ *subnet.SubnetService, _ context.Context, ns string, vpcSubnets []*model.VpcSubnet, tags []model.Tag, subnetsetCR *v1alpha1.SubnetSet) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "GetVPCNetworkConfigByNamespace", func(_ *vpc.VPCService, ns string) (*v1alpha1.VPCNetworkConfiguration, error) {
@@ -794,14 +794,14 @@ func TestReconcile_DeleteSubnetSet(t *testing.T) {
 					}
 				})
 
-				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, parentSubnet *model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, _ context.Context, parentSubnet *model.VpcSubnet) error {
 					return nil
 				})
 
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return nil
 				})
 				return patches
@@ -833,14 +833,14 @@ func TestReconcile_DeleteSubnetSet(t *testing.T) {
 					}
 				})
 
-				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, parentSubnet *model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, _ context.Context, parentSubnet *model.VpcSubnet) error {
 					return nil
 				})
 
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, _ string) bool {
 					return false
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return nil
 				})
 				return patches
@@ -872,14 +872,14 @@ func TestReconcile_DeleteSubnetSet(t *testing.T) {
 					}
 				})
 
-				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, parentSubnet *model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, _ context.Context, parentSubnet *model.VpcSubnet) error {
 					return nil
 				})
 
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return errors.New("delete NSX Subnet failed")
 				})
 				return patches
@@ -951,7 +951,7 @@ func TestReconcile_DeleteSubnetSet_WithFinalizer(t *testing.T) {
 		return nil
 	})
 
-	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 		return nil
 	})
 
@@ -1034,10 +1034,10 @@ func TestSubnetSetReconciler_CollectGarbage(t *testing.T) {
 	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 		return nil
 	})
-	patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, parentSubnet *model.VpcSubnet) error {
+	patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, _ context.Context, parentSubnet *model.VpcSubnet) error {
 		return nil
 	})
-	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 		return nil
 	})
 
@@ -1106,12 +1106,12 @@ func TestSubnetSetReconciler_deleteSubnetForSubnetSet(t *testing.T) {
 		}
 	})
 	defer patches.Reset()
-	patches.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnets", func(_ *SubnetSetReconciler, nsxSubnets []*model.VpcSubnet, deleteBindingMaps bool) (hasStalePort bool, err error) {
+	patches.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnets", func(_ *SubnetSetReconciler, _ context.Context, nsxSubnets []*model.VpcSubnet, deleteBindingMaps bool) (hasStalePort bool, err error) {
 		assert.Equal(t, vpcSubnet1, nsxSubnets[0])
 		assert.Equal(t, false, deleteBindingMaps)
 		return false, nil
 	})
-	err := r.deleteSubnetForSubnetSet(subnetSet, true, false)
+	err := r.deleteSubnetForSubnetSet(context.TODO(), subnetSet, true, false)
 	assert.Nil(t, err)
 }
 
@@ -1297,7 +1297,7 @@ func TestDeleteSubnets(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
 					return path != "subnet1-path"
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					if *nsxSubnet.Id == "net1" {
 						require.Fail(t, "SubnetService.DeleteSubnet should not be called if stale ports exist")
 					}
@@ -1323,7 +1323,7 @@ func TestDeleteSubnets(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
 					return true
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					if *nsxSubnet.Id == "net1" {
 						return fmt.Errorf("net1 deletion failed")
 					}
@@ -1349,7 +1349,7 @@ func TestDeleteSubnets(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
 					return true
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeletePortCount", func(_ *subnetport.SubnetPortService, _ string) {})
@@ -1372,13 +1372,13 @@ func TestDeleteSubnets(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
 					return true
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, parentSubnet *model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, _ context.Context, parentSubnet *model.VpcSubnet) error {
 					if *parentSubnet.Id == "net1" {
 						return fmt.Errorf("binding maps deletion failed")
 					}
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					if *nsxSubnet.Id == "net1" {
 						require.Fail(t, "SubnetService.DeleteSubnet should not be called if binding maps are failed to delete")
 					}
@@ -1404,10 +1404,10 @@ func TestDeleteSubnets(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
 					return true
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, parentSubnet *model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, _ context.Context, parentSubnet *model.VpcSubnet) error {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeletePortCount", func(_ *subnetport.SubnetPortService, _ string) {})
@@ -1428,7 +1428,7 @@ func TestDeleteSubnets(t *testing.T) {
 				defer patches.Reset()
 			}
 
-			hasPorts, err := r.deleteSubnets(tc.nsxSubnets, tc.deleteBindingMaps)
+			hasPorts, err := r.deleteSubnets(context.TODO(), tc.nsxSubnets, tc.deleteBindingMaps)
 			if tc.expErrStr != "" {
 				require.EqualError(t, err, tc.expErrStr)
 			} else {
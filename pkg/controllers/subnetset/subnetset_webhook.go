@@ -60,7 +60,8 @@ func defaultSubnetSetLabelChanged(oldSubnetSet, subnetSet *v1alpha1.SubnetSet) b
 }
 
 func hasExclusiveFields(s *v1alpha1.SubnetSet) bool {
-	return s.Spec.SubnetNames != nil && (s.Spec.IPv4SubnetSize != 0 || s.Spec.IPv6PrefixLength != 0 || s.Spec.AccessMode != "" || s.Spec.SubnetDHCPConfig.Mode != "" || s.Spec.SubnetDHCPv6Config.Mode != "")
+	return s.Spec.SubnetNames != nil && (s.Spec.IPv4SubnetSize != 0 || s.Spec.IPv6PrefixLength != 0 || s.Spec.AccessMode != "" || s.Spec.SubnetDHCPConfig.Mode != "" || s.Spec.SubnetDHCPv6Config.Mode != "" ||
+		s.Spec.MaxSubnets != 0 || s.Spec.MaxIPs != 0)
 }
 
 func subnetSetType(s *v1alpha1.SubnetSet) SubnetSetType {
@@ -182,7 +183,7 @@ func (v *SubnetSetValidator) Handle(ctx context.Context, req admission.Request)
 	}
 	if req.Operation != admissionv1.Delete {
 		if hasExclusiveFields(subnetSet) {
-			return admission.Denied("SubnetSet spec.subnetNames is exclusive with spec.ipv4SubnetSize, spec.accessMode and spec.subnetDHCPConfig")
+			return admission.Denied("SubnetSet spec.subnetNames is exclusive with spec.ipv4SubnetSize, spec.accessMode, spec.subnetDHCPConfig, spec.maxSubnets and spec.maxIPs")
 		}
 		err := controllercommon.CheckAccessModeOrVisibility(v.Client, ctx, subnetSet.Namespace, string(subnetSet.Spec.AccessMode), "subnetset")
 		if err != nil {
@@ -0,0 +1,157 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package virtualmachine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+)
+
+var (
+	log           = logger.Log
+	ResultNormal  = common.ResultNormal
+	MetricResType = common.MetricResTypeVirtualMachine
+)
+
+// VirtualMachineReconciler publishes NSX DNS records for the nsx.vmware.com/hostname annotation of VM Operator
+// VirtualMachines, pointing to the primary IP of the VirtualMachine.
+type VirtualMachineReconciler struct {
+	Client  client.Client
+	Scheme  *apimachineryruntime.Scheme
+	Service *servicecommon.Service
+	DNS     dns.DNSRecordProvider
+}
+
+func (r *VirtualMachineReconciler) deleteDNSForVirtualMachine(ctx context.Context, namespace, name string, op string) error {
+	if _, err := r.DNS.DeleteRecordByOwnerNN(ctx, dns.ResourceKindVirtualMachine, namespace, name); err != nil {
+		log.Error(err, "Failed to delete DNS records for VirtualMachine", "Namespace", namespace, "Name", name, "Operation", op)
+		return fmt.Errorf("deleting DNS records for %s: %w", op, err)
+	}
+	return nil
+}
+
+func (r *VirtualMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	vm := &vmv1alpha1.VirtualMachine{}
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling VirtualMachine", "VirtualMachine", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		if r.Service != nil {
+			metrics.HistogramObserveSince(r.Service.NSXConfig, metrics.ControllerReconcileDuration, MetricResType, startTime)
+		}
+	}()
+
+	if err := r.Client.Get(ctx, req.NamespacedName, vm); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Not found VirtualMachine", "req", req.NamespacedName)
+			if err := r.deleteDNSForVirtualMachine(ctx, req.Namespace, req.Name, "deleted VirtualMachine"); err != nil {
+				return common.ResultRequeueAfter10sec, nil
+			}
+			return ResultNormal, nil
+		}
+		log.Error(err, "Failed to fetch VirtualMachine", "req", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+
+	if !vm.ObjectMeta.DeletionTimestamp.IsZero() {
+		if err := r.deleteDNSForVirtualMachine(ctx, req.Namespace, req.Name, "terminating VirtualMachine"); err != nil {
+			return common.ResultRequeueAfter10sec, nil
+		}
+		return ResultNormal, nil
+	}
+
+	log.Info("Reconciling VirtualMachine", "VirtualMachine", req.NamespacedName)
+	if r.Service != nil {
+		metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerSyncTotal, MetricResType)
+	}
+	if err := r.reconcileVirtualMachineDNS(ctx, vm); err != nil {
+		log.Error(err, "Failed to reconcile DNS for VirtualMachine", "VirtualMachine", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	return ResultNormal, nil
+}
+
+func (r *VirtualMachineReconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vmv1alpha1.VirtualMachine{}, builder.WithPredicates(predicateVirtualMachineDNSChanged())).
+		Watches(
+			&v1alpha1.NetworkInfo{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueVirtualMachineRequestsFromNetworkInfo),
+			builder.WithPredicates(predicateNetworkInfoAllowedDNSDomainsChanged()),
+		).
+		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(),
+			}).
+		Complete(tracing.NewReconciler("VirtualMachine", r))
+}
+
+func (r *VirtualMachineReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func (r *VirtualMachineReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "VirtualMachine")
+		return err
+	}
+	err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		stop := make(chan bool)
+		go func() {
+			<-ctx.Done()
+			close(stop)
+		}()
+		common.GenericGarbageCollector(stop, servicecommon.GCInterval, r.CollectGarbage)
+		return nil
+	}))
+	if err != nil {
+		log.Error(err, "Failed to add VirtualMachine GC to manager")
+		return err
+	}
+	return nil
+}
+
+func (r *VirtualMachineReconciler) CollectGarbage(ctx context.Context) error {
+	return r.collectDNSGarbage(ctx)
+}
+
+// NewVirtualMachineReconciler returns nil if the DNS record service is not available, VirtualMachines are only reconciled for DNS.
+func NewVirtualMachineReconciler(mgr ctrl.Manager, commonService servicecommon.Service, dnsRecordService *dns.DNSRecordService) *VirtualMachineReconciler {
+	if dnsRecordService == nil {
+		log.Info("VirtualMachine controller isn't started since DNS record service is not available")
+		return nil
+	}
+	return &VirtualMachineReconciler{
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Service: &commonService,
+		DNS:     dnsRecordService,
+	}
+}
+
+// vmNN is a convenience for log keys.
+func vmNN(vm *vmv1alpha1.VirtualMachine) types.NamespacedName {
+	return types.NamespacedName{Namespace: vm.Namespace, Name: vm.Name}
+}
@@ -0,0 +1,346 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package virtualmachine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extannotations "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/annotations"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+const (
+	conditionTypeDNSRecordReady vmv1alpha1.ConditionType = "DNSRecordReady"
+	reasonDNSRecordConfigured                            = "DNSRecordConfigured"
+	reasonDNSRecordFailed                                = "DNSRecordFailed"
+)
+
+// parseDNSHostnamesFromAnnotation returns trimmed unique FQDNs from nsx.vmware.com/hostname (comma-separated tokens are supported).
+func parseDNSHostnamesFromAnnotation(annotations map[string]string) []string {
+	if len(annotations) == 0 {
+		return nil
+	}
+	if _, ok := annotations[servicecommon.AnnotationsDNSSkip]; ok {
+		return nil
+	}
+	return extannotations.HostnamesFromAnnotations(annotations, servicecommon.AnnotationDNSHostnameKey)
+}
+
+// primaryIPOfVirtualMachine returns status.vmIp, or the first IP of the first network interface reporting one.
+// Network interface IPs are in CIDR notation, the prefix length is dropped.
+func primaryIPOfVirtualMachine(vm *vmv1alpha1.VirtualMachine) string {
+	if ip := strings.TrimSpace(vm.Status.VmIp); ip != "" {
+		return ip
+	}
+	for _, nic := range vm.Status.NetworkInterfaces {
+		for _, addr := range nic.IpAddresses {
+			if ip, _, err := net.ParseCIDR(addr); err == nil {
+				return ip.String()
+			}
+			if ip := net.ParseIP(addr); ip != nil {
+				return ip.String()
+			}
+		}
+	}
+	return ""
+}
+
+// buildVirtualMachineDNSBatch builds owner-scoped DNS rows for a VirtualMachine: annotation hostnames, the
+// primary IP as target, then ValidateEndpointsByZone for namespace VPC policy.
+func buildVirtualMachineDNSBatch(vm *vmv1alpha1.VirtualMachine, w dns.DNSRecordProvider) (*dns.AggregatedDNSEndpoints, error) {
+	hostnames := parseDNSHostnamesFromAnnotation(vm.GetAnnotations())
+	if len(hostnames) == 0 {
+		return nil, nil
+	}
+	ip := primaryIPOfVirtualMachine(vm)
+	if ip == "" {
+		log.Debug("VirtualMachine has hostname annotation but no IP yet", "namespace", vm.Namespace, "name", vm.Name)
+		return nil, nil
+	}
+	targets := extdns.NewTargets(ip)
+	ttl := extdns.TTL(0)
+	var eps []*extdns.Endpoint
+	for _, h := range hostnames {
+		for _, ep := range extdns.EndpointsForHostname(h, targets, ttl) {
+			if ep == nil {
+				log.Info("Skipping invalid DNS hostname", "hostname", h, "namespace", vm.Namespace, "name", vm.Name)
+				continue
+			}
+			eps = append(eps, ep)
+		}
+	}
+	if len(eps) == 0 {
+		return nil, nil
+	}
+	owner := &dns.ResourceRef{Kind: dns.ResourceKindVirtualMachine, Object: vm.GetObjectMeta()}
+	rows, _, err := w.ValidateEndpointsByZone(vm.Namespace, owner, eps)
+	if len(rows) == 0 {
+		return nil, err
+	}
+	log.Info("DNS batch built for VirtualMachine", "namespace", vm.Namespace, "name", vm.Name, "rows", len(rows))
+	return dns.NewOwnerScopedAggregatedRouteDNS(owner, rows), err
+}
+
+func buildDNSRecordReadyCondition(err error) vmv1alpha1.Condition {
+	cond := vmv1alpha1.Condition{
+		Type:               conditionTypeDNSRecordReady,
+		LastTransitionTime: metav1.Now(),
+	}
+	if err != nil {
+		cond.Status = corev1.ConditionFalse
+		cond.Severity = vmv1alpha1.ConditionSeverityWarning
+		cond.Reason = reasonDNSRecordFailed
+		cond.Message = err.Error()
+	} else {
+		cond.Status = corev1.ConditionTrue
+		cond.Reason = reasonDNSRecordConfigured
+	}
+	return cond
+}
+
+// setDNSRecordReadyCondition sets cond in conditions, keeping LastTransitionTime if the status is unchanged.
+// It returns false if nothing changed.
+func setDNSRecordReadyCondition(conditions *[]vmv1alpha1.Condition, cond vmv1alpha1.Condition) bool {
+	for i := range *conditions {
+		existing := &(*conditions)[i]
+		if existing.Type != cond.Type {
+			continue
+		}
+		if existing.Status == cond.Status && existing.Reason == cond.Reason && existing.Message == cond.Message && existing.Severity == cond.Severity {
+			return false
+		}
+		if existing.Status == cond.Status {
+			cond.LastTransitionTime = existing.LastTransitionTime
+		}
+		*existing = cond
+		return true
+	}
+	*conditions = append(*conditions, cond)
+	return true
+}
+
+// updateVirtualMachineDNSReadyCondition sets the DNSRecordReady condition from the DNS reconcile outcome (True when err is nil).
+func (r *VirtualMachineReconciler) updateVirtualMachineDNSReadyCondition(ctx context.Context, key types.NamespacedName, err error) error {
+	cond := buildDNSRecordReadyCondition(err)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		vm := &vmv1alpha1.VirtualMachine{}
+		if getErr := r.Client.Get(ctx, key, vm); getErr != nil {
+			return client.IgnoreNotFound(getErr)
+		}
+		patch := client.MergeFromWithOptions(vm.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		if !setDNSRecordReadyCondition(&vm.Status.Conditions, cond) {
+			return nil
+		}
+		return r.Client.Status().Patch(ctx, vm, patch)
+	})
+}
+
+// removeVirtualMachineDNSReadyCondition removes the DNSRecordReady condition; ignores NotFound.
+func (r *VirtualMachineReconciler) removeVirtualMachineDNSReadyCondition(ctx context.Context, key types.NamespacedName) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		vm := &vmv1alpha1.VirtualMachine{}
+		if err := r.Client.Get(ctx, key, vm); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		patch := client.MergeFromWithOptions(vm.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		n := len(vm.Status.Conditions)
+		vm.Status.Conditions = slices.DeleteFunc(vm.Status.Conditions, func(c vmv1alpha1.Condition) bool {
+			return c.Type == conditionTypeDNSRecordReady
+		})
+		if len(vm.Status.Conditions) == n {
+			return nil
+		}
+		return r.Client.Status().Patch(ctx, vm, patch)
+	})
+}
+
+// reconcileVirtualMachineDNS applies DNS rows for the VirtualMachine.
+func (r *VirtualMachineReconciler) reconcileVirtualMachineDNS(ctx context.Context, vm *vmv1alpha1.VirtualMachine) error {
+	key := vmNN(vm)
+	log.Info("Reconciling DNS for VirtualMachine", "VirtualMachine", key)
+	batch, err := buildVirtualMachineDNSBatch(vm, r.DNS)
+	if err != nil {
+		var zoneValErr *dns.DNSZoneValidationError
+		if errors.As(err, &zoneValErr) {
+			log.Error(err, "Failed to validate DNS records for VirtualMachine with the allowed DNS zones", "VirtualMachine", key)
+			if uerr := r.updateVirtualMachineDNSReadyCondition(ctx, key, err); uerr != nil {
+				log.Error(uerr, "Failed to update DNS conditions", "VirtualMachine", key)
+				return uerr
+			}
+			// If there are valid rows, we should still apply them
+			if batch != nil && len(batch.Rows) > 0 {
+				if _, uErr := r.DNS.CreateOrUpdateRecords(ctx, batch); uErr != nil {
+					log.Error(uErr, "Failed to reconcile valid DNS records despite validation errors", "VirtualMachine", key)
+					return uErr
+				}
+			} else if dErr := r.deleteDNSForVirtualMachine(ctx, vm.Namespace, vm.Name, "stale DNS records"); dErr != nil {
+				return dErr
+			}
+			// For validation errors, we do not automatically requeue. We wait for the user to update the VirtualMachine.
+			return nil
+		}
+		log.Error(err, "Failed to build DNS endpoints for VirtualMachine", "VirtualMachine", key)
+		if uerr := r.updateVirtualMachineDNSReadyCondition(ctx, key, err); uerr != nil {
+			log.Error(uerr, "Failed to update DNS conditions", "VirtualMachine", key)
+			return uerr
+		}
+		return err
+	}
+
+	if batch == nil || len(batch.Rows) == 0 {
+		return r.clearDNSAndConditionForVirtualMachine(ctx, key, "stale DNS records")
+	}
+
+	_, uErr := r.DNS.CreateOrUpdateRecords(ctx, batch)
+	if uErr != nil {
+		log.Error(uErr, "Failed to reconcile DNS records", "VirtualMachine", key)
+	}
+	if condErr := r.updateVirtualMachineDNSReadyCondition(ctx, key, uErr); condErr != nil {
+		log.Error(condErr, "Failed to update DNS ready condition", "VirtualMachine", key)
+		if uErr != nil {
+			return fmt.Errorf("updating condition: %v, reconciling DNS: %w", condErr, uErr)
+		}
+		return fmt.Errorf("updating condition: %w", condErr)
+	}
+	return uErr
+}
+
+func (r *VirtualMachineReconciler) clearDNSAndConditionForVirtualMachine(ctx context.Context, key types.NamespacedName, op string) error {
+	if err := r.deleteDNSForVirtualMachine(ctx, key.Namespace, key.Name, op); err != nil {
+		return err
+	}
+	if err := r.removeVirtualMachineDNSReadyCondition(ctx, key); err != nil {
+		log.Error(err, "Failed to clear VirtualMachine DNS Ready condition", "VirtualMachine", key.String(), "Operation", op)
+		return fmt.Errorf("clearing DNS condition for %s: %w", op, err)
+	}
+	return nil
+}
+
+// getVirtualMachinesWithDNS returns VirtualMachines that should have DNS records.
+func getVirtualMachinesWithDNS(ctx context.Context, c client.Client, listOpts ...client.ListOption) ([]vmv1alpha1.VirtualMachine, error) {
+	vmList := &vmv1alpha1.VirtualMachineList{}
+	if err := c.List(ctx, vmList, listOpts...); err != nil {
+		return nil, err
+	}
+	var filtered []vmv1alpha1.VirtualMachine
+	for i := range vmList.Items {
+		vm := vmList.Items[i]
+		if !vm.ObjectMeta.DeletionTimestamp.IsZero() || len(parseDNSHostnamesFromAnnotation(vm.GetAnnotations())) == 0 {
+			continue
+		}
+		filtered = append(filtered, vm)
+	}
+	return filtered, nil
+}
+
+// enqueueVirtualMachineRequestsFromNetworkInfo requeues VirtualMachines that publish DNS when namespace AllowedDNSDomains change.
+func (r *VirtualMachineReconciler) enqueueVirtualMachineRequestsFromNetworkInfo(ctx context.Context, obj client.Object) []reconcile.Request {
+	ni, ok := obj.(*v1alpha1.NetworkInfo)
+	if !ok || ni == nil {
+		return nil
+	}
+	vms, err := getVirtualMachinesWithDNS(ctx, r.Client, client.InNamespace(ni.Namespace))
+	if err != nil {
+		log.Error(err, "Failed to list VirtualMachines for NetworkInfo DNS domain change", "Namespace", ni.Namespace)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for i := range vms {
+		reqs = append(reqs, reconcile.Request{NamespacedName: vmNN(&vms[i])})
+	}
+	return reqs
+}
+
+func predicateNetworkInfoAllowedDNSDomainsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNI, ok1 := e.ObjectOld.(*v1alpha1.NetworkInfo)
+			newNI, ok2 := e.ObjectNew.(*v1alpha1.NetworkInfo)
+			if !ok1 || !ok2 {
+				return false
+			}
+			return !slices.Equal(oldNI.AllowedDNSDomains, newNI.AllowedDNSDomains)
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// predicateVirtualMachineDNSChanged filters out VirtualMachine updates which change neither the DNS annotations,
+// the primary IP nor the deletion state, VirtualMachine status is updated frequently by VM Operator.
+func predicateVirtualMachineDNSChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldVM, ok1 := e.ObjectOld.(*vmv1alpha1.VirtualMachine)
+			newVM, ok2 := e.ObjectNew.(*vmv1alpha1.VirtualMachine)
+			if !ok1 || !ok2 {
+				return false
+			}
+			oldAnnotations, newAnnotations := oldVM.GetAnnotations(), newVM.GetAnnotations()
+			_, oldSkip := oldAnnotations[servicecommon.AnnotationsDNSSkip]
+			_, newSkip := newAnnotations[servicecommon.AnnotationsDNSSkip]
+			return oldAnnotations[servicecommon.AnnotationDNSHostnameKey] != newAnnotations[servicecommon.AnnotationDNSHostnameKey] ||
+				oldSkip != newSkip ||
+				dns.RecordAnnotationsChanged(oldAnnotations, newAnnotations) ||
+				primaryIPOfVirtualMachine(oldVM) != primaryIPOfVirtualMachine(newVM) ||
+				oldVM.DeletionTimestamp.IsZero() != newVM.DeletionTimestamp.IsZero()
+		},
+	}
+}
+
+// collectDNSGarbage deletes DNS records whose VirtualMachine owner is gone or no longer has the hostname annotation.
+func (r *VirtualMachineReconciler) collectDNSGarbage(ctx context.Context) error {
+	if r.DNS == nil {
+		return nil
+	}
+	apiSet := sets.New[types.NamespacedName]()
+	vms, err := getVirtualMachinesWithDNS(ctx, r.Client)
+	if err != nil {
+		log.Error(err, "VirtualMachine GC: failed to list VirtualMachines")
+		return err
+	}
+	for i := range vms {
+		apiSet.Insert(vmNN(&vms[i]))
+	}
+	ownersByKind := r.DNS.ListRecordOwnerResource()
+	var errs []error
+	for nn := range ownersByKind[dns.ResourceKindVirtualMachine] {
+		if apiSet.Has(nn) {
+			continue
+		}
+		if err := r.clearDNSAndConditionForVirtualMachine(ctx, nn, "GC: missing or ineligible VirtualMachine owner"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("virtual machine garbage collection encountered %d error(s): %w", len(errs), errors.Join(errs...))
+	}
+	return nil
+}
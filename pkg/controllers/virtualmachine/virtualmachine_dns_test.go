@@ -0,0 +1,245 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package virtualmachine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	mockdns "github.com/vmware-tanzu/nsx-operator/pkg/mock/dnsrecordprovider"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+// stubValidatedRows mimics ValidateEndpointsByZone for *.example.com under /zones/t.
+func stubValidatedRows(eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+	const zpath = "/orgs/org1/projects/proj1/dns-services/dns1/zones/t"
+	var rows []dns.EndpointRow
+	for _, ep := range eps {
+		dn := strings.ToLower(strings.TrimSpace(ep.DNSName))
+		if !strings.HasSuffix(dn, ".example.com") {
+			return nil, nil, fmt.Errorf("hostname %q does not match stub allowed domain", ep.DNSName)
+		}
+		rows = append(rows, *dns.NewEndpointRow(ep, zpath, strings.TrimSuffix(dn, ".example.com")))
+	}
+	return rows, map[string]string{zpath: "example.com"}, nil
+}
+
+func vmTestScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, vmv1alpha1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+func vmFakeClient(scheme *runtime.Scheme, objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&vmv1alpha1.VirtualMachine{}).WithObjects(objs...).Build()
+}
+
+func makeVM(name, hostname, ip string) *vmv1alpha1.VirtualMachine {
+	vm := &vmv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: name, UID: types.UID(name + "-uid")},
+	}
+	if hostname != "" {
+		vm.Annotations = map[string]string{servicecommon.AnnotationDNSHostnameKey: hostname}
+	}
+	vm.Status.VmIp = ip
+	return vm
+}
+
+func getDNSRecordReadyCondition(t *testing.T, c client.Client, name string) *vmv1alpha1.Condition {
+	vm := &vmv1alpha1.VirtualMachine{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "ns1", Name: name}, vm))
+	for i := range vm.Status.Conditions {
+		if vm.Status.Conditions[i].Type == conditionTypeDNSRecordReady {
+			return &vm.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func TestPrimaryIPOfVirtualMachine(t *testing.T) {
+	vm := makeVM("vm", "", "")
+	assert.Equal(t, "", primaryIPOfVirtualMachine(vm))
+
+	vm.Status.NetworkInterfaces = []vmv1alpha1.NetworkInterfaceStatus{
+		{MacAddress: "00:50:56:00:00:01"},
+		{IpAddresses: []string{"fe80::1/64", "192.0.2.10/24"}},
+	}
+	assert.Equal(t, "fe80::1", primaryIPOfVirtualMachine(vm))
+
+	vm.Status.NetworkInterfaces[1].IpAddresses = []string{"192.0.2.11"}
+	assert.Equal(t, "192.0.2.11", primaryIPOfVirtualMachine(vm))
+
+	vm.Status.VmIp = "192.0.2.1"
+	assert.Equal(t, "192.0.2.1", primaryIPOfVirtualMachine(vm))
+}
+
+func TestReconcileVirtualMachineDNS(t *testing.T) {
+	ctx := context.Background()
+	scheme := vmTestScheme(t)
+	tests := []struct {
+		name       string
+		vm         *vmv1alpha1.VirtualMachine
+		setupMock  func(m *mockdns.MockDNSRecordProvider)
+		wantErr    bool
+		wantStatus corev1.ConditionStatus
+	}{
+		{
+			name: "hostname_with_ip_publishes",
+			vm:   makeVM("vm", "vm1.example.com", "192.0.2.1"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns1", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ string, owner *dns.ResourceRef, eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+						require.Equal(t, dns.ResourceKindVirtualMachine, owner.Kind)
+						require.Len(t, eps, 1)
+						require.Equal(t, []string{"192.0.2.1"}, []string(eps[0].Targets))
+						return stubValidatedRows(eps)
+					}).Times(1)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
+			},
+			wantStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "no_ip_deletes",
+			vm:   makeVM("vm", "vm1.example.com", ""),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindVirtualMachine, "ns1", "vm").Return(false, nil).Times(1)
+			},
+		},
+		{
+			name: "zone_validation_error_sets_condition",
+			vm:   makeVM("vm", "vm1.other.com", "192.0.2.1"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					nil, nil, &dns.DNSZoneValidationError{Msg: "zone mismatch"}).Times(1)
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindVirtualMachine, "ns1", "vm").Return(false, nil).Times(1)
+			},
+			wantStatus: corev1.ConditionFalse,
+		},
+		{
+			name: "create_error_sets_condition",
+			vm:   makeVM("vm", "vm1.example.com", "192.0.2.1"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ string, _ *dns.ResourceRef, eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+						return stubValidatedRows(eps)
+					}).Times(1)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("mock create error")).Times(1)
+			},
+			wantErr:    true,
+			wantStatus: corev1.ConditionFalse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtl := gomock.NewController(t)
+			m := mockdns.NewMockDNSRecordProvider(mockCtl)
+			tt.setupMock(m)
+			c := vmFakeClient(scheme, tt.vm)
+			r := &VirtualMachineReconciler{Client: c, DNS: m}
+			err := r.reconcileVirtualMachineDNS(ctx, tt.vm)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			cond := getDNSRecordReadyCondition(t, c, tt.vm.Name)
+			if tt.wantStatus == "" {
+				assert.Nil(t, cond)
+				return
+			}
+			require.NotNil(t, cond)
+			assert.Equal(t, tt.wantStatus, cond.Status)
+		})
+	}
+}
+
+func TestSetDNSRecordReadyCondition(t *testing.T) {
+	other := vmv1alpha1.Condition{Type: vmv1alpha1.ReadyCondition, Status: corev1.ConditionTrue}
+	conditions := []vmv1alpha1.Condition{other}
+	ready := buildDNSRecordReadyCondition(nil)
+	assert.True(t, setDNSRecordReadyCondition(&conditions, ready))
+	assert.Len(t, conditions, 2)
+	assert.False(t, setDNSRecordReadyCondition(&conditions, buildDNSRecordReadyCondition(nil)))
+
+	assert.True(t, setDNSRecordReadyCondition(&conditions, buildDNSRecordReadyCondition(fmt.Errorf("failed"))))
+	assert.Equal(t, []vmv1alpha1.Condition{other}, conditions[:1])
+	assert.Equal(t, corev1.ConditionFalse, conditions[1].Status)
+	assert.Equal(t, "failed", conditions[1].Message)
+}
+
+func TestVirtualMachineReconciler_Reconcile_clearsCondition(t *testing.T) {
+	ctx := context.Background()
+	scheme := vmTestScheme(t)
+	vm := makeVM("vm", "", "192.0.2.1")
+	vm.Status.Conditions = []vmv1alpha1.Condition{buildDNSRecordReadyCondition(nil)}
+	c := vmFakeClient(scheme, vm)
+	mockCtl := gomock.NewController(t)
+	m := mockdns.NewMockDNSRecordProvider(mockCtl)
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindVirtualMachine, "ns1", "vm").Return(true, nil).Times(1)
+	r := &VirtualMachineReconciler{Client: c, DNS: m}
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "vm"}})
+	require.NoError(t, err)
+	assert.Equal(t, ResultNormal, result)
+	assert.Nil(t, getDNSRecordReadyCondition(t, c, "vm"))
+}
+
+func TestVirtualMachineReconciler_CollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	scheme := vmTestScheme(t)
+	mockCtl := gomock.NewController(t)
+	m := mockdns.NewMockDNSRecordProvider(mockCtl)
+	m.EXPECT().ListRecordOwnerResource().Return(map[string]sets.Set[types.NamespacedName]{
+		dns.ResourceKindVirtualMachine: sets.New(
+			types.NamespacedName{Namespace: "ns1", Name: "live"},
+			types.NamespacedName{Namespace: "ns1", Name: "gone"},
+		),
+	}).Times(1)
+	m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindVirtualMachine, "ns1", "gone").Return(true, nil).Times(1)
+
+	r := &VirtualMachineReconciler{Client: vmFakeClient(scheme, makeVM("live", "vm1.example.com", "192.0.2.1")), DNS: m}
+	require.NoError(t, r.CollectGarbage(ctx))
+}
+
+func TestPredicateVirtualMachineDNSChanged(t *testing.T) {
+	p := predicateVirtualMachineDNSChanged()
+	oldVM := makeVM("vm", "vm1.example.com", "192.0.2.1")
+
+	statusOnly := oldVM.DeepCopy()
+	statusOnly.Status.PowerState = vmv1alpha1.VirtualMachinePoweredOn
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: oldVM, ObjectNew: statusOnly}))
+
+	newIP := oldVM.DeepCopy()
+	newIP.Status.VmIp = "192.0.2.2"
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: oldVM, ObjectNew: newIP}))
+
+	ptr := oldVM.DeepCopy()
+	ptr.Annotations[servicecommon.AnnotationDNSPTRKey] = "true"
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: oldVM, ObjectNew: ptr}))
+
+	skipped := oldVM.DeepCopy()
+	skipped.Annotations[servicecommon.AnnotationsDNSSkip] = "true"
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: oldVM, ObjectNew: skipped}))
+	assert.True(t, p.Create(event.CreateEvent{Object: oldVM}))
+}
@@ -0,0 +1,106 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+)
+
+var PredicateFuncsForIPAllocations = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldObj, oldOK := e.ObjectOld.(*v1alpha1.IPAddressAllocation)
+		newObj, newOK := e.ObjectNew.(*v1alpha1.IPAddressAllocation)
+		if !oldOK || !newOK {
+			return false
+		}
+		return common.IsObjectUpdateToReady(oldObj.Status.Conditions, newObj.Status.Conditions) ||
+			common.IsObjectUpdateToUnready(oldObj.Status.Conditions, newObj.Status.Conditions)
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+var PredicateFuncsForServiceEndpoints = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldObj, oldOK := e.ObjectOld.(*v1alpha1.ServiceEndpoint)
+		newObj, newOK := e.ObjectNew.(*v1alpha1.ServiceEndpoint)
+		if !oldOK || !newOK {
+			return false
+		}
+		// The VPCEndpoints are requeued to consume or release the ServiceEndpoint when its allowed Namespaces are changed.
+		return meta.IsStatusConditionTrue(oldObj.Status.Conditions, conditionTypeReady) !=
+			meta.IsStatusConditionTrue(newObj.Status.Conditions, conditionTypeReady) ||
+			!sets.New(oldObj.Spec.AllowedNamespaces...).Equal(sets.New(newObj.Spec.AllowedNamespaces...))
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		// The VPCEndpoints consuming the deleted ServiceEndpoint must release their NSX VpcEndpoints.
+		return true
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+func requeueVPCEndpointByIPAllocation(ctx context.Context, c client.Client, _, objNew client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	ipAllocation := objNew.(*v1alpha1.IPAddressAllocation)
+	vpcEndpointList := &v1alpha1.VPCEndpointList{}
+	err := c.List(ctx, vpcEndpointList, client.InNamespace(ipAllocation.Namespace), client.MatchingFields{ipAllocationNameIndexKey: ipAllocation.Name})
+	if err != nil {
+		log.Error(err, "Failed to list VPCEndpoints with IPAddressAllocation for update event", "Namespace", ipAllocation.Namespace, "IPAddressAllocation", ipAllocation.Name)
+		return
+	}
+	requeueVPCEndpoints(vpcEndpointList.Items, "IPAddressAllocation", q)
+}
+
+func requeueVPCEndpointByServiceEndpoint(ctx context.Context, c client.Client, _, objNew client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	requeueVPCEndpointsWithServiceEndpoint(ctx, c, objNew.(*v1alpha1.ServiceEndpoint), "update", q)
+}
+
+func requeueVPCEndpointByServiceEndpointDelete(ctx context.Context, c client.Client, obj client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	requeueVPCEndpointsWithServiceEndpoint(ctx, c, obj.(*v1alpha1.ServiceEndpoint), "delete", q)
+}
+
+func requeueVPCEndpointsWithServiceEndpoint(ctx context.Context, c client.Client, serviceEndpoint *v1alpha1.ServiceEndpoint, eventType string, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	vpcEndpointList := &v1alpha1.VPCEndpointList{}
+	err := c.List(ctx, vpcEndpointList, client.MatchingFields{serviceEndpointNameIndexKey: types.NamespacedName{Namespace: serviceEndpoint.Namespace, Name: serviceEndpoint.Name}.String()})
+	if err != nil {
+		log.Error(err, "Failed to list VPCEndpoints with ServiceEndpoint", "event", eventType, "Namespace", serviceEndpoint.Namespace, "ServiceEndpoint", serviceEndpoint.Name)
+		return
+	}
+	requeueVPCEndpoints(vpcEndpointList.Items, "ServiceEndpoint", q)
+}
+
+func requeueVPCEndpoints(vpcEndpoints []v1alpha1.VPCEndpoint, dependency string, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	for _, vpcEndpoint := range vpcEndpoints {
+		log.Info("Requeue VPCEndpoint because the dependency realization state is changed", "Dependency", dependency, "Namespace", vpcEndpoint.Namespace, "Name", vpcEndpoint.Name)
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      vpcEndpoint.Name,
+				Namespace: vpcEndpoint.Namespace,
+			},
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+)
+
+func TestPredicateFuncsForIPAllocations(t *testing.T) {
+	unready := ipAllocation.DeepCopy()
+	unready.Status.Conditions = []v1alpha1.Condition{{Type: v1alpha1.Ready, Status: v1.ConditionFalse}}
+
+	assert.False(t, PredicateFuncsForIPAllocations.CreateFunc(event.CreateEvent{Object: ipAllocation}))
+	assert.True(t, PredicateFuncsForIPAllocations.UpdateFunc(event.UpdateEvent{ObjectOld: unready, ObjectNew: ipAllocation}))
+	assert.True(t, PredicateFuncsForIPAllocations.UpdateFunc(event.UpdateEvent{ObjectOld: ipAllocation, ObjectNew: unready}))
+	assert.False(t, PredicateFuncsForIPAllocations.UpdateFunc(event.UpdateEvent{ObjectOld: ipAllocation, ObjectNew: ipAllocation}))
+	assert.False(t, PredicateFuncsForIPAllocations.UpdateFunc(event.UpdateEvent{ObjectOld: vpcEndpoint, ObjectNew: ipAllocation}))
+	assert.False(t, PredicateFuncsForIPAllocations.DeleteFunc(event.DeleteEvent{Object: ipAllocation}))
+	assert.False(t, PredicateFuncsForIPAllocations.GenericFunc(event.GenericEvent{Object: ipAllocation}))
+}
+
+func TestPredicateFuncsForServiceEndpoints(t *testing.T) {
+	unready := serviceEndpoint.DeepCopy()
+	unready.Status.Conditions = []metav1.Condition{{Type: conditionTypeReady, Status: metav1.ConditionFalse}}
+
+	assert.False(t, PredicateFuncsForServiceEndpoints.CreateFunc(event.CreateEvent{Object: serviceEndpoint}))
+	assert.True(t, PredicateFuncsForServiceEndpoints.UpdateFunc(event.UpdateEvent{ObjectOld: unready, ObjectNew: serviceEndpoint}))
+	assert.True(t, PredicateFuncsForServiceEndpoints.UpdateFunc(event.UpdateEvent{ObjectOld: serviceEndpoint, ObjectNew: unready}))
+	assert.False(t, PredicateFuncsForServiceEndpoints.UpdateFunc(event.UpdateEvent{ObjectOld: serviceEndpoint, ObjectNew: serviceEndpoint}))
+	allowed := serviceEndpoint.DeepCopy()
+	allowed.Spec.AllowedNamespaces = []string{"ns-other", "ns-consumer"}
+	assert.True(t, PredicateFuncsForServiceEndpoints.UpdateFunc(event.UpdateEvent{ObjectOld: serviceEndpoint, ObjectNew: allowed}))
+	reordered := allowed.DeepCopy()
+	reordered.Spec.AllowedNamespaces = []string{"ns-consumer", "ns-other"}
+	assert.False(t, PredicateFuncsForServiceEndpoints.UpdateFunc(event.UpdateEvent{ObjectOld: allowed, ObjectNew: reordered}))
+	assert.True(t, PredicateFuncsForServiceEndpoints.DeleteFunc(event.DeleteEvent{Object: serviceEndpoint}))
+	assert.False(t, PredicateFuncsForServiceEndpoints.GenericFunc(event.GenericEvent{Object: serviceEndpoint}))
+}
+
+func newIndexedClient(objs ...client.Object) client.Client {
+	newScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(newScheme))
+	utilruntime.Must(v1alpha1.AddToScheme(newScheme))
+	return fake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithObjects(objs...).
+		WithIndex(&v1alpha1.VPCEndpoint{}, ipAllocationNameIndexKey, vpcEndpointIPAllocationNameIndexFunc).
+		WithIndex(&v1alpha1.VPCEndpoint{}, serviceEndpointNameIndexKey, vpcEndpointServiceEndpointNameIndexFunc).
+		Build()
+}
+
+func TestRequeueVPCEndpointByIPAllocation(t *testing.T) {
+	myQueue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer myQueue.ShutDown()
+	fakeClient := newIndexedClient(vpcEndpoint)
+
+	requeueVPCEndpointByIPAllocation(context.TODO(), fakeClient, ipAllocation, ipAllocation, myQueue)
+	require.Equal(t, 1, myQueue.Len())
+	item, _ := myQueue.Get()
+	assert.Equal(t, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns-consumer", Name: "vpcep-1"}}, item)
+	myQueue.Done(item)
+
+	otherAllocation := ipAllocation.DeepCopy()
+	otherAllocation.Namespace = "ns-other"
+	requeueVPCEndpointByIPAllocation(context.TODO(), fakeClient, otherAllocation, otherAllocation, myQueue)
+	require.Equal(t, 0, myQueue.Len())
+}
+
+func TestRequeueVPCEndpointByServiceEndpoint(t *testing.T) {
+	myQueue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer myQueue.ShutDown()
+	// The bare name refers to the ServiceEndpoint in the VPCEndpoint's own Namespace.
+	byBareName := vpcEndpoint.DeepCopy()
+	byBareName.Name = "vpcep-2"
+	byBareName.Spec.ServiceEndpointName = "sep-1"
+	byBareNameInProvider := byBareName.DeepCopy()
+	byBareNameInProvider.Namespace = "ns-provider"
+	fakeClient := newIndexedClient(vpcEndpoint, byBareName, byBareNameInProvider)
+
+	requeueVPCEndpointByServiceEndpoint(context.TODO(), fakeClient, serviceEndpoint, serviceEndpoint, myQueue)
+	require.Equal(t, 2, myQueue.Len())
+	requeued := sets.New[types.NamespacedName]()
+	for myQueue.Len() > 0 {
+		item, _ := myQueue.Get()
+		requeued.Insert(item.NamespacedName)
+		myQueue.Done(item)
+		myQueue.Forget(item)
+	}
+	assert.Equal(t, sets.New(types.NamespacedName{Namespace: "ns-consumer", Name: "vpcep-1"}, types.NamespacedName{Namespace: "ns-provider", Name: "vpcep-2"}), requeued)
+
+	otherServiceEndpoint := serviceEndpoint.DeepCopy()
+	otherServiceEndpoint.Name = "sep-2"
+	requeueVPCEndpointByServiceEndpoint(context.TODO(), fakeClient, otherServiceEndpoint, otherServiceEndpoint, myQueue)
+	require.Equal(t, 0, myQueue.Len())
+}
+
+func TestRequeueVPCEndpointByServiceEndpointDelete(t *testing.T) {
+	myQueue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer myQueue.ShutDown()
+	fakeClient := newIndexedClient(vpcEndpoint)
+
+	requeueVPCEndpointByServiceEndpointDelete(context.TODO(), fakeClient, serviceEndpoint, myQueue)
+	require.Equal(t, 1, myQueue.Len())
+	item, _ := myQueue.Get()
+	assert.Equal(t, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns-consumer", Name: "vpcep-1"}}, item)
+	myQueue.Done(item)
+}
@@ -0,0 +1,466 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpcendpoint"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+)
+
+var (
+	log = logger.Log
+)
+
+const (
+	conditionTypeReady      = "Ready"
+	reasonVPCEndpointReady  = "VPCEndpointReady"
+	reasonVPCEndpointFailed = "VPCEndpointFailed"
+
+	ipAllocationNameIndexKey    = "spec.ipAllocationName"
+	serviceEndpointNameIndexKey = "spec.serviceEndpointName"
+)
+
+// errServiceEndpointNotAllowed is returned when a ServiceEndpoint doesn't allow the Namespace of the VPCEndpoint.
+var errServiceEndpointNotAllowed = errors.New("namespace not allowed")
+
+type errorWithRetry struct {
+	error
+	retry   bool
+	message string
+}
+
+// Reconciler reconciles a VPCEndpoint object
+type Reconciler struct {
+	Client             client.Client
+	Scheme             *runtime.Scheme
+	VPCEndpointService *vpcendpoint.VPCEndpointService
+	StatusUpdater      common.StatusUpdater
+}
+
+func NewReconciler(mgr ctrl.Manager, vpcEndpointService *vpcendpoint.VPCEndpointService) *Reconciler {
+	recorder := mgr.GetEventRecorderFor("vpcendpoint-controller") //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	return &Reconciler{
+		Client:             mgr.GetClient(),
+		Scheme:             mgr.GetScheme(),
+		VPCEndpointService: vpcEndpointService,
+		StatusUpdater:      common.NewStatusUpdater(mgr.GetClient(), vpcEndpointService.NSXConfig, recorder, common.MetricResTypeVPCEndpoint, "VpcEndpoint", "VPCEndpoint"),
+	}
+}
+
+func (r *Reconciler) StartController(mgr ctrl.Manager, hookServer webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "VPCEndpoint")
+		return err
+	}
+	if err := r.SetupFieldIndexers(mgr); err != nil {
+		log.Error(err, "Failed to setup field indexers", "controller", "VPCEndpoint")
+		return err
+	}
+	if hookServer != nil {
+		hookServer.Register("/validate-crd-nsx-vmware-com-v1alpha1-vpcendpoint",
+			&webhook.Admission{
+				Handler: &VPCEndpointValidator{
+					Client:  mgr.GetClient(),
+					decoder: admission.NewDecoder(mgr.GetScheme()),
+				},
+			})
+	}
+	go common.GenericGarbageCollector(make(chan bool), servicecommon.GCInterval, r.CollectGarbage)
+	return nil
+}
+
+// SetupFieldIndexers sets up the field indexers for VPCEndpoint
+func (r *Reconciler) SetupFieldIndexers(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &v1alpha1.VPCEndpoint{}, ipAllocationNameIndexKey, vpcEndpointIPAllocationNameIndexFunc); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &v1alpha1.VPCEndpoint{}, serviceEndpointNameIndexKey, vpcEndpointServiceEndpointNameIndexFunc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// vpcEndpointIPAllocationNameIndexFunc is an index function that indexes VPCEndpoint by IPAddressAllocation name
+func vpcEndpointIPAllocationNameIndexFunc(obj client.Object) []string {
+	vpcEndpoint, ok := obj.(*v1alpha1.VPCEndpoint)
+	if !ok {
+		log.Info("Invalid object", "type", reflect.TypeOf(obj))
+		return []string{}
+	}
+	if vpcEndpoint.Spec.IPAllocationName == "" {
+		return []string{}
+	}
+	return []string{vpcEndpoint.Spec.IPAllocationName}
+}
+
+// vpcEndpointServiceEndpointNameIndexFunc is an index function that indexes VPCEndpoint by the "<namespace>/<name>" of the ServiceEndpoint
+func vpcEndpointServiceEndpointNameIndexFunc(obj client.Object) []string {
+	vpcEndpoint, ok := obj.(*v1alpha1.VPCEndpoint)
+	if !ok {
+		log.Info("Invalid object", "type", reflect.TypeOf(obj))
+		return []string{}
+	}
+	if vpcEndpoint.Spec.ServiceEndpointName == "" {
+		return []string{}
+	}
+	return []string{getServiceEndpointKey(vpcEndpoint).String()}
+}
+
+// getServiceEndpointKey returns the NamespacedName of the ServiceEndpoint referred by the VPCEndpoint. The reference
+// without the Namespace points to the ServiceEndpoint in the same Namespace as the VPCEndpoint.
+func getServiceEndpointKey(vpcEndpoint *v1alpha1.VPCEndpoint) types.NamespacedName {
+	if namespace, name, found := strings.Cut(vpcEndpoint.Spec.ServiceEndpointName, "/"); found {
+		return types.NamespacedName{Namespace: namespace, Name: name}
+	}
+	return types.NamespacedName{Namespace: vpcEndpoint.Namespace, Name: vpcEndpoint.Spec.ServiceEndpointName}
+}
+
+// isNamespaceAllowed returns true if the VPCEndpoints in the Namespace are allowed to consume the ServiceEndpoint,
+// which is the case for its own Namespace and the Namespaces listed in its AllowedNamespaces.
+func isNamespaceAllowed(serviceEndpoint *v1alpha1.ServiceEndpoint, namespace string) bool {
+	return serviceEndpoint.Namespace == namespace || slices.Contains(serviceEndpoint.Spec.AllowedNamespaces, namespace)
+}
+
+func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.VPCEndpoint{}).
+		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.NumReconcile(),
+		}).
+		Watches(
+			&v1alpha1.IPAddressAllocation{},
+			&common.EnqueueRequestForDependency{
+				Client:          r.Client,
+				RequeueByUpdate: requeueVPCEndpointByIPAllocation,
+				ResourceType:    "IPAddressAllocation",
+			},
+			builder.WithPredicates(PredicateFuncsForIPAllocations),
+		).
+		Watches(
+			&v1alpha1.ServiceEndpoint{},
+			&common.EnqueueRequestForDependency{
+				Client:          r.Client,
+				RequeueByUpdate: requeueVPCEndpointByServiceEndpoint,
+				RequeueByDelete: requeueVPCEndpointByServiceEndpointDelete,
+				ResourceType:    "ServiceEndpoint",
+			},
+			builder.WithPredicates(PredicateFuncsForServiceEndpoints),
+		).
+		Complete(tracing.NewReconciler("VPCEndpoint", r))
+}
+
+// RestoreReconcile recreates the NSX VpcEndpoints for the realized VPCEndpoint CRs after NSX is restored.
+func (r *Reconciler) RestoreReconcile() error {
+	if !r.VPCEndpointService.NSXClient.NSXCheckVersion(nsx.VPCEndpoint) {
+		return nil
+	}
+	restoreList, err := r.getRestoreList()
+	if err != nil {
+		return fmt.Errorf("failed to get VPCEndpoint restore list: %w", err)
+	}
+	var errorList []error
+	for _, key := range restoreList {
+		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+		if err != nil || common.IsReconcileResultRequeue(result) {
+			errorList = append(errorList, fmt.Errorf("failed to restore VPCEndpoint %s, error: %w", key, err))
+		}
+	}
+	if len(errorList) > 0 {
+		return fmt.Errorf("errors found in VPCEndpoint restore: %v", errorList)
+	}
+	return nil
+}
+
+func (r *Reconciler) getRestoreList() ([]types.NamespacedName, error) {
+	restoreList := []types.NamespacedName{}
+	vpcEndpointList := &v1alpha1.VPCEndpointList{}
+	if err := r.Client.List(context.TODO(), vpcEndpointList); err != nil {
+		return restoreList, err
+	}
+	nsxVPCEndpointCRUIDs := r.VPCEndpointService.ListVPCEndpointCRUIDsInStore()
+	for _, vpcEndpoint := range vpcEndpointList.Items {
+		// Restore the VPCEndpoint which has been realized before but is missing on NSX
+		if meta.IsStatusConditionTrue(vpcEndpoint.Status.Conditions, conditionTypeReady) && !nsxVPCEndpointCRUIDs.Has(string(vpcEndpoint.UID)) {
+			restoreList = append(restoreList, types.NamespacedName{Namespace: vpcEndpoint.Namespace, Name: vpcEndpoint.Name})
+		}
+	}
+	return restoreList, nil
+}
+
+func (r *Reconciler) setNotSupported(ctx context.Context, req ctrl.Request) error {
+	vpcEndpointCR := &v1alpha1.VPCEndpoint{}
+	if err := r.Client.Get(ctx, req.NamespacedName, vpcEndpointCR); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		log.Error(err, "Unable to fetch VPCEndpoint CR", "VPCEndpoint", req.NamespacedName)
+		return err
+	}
+	r.StatusUpdater.UpdateFail(ctx, vpcEndpointCR, fmt.Errorf("NSX VPC endpoint is not supported"), "NSX VPC endpoint is not supported", setReadyStatusFalse)
+	return nil
+}
+
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=vpcendpoints,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=vpcendpoints/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=serviceendpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=ipaddressallocations,verbs=get;list;watch
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling VPCEndpoint", "VPCEndpoint", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+		r.StatusUpdater.ObserveReconcileDuration(startTime)
+	}()
+	r.StatusUpdater.IncreaseSyncTotal()
+
+	if !r.VPCEndpointService.NSXClient.NSXCheckVersion(nsx.VPCEndpoint) {
+		log.Warn("NSX VPC endpoint is not supported", "VPCEndpoint", req.NamespacedName)
+		if err := r.setNotSupported(ctx, req); err != nil {
+			return common.ResultRequeue, nil
+		}
+		return common.ResultNormal, nil
+	}
+
+	vpcEndpointCR := &v1alpha1.VPCEndpoint{}
+	if err := r.Client.Get(ctx, req.NamespacedName, vpcEndpointCR); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.StatusUpdater.IncreaseDeleteTotal()
+			if err := r.VPCEndpointService.DeleteVPCEndpointByCRName(ctx, req.Namespace, req.Name); err != nil {
+				log.Error(err, "Failed to delete NSX VpcEndpoint", "VPCEndpoint", req.NamespacedName)
+				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
+				return common.ResultRequeue, nil
+			}
+			r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
+			return common.ResultNormal, nil
+		}
+		log.Error(err, "Unable to fetch VPCEndpoint CR", "VPCEndpoint", req.NamespacedName)
+		return common.ResultRequeue, nil
+	}
+
+	if !vpcEndpointCR.ObjectMeta.DeletionTimestamp.IsZero() {
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err := r.VPCEndpointService.DeleteVPCEndpointByCRId(ctx, string(vpcEndpointCR.UID)); err != nil {
+			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
+			return common.ResultRequeue, nil
+		}
+		r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
+		return common.ResultNormal, nil
+	}
+
+	r.StatusUpdater.IncreaseUpdateTotal()
+	// The IPAddressAllocation and the ServiceEndpoint must be realized before creating the NSX VpcEndpoint
+	ipAllocationCR, validateErr := r.validateIPAllocation(ctx, req.Namespace, vpcEndpointCR.Spec.IPAllocationName)
+	var serviceEndpointCR *v1alpha1.ServiceEndpoint
+	if validateErr == nil {
+		serviceEndpointCR, validateErr = r.validateServiceEndpoint(ctx, vpcEndpointCR)
+	}
+	if validateErr != nil {
+		// The NSX VpcEndpoint consuming a deleted ServiceEndpoint is removed, otherwise it refers to a stale
+		// NSX VpcServiceEndpoint and blocks its deletion. The VPCEndpoint is recreated once the ServiceEndpoint
+		// is realized again. It is also removed once the ServiceEndpoint no longer allows the Namespace.
+		if ipAllocationCR != nil && (apierrors.IsNotFound(validateErr.error) || errors.Is(validateErr.error, errServiceEndpointNotAllowed)) {
+			if err := r.VPCEndpointService.DeleteVPCEndpointByCRId(ctx, string(vpcEndpointCR.UID)); err != nil {
+				r.StatusUpdater.UpdateFail(ctx, vpcEndpointCR, err, "Failed to delete NSX VpcEndpoint for the deleted ServiceEndpoint", setReadyStatusFalse)
+				return common.ResultRequeue, nil
+			}
+		}
+		r.StatusUpdater.UpdateFail(ctx, vpcEndpointCR, validateErr.error, validateErr.message, setReadyStatusFalse, validateErr.message)
+		if validateErr.retry {
+			return common.ResultRequeue, nil
+		}
+		return common.ResultNormal, nil
+	}
+
+	if _, err := r.VPCEndpointService.CreateOrUpdateVPCEndpoint(ctx, vpcEndpointCR, ipAllocationCR, serviceEndpointCR); err != nil {
+		r.StatusUpdater.UpdateFail(ctx, vpcEndpointCR, err, "Failed to create or update NSX VpcEndpoint", setReadyStatusFalse)
+		return common.ResultRequeue, nil
+	}
+	r.StatusUpdater.UpdateSuccess(ctx, vpcEndpointCR, setReadyStatusTrue)
+	return common.ResultNormal, nil
+}
+
+func (r *Reconciler) validateIPAllocation(ctx context.Context, ns, name string) (*v1alpha1.IPAddressAllocation, *errorWithRetry) {
+	ipAllocationCR := &v1alpha1.IPAddressAllocation{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, ipAllocationCR); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Not requeue the VPCEndpoint if the IPAddressAllocation is not created,
+			// it will be requeued once the IPAddressAllocation is realized.
+			log.Debug("IPAddressAllocation CR does not exist", "Namespace", ns, "IPAddressAllocation", name)
+			return nil, &errorWithRetry{
+				error:   err,
+				retry:   false,
+				message: fmt.Sprintf("IPAddressAllocation %s is not found", name),
+			}
+		}
+		return nil, &errorWithRetry{
+			error:   err,
+			retry:   true,
+			message: "failed to get IPAddressAllocation",
+		}
+	}
+	for _, con := range ipAllocationCR.Status.Conditions {
+		if con.Type == v1alpha1.Ready && con.Status == v1.ConditionTrue {
+			return ipAllocationCR, nil
+		}
+	}
+	log.Debug("IPAddressAllocation is not realized", "Namespace", ns, "IPAddressAllocation", name)
+	return nil, &errorWithRetry{
+		error:   fmt.Errorf("IPAddressAllocation %s is not realized", name),
+		retry:   false,
+		message: fmt.Sprintf("IPAddressAllocation %s is not realized", name),
+	}
+}
+
+func (r *Reconciler) validateServiceEndpoint(ctx context.Context, vpcEndpointCR *v1alpha1.VPCEndpoint) (*v1alpha1.ServiceEndpoint, *errorWithRetry) {
+	key := getServiceEndpointKey(vpcEndpointCR)
+	serviceEndpointCR := &v1alpha1.ServiceEndpoint{}
+	if err := r.Client.Get(ctx, key, serviceEndpointCR); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Not requeue the VPCEndpoint if the ServiceEndpoint is not created,
+			// it will be requeued once the ServiceEndpoint is realized.
+			log.Debug("ServiceEndpoint CR does not exist", "ServiceEndpoint", key)
+			return nil, &errorWithRetry{
+				error:   err,
+				retry:   false,
+				message: fmt.Sprintf("ServiceEndpoint %s is not found", key),
+			}
+		}
+		return nil, &errorWithRetry{
+			error:   err,
+			retry:   true,
+			message: "failed to get ServiceEndpoint",
+		}
+	}
+	// Not requeue the VPCEndpoint if the Namespace is not allowed, it will be requeued once
+	// the AllowedNamespaces of the ServiceEndpoint is updated.
+	if !isNamespaceAllowed(serviceEndpointCR, vpcEndpointCR.Namespace) {
+		log.Debug("ServiceEndpoint does not allow the Namespace", "ServiceEndpoint", key, "Namespace", vpcEndpointCR.Namespace)
+		return nil, &errorWithRetry{
+			error:   fmt.Errorf("ServiceEndpoint %s does not allow VPCEndpoints in Namespace %s: %w", key, vpcEndpointCR.Namespace, errServiceEndpointNotAllowed),
+			retry:   false,
+			message: fmt.Sprintf("ServiceEndpoint %s does not allow VPCEndpoints in Namespace %s", key, vpcEndpointCR.Namespace),
+		}
+	}
+	if !meta.IsStatusConditionTrue(serviceEndpointCR.Status.Conditions, conditionTypeReady) {
+		log.Debug("ServiceEndpoint is not realized", "ServiceEndpoint", key)
+		return nil, &errorWithRetry{
+			error:   fmt.Errorf("ServiceEndpoint %s is not realized", key),
+			retry:   false,
+			message: fmt.Sprintf("ServiceEndpoint %s is not realized", key),
+		}
+	}
+	return serviceEndpointCR, nil
+}
+
+func setReadyStatusTrue(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, _ ...interface{}) {
+	vpcEndpointCR := obj.(*v1alpha1.VPCEndpoint)
+	updateReadyCondition(client, ctx, vpcEndpointCR, metav1.Condition{
+		Type:               conditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonVPCEndpointReady,
+		Message:            "NSX VpcEndpoint has been successfully created/updated",
+		LastTransitionTime: transitionTime,
+	})
+}
+
+func setReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, args ...interface{}) {
+	vpcEndpointCR := obj.(*v1alpha1.VPCEndpoint)
+	condition := metav1.Condition{
+		Type:               conditionTypeReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reasonVPCEndpointFailed,
+		Message:            "NSX VpcEndpoint could not be created/updated",
+		LastTransitionTime: transitionTime,
+	}
+	if len(args) > 0 {
+		condition.Message = args[0].(string)
+	} else if err != nil {
+		condition.Message = fmt.Sprintf("Error occurred while processing the VPCEndpoint CR. Please check the config and try again. Error: %v", err)
+	}
+	updateReadyCondition(client, ctx, vpcEndpointCR, condition)
+}
+
+func updateReadyCondition(client client.Client, ctx context.Context, vpcEndpoint *v1alpha1.VPCEndpoint, condition metav1.Condition) {
+	condition.ObservedGeneration = vpcEndpoint.Generation
+	if !meta.SetStatusCondition(&vpcEndpoint.Status.Conditions, condition) {
+		log.Trace("Conditions already match", "VPCEndpoint", vpcEndpoint.Name, "Condition", condition)
+		return
+	}
+	if err := client.Status().Update(ctx, vpcEndpoint); err != nil {
+		log.Error(err, "Failed to update VPCEndpoint status", "Name", vpcEndpoint.Name, "Namespace", vpcEndpoint.Namespace)
+		return
+	}
+	log.Info("Updated VPCEndpoint", "Name", vpcEndpoint.Name, "Namespace", vpcEndpoint.Namespace, "Status", vpcEndpoint.Status)
+}
+
+// CollectGarbage collects the stale NSX VpcEndpoints whose VPCEndpoint CRs have been removed from K8s.
+// It implements the interface GarbageCollector method.
+func (r *Reconciler) CollectGarbage(ctx context.Context) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("VPCEndpoint garbage collection completed", "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	crUIDs, err := r.listVPCEndpointUIDsFromCRs(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list VPCEndpoint CRs")
+		return err
+	}
+	nsxCRUIDs := r.VPCEndpointService.ListVPCEndpointCRUIDsInStore()
+
+	var errList []error
+	for uid := range nsxCRUIDs.Difference(crUIDs) {
+		log.Info("GC collected VPCEndpoint CR", "UID", uid)
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err = r.VPCEndpointService.DeleteVPCEndpointByCRId(ctx, uid); err != nil {
+			errList = append(errList, err)
+			r.StatusUpdater.IncreaseDeleteFailTotal()
+		} else {
+			r.StatusUpdater.IncreaseDeleteSuccessTotal()
+		}
+	}
+	if len(errList) > 0 {
+		return fmt.Errorf("errors found in VPCEndpoint garbage collection: %s", errList)
+	}
+	return nil
+}
+
+func (r *Reconciler) listVPCEndpointUIDsFromCRs(ctx context.Context) (sets.Set[string], error) {
+	crUIDs := sets.New[string]()
+	vpcEndpointList := &v1alpha1.VPCEndpointList{}
+	if err := r.Client.List(ctx, vpcEndpointList); err != nil {
+		return nil, err
+	}
+	for _, vpcEndpoint := range vpcEndpointList.Items {
+		crUIDs.Insert(string(vpcEndpoint.UID))
+	}
+	return crUIDs, nil
+}
@@ -0,0 +1,420 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpcendpoint"
+)
+
+var (
+	readyCondition = []metav1.Condition{{Type: conditionTypeReady, Status: metav1.ConditionTrue, Reason: "ServiceEndpointReady"}}
+
+	ipAllocation = &v1alpha1.IPAddressAllocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "ipa-1", Namespace: "ns-consumer"},
+		Status: v1alpha1.IPAddressAllocationStatus{
+			Conditions: []v1alpha1.Condition{{Type: v1alpha1.Ready, Status: v1.ConditionTrue}},
+		},
+	}
+	serviceEndpoint = &v1alpha1.ServiceEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "sep-1", Namespace: "ns-provider", UID: "sep-1-uid"},
+		Spec:       v1alpha1.ServiceEndpointSpec{AllowedNamespaces: []string{"ns-consumer"}},
+		Status:     v1alpha1.ServiceEndpointStatus{Conditions: readyCondition},
+	}
+	vpcEndpoint = &v1alpha1.VPCEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "vpcep-1", Namespace: "ns-consumer", UID: "vpcep-1-uid", Generation: 3},
+		Spec: v1alpha1.VPCEndpointSpec{
+			ServiceEndpointName: "ns-provider/sep-1",
+			IPAllocationName:    "ipa-1",
+		},
+	}
+)
+
+func TestReconciler_GetRestoreList(t *testing.T) {
+	realized := vpcEndpoint.DeepCopy()
+	realized.Status.Conditions = readyCondition
+	inStore := realized.DeepCopy()
+	inStore.Name, inStore.UID = "vpcep-2", "vpcep-2-uid"
+	r := createFakeReconciler(realized, inStore, &v1alpha1.VPCEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "vpcep-3", Namespace: "ns-consumer", UID: "vpcep-3-uid"},
+	})
+	require.NoError(t, r.VPCEndpointService.VPCEndpointStore.Apply(&model.VpcEndpoint{
+		Id:   servicecommon.String("vpcep-2-id"),
+		Tags: []model.Tag{{Scope: servicecommon.String(servicecommon.TagScopeVPCEndpointCRUID), Tag: servicecommon.String("vpcep-2-uid")}},
+	}))
+
+	list, err := r.getRestoreList()
+	require.NoError(t, err)
+	assert.Equal(t, []types.NamespacedName{{Namespace: "ns-consumer", Name: "vpcep-1"}}, list)
+}
+
+func TestReconciler_RestoreReconcile(t *testing.T) {
+	realized := vpcEndpoint.DeepCopy()
+	realized.Status.Conditions = readyCondition
+	r := createFakeReconciler(realized, ipAllocation, serviceEndpoint)
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+		return true
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "CreateOrUpdateVPCEndpoint", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, _ *v1alpha1.VPCEndpoint, _ *v1alpha1.IPAddressAllocation, _ *v1alpha1.ServiceEndpoint) (*model.VpcEndpoint, error) {
+		return &model.VpcEndpoint{}, nil
+	})
+	assert.NoError(t, r.RestoreReconcile())
+
+	patches.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "CreateOrUpdateVPCEndpoint", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, _ *v1alpha1.VPCEndpoint, _ *v1alpha1.IPAddressAllocation, _ *v1alpha1.ServiceEndpoint) (*model.VpcEndpoint, error) {
+		return nil, fmt.Errorf("mocked create error")
+	})
+	assert.ErrorContains(t, r.RestoreReconcile(), "errors found in VPCEndpoint restore")
+}
+
+func TestReconciler_StartController(t *testing.T) {
+	mockMgr := &MockManager{scheme: runtime.NewScheme()}
+	patches := gomonkey.ApplyFunc((*Reconciler).setupWithManager, func(r *Reconciler, mgr manager.Manager) error {
+		return nil
+	})
+	patches.ApplyFunc(common.GenericGarbageCollector, func(cancel chan bool, timeout time.Duration, f func(ctx context.Context) error) {})
+	patches.ApplyFunc((*Reconciler).SetupFieldIndexers, func(r *Reconciler, mgr manager.Manager) error {
+		return nil
+	})
+	defer patches.Reset()
+	r := createFakeReconciler()
+	assert.NoError(t, r.StartController(mockMgr, nil))
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns-consumer", Name: "vpcep-1"}}
+	unrealizedAllocation := ipAllocation.DeepCopy()
+	unrealizedAllocation.Status.Conditions = nil
+	deleting := vpcEndpoint.DeepCopy()
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deleting.Finalizers = []string{"test"}
+	notAllowed := serviceEndpoint.DeepCopy()
+	notAllowed.Spec.AllowedNamespaces = []string{"ns-other"}
+
+	tests := []struct {
+		name            string
+		objects         []client.Object
+		supported       bool
+		preparedFunc    func(r *Reconciler) *gomonkey.Patches
+		expectedResult  ctrl.Result
+		expectedStatus  metav1.ConditionStatus
+		expectedMessage string
+	}{
+		{
+			name:            "VPCEndpoint not supported",
+			objects:         []client.Object{vpcEndpoint},
+			expectedResult:  common.ResultNormal,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedMessage: "NSX VPC endpoint is not supported",
+		},
+		{
+			name:      "VPCEndpoint deleted",
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteVPCEndpointByCRName", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, ns, name string) error {
+					assert.Equal(t, "ns-consumer", ns)
+					assert.Equal(t, "vpcep-1", name)
+					return nil
+				})
+			},
+			expectedResult: common.ResultNormal,
+		},
+		{
+			name:      "VPCEndpoint deleted with NSX deletion error",
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteVPCEndpointByCRName", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, _, _ string) error {
+					return fmt.Errorf("mocked delete error")
+				})
+			},
+			expectedResult: common.ResultRequeue,
+		},
+		{
+			name:      "VPCEndpoint being deleted",
+			objects:   []client.Object{deleting},
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteVPCEndpointByCRId", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, id string) error {
+					assert.Equal(t, "vpcep-1-uid", id)
+					return nil
+				})
+			},
+			expectedResult: common.ResultNormal,
+		},
+		{
+			name:            "IPAddressAllocation not found",
+			objects:         []client.Object{vpcEndpoint, serviceEndpoint},
+			supported:       true,
+			expectedResult:  common.ResultNormal,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedMessage: "IPAddressAllocation ipa-1 is not found",
+		},
+		{
+			name:            "IPAddressAllocation not realized",
+			objects:         []client.Object{vpcEndpoint, unrealizedAllocation, serviceEndpoint},
+			supported:       true,
+			expectedResult:  common.ResultNormal,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedMessage: "IPAddressAllocation ipa-1 is not realized",
+		},
+		{
+			name:      "ServiceEndpoint not found",
+			objects:   []client.Object{vpcEndpoint, ipAllocation},
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteVPCEndpointByCRId", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, id string) error {
+					assert.Equal(t, "vpcep-1-uid", id)
+					return nil
+				})
+			},
+			expectedResult:  common.ResultNormal,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedMessage: "ServiceEndpoint ns-provider/sep-1 is not found",
+		},
+		{
+			name:      "ServiceEndpoint deleted with NSX VpcEndpoint deletion error",
+			objects:   []client.Object{vpcEndpoint, ipAllocation},
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteVPCEndpointByCRId", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, _ string) error {
+					return fmt.Errorf("mocked delete error")
+				})
+			},
+			expectedResult: common.ResultRequeue,
+			expectedStatus: metav1.ConditionFalse,
+		},
+		{
+			name:      "ServiceEndpoint not allowing the Namespace",
+			objects:   []client.Object{vpcEndpoint, ipAllocation, notAllowed},
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteVPCEndpointByCRId", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, id string) error {
+					assert.Equal(t, "vpcep-1-uid", id)
+					return nil
+				})
+			},
+			expectedResult:  common.ResultNormal,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedMessage: "ServiceEndpoint ns-provider/sep-1 does not allow VPCEndpoints in Namespace ns-consumer",
+		},
+		{
+			name:      "NSX VpcEndpoint creation error",
+			objects:   []client.Object{vpcEndpoint, ipAllocation, serviceEndpoint},
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "CreateOrUpdateVPCEndpoint", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, _ *v1alpha1.VPCEndpoint, _ *v1alpha1.IPAddressAllocation, _ *v1alpha1.ServiceEndpoint) (*model.VpcEndpoint, error) {
+					return nil, fmt.Errorf("mocked create error")
+				})
+			},
+			expectedResult: common.ResultRequeue,
+			expectedStatus: metav1.ConditionFalse,
+		},
+		{
+			name:      "NSX VpcEndpoint created",
+			objects:   []client.Object{vpcEndpoint, ipAllocation, serviceEndpoint},
+			supported: true,
+			preparedFunc: func(r *Reconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "CreateOrUpdateVPCEndpoint", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, obj *v1alpha1.VPCEndpoint, allocation *v1alpha1.IPAddressAllocation, sep *v1alpha1.ServiceEndpoint) (*model.VpcEndpoint, error) {
+					assert.Equal(t, "ipa-1", allocation.Name)
+					assert.Equal(t, "sep-1-uid", string(sep.UID))
+					return &model.VpcEndpoint{}, nil
+				})
+			},
+			expectedResult: common.ResultNormal,
+			expectedStatus: metav1.ConditionTrue,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := createFakeReconciler(tc.objects...)
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+				return tc.supported
+			})
+			defer patches.Reset()
+			if tc.preparedFunc != nil {
+				defer tc.preparedFunc(r).Reset()
+			}
+			result, err := r.Reconcile(context.TODO(), request)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, result)
+			if tc.expectedStatus != "" {
+				updated := &v1alpha1.VPCEndpoint{}
+				require.NoError(t, r.Client.Get(context.TODO(), request.NamespacedName, updated))
+				cond := meta.FindStatusCondition(updated.Status.Conditions, conditionTypeReady)
+				require.NotNil(t, cond)
+				assert.Equal(t, tc.expectedStatus, cond.Status)
+				assert.Equal(t, int64(3), cond.ObservedGeneration)
+				if tc.expectedMessage != "" {
+					assert.Contains(t, cond.Message, tc.expectedMessage)
+				}
+			}
+		})
+	}
+}
+
+func TestReconciler_validateServiceEndpoint(t *testing.T) {
+	unrealized := serviceEndpoint.DeepCopy()
+	unrealized.Namespace = "ns-other"
+	unrealized.Status.Conditions = nil
+	sameNamespace := serviceEndpoint.DeepCopy()
+	sameNamespace.Namespace = "ns-consumer"
+	sameNamespace.Spec.AllowedNamespaces = nil
+	notAllowed := serviceEndpoint.DeepCopy()
+	notAllowed.Namespace = "ns-other"
+	notAllowed.Spec.AllowedNamespaces = nil
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		ref         string
+		expectedErr string
+		expectedNS  string
+	}{
+		{name: "Namespaced reference", objects: []client.Object{serviceEndpoint, sameNamespace}, ref: "ns-provider/sep-1", expectedNS: "ns-provider"},
+		{name: "Bare name reference", objects: []client.Object{serviceEndpoint, sameNamespace}, ref: "sep-1", expectedNS: "ns-consumer"},
+		{name: "Bare name in other Namespace", objects: []client.Object{serviceEndpoint}, ref: "sep-1", expectedErr: "ServiceEndpoint ns-consumer/sep-1 is not found"},
+		{name: "ServiceEndpoint not realized", objects: []client.Object{unrealized}, ref: "ns-other/sep-1", expectedErr: "ServiceEndpoint ns-other/sep-1 is not realized"},
+		{name: "Namespace not allowed", objects: []client.Object{notAllowed}, ref: "ns-other/sep-1", expectedErr: "ServiceEndpoint ns-other/sep-1 does not allow VPCEndpoints in Namespace ns-consumer"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := createFakeReconciler(tc.objects...)
+			obj := vpcEndpoint.DeepCopy()
+			obj.Spec.ServiceEndpointName = tc.ref
+			sep, validateErr := r.validateServiceEndpoint(context.TODO(), obj)
+			if tc.expectedErr != "" {
+				require.NotNil(t, validateErr)
+				assert.False(t, validateErr.retry)
+				assert.Contains(t, validateErr.message, tc.expectedErr)
+				return
+			}
+			require.Nil(t, validateErr)
+			assert.Equal(t, tc.expectedNS, sep.Namespace)
+		})
+	}
+}
+
+func TestReconciler_CollectGarbage(t *testing.T) {
+	r := createFakeReconciler(vpcEndpoint)
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "ListVPCEndpointCRUIDsInStore", func(_ *vpcendpoint.VPCEndpointService) sets.Set[string] {
+		return sets.New("vpcep-1-uid", "vpcep-2-uid")
+	})
+	defer patches.Reset()
+	deleteErr := fmt.Errorf("mocked deletion error")
+	patches.ApplyMethod(reflect.TypeOf(r.VPCEndpointService), "DeleteVPCEndpointByCRId", func(_ *vpcendpoint.VPCEndpointService, _ context.Context, id string) error {
+		assert.Equal(t, "vpcep-2-uid", id)
+		return deleteErr
+	})
+	assert.ErrorContains(t, r.CollectGarbage(context.TODO()), "mocked deletion error")
+
+	deleteErr = nil
+	assert.NoError(t, r.CollectGarbage(context.TODO()))
+}
+
+func TestIndexFuncs(t *testing.T) {
+	assert.Equal(t, []string{"ipa-1"}, vpcEndpointIPAllocationNameIndexFunc(vpcEndpoint))
+	assert.Equal(t, []string{"ns-provider/sep-1"}, vpcEndpointServiceEndpointNameIndexFunc(vpcEndpoint))
+	byBareName := vpcEndpoint.DeepCopy()
+	byBareName.Spec.ServiceEndpointName = "sep-1"
+	assert.Equal(t, []string{"ns-consumer/sep-1"}, vpcEndpointServiceEndpointNameIndexFunc(byBareName))
+	assert.Empty(t, vpcEndpointIPAllocationNameIndexFunc(&v1alpha1.VPCEndpoint{}))
+	assert.Empty(t, vpcEndpointServiceEndpointNameIndexFunc(&v1alpha1.Subnet{}))
+}
+
+func createFakeReconciler(objs ...client.Object) *Reconciler {
+	mgr := newMockManager(objs...)
+	svc := servicecommon.Service{
+		Client:    mgr.GetClient(),
+		NSXClient: &nsx.Client{},
+		NSXConfig: &config.NSXOperatorConfig{
+			NsxConfig: &config.NsxConfig{
+				EnforcementPoint: "vmc-enforcementpoint",
+			},
+		},
+	}
+	vpcEndpointService := &vpcendpoint.VPCEndpointService{
+		Service:              svc,
+		VPCEndpointStore:     vpcendpoint.SetupVPCEndpointStore(),
+		ServiceEndpointStore: vpcendpoint.SetupServiceEndpointStore(),
+	}
+	return NewReconciler(mgr, vpcEndpointService)
+}
+
+func newMockManager(objs ...client.Object) ctrl.Manager {
+	newScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(newScheme))
+	utilruntime.Must(v1alpha1.AddToScheme(newScheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(newScheme).WithObjects(objs...).WithStatusSubresource(&v1alpha1.VPCEndpoint{}).Build()
+	return &MockManager{
+		client:   fakeClient,
+		scheme:   newScheme,
+		recorder: &fakeRecorder{},
+	}
+}
+
+type MockManager struct {
+	ctrl.Manager
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+func (m *MockManager) GetClient() client.Client {
+	return m.client
+}
+
+func (m *MockManager) GetScheme() *runtime.Scheme {
+	return m.scheme
+}
+
+func (m *MockManager) GetEventRecorderFor(name string) record.EventRecorder {
+	return m.recorder
+}
+
+func (m *MockManager) Add(runnable manager.Runnable) error {
+	return nil
+}
+
+func (m *MockManager) Start(context.Context) error {
+	return nil
+}
+
+type fakeRecorder struct{}
+
+func (recorder fakeRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+}
+
+func (recorder fakeRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (recorder fakeRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+}
@@ -0,0 +1,70 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+)
+
+// Create validator instead of using the existing one in controller-runtime because the existing one can't
+// inspect admission.Request in Handle function.
+
+//+kubebuilder:webhook:path=/validate-crd-nsx-vmware-com-v1alpha1-vpcendpoint,mutating=false,failurePolicy=fail,sideEffects=None,groups=crd.nsx.vmware.com,resources=vpcendpoints,verbs=create;update,versions=v1alpha1,name=vpcendpoint.validating.crd.nsx.vmware.com,admissionReviewVersions=v1
+
+type VPCEndpointValidator struct {
+	Client  client.Client
+	decoder admission.Decoder
+}
+
+// Handle denies the VPCEndpoint referring to a ServiceEndpoint in another Namespace unless the ServiceEndpoint
+// exists and allows the Namespace of the VPCEndpoint.
+func (v *VPCEndpointValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+	vpcEndpoint := &v1alpha1.VPCEndpoint{}
+	if err := v.decoder.Decode(req, vpcEndpoint); err != nil {
+		log.Error(err, "Error while decoding VPCEndpoint", "VPCEndpoint", req.Namespace+"/"+req.Name)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	key := getServiceEndpointKey(vpcEndpoint)
+	if key.Namespace == "" || key.Name == "" {
+		return admission.Denied(fmt.Sprintf("serviceEndpointName %s must be in the format <namespace>/<name> or <name>", vpcEndpoint.Spec.ServiceEndpointName))
+	}
+	if key.Namespace == vpcEndpoint.Namespace {
+		return admission.Allowed("")
+	}
+	if req.Operation == admissionv1.Update {
+		oldVPCEndpoint := &v1alpha1.VPCEndpoint{}
+		if err := v.decoder.DecodeRaw(req.OldObject, oldVPCEndpoint); err != nil {
+			log.Error(err, "Error while decoding VPCEndpoint", "VPCEndpoint", req.Namespace+"/"+req.Name)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		// The consent of the ServiceEndpoint has been checked when the reference was set, the controller
+		// releases the VPCEndpoint once the ServiceEndpoint no longer allows the Namespace.
+		if getServiceEndpointKey(oldVPCEndpoint) == key {
+			return admission.Allowed("")
+		}
+	}
+	serviceEndpoint := &v1alpha1.ServiceEndpoint{}
+	err := v.Client.Get(ctx, key, serviceEndpoint)
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "Failed to get ServiceEndpoint", "ServiceEndpoint", key)
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if err != nil || !isNamespaceAllowed(serviceEndpoint, vpcEndpoint.Namespace) {
+		// The same message is returned for a missing ServiceEndpoint to not disclose the ServiceEndpoints of other Namespaces.
+		return admission.Denied(fmt.Sprintf("ServiceEndpoint %s does not exist or does not allow VPCEndpoints in Namespace %s", key, vpcEndpoint.Namespace))
+	}
+	return admission.Allowed("")
+}
@@ -0,0 +1,101 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+)
+
+func TestVPCEndpointValidator_Handle(t *testing.T) {
+	notAllowed := serviceEndpoint.DeepCopy()
+	notAllowed.Spec.AllowedNamespaces = []string{"ns-other"}
+
+	tests := []struct {
+		name        string
+		operation   admissionv1.Operation
+		objects     []client.Object
+		oldRef      string
+		ref         string
+		allowed     bool
+		expectedMsg string
+	}{
+		{name: "Delete", operation: admissionv1.Delete, allowed: true},
+		{name: "Same Namespace", operation: admissionv1.Create, ref: "sep-1", allowed: true},
+		{name: "Same Namespace with the Namespace", operation: admissionv1.Create, ref: "ns-consumer/sep-1", allowed: true},
+		{name: "Invalid reference", operation: admissionv1.Create, ref: "ns-provider/", expectedMsg: "serviceEndpointName ns-provider/ must be in the format <namespace>/<name> or <name>"},
+		{name: "Namespace allowed", operation: admissionv1.Create, objects: []client.Object{serviceEndpoint}, ref: "ns-provider/sep-1", allowed: true},
+		{name: "Namespace not allowed", operation: admissionv1.Create, objects: []client.Object{notAllowed}, ref: "ns-provider/sep-1", expectedMsg: "ServiceEndpoint ns-provider/sep-1 does not exist or does not allow VPCEndpoints in Namespace ns-consumer"},
+		{name: "ServiceEndpoint not found", operation: admissionv1.Create, ref: "ns-provider/sep-1", expectedMsg: "ServiceEndpoint ns-provider/sep-1 does not exist or does not allow VPCEndpoints in Namespace ns-consumer"},
+		{name: "Reference updated to a Namespace not allowed", operation: admissionv1.Update, objects: []client.Object{notAllowed}, oldRef: "sep-1", ref: "ns-provider/sep-1", expectedMsg: "does not allow VPCEndpoints in Namespace ns-consumer"},
+		{name: "Reference not updated", operation: admissionv1.Update, objects: []client.Object{notAllowed}, oldRef: "ns-provider/sep-1", ref: "ns-provider/sep-1", allowed: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			utilruntime.Must(v1alpha1.AddToScheme(scheme))
+			validator := &VPCEndpointValidator{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build(),
+				decoder: admission.NewDecoder(scheme),
+			}
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: tc.operation,
+				Namespace: "ns-consumer",
+				Name:      "vpcep-1",
+				Object:    runtime.RawExtension{Raw: marshalVPCEndpoint(t, tc.ref)},
+				OldObject: runtime.RawExtension{Raw: marshalVPCEndpoint(t, tc.oldRef)},
+			}}
+			resp := validator.Handle(context.TODO(), req)
+			assert.Equal(t, tc.allowed, resp.Allowed)
+			if tc.expectedMsg != "" {
+				assert.Contains(t, resp.Result.Message, tc.expectedMsg)
+			}
+		})
+	}
+
+	t.Run("Failed to get ServiceEndpoint", func(t *testing.T) {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(v1alpha1.AddToScheme(scheme))
+		validator := &VPCEndpointValidator{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+					return fmt.Errorf("mocked get error")
+				},
+			}).Build(),
+			decoder: admission.NewDecoder(scheme),
+		}
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "ns-consumer",
+			Name:      "vpcep-1",
+			Object:    runtime.RawExtension{Raw: marshalVPCEndpoint(t, "ns-provider/sep-1")},
+		}}
+		resp := validator.Handle(context.TODO(), req)
+		assert.False(t, resp.Allowed)
+		assert.Equal(t, int32(http.StatusInternalServerError), resp.Result.Code)
+	})
+}
+
+func marshalVPCEndpoint(t *testing.T, ref string) []byte {
+	obj := vpcEndpoint.DeepCopy()
+	obj.Spec.ServiceEndpointName = ref
+	raw, err := json.Marshal(obj)
+	require.NoError(t, err)
+	return raw
+}
@@ -0,0 +1,141 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+)
+
+// DescribeNamespace reports the NetworkInfo of the Namespace with the VPC path and LB provider from its
+// VPCNetworkConfiguration, and the SubnetSets and Subnets of the Namespace. The VPC realized state is read from NSX if
+// the NSX Manager is configured.
+func (d *Diagnoser) DescribeNamespace(ctx context.Context, namespace string) (*NamespaceReport, error) {
+	ns, err := d.KubeClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Namespace %s: %w", namespace, err)
+	}
+	nc, err := d.vpcNetworkConfigurationOf(ctx, ns.Annotations[common.AnnotationVPCNetworkConfig])
+	if err != nil {
+		return nil, err
+	}
+	report := &NamespaceReport{Namespace: namespace}
+	if nc != nil {
+		report.VPCNetworkConfiguration = nc.Name
+	}
+
+	networkInfos, err := d.CRDClient.CrdV1alpha1().NetworkInfos(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NetworkInfos in %s: %w", namespace, err)
+	}
+	for _, ni := range networkInfos.Items {
+		for _, state := range ni.VPCs {
+			vpcReport := VPCReport{
+				NetworkInfo:             ni.Name,
+				Name:                    state.Name,
+				DefaultSNATIP:           state.DefaultSNATIP,
+				LoadBalancerIPAddresses: state.LoadBalancerIPAddresses,
+				PrivateIPs:              state.PrivateIPs,
+			}
+			if info := vpcInfoOf(nc, state.Name); info != nil {
+				vpcReport.Path = info.VPCPath
+				vpcReport.LBProvider = string(lbProviderOf(info))
+			}
+			if d.NSX != nil && vpcReport.Path != "" {
+				vpcReport.RealizedState = d.realizedState(vpcReport.Path)
+			}
+			report.VPCs = append(report.VPCs, vpcReport)
+		}
+	}
+
+	subnetSets, err := d.CRDClient.CrdV1alpha1().SubnetSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SubnetSets in %s: %w", namespace, err)
+	}
+	for _, subnetSet := range subnetSets.Items {
+		subnetSetReport := SubnetSetReport{Name: subnetSet.Name}
+		for _, subnet := range subnetSet.Status.Subnets {
+			subnetSetReport.NetworkAddresses = append(subnetSetReport.NetworkAddresses, subnet.NetworkAddresses...)
+		}
+		report.SubnetSets = append(report.SubnetSets, subnetSetReport)
+	}
+	sort.Slice(report.SubnetSets, func(i, j int) bool { return report.SubnetSets[i].Name < report.SubnetSets[j].Name })
+
+	subnets, err := d.CRDClient.CrdV1alpha1().Subnets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Subnets in %s: %w", namespace, err)
+	}
+	for _, subnet := range subnets.Items {
+		report.Subnets = append(report.Subnets, SubnetReport{
+			Name:             subnet.Name,
+			NetworkAddresses: subnet.Status.NetworkAddresses,
+			GatewayAddresses: subnet.Status.GatewayAddresses,
+			Ready:            readyStatus(subnet.Status.Conditions),
+		})
+	}
+	sort.Slice(report.Subnets, func(i, j int) bool { return report.Subnets[i].Name < report.Subnets[j].Name })
+	return report, nil
+}
+
+// vpcNetworkConfigurationOf returns the VPCNetworkConfiguration named in the Namespace annotation, or the default
+// one if the Namespace is not annotated. It is nil if there is no default VPCNetworkConfiguration.
+func (d *Diagnoser) vpcNetworkConfigurationOf(ctx context.Context, name string) (*v1alpha1.VPCNetworkConfiguration, error) {
+	if name != "" {
+		nc, err := d.CRDClient.CrdV1alpha1().VPCNetworkConfigurations().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get VPCNetworkConfiguration %s: %w", name, err)
+		}
+		return nc, nil
+	}
+	ncs, err := d.CRDClient.CrdV1alpha1().VPCNetworkConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VPCNetworkConfigurations: %w", err)
+	}
+	for i := range ncs.Items {
+		if common.IsDefaultNetworkConfigCR(&ncs.Items[i]) {
+			return &ncs.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func vpcInfoOf(nc *v1alpha1.VPCNetworkConfiguration, name string) *v1alpha1.VPCInfo {
+	if nc == nil {
+		return nil
+	}
+	for i := range nc.Status.VPCs {
+		if nc.Status.VPCs[i].Name == name {
+			return &nc.Status.VPCs[i]
+		}
+	}
+	return nil
+}
+
+// lbProviderOf returns the LB provider of the VPC by the LB paths in the VPCNetworkConfiguration status.
+func lbProviderOf(info *v1alpha1.VPCInfo) vpc.LBProvider {
+	switch {
+	case info.AVISESubnetPath != "":
+		return vpc.AVILB
+	case info.NSXLoadBalancerPath != "":
+		return vpc.NSXLB
+	default:
+		return vpc.NoneLB
+	}
+}
+
+func readyStatus(conditions []v1alpha1.Condition) string {
+	for _, condition := range conditions {
+		if condition.Type == v1alpha1.Ready {
+			return string(condition.Status)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,120 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	crdfake "github.com/vmware-tanzu/nsx-operator/pkg/client/clientset/versioned/fake"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const testVPCPath = "/orgs/default/projects/p1/vpcs/ns1-vpc"
+
+func namespaceTestObjects(annotations map[string]string) ([]runtime.Object, []runtime.Object) {
+	kubeObjects := []runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "ns1-uid", Annotations: annotations}},
+	}
+	crdObjects := []runtime.Object{
+		&v1alpha1.VPCNetworkConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Annotations: map[string]string{common.AnnotationDefaultNetworkConfig: "true"}},
+			Status: v1alpha1.VPCNetworkConfigurationStatus{VPCs: []v1alpha1.VPCInfo{
+				{Name: "ns1-vpc", VPCPath: testVPCPath, NSXLoadBalancerPath: testVPCPath + "/vpc-lbs/default"},
+			}},
+		},
+		&v1alpha1.VPCNetworkConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "custom"},
+			Status: v1alpha1.VPCNetworkConfigurationStatus{VPCs: []v1alpha1.VPCInfo{
+				{Name: "ns1-vpc", VPCPath: testVPCPath, AVISESubnetPath: testVPCPath + "/subnets/_AVI_SUBNET--LB"},
+			}},
+		},
+		&v1alpha1.NetworkInfo{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ns1"},
+			VPCs: []v1alpha1.VPCState{{
+				Name: "ns1-vpc", DefaultSNATIP: "10.0.0.1", LoadBalancerIPAddresses: "100.64.0.0/24", PrivateIPs: []string{"172.16.0.0/16"},
+			}},
+		},
+		&v1alpha1.SubnetSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod-default"},
+			Status: v1alpha1.SubnetSetStatus{Subnets: []v1alpha1.SubnetInfo{
+				{NetworkAddresses: []string{"172.16.0.0/28"}}, {NetworkAddresses: []string{"172.16.0.16/28"}},
+			}},
+		},
+		&v1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "subnet1"},
+			Status: v1alpha1.SubnetStatus{
+				NetworkAddresses: []string{"172.16.1.0/24"},
+				GatewayAddresses: []string{"172.16.1.1/24"},
+				Conditions:       []v1alpha1.Condition{{Type: v1alpha1.Ready, Status: corev1.ConditionTrue}},
+			},
+		},
+		&v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "subnet2"}},
+	}
+	return kubeObjects, crdObjects
+}
+
+func TestDescribeNamespace_table(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		nsx            *fakeNSXReader
+		wantConfig     string
+		wantLBProvider string
+		wantRealized   string
+	}{
+		{name: "default_config_without_nsx", wantConfig: "default", wantLBProvider: "nsx-lb"},
+		{
+			name:           "annotated_config_with_nsx",
+			annotations:    map[string]string{common.AnnotationVPCNetworkConfig: "custom"},
+			nsx:            &fakeNSXReader{states: map[string]string{testVPCPath: "REALIZED"}},
+			wantConfig:     "custom",
+			wantLBProvider: "avi",
+			wantRealized:   "REALIZED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeObjects, crdObjects := namespaceTestObjects(tt.annotations)
+			d := &Diagnoser{KubeClient: kubefake.NewSimpleClientset(kubeObjects...), CRDClient: crdfake.NewSimpleClientset(crdObjects...)}
+			if tt.nsx != nil {
+				d.NSX = tt.nsx
+			}
+			report, err := d.DescribeNamespace(context.TODO(), "ns1")
+			require.NoError(t, err)
+			require.Equal(t, tt.wantConfig, report.VPCNetworkConfiguration)
+			require.Equal(t, []VPCReport{{
+				NetworkInfo:             "ns1",
+				Name:                    "ns1-vpc",
+				Path:                    testVPCPath,
+				DefaultSNATIP:           "10.0.0.1",
+				LBProvider:              tt.wantLBProvider,
+				LoadBalancerIPAddresses: "100.64.0.0/24",
+				PrivateIPs:              []string{"172.16.0.0/16"},
+				RealizedState:           tt.wantRealized,
+			}}, report.VPCs)
+			require.Equal(t, []SubnetSetReport{{Name: "pod-default", NetworkAddresses: []string{"172.16.0.0/28", "172.16.0.16/28"}}}, report.SubnetSets)
+			require.Equal(t, []SubnetReport{{
+				Name: "subnet1", NetworkAddresses: []string{"172.16.1.0/24"}, GatewayAddresses: []string{"172.16.1.1/24"}, Ready: "True",
+			}}, report.Subnets)
+		})
+	}
+}
+
+func TestDescribeNamespace_errors(t *testing.T) {
+	kubeObjects, crdObjects := namespaceTestObjects(map[string]string{common.AnnotationVPCNetworkConfig: "missing"})
+	d := &Diagnoser{KubeClient: kubefake.NewSimpleClientset(kubeObjects...), CRDClient: crdfake.NewSimpleClientset(crdObjects...)}
+
+	_, err := d.DescribeNamespace(context.TODO(), "ns2")
+	require.ErrorContains(t, err, "failed to get Namespace ns2")
+	_, err = d.DescribeNamespace(context.TODO(), "ns1")
+	require.ErrorContains(t, err, "failed to get VPCNetworkConfiguration missing")
+}
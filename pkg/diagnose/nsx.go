@@ -0,0 +1,194 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+const searchPageSize = int64(1000)
+
+type nsxReader struct {
+	queryClient    search.QueryClient
+	realizedClient realized_state.RealizedEntitiesClient
+}
+
+// NewNSXReader returns the NSXReader with the NSX Policy search and realized state APIs of the client.
+func NewNSXReader(client *nsx.Client) NSXReader {
+	return &nsxReader{queryClient: client.QueryClient, realizedClient: client.RealizedEntitiesClient}
+}
+
+func (r *nsxReader) Search(query string) ([]NSXObject, error) {
+	var objects []NSXObject
+	var cursor *string
+	pageSize := searchPageSize
+	for {
+		response, err := r.queryClient.List(query, cursor, nil, &pageSize, nil, nil)
+		if err != nil {
+			return nil, nsxutil.TransNSXApiError(err)
+		}
+		for _, result := range response.Results {
+			objects = append(objects, nsxObjectFromStructValue(result))
+		}
+		cursor = response.Cursor
+		if cursor == nil || response.ResultCount == nil {
+			break
+		}
+		c, err := strconv.Atoi(*cursor)
+		if err != nil || int64(c) >= *response.ResultCount {
+			break
+		}
+	}
+	return objects, nil
+}
+
+// RealizedState returns ERROR if any realized entity of the path is in error, otherwise the first state which is
+// not REALIZED.
+func (r *nsxReader) RealizedState(intentPath string) (string, error) {
+	results, err := r.realizedClient.List(intentPath, nil)
+	if err != nil {
+		return "", nsxutil.TransNSXApiError(err)
+	}
+	state := ""
+	for _, result := range results.Results {
+		if result.State == nil {
+			continue
+		}
+		switch {
+		case *result.State == model.GenericPolicyRealizedResource_STATE_ERROR:
+			return *result.State, nil
+		case state == "" || state == model.GenericPolicyRealizedResource_STATE_REALIZED:
+			state = *result.State
+		}
+	}
+	return state, nil
+}
+
+func nsxObjectFromStructValue(sv *data.StructValue) NSXObject {
+	obj := NSXObject{
+		ResourceType: stringField(sv, "resource_type"),
+		ID:           stringField(sv, "id"),
+		DisplayName:  stringField(sv, "display_name"),
+		Path:         stringField(sv, "path"),
+		Action:       stringField(sv, "action"),
+	}
+	if attachment, ok := field(sv, "attachment").(*data.StructValue); ok {
+		obj.AttachmentID = stringField(attachment, "id")
+	}
+	if tags, ok := field(sv, "tags").(*data.ListValue); ok {
+		obj.Tags = make(map[string]string, len(tags.List()))
+		for _, v := range tags.List() {
+			if tag, ok := unwrapOptional(v).(*data.StructValue); ok {
+				obj.Tags[stringField(tag, "scope")] = stringField(tag, "tag")
+			}
+		}
+	}
+	return obj
+}
+
+func field(sv *data.StructValue, name string) data.DataValue {
+	if sv == nil || !sv.HasField(name) {
+		return nil
+	}
+	v, _ := sv.Field(name)
+	return unwrapOptional(v)
+}
+
+func unwrapOptional(v data.DataValue) data.DataValue {
+	if optional, ok := v.(*data.OptionalValue); ok {
+		return optional.Value()
+	}
+	return v
+}
+
+func stringField(sv *data.StructValue, name string) string {
+	if s, ok := field(sv, name).(*data.StringValue); ok {
+		return s.Value()
+	}
+	return ""
+}
+
+// searchQuery joins the search terms, it skips the NSX objects marked for deletion.
+func searchQuery(terms ...string) string {
+	return strings.Join(append(terms, "marked_for_delete:false"), " AND ")
+}
+
+func resourceTypeTerm(resourceType string) string {
+	return fmt.Sprintf("%s:%s", common.ResourceType, resourceType)
+}
+
+// tagTerms matches the NSX objects with both the tag scope and one of the tag values, the scope and the value may
+// come from different tags, so the results are filtered with hasTag.
+func tagTerms(scope string, tags ...string) string {
+	values := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		values = append(values, strings.ReplaceAll(tag, ":", "\\:"))
+	}
+	value := values[0]
+	if len(values) > 1 {
+		value = "(" + strings.Join(values, " OR ") + ")"
+	}
+	return fmt.Sprintf("tags.scope:%s AND tags.tag:%s", strings.ReplaceAll(scope, "/", "\\/"), value)
+}
+
+// ownerUID returns the owner UID of the tag value, the NSX objects of a NetworkPolicy are tagged with the UID and an
+// "_allow" or "_isolation" suffix, the ones of an AdminNetworkPolicy or a BaselineAdminNetworkPolicy are tagged with
+// the UID, the subject Namespace and an "_anp" or "_banp" suffix, e.g., "${UID}.${Namespace}_anp".
+func ownerUID(tag string) string {
+	uid, _, _ := strings.Cut(tag, common.ConnectorUnderline)
+	uid, _, _ = strings.Cut(uid, ".")
+	return uid
+}
+
+// ownedBy reports whether the NSX object is tagged with one of the scopes and the owner UID.
+func ownedBy(obj *NSXObject, uid string, scopes ...string) bool {
+	for _, scope := range scopes {
+		if tag, ok := obj.Tags[scope]; ok && ownerUID(tag) == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// searchOwned returns the NSX objects of the resource type tagged with the owner UID in one of the scopes.
+func (d *Diagnoser) searchOwned(resourceType string, uid string, scopes ...string) ([]NSXObject, error) {
+	var owned []NSXObject
+	for _, scope := range scopes {
+		tags := []string{uid}
+		if scope == common.TagScopeNetworkPolicyUID {
+			tags = []string{uid + "_allow", uid + "_isolation"}
+		}
+		objects, err := d.NSX.Search(searchQuery(resourceTypeTerm(resourceType), tagTerms(scope, tags...)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to search NSX %s for %s: %w", resourceType, uid, err)
+		}
+		for i := range objects {
+			if ownedBy(&objects[i], uid, scope) {
+				owned = append(owned, objects[i])
+			}
+		}
+	}
+	return owned, nil
+}
+
+// realizedState returns the realized state of the path, or the error message if it cannot be read, so that a
+// broken realization API doesn't hide the rest of a report.
+func (d *Diagnoser) realizedState(path string) string {
+	state, err := d.NSX.RealizedState(path)
+	if err != nil {
+		return fmt.Sprintf("unknown: %v", err)
+	}
+	return state
+}
@@ -0,0 +1,147 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.uber.org/mock/gomock"
+
+	mocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/searchclient"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// fakeNSXReader returns the objects of the resource type in the query, or all the objects if the query has no
+// resource type, the tags are filtered by the callers.
+type fakeNSXReader struct {
+	objects   []NSXObject
+	states    map[string]string
+	searchErr error
+	queries   []string
+}
+
+func (f *fakeNSXReader) Search(query string) ([]NSXObject, error) {
+	f.queries = append(f.queries, query)
+	if f.searchErr != nil {
+		return nil, f.searchErr
+	}
+	var out []NSXObject
+	for _, obj := range f.objects {
+		if !strings.Contains(query, common.ResourceType+":") || strings.Contains(query, resourceTypeTerm(obj.ResourceType)+" ") {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeNSXReader) RealizedState(intentPath string) (string, error) {
+	state, ok := f.states[intentPath]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return state, nil
+}
+
+type fakeRealizedEntitiesClient struct {
+	states []string
+}
+
+func (f *fakeRealizedEntitiesClient) List(intentPathParam string, sitePathParam *string) (model.GenericPolicyRealizedResourceListResult, error) {
+	var result model.GenericPolicyRealizedResourceListResult
+	for i := range f.states {
+		result.Results = append(result.Results, model.GenericPolicyRealizedResource{State: &f.states[i]})
+	}
+	return result, nil
+}
+
+func subnetPortStructValue(t *testing.T, id string) *data.StructValue {
+	port := model.VpcSubnetPort{
+		Id:           common.String(id),
+		DisplayName:  common.String(id + "-name"),
+		Path:         common.String("/orgs/default/projects/p1/vpcs/v1/subnets/s1/ports/" + id),
+		ResourceType: common.String(common.ResourceTypeSubnetPort),
+		Attachment:   &model.PortAttachment{Id: common.String(id + "-attachment")},
+		Tags: []model.Tag{
+			{Scope: common.String(common.TagScopeCluster), Tag: common.String("cl1")},
+			{Scope: common.String(common.TagScopePodUID), Tag: common.String(id + "-uid")},
+		},
+	}
+	dv, errs := common.NewConverter().ConvertToVapi(port, model.VpcSubnetPortBindingType())
+	require.Empty(t, errs)
+	return dv.(*data.StructValue)
+}
+
+func TestNSXReaderSearch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	queryClient := mocks.NewMockQueryClient(ctrl)
+	query := searchQuery(resourceTypeTerm(common.ResourceTypeSubnetPort), tagTerms(common.TagScopeCluster, "domain-c9:1"))
+	require.Equal(t, `resource_type:VpcSubnetPort AND tags.scope:nsx-op\/cluster AND tags.tag:domain-c9\:1 AND marked_for_delete:false`, query)
+
+	cursor, count := "1", int64(2)
+	gomock.InOrder(
+		queryClient.EXPECT().List(query, nil, nil, gomock.Any(), nil, nil).Return(model.SearchResponse{
+			Results: []*data.StructValue{subnetPortStructValue(t, "port1")}, Cursor: &cursor, ResultCount: &count,
+		}, nil),
+		queryClient.EXPECT().List(query, &cursor, nil, gomock.Any(), nil, nil).Return(model.SearchResponse{
+			Results: []*data.StructValue{subnetPortStructValue(t, "port2")}, ResultCount: &count,
+		}, nil),
+	)
+	reader := &nsxReader{queryClient: queryClient}
+	objects, err := reader.Search(query)
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, NSXObject{
+		ResourceType: common.ResourceTypeSubnetPort,
+		ID:           "port1",
+		DisplayName:  "port1-name",
+		Path:         "/orgs/default/projects/p1/vpcs/v1/subnets/s1/ports/port1",
+		AttachmentID: "port1-attachment",
+		Tags:         map[string]string{common.TagScopeCluster: "cl1", common.TagScopePodUID: "port1-uid"},
+	}, objects[0])
+	require.Equal(t, "port2", objects[1].ID)
+
+	queryClient.EXPECT().List(query, nil, nil, gomock.Any(), nil, nil).Return(model.SearchResponse{}, errors.New("unavailable"))
+	_, err = reader.Search(query)
+	require.ErrorContains(t, err, "unavailable")
+}
+
+func TestNSXReaderRealizedState_table(t *testing.T) {
+	tests := []struct {
+		name   string
+		states []string
+		want   string
+	}{
+		{name: "no_entity"},
+		{name: "realized", states: []string{"REALIZED", "REALIZED"}, want: "REALIZED"},
+		{name: "in_progress", states: []string{"REALIZED", "IN_PROGRESS"}, want: "IN_PROGRESS"},
+		{name: "error_wins", states: []string{"UNREALIZED", "ERROR", "REALIZED"}, want: "ERROR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := &nsxReader{realizedClient: &fakeRealizedEntitiesClient{states: tt.states}}
+			state, err := reader.RealizedState("/orgs/default/projects/p1/vpcs/v1")
+			require.NoError(t, err)
+			require.Equal(t, tt.want, state)
+		})
+	}
+}
+
+func TestTagTermsAndOwnerUID(t *testing.T) {
+	require.Equal(t, `tags.scope:nsx-op\/network_policy_uid AND tags.tag:(uid1_allow OR uid1_isolation)`,
+		tagTerms(common.TagScopeNetworkPolicyUID, "uid1_allow", "uid1_isolation"))
+	require.Equal(t, "uid1", ownerUID("uid1_allow"))
+	require.Equal(t, "uid1", ownerUID("uid1"))
+	require.Equal(t, "uid1", ownerUID("uid1.ns1_anp"))
+	require.Equal(t, "uid1", ownerUID("uid1.ns1_banp"))
+
+	obj := &NSXObject{Tags: map[string]string{common.TagScopeNetworkPolicyUID: "uid1_isolation"}}
+	require.True(t, ownedBy(obj, "uid1", common.TagScopeSecurityPolicyUID, common.TagScopeNetworkPolicyUID))
+	require.False(t, ownedBy(obj, "uid2", common.TagScopeNetworkPolicyUID))
+	require.False(t, ownedBy(obj, "uid1", common.TagScopeSecurityPolicyUID))
+}
@@ -0,0 +1,184 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+type ownerLister func(ctx context.Context, d *Diagnoser) (runtime.Object, error)
+
+type ownerScope struct {
+	scope string
+	kind  string
+	// suffix is set if the kind is told apart by the suffix of the tag value, e.g., the AdminNetworkPolicy and the
+	// BaselineAdminNetworkPolicy share the tag scope with the "_anp" and "_banp" suffixes.
+	suffix string
+}
+
+// ownerScopes are the tag scopes of the owner UIDs in the order to look up the owner of an NSX object, the NSX
+// objects created for a CR are also tagged with the Namespace UID, so the Namespace scopes are the last.
+var ownerScopes = []ownerScope{
+	{scope: common.TagScopePodUID, kind: "Pod"},
+	{scope: common.TagScopeSubnetPortCRUID, kind: "SubnetPort"},
+	{scope: common.TagScopeSubnetCRUID, kind: "Subnet"},
+	{scope: common.TagScopeSubnetSetCRUID, kind: "SubnetSet"},
+	{scope: common.TagScopeSecurityPolicyCRUID, kind: "SecurityPolicy"},
+	{scope: common.TagScopeSecurityPolicyUID, kind: "SecurityPolicy"},
+	{scope: common.TagScopeNetworkPolicyUID, kind: "NetworkPolicy"},
+	{scope: common.TagScopeAdminNetworkPolicyUID, kind: "AdminNetworkPolicy", suffix: "_anp"},
+	{scope: common.TagScopeAdminNetworkPolicyUID, kind: "BaselineAdminNetworkPolicy", suffix: "_banp"},
+	{scope: common.TagScopeStaticRouteCRUID, kind: "StaticRoute"},
+	{scope: common.TagScopeIPAddressAllocationCRUID, kind: "IPAddressAllocation"},
+	{scope: common.TagScopeAddressBindingCRUID, kind: "AddressBinding"},
+	{scope: common.TagScopeSubnetBindingCRUID, kind: "SubnetConnectionBindingMap"},
+	{scope: common.TagScopeSubnetIPReservationCRUID, kind: "SubnetIPReservation"},
+	{scope: common.TagScopeVPCEndpointCRUID, kind: "VPCEndpoint"},
+	{scope: common.TagScopeServiceEndpointCRUID, kind: "ServiceEndpoint"},
+	{scope: common.TagScopeSubnetPortSettingCRUID, kind: "SubnetPortSetting"},
+	{scope: common.TagScopeNamespaceUID, kind: "Namespace"},
+	{scope: common.TagScopeVMNamespaceUID, kind: "Namespace"},
+}
+
+var ownerListers = map[string]ownerLister{
+	"Pod": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.KubeClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"Namespace": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.KubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	},
+	"NetworkPolicy": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.KubeClient.NetworkingV1().NetworkPolicies(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"AdminNetworkPolicy": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.PolicyClient.PolicyV1alpha1().AdminNetworkPolicies().List(ctx, metav1.ListOptions{})
+	},
+	"BaselineAdminNetworkPolicy": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.PolicyClient.PolicyV1alpha1().BaselineAdminNetworkPolicies().List(ctx, metav1.ListOptions{})
+	},
+	"SubnetPort": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().SubnetPorts(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"Subnet": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().Subnets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"SubnetSet": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().SubnetSets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"SecurityPolicy": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().SecurityPolicies(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"StaticRoute": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().StaticRoutes(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"IPAddressAllocation": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().IPAddressAllocations(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"AddressBinding": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().AddressBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"SubnetConnectionBindingMap": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().SubnetConnectionBindingMaps(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"SubnetIPReservation": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().SubnetIPReservations(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"VPCEndpoint": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().VPCEndpoints(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"ServiceEndpoint": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().ServiceEndpoints(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+	"SubnetPortSetting": func(ctx context.Context, d *Diagnoser) (runtime.Object, error) {
+		return d.CRDClient.CrdV1alpha1().SubnetPortSettings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	},
+}
+
+// Orphans lists the NSX objects tagged for the cluster whose owner CR, Pod, NetworkPolicy or Namespace no longer
+// exists. The owner is found by the first tag scope of ownerScopes on the NSX object, the NSX objects without any of
+// these scopes, e.g., the DNS records or the shared groups, are skipped.
+func (d *Diagnoser) Orphans(ctx context.Context) (*OrphanReport, error) {
+	if d.NSX == nil {
+		return nil, errNSXRequired
+	}
+	if d.Cluster == "" {
+		return nil, errors.New("the cluster is not configured, set -cluster")
+	}
+	objects, err := d.NSX.Search(searchQuery(tagTerms(common.TagScopeCluster, d.Cluster)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search NSX objects of cluster %s: %w", d.Cluster, err)
+	}
+	report := &OrphanReport{Cluster: d.Cluster}
+	ownerUIDs := map[string]sets.Set[string]{}
+	for i := range objects {
+		obj := &objects[i]
+		if obj.Tags[common.TagScopeCluster] != d.Cluster {
+			continue
+		}
+		owner, tag := ownerOf(obj)
+		if owner == nil {
+			report.Skipped++
+			continue
+		}
+		uids, ok := ownerUIDs[owner.kind]
+		if !ok {
+			if uids, err = d.listOwnerUIDs(ctx, owner.kind); err != nil {
+				return nil, err
+			}
+			ownerUIDs[owner.kind] = uids
+		}
+		if uid := ownerUID(tag); !uids.Has(uid) {
+			report.Orphans = append(report.Orphans, Orphan{
+				ResourceType:  obj.ResourceType,
+				ID:            obj.ID,
+				Path:          obj.Path,
+				OwnerKind:     owner.kind,
+				OwnerTagScope: owner.scope,
+				OwnerUID:      uid,
+			})
+		}
+	}
+	sort.Slice(report.Orphans, func(i, j int) bool { return report.Orphans[i].Path < report.Orphans[j].Path })
+	return report, nil
+}
+
+func ownerOf(obj *NSXObject) (*ownerScope, string) {
+	for i := range ownerScopes {
+		if tag, ok := obj.Tags[ownerScopes[i].scope]; ok && tag != "" && strings.HasSuffix(tag, ownerScopes[i].suffix) {
+			return &ownerScopes[i], tag
+		}
+	}
+	return nil, ""
+}
+
+func (d *Diagnoser) listOwnerUIDs(ctx context.Context, kind string) (sets.Set[string], error) {
+	list, err := ownerListers[kind](ctx, d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", kind, err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	uids := sets.New[string]()
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return nil, err
+		}
+		uids.Insert(string(accessor.GetUID()))
+	}
+	return uids, nil
+}
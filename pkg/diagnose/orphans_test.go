@@ -0,0 +1,89 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+	policyfake "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	crdfake "github.com/vmware-tanzu/nsx-operator/pkg/client/clientset/versioned/fake"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestOrphans(t *testing.T) {
+	clusterTags := func(scope, uid string) map[string]string {
+		return map[string]string{common.TagScopeCluster: "cl1", common.TagScopeNamespaceUID: "ns1-uid", scope: uid}
+	}
+	nsx := &fakeNSXReader{objects: []NSXObject{
+		{ResourceType: common.ResourceTypeVpc, ID: "ns1-vpc", Path: "/vpcs/ns1-vpc", Tags: map[string]string{common.TagScopeCluster: "cl1", common.TagScopeNamespaceUID: "ns1-uid"}},
+		{ResourceType: common.ResourceTypeVpc, ID: "ns2-vpc", Path: "/vpcs/ns2-vpc", Tags: map[string]string{common.TagScopeCluster: "cl1", common.TagScopeNamespaceUID: "ns2-uid"}},
+		{ResourceType: "VpcSubnet", ID: "subnet1", Path: "/vpcs/ns1-vpc/subnets/subnet1", Tags: clusterTags(common.TagScopeSubnetCRUID, "subnet1-uid")},
+		{ResourceType: "VpcSubnet", ID: "subnet2", Path: "/vpcs/ns1-vpc/subnets/subnet2", Tags: clusterTags(common.TagScopeSubnetCRUID, "subnet2-uid")},
+		{ResourceType: common.ResourceTypeSubnetPort, ID: "pod1", Path: "/vpcs/ns1-vpc/subnets/subnet1/ports/pod1", Tags: clusterTags(common.TagScopePodUID, "pod1-uid")},
+		{ResourceType: common.ResourceTypeSubnetPort, ID: "pod2", Path: "/vpcs/ns1-vpc/subnets/subnet1/ports/pod2", Tags: clusterTags(common.TagScopePodUID, "pod2-uid")},
+		{ResourceType: common.ResourceTypeRule, ID: "np_allow_0", Path: "/vpcs/ns1-vpc/security-policies/np_allow/rules/np_allow_0", Tags: clusterTags(common.TagScopeNetworkPolicyUID, "np-uid_allow")},
+		{ResourceType: common.ResourceTypeSecurityPolicy, ID: "anp1", Path: "/vpcs/ns1-vpc/security-policies/anp1", Tags: clusterTags(common.TagScopeAdminNetworkPolicyUID, "anp1-uid.ns1_anp")},
+		{ResourceType: common.ResourceTypeSecurityPolicy, ID: "anp2", Path: "/vpcs/ns1-vpc/security-policies/anp2", Tags: clusterTags(common.TagScopeAdminNetworkPolicyUID, "anp2-uid.ns1_anp")},
+		{ResourceType: common.ResourceTypeSecurityPolicy, ID: "banp1", Path: "/vpcs/ns1-vpc/security-policies/banp1", Tags: clusterTags(common.TagScopeAdminNetworkPolicyUID, "banp1-uid.ns1_banp")},
+		{ResourceType: common.ResourceTypeSecurityPolicy, ID: "banp2", Path: "/vpcs/ns1-vpc/security-policies/banp2", Tags: clusterTags(common.TagScopeAdminNetworkPolicyUID, "anp1-uid.ns1_banp")},
+		{ResourceType: "DnsRecord", ID: "record1", Path: "/dns-records/record1", Tags: map[string]string{common.TagScopeCluster: "cl1"}},
+		{ResourceType: common.ResourceTypeVpc, ID: "other-vpc", Path: "/vpcs/other-vpc", Tags: map[string]string{common.TagScopeCluster: "cl2", common.TagScopeNamespaceUID: "ns2-uid"}},
+	}}
+	d := &Diagnoser{
+		KubeClient: kubefake.NewSimpleClientset(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "ns1-uid"}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1", UID: "pod1-uid"}},
+		),
+		CRDClient: crdfake.NewSimpleClientset(&v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "subnet1", UID: "subnet1-uid"}}),
+		PolicyClient: policyfake.NewSimpleClientset(
+			&policyv1alpha1.AdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "anp1", UID: "anp1-uid"}},
+			&policyv1alpha1.BaselineAdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "banp1-uid"}},
+		),
+		NSX:     nsx,
+		Cluster: "cl1",
+	}
+
+	report, err := d.Orphans(context.TODO())
+	require.NoError(t, err)
+	require.Equal(t, []string{`tags.scope:nsx-op\/cluster AND tags.tag:cl1 AND marked_for_delete:false`}, nsx.queries)
+	require.Equal(t, 1, report.Skipped)
+	require.Equal(t, []Orphan{
+		{ResourceType: common.ResourceTypeSecurityPolicy, ID: "anp2", Path: "/vpcs/ns1-vpc/security-policies/anp2", OwnerKind: "AdminNetworkPolicy", OwnerTagScope: common.TagScopeAdminNetworkPolicyUID, OwnerUID: "anp2-uid"},
+		{ResourceType: common.ResourceTypeSecurityPolicy, ID: "banp2", Path: "/vpcs/ns1-vpc/security-policies/banp2", OwnerKind: "BaselineAdminNetworkPolicy", OwnerTagScope: common.TagScopeAdminNetworkPolicyUID, OwnerUID: "anp1-uid"},
+		{ResourceType: common.ResourceTypeRule, ID: "np_allow_0", Path: "/vpcs/ns1-vpc/security-policies/np_allow/rules/np_allow_0", OwnerKind: "NetworkPolicy", OwnerTagScope: common.TagScopeNetworkPolicyUID, OwnerUID: "np-uid"},
+		{ResourceType: common.ResourceTypeSubnetPort, ID: "pod2", Path: "/vpcs/ns1-vpc/subnets/subnet1/ports/pod2", OwnerKind: "Pod", OwnerTagScope: common.TagScopePodUID, OwnerUID: "pod2-uid"},
+		{ResourceType: "VpcSubnet", ID: "subnet2", Path: "/vpcs/ns1-vpc/subnets/subnet2", OwnerKind: "Subnet", OwnerTagScope: common.TagScopeSubnetCRUID, OwnerUID: "subnet2-uid"},
+		{ResourceType: common.ResourceTypeVpc, ID: "ns2-vpc", Path: "/vpcs/ns2-vpc", OwnerKind: "Namespace", OwnerTagScope: common.TagScopeNamespaceUID, OwnerUID: "ns2-uid"},
+	}, report.Orphans)
+
+	d.Cluster = ""
+	_, err = d.Orphans(context.TODO())
+	require.ErrorContains(t, err, "set -cluster")
+
+	d.Cluster = "cl1"
+	nsx.searchErr = errors.New("unavailable")
+	_, err = d.Orphans(context.TODO())
+	require.ErrorContains(t, err, "unavailable")
+}
+
+func TestOwnerListers(t *testing.T) {
+	for _, owner := range ownerScopes {
+		require.Contains(t, ownerListers, owner.kind, "no lister for %s", owner.scope)
+	}
+	d := &Diagnoser{KubeClient: kubefake.NewSimpleClientset(), CRDClient: crdfake.NewSimpleClientset(), PolicyClient: policyfake.NewSimpleClientset()}
+	for kind := range ownerListers {
+		uids, err := d.listOwnerUIDs(context.TODO(), kind)
+		require.NoError(t, err, kind)
+		require.Empty(t, uids, kind)
+	}
+}
@@ -0,0 +1,127 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// TracePod reports the NSX subnet port of the Pod with its attachment and realized state, and the NSX DFW rules of the
+// SecurityPolicies and NetworkPolicies applied to the Pod.
+func (d *Diagnoser) TracePod(ctx context.Context, namespace, name string) (*PodReport, error) {
+	if d.NSX == nil {
+		return nil, errNSXRequired
+	}
+	pod, err := d.KubeClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pod %s/%s: %w", namespace, name, err)
+	}
+	report := &PodReport{
+		Namespace:  namespace,
+		Name:       name,
+		UID:        string(pod.UID),
+		MACAddress: pod.Annotations[common.AnnotationPodMAC],
+		Attachment: pod.Annotations[common.AnnotationAttachment],
+	}
+	for _, ip := range pod.Status.PodIPs {
+		report.PodIPs = append(report.PodIPs, ip.IP)
+	}
+
+	ports, err := d.searchOwned(common.ResourceTypeSubnetPort, string(pod.UID), common.TagScopePodUID)
+	if err != nil {
+		return nil, err
+	}
+	for _, port := range ports {
+		report.SubnetPorts = append(report.SubnetPorts, SubnetPortReport{
+			ID:            port.ID,
+			DisplayName:   port.DisplayName,
+			Path:          port.Path,
+			AttachmentID:  port.AttachmentID,
+			RealizedState: d.realizedState(port.Path),
+		})
+	}
+
+	report.Rules, err = d.podRules(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// podRules returns the NSX rules of the SecurityPolicies and NetworkPolicies whose appliedTo or podSelector selects
+// the Pod.
+func (d *Diagnoser) podRules(ctx context.Context, pod *corev1.Pod) ([]RuleReport, error) {
+	podLabels := labels.Set(pod.Labels)
+	var reports []RuleReport
+
+	sps, err := d.CRDClient.CrdV1alpha1().SecurityPolicies(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SecurityPolicies in %s: %w", pod.Namespace, err)
+	}
+	for i := range sps.Items {
+		sp := &sps.Items[i]
+		// The policy level appliedTo takes precedence over the rule level.
+		keep := func(rule *v1alpha1.SecurityPolicyRule) bool {
+			if len(sp.Spec.AppliedTo) > 0 {
+				return targetsSelectPod(sp.Spec.AppliedTo, podLabels)
+			}
+			return targetsSelectPod(rule.AppliedTo, podLabels)
+		}
+		applied := false
+		for j := range sp.Spec.Rules {
+			applied = applied || keep(&sp.Spec.Rules[j])
+		}
+		if !applied {
+			continue
+		}
+		rules, err := d.searchOwned(common.ResourceTypeRule, string(sp.UID), securityPolicyUIDScopes...)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, securityPolicyRuleReports(sp, rules, keep)...)
+	}
+
+	nps, err := d.KubeClient.NetworkingV1().NetworkPolicies(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NetworkPolicies in %s: %w", pod.Namespace, err)
+	}
+	for _, np := range nps.Items {
+		selector, err := metav1.LabelSelectorAsSelector(&np.Spec.PodSelector)
+		if err != nil || !selector.Matches(podLabels) {
+			continue
+		}
+		rules, err := d.searchOwned(common.ResourceTypeRule, string(np.UID), common.TagScopeNetworkPolicyUID)
+		if err != nil {
+			return nil, err
+		}
+		var npReports []RuleReport
+		for i := range rules {
+			npReports = append(npReports, ruleReport("NetworkPolicy/"+np.Name, "", &rules[i]))
+		}
+		sortRuleReports(npReports)
+		reports = append(reports, npReports...)
+	}
+	return reports, nil
+}
+
+func targetsSelectPod(targets []v1alpha1.SecurityPolicyTarget, podLabels labels.Set) bool {
+	for _, target := range targets {
+		if target.PodSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(target.PodSelector)
+		if err == nil && selector.Matches(podLabels) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	crdfake "github.com/vmware-tanzu/nsx-operator/pkg/client/clientset/versioned/fake"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const testPortPath = "/orgs/default/projects/p1/vpcs/ns1-vpc/subnets/pod-default-0/ports/pod1_pod-uid"
+
+func TestTracePod(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1", Name: "pod1", UID: "pod-uid", Labels: map[string]string{"app": "web"},
+			Annotations: map[string]string{common.AnnotationPodMAC: "04:50:56:00:00:01", common.AnnotationAttachment: "attachment1"},
+		},
+		Status: corev1.PodStatus{PodIPs: []corev1.PodIP{{IP: "172.16.0.2"}}},
+	}
+	allPods := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "np-all", UID: "np-uid"}}
+	dbPods := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "np-db", UID: "np-db-uid"},
+		Spec:       networkingv1.NetworkPolicySpec{PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+	}
+	sp := testSecurityPolicy()
+	policyApplied := testSecurityPolicy()
+	policyApplied.Name, policyApplied.UID = "sp-db", "uid2"
+	policyApplied.Spec.AppliedTo = []v1alpha1.SecurityPolicyTarget{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}}}
+
+	objects := append(securityPolicyNSXObjects(sp),
+		NSXObject{ResourceType: common.ResourceTypeSubnetPort, ID: "pod1_pod-uid", Path: testPortPath, AttachmentID: "attachment1",
+			Tags: map[string]string{common.TagScopePodUID: "pod-uid"}},
+		NSXObject{ResourceType: common.ResourceTypeSubnetPort, ID: "pod2", Path: "/pod2", Tags: map[string]string{common.TagScopePodUID: "pod2-uid"}},
+		NSXObject{ResourceType: common.ResourceTypeRule, ID: "np-all_allow_0", Path: "/np-all/rules/np-all_allow_0", Action: "ALLOW",
+			Tags: map[string]string{common.TagScopeNetworkPolicyUID: "np-uid_allow"}},
+		NSXObject{ResourceType: common.ResourceTypeRule, ID: "np-all_isolation_0", Path: "/np-all/rules/np-all_isolation_0", Action: "DROP",
+			Tags: map[string]string{common.TagScopeNetworkPolicyUID: "np-uid_isolation"}},
+		NSXObject{ResourceType: common.ResourceTypeRule, ID: "np-db_allow_0", Path: "/np-db/rules/np-db_allow_0",
+			Tags: map[string]string{common.TagScopeNetworkPolicyUID: "np-db-uid_allow"}},
+	)
+	nsx := &fakeNSXReader{objects: objects, states: map[string]string{testPortPath: "REALIZED"}}
+	d := &Diagnoser{
+		KubeClient: kubefake.NewSimpleClientset(pod, allPods, dbPods),
+		CRDClient:  crdfake.NewSimpleClientset(sp, policyApplied),
+		NSX:        nsx,
+	}
+
+	report, err := d.TracePod(context.TODO(), "ns1", "pod1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"172.16.0.2"}, report.PodIPs)
+	require.Equal(t, "04:50:56:00:00:01", report.MACAddress)
+	require.Equal(t, "attachment1", report.Attachment)
+	require.Equal(t, []SubnetPortReport{{ID: "pod1_pod-uid", Path: testPortPath, AttachmentID: "attachment1", RealizedState: "REALIZED"}}, report.SubnetPorts)
+	require.Equal(t, []RuleReport{
+		{Owner: "SecurityPolicy/sp1", CRRule: "allow-web", ID: "allow-web_uid1_0", Path: testPolicyPath + "/rules/allow-web_uid1_0", Action: "ALLOW"},
+		{Owner: "NetworkPolicy/np-all", ID: "np-all_allow_0", Path: "/np-all/rules/np-all_allow_0", Action: "ALLOW"},
+		{Owner: "NetworkPolicy/np-all", ID: "np-all_isolation_0", Path: "/np-all/rules/np-all_isolation_0", Action: "DROP"},
+	}, report.Rules)
+	require.Contains(t, nsx.queries, searchQuery(resourceTypeTerm(common.ResourceTypeRule),
+		tagTerms(common.TagScopeNetworkPolicyUID, "np-uid_allow", "np-uid_isolation")))
+
+	_, err = d.TracePod(context.TODO(), "ns1", "missing")
+	require.ErrorContains(t, err, "failed to get Pod ns1/missing")
+}
+
+func TestTargetsSelectPod_table(t *testing.T) {
+	web := map[string]string{"app": "web"}
+	tests := []struct {
+		name    string
+		targets []v1alpha1.SecurityPolicyTarget
+		want    bool
+	}{
+		{name: "no_targets"},
+		{name: "vm_selector_only", targets: []v1alpha1.SecurityPolicyTarget{{VMSelector: &metav1.LabelSelector{}}}},
+		{name: "empty_pod_selector", targets: []v1alpha1.SecurityPolicyTarget{{PodSelector: &metav1.LabelSelector{}}}, want: true},
+		{name: "matching_pod_selector", targets: []v1alpha1.SecurityPolicyTarget{
+			{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+			{PodSelector: &metav1.LabelSelector{MatchLabels: web}},
+		}, want: true},
+		{name: "invalid_pod_selector", targets: []v1alpha1.SecurityPolicyTarget{{PodSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Bad"}},
+		}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, targetsSelectPod(tt.targets, web))
+		})
+	}
+}
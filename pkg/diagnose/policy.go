@@ -0,0 +1,121 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+// securityPolicyUIDScopes are the tag scopes of the SecurityPolicy CR UID in VPC and T1 mode.
+var securityPolicyUIDScopes = []string{common.TagScopeSecurityPolicyCRUID, common.TagScopeSecurityPolicyUID}
+
+// ExplainPolicy maps the SecurityPolicy CR to the NSX security policies, rules and groups created for it. An NSX rule
+// is mapped to the CR rule by the rule hash.
+func (d *Diagnoser) ExplainPolicy(ctx context.Context, namespace, name string) (*PolicyReport, error) {
+	if d.NSX == nil {
+		return nil, errNSXRequired
+	}
+	sp, err := d.CRDClient.CrdV1alpha1().SecurityPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SecurityPolicy %s/%s: %w", namespace, name, err)
+	}
+	uid := string(sp.UID)
+	report := &PolicyReport{Namespace: namespace, Name: name, UID: uid}
+
+	policies, err := d.searchOwned(common.ResourceTypeSecurityPolicy, uid, securityPolicyUIDScopes...)
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		report.Policies = append(report.Policies, NSXPolicyReport{
+			ID:            policy.ID,
+			Path:          policy.Path,
+			RealizedState: d.realizedState(policy.Path),
+		})
+	}
+
+	rules, err := d.searchOwned(common.ResourceTypeRule, uid, securityPolicyUIDScopes...)
+	if err != nil {
+		return nil, err
+	}
+	report.Rules = securityPolicyRuleReports(sp, rules, nil)
+
+	groups, err := d.searchOwned(common.ResourceTypeGroup, uid, securityPolicyUIDScopes...)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		report.Groups = append(report.Groups, GroupReport{
+			ID:        group.ID,
+			Path:      group.Path,
+			GroupType: group.Tags[common.TagScopeGroupType],
+			RuleID:    group.Tags[common.TagScopeRuleID],
+		})
+	}
+	sort.Slice(report.Groups, func(i, j int) bool { return report.Groups[i].Path < report.Groups[j].Path })
+	return report, nil
+}
+
+// securityPolicyRuleReports returns the reports of the NSX rules of the SecurityPolicy. If keep is set, only the NSX
+// rules of the CR rules kept are returned, otherwise the NSX rules not mapped to any CR rule, e.g., the rules not
+// deleted yet after the CR rule is changed, are returned without CRRule.
+func securityPolicyRuleReports(sp *v1alpha1.SecurityPolicy, nsxRules []NSXObject, keep func(*v1alpha1.SecurityPolicyRule) bool) []RuleReport {
+	t1 := securitypolicy.VPCToT1(sp.DeepCopy())
+	hashes := make([]string, len(t1.Spec.Rules))
+	for i := range t1.Spec.Rules {
+		hashes[i] = securitypolicy.RuleHash(&t1.Spec.Rules[i])
+	}
+	owner := "SecurityPolicy/" + sp.Name
+	var reports []RuleReport
+	for _, rule := range nsxRules {
+		idx := -1
+		for i, hash := range hashes {
+			if rule.Tags[common.TagScopeRuleHash] == hash {
+				idx = i
+				break
+			}
+		}
+		if keep != nil && (idx < 0 || !keep(&sp.Spec.Rules[idx])) {
+			continue
+		}
+		crRule := ""
+		if idx >= 0 {
+			crRule = sp.Spec.Rules[idx].Name
+			if crRule == "" {
+				crRule = fmt.Sprintf("rules[%d]", idx)
+			}
+		}
+		reports = append(reports, ruleReport(owner, crRule, &rule))
+	}
+	sortRuleReports(reports)
+	return reports
+}
+
+func ruleReport(owner, crRule string, rule *NSXObject) RuleReport {
+	return RuleReport{
+		Owner:       owner,
+		CRRule:      crRule,
+		ID:          rule.ID,
+		DisplayName: rule.DisplayName,
+		Path:        rule.Path,
+		Action:      rule.Action,
+	}
+}
+
+func sortRuleReports(reports []RuleReport) {
+	sort.SliceStable(reports, func(i, j int) bool {
+		if reports[i].CRRule != reports[j].CRRule {
+			return reports[i].CRRule < reports[j].CRRule
+		}
+		return reports[i].Path < reports[j].Path
+	})
+}
@@ -0,0 +1,95 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	crdfake "github.com/vmware-tanzu/nsx-operator/pkg/client/clientset/versioned/fake"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+const testPolicyPath = "/orgs/default/projects/p1/vpcs/ns1-vpc/security-policies/sp1_uid1"
+
+func testSecurityPolicy() *v1alpha1.SecurityPolicy {
+	allow, drop := v1alpha1.RuleActionAllow, v1alpha1.RuleActionDrop
+	in := v1alpha1.RuleDirectionIn
+	return &v1alpha1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sp1", UID: "uid1"},
+		Spec: v1alpha1.SecurityPolicySpec{
+			Rules: []v1alpha1.SecurityPolicyRule{
+				{
+					Name: "allow-web", Action: &allow, Direction: &in,
+					AppliedTo: []v1alpha1.SecurityPolicyTarget{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}}},
+				},
+				{
+					Action: &drop, Direction: &in,
+					AppliedTo: []v1alpha1.SecurityPolicyTarget{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}}},
+				},
+			},
+		},
+	}
+}
+
+// securityPolicyNSXObjects returns the NSX policy, a rule for each CR rule, a stale rule, the groups, and the rule of
+// another SecurityPolicy which has the same search tag value in another scope.
+func securityPolicyNSXObjects(sp *v1alpha1.SecurityPolicy) []NSXObject {
+	t1 := securitypolicy.VPCToT1(sp.DeepCopy())
+	uid := string(sp.UID)
+	tags := func(extra map[string]string) map[string]string {
+		out := map[string]string{common.TagScopeCluster: "cl1", common.TagScopeSecurityPolicyUID: uid}
+		for k, v := range extra {
+			out[k] = v
+		}
+		return out
+	}
+	return []NSXObject{
+		{ResourceType: common.ResourceTypeSecurityPolicy, ID: "sp1_uid1", Path: testPolicyPath, Tags: tags(nil)},
+		{ResourceType: common.ResourceTypeRule, ID: "allow-web_uid1_0", Path: testPolicyPath + "/rules/allow-web_uid1_0", Action: "ALLOW",
+			Tags: tags(map[string]string{common.TagScopeRuleHash: securitypolicy.RuleHash(&t1.Spec.Rules[0])})},
+		{ResourceType: common.ResourceTypeRule, ID: "sp1_uid1_1", Path: testPolicyPath + "/rules/sp1_uid1_1", Action: "DROP",
+			Tags: tags(map[string]string{common.TagScopeRuleHash: securitypolicy.RuleHash(&t1.Spec.Rules[1])})},
+		{ResourceType: common.ResourceTypeRule, ID: "stale_uid1_2", Path: testPolicyPath + "/rules/stale_uid1_2", Action: "ALLOW",
+			Tags: tags(map[string]string{common.TagScopeRuleHash: "stale"})},
+		{ResourceType: common.ResourceTypeRule, ID: "other", Path: "/other", Tags: map[string]string{common.TagScopeNetworkPolicyUID: uid}},
+		{ResourceType: common.ResourceTypeGroup, ID: "sp1_uid1_scope", Path: "/orgs/default/projects/p1/vpcs/ns1-vpc/groups/sp1_uid1_scope",
+			Tags: tags(map[string]string{common.TagScopeGroupType: common.TagValueGroupScope})},
+		{ResourceType: common.ResourceTypeGroup, ID: "sp1_uid1_0_src", Path: "/orgs/default/projects/p1/vpcs/ns1-vpc/groups/sp1_uid1_0_src",
+			Tags: tags(map[string]string{common.TagScopeGroupType: common.TagValueGroupSource, common.TagScopeRuleID: "allow-web_uid1_0"})},
+	}
+}
+
+func TestExplainPolicy(t *testing.T) {
+	sp := testSecurityPolicy()
+	nsx := &fakeNSXReader{objects: securityPolicyNSXObjects(sp), states: map[string]string{testPolicyPath: "REALIZED"}}
+	d := &Diagnoser{KubeClient: kubefake.NewSimpleClientset(), CRDClient: crdfake.NewSimpleClientset(sp), NSX: nsx}
+
+	report, err := d.ExplainPolicy(context.TODO(), "ns1", "sp1")
+	require.NoError(t, err)
+	require.Equal(t, "uid1", report.UID)
+	require.Equal(t, []NSXPolicyReport{{ID: "sp1_uid1", Path: testPolicyPath, RealizedState: "REALIZED"}}, report.Policies)
+	require.Equal(t, []RuleReport{
+		{Owner: "SecurityPolicy/sp1", ID: "stale_uid1_2", Path: testPolicyPath + "/rules/stale_uid1_2", Action: "ALLOW"},
+		{Owner: "SecurityPolicy/sp1", CRRule: "allow-web", ID: "allow-web_uid1_0", Path: testPolicyPath + "/rules/allow-web_uid1_0", Action: "ALLOW"},
+		{Owner: "SecurityPolicy/sp1", CRRule: "rules[1]", ID: "sp1_uid1_1", Path: testPolicyPath + "/rules/sp1_uid1_1", Action: "DROP"},
+	}, report.Rules)
+	require.Equal(t, []GroupReport{
+		{ID: "sp1_uid1_0_src", Path: "/orgs/default/projects/p1/vpcs/ns1-vpc/groups/sp1_uid1_0_src", GroupType: "source", RuleID: "allow-web_uid1_0"},
+		{ID: "sp1_uid1_scope", Path: "/orgs/default/projects/p1/vpcs/ns1-vpc/groups/sp1_uid1_scope", GroupType: "scope"},
+	}, report.Groups)
+
+	_, err = d.ExplainPolicy(context.TODO(), "ns1", "missing")
+	require.ErrorContains(t, err, "failed to get SecurityPolicy ns1/missing")
+
+	d.NSX = nil
+	_, err = d.ExplainPolicy(context.TODO(), "ns1", "sp1")
+	require.ErrorIs(t, err, errNSXRequired)
+}
@@ -0,0 +1,134 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	OutputFormatText = "text"
+	OutputFormatJSON = "json"
+	OutputFormatYAML = "yaml"
+)
+
+// Report is the result of a command.
+type Report interface {
+	writeText(w io.Writer)
+}
+
+// WriteReport writes the report of a command to w in the given format, text, json or yaml.
+func WriteReport(w io.Writer, report Report, format string) error {
+	var data []byte
+	var err error
+	switch format {
+	case OutputFormatText:
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		report.writeText(tw)
+		return tw.Flush()
+	case OutputFormatJSON:
+		data, err = json.MarshalIndent(report, "", "  ")
+		data = append(data, '\n')
+	case OutputFormatYAML:
+		data, err = yaml.Marshal(report)
+	default:
+		return fmt.Errorf("unsupported output format %q, it should be %s, %s or %s", format, OutputFormatText, OutputFormatJSON, OutputFormatYAML)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func (r *NamespaceReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "Namespace:\t%s\n", r.Namespace)
+	fmt.Fprintf(w, "VPCNetworkConfiguration:\t%s\n", orNone(r.VPCNetworkConfiguration))
+	writeTable(w, "VPCs", []string{"NETWORKINFO", "VPC", "PATH", "SNAT IP", "LB PROVIDER", "LB IPS", "PRIVATE IPS", "REALIZED"}, len(r.VPCs), func(i int) []string {
+		v := r.VPCs[i]
+		return []string{v.NetworkInfo, v.Name, v.Path, v.DefaultSNATIP, v.LBProvider, v.LoadBalancerIPAddresses, strings.Join(v.PrivateIPs, ","), v.RealizedState}
+	})
+	writeTable(w, "SubnetSets", []string{"NAME", "NETWORK ADDRESSES"}, len(r.SubnetSets), func(i int) []string {
+		s := r.SubnetSets[i]
+		return []string{s.Name, strings.Join(s.NetworkAddresses, ",")}
+	})
+	writeTable(w, "Subnets", []string{"NAME", "NETWORK ADDRESSES", "GATEWAY ADDRESSES", "READY"}, len(r.Subnets), func(i int) []string {
+		s := r.Subnets[i]
+		return []string{s.Name, strings.Join(s.NetworkAddresses, ","), strings.Join(s.GatewayAddresses, ","), s.Ready}
+	})
+}
+
+func (r *PodReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "Pod:\t%s/%s\n", r.Namespace, r.Name)
+	fmt.Fprintf(w, "UID:\t%s\n", r.UID)
+	fmt.Fprintf(w, "IPs:\t%s\n", orNone(strings.Join(r.PodIPs, ",")))
+	fmt.Fprintf(w, "MAC:\t%s\n", orNone(r.MACAddress))
+	fmt.Fprintf(w, "Attachment:\t%s\n", orNone(r.Attachment))
+	writeTable(w, "SubnetPorts", []string{"ID", "PATH", "ATTACHMENT", "REALIZED"}, len(r.SubnetPorts), func(i int) []string {
+		p := r.SubnetPorts[i]
+		return []string{p.ID, p.Path, p.AttachmentID, p.RealizedState}
+	})
+	writeRules(w, r.Rules)
+}
+
+func (r *PolicyReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "SecurityPolicy:\t%s/%s\n", r.Namespace, r.Name)
+	fmt.Fprintf(w, "UID:\t%s\n", r.UID)
+	writeTable(w, "NSX SecurityPolicies", []string{"ID", "PATH", "REALIZED"}, len(r.Policies), func(i int) []string {
+		p := r.Policies[i]
+		return []string{p.ID, p.Path, p.RealizedState}
+	})
+	writeRules(w, r.Rules)
+	writeTable(w, "NSX Groups", []string{"ID", "GROUP TYPE", "RULE ID", "PATH"}, len(r.Groups), func(i int) []string {
+		g := r.Groups[i]
+		return []string{g.ID, g.GroupType, g.RuleID, g.Path}
+	})
+}
+
+func (r *OrphanReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "Cluster:\t%s\n", r.Cluster)
+	fmt.Fprintf(w, "Skipped:\t%s\n", strconv.Itoa(r.Skipped))
+	writeTable(w, "Orphans", []string{"RESOURCE TYPE", "ID", "OWNER", "OWNER UID", "PATH"}, len(r.Orphans), func(i int) []string {
+		o := r.Orphans[i]
+		return []string{o.ResourceType, o.ID, o.OwnerKind, o.OwnerUID, o.Path}
+	})
+}
+
+func writeRules(w io.Writer, rules []RuleReport) {
+	writeTable(w, "NSX Rules", []string{"OWNER", "CR RULE", "ID", "ACTION", "PATH"}, len(rules), func(i int) []string {
+		r := rules[i]
+		return []string{r.Owner, r.CRRule, r.ID, r.Action, r.Path}
+	})
+}
+
+// writeTable writes the rows after a title line, the empty cells are written as "-".
+func writeTable(w io.Writer, title string, header []string, rows int, row func(i int) []string) {
+	fmt.Fprintf(w, "\n%s:\n", title)
+	if rows == 0 {
+		fmt.Fprintln(w, "  <none>")
+		return
+	}
+	fmt.Fprintf(w, "  %s\n", strings.Join(header, "\t"))
+	for i := 0; i < rows; i++ {
+		cells := row(i)
+		for j := range cells {
+			cells[j] = orNone(cells[j])
+		}
+		fmt.Fprintf(w, "  %s\n", strings.Join(cells, "\t"))
+	}
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,52 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package diagnose
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestWriteReport(t *testing.T) {
+	report := &NamespaceReport{
+		Namespace: "ns1",
+		VPCs:      []VPCReport{{NetworkInfo: "ns1", Name: "ns1-vpc", Path: "/vpcs/ns1-vpc", DefaultSNATIP: "10.0.0.1", LBProvider: "nsx-lb"}},
+		Subnets:   []SubnetReport{{Name: "subnet1", NetworkAddresses: []string{"172.16.0.0/28", "172.16.0.16/28"}, Ready: "True"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteReport(&buf, report, OutputFormatText))
+	require.Equal(t, `Namespace:                ns1
+VPCNetworkConfiguration:  -
+
+VPCs:
+  NETWORKINFO  VPC      PATH           SNAT IP   LB PROVIDER  LB IPS  PRIVATE IPS  REALIZED
+  ns1          ns1-vpc  /vpcs/ns1-vpc  10.0.0.1  nsx-lb       -       -            -
+
+SubnetSets:
+  <none>
+
+Subnets:
+  NAME     NETWORK ADDRESSES             GATEWAY ADDRESSES  READY
+  subnet1  172.16.0.0/28,172.16.0.16/28  -                  True
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteReport(&buf, report, OutputFormatJSON))
+	var fromJSON NamespaceReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &fromJSON))
+	require.Equal(t, *report, fromJSON)
+
+	buf.Reset()
+	require.NoError(t, WriteReport(&buf, &OrphanReport{Cluster: "cl1", Orphans: []Orphan{{ResourceType: "Vpc", ID: "vpc1"}}}, OutputFormatYAML))
+	var fromYAML OrphanReport
+	require.NoError(t, yaml.Unmarshal(buf.Bytes(), &fromYAML))
+	require.Equal(t, "vpc1", fromYAML.Orphans[0].ID)
+
+	require.ErrorContains(t, WriteReport(&buf, report, "wide"), `unsupported output format "wide"`)
+}
@@ -0,0 +1,155 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+// Package diagnose reads the nsx-operator CRs and the NSX objects realized for them side by side, it backs the
+// kubectl-nsx plugin.
+package diagnose
+
+import (
+	"errors"
+
+	"k8s.io/client-go/kubernetes"
+	policyclientset "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/client/clientset/versioned"
+)
+
+var errNSXRequired = errors.New("the NSX Manager is not configured, set -mgr-ip and the NSX credentials")
+
+// NSXReader reads the NSX objects created by nsx-operator.
+type NSXReader interface {
+	// Search returns all the NSX objects matching the NSX search query.
+	Search(query string) ([]NSXObject, error)
+	// RealizedState returns the realized state of the NSX intent path, e.g., REALIZED, IN_PROGRESS or ERROR, it is
+	// empty if NSX reports no realized entity for the path.
+	RealizedState(intentPath string) (string, error)
+}
+
+// NSXObject is the subset of the NSX search result fields used to diagnose the CRs.
+type NSXObject struct {
+	ResourceType string            `json:"resourceType"`
+	ID           string            `json:"id"`
+	DisplayName  string            `json:"displayName,omitempty"`
+	Path         string            `json:"path,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	// Action is set for the NSX DFW rules.
+	Action string `json:"action,omitempty"`
+	// AttachmentID is set for the NSX subnet ports.
+	AttachmentID string `json:"attachmentID,omitempty"`
+}
+
+// Diagnoser runs the kubectl-nsx commands.
+type Diagnoser struct {
+	KubeClient kubernetes.Interface
+	CRDClient  versioned.Interface
+	// PolicyClient reads the AdminNetworkPolicies and BaselineAdminNetworkPolicies.
+	PolicyClient policyclientset.Interface
+	// NSX is nil if the NSX Manager is not configured, describe-namespace then only reports the CR states, the
+	// other commands fail.
+	NSX NSXReader
+	// Cluster is the nsx-op/cluster tag value of the NSX objects created by nsx-operator.
+	Cluster string
+}
+
+// NamespaceReport is the result of describe-namespace.
+type NamespaceReport struct {
+	Namespace               string            `json:"namespace"`
+	VPCNetworkConfiguration string            `json:"vpcNetworkConfiguration,omitempty"`
+	VPCs                    []VPCReport       `json:"vpcs,omitempty"`
+	SubnetSets              []SubnetSetReport `json:"subnetSets,omitempty"`
+	Subnets                 []SubnetReport    `json:"subnets,omitempty"`
+}
+
+type VPCReport struct {
+	NetworkInfo             string   `json:"networkInfo"`
+	Name                    string   `json:"name"`
+	Path                    string   `json:"path,omitempty"`
+	DefaultSNATIP           string   `json:"defaultSNATIP,omitempty"`
+	LBProvider              string   `json:"lbProvider,omitempty"`
+	LoadBalancerIPAddresses string   `json:"loadBalancerIPAddresses,omitempty"`
+	PrivateIPs              []string `json:"privateIPs,omitempty"`
+	RealizedState           string   `json:"realizedState,omitempty"`
+}
+
+type SubnetSetReport struct {
+	Name             string   `json:"name"`
+	NetworkAddresses []string `json:"networkAddresses,omitempty"`
+}
+
+type SubnetReport struct {
+	Name             string   `json:"name"`
+	NetworkAddresses []string `json:"networkAddresses,omitempty"`
+	GatewayAddresses []string `json:"gatewayAddresses,omitempty"`
+	Ready            string   `json:"ready,omitempty"`
+}
+
+// PodReport is the result of trace-pod.
+type PodReport struct {
+	Namespace   string             `json:"namespace"`
+	Name        string             `json:"name"`
+	UID         string             `json:"uid"`
+	PodIPs      []string           `json:"podIPs,omitempty"`
+	MACAddress  string             `json:"macAddress,omitempty"`
+	Attachment  string             `json:"attachment,omitempty"`
+	SubnetPorts []SubnetPortReport `json:"subnetPorts,omitempty"`
+	Rules       []RuleReport       `json:"rules,omitempty"`
+}
+
+type SubnetPortReport struct {
+	ID            string `json:"id"`
+	DisplayName   string `json:"displayName,omitempty"`
+	Path          string `json:"path"`
+	AttachmentID  string `json:"attachmentID,omitempty"`
+	RealizedState string `json:"realizedState,omitempty"`
+}
+
+// RuleReport is an NSX DFW rule, Owner is the kind/name of the CR the rule is created for.
+type RuleReport struct {
+	Owner       string `json:"owner,omitempty"`
+	CRRule      string `json:"crRule,omitempty"`
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName,omitempty"`
+	Path        string `json:"path"`
+	Action      string `json:"action,omitempty"`
+}
+
+// PolicyReport is the result of policy-explain.
+type PolicyReport struct {
+	Namespace string            `json:"namespace"`
+	Name      string            `json:"name"`
+	UID       string            `json:"uid"`
+	Policies  []NSXPolicyReport `json:"policies,omitempty"`
+	Rules     []RuleReport      `json:"rules,omitempty"`
+	Groups    []GroupReport     `json:"groups,omitempty"`
+}
+
+type NSXPolicyReport struct {
+	ID            string `json:"id"`
+	Path          string `json:"path"`
+	RealizedState string `json:"realizedState,omitempty"`
+}
+
+type GroupReport struct {
+	ID        string `json:"id"`
+	Path      string `json:"path"`
+	GroupType string `json:"groupType,omitempty"`
+	RuleID    string `json:"ruleID,omitempty"`
+}
+
+// OrphanReport is the result of orphans.
+type OrphanReport struct {
+	Cluster string   `json:"cluster"`
+	Orphans []Orphan `json:"orphans,omitempty"`
+	// Skipped is the number of the NSX objects tagged for the cluster without an owner tag known by the plugin.
+	Skipped int `json:"skipped"`
+}
+
+// Orphan is an NSX object whose owner, found by the OwnerTagScope tag, no longer exists.
+type Orphan struct {
+	ResourceType  string `json:"resourceType"`
+	ID            string `json:"id"`
+	Path          string `json:"path"`
+	OwnerKind     string `json:"ownerKind"`
+	OwnerTagScope string `json:"ownerTagScope"`
+	OwnerUID      string `json:"ownerUID"`
+}
@@ -24,7 +24,7 @@ import (
 func newIPBlockUsageREST() *ipBlockUsageStorage {
 	provider := fakeVPCInfoProvider{namespaces: []string{"ns1"}}
 	return NewIPBlockUsageStorage(
-		storage.NewIPBlockUsageStorage(&nsx.Client{}, provider),
+		storage.NewIPBlockUsageStorage(&nsx.Client{}, provider, nil),
 		provider,
 	)
 }
@@ -113,7 +113,7 @@ func TestIPBlockUsageStorage_List_CrossNamespace_ErrorSkipped(t *testing.T) {
 	nsxClient := &nsx.Client{}
 	nsxClient.ProjectIPBlockUsageClient = &fakeErrProjectIPBlockUsageClient{}
 	r := NewIPBlockUsageStorage(
-		storage.NewIPBlockUsageStorage(nsxClient, provider),
+		storage.NewIPBlockUsageStorage(nsxClient, provider, nil),
 		provider,
 	)
 	result, err := r.List(context.Background(), nil)
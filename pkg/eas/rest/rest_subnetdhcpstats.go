@@ -33,7 +33,7 @@ func NewSubnetDHCPStatsStorage(store *storage.SubnetDHCPStatsStorage) *subnetDHC
 	return &subnetDHCPStatsStorage{store: store}
 }
 
-// subnetDHCPStatsStorage supports Get-by-name only; List is not exposed for this resource.
+// subnetDHCPStatsStorage supports Get-by-name only; List and Watch are not exposed for this resource.
 type subnetDHCPStatsStorage struct {
 	store *storage.SubnetDHCPStatsStorage
 }
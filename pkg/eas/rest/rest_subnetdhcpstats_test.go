@@ -19,7 +19,7 @@ import (
 
 func newSubnetDHCPStatsREST() *subnetDHCPStatsStorage {
 	return NewSubnetDHCPStatsStorage(
-		storage.NewSubnetDHCPStatsStorage(&nsx.Client{}, newTestFakeK8sClient().Build()),
+		storage.NewSubnetDHCPStatsStorage(&nsx.Client{}, newTestFakeK8sClient().Build(), nil),
 	)
 }
 
@@ -36,7 +36,7 @@ func NewSubnetIPPoolsStorage(store *storage.SubnetIPPoolsStorage) *subnetIPPools
 	return &subnetIPPoolsStorage{store: store}
 }
 
-// subnetIPPoolsStorage supports Get-by-name only; List is not exposed for this resource.
+// subnetIPPoolsStorage supports Get-by-name only; List and Watch are not exposed for this resource.
 type subnetIPPoolsStorage struct {
 	store *storage.SubnetIPPoolsStorage
 }
@@ -19,7 +19,7 @@ import (
 
 func newSubnetIPPoolsREST() *subnetIPPoolsStorage {
 	return NewSubnetIPPoolsStorage(
-		storage.NewSubnetIPPoolsStorage(&nsx.Client{}, newTestFakeK8sClient().Build()),
+		storage.NewSubnetIPPoolsStorage(&nsx.Client{}, newTestFakeK8sClient().Build(), nil),
 	)
 }
 
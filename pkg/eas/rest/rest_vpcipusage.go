@@ -11,6 +11,7 @@ import (
 	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/apiserver/pkg/endpoints/request"
 
 	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
@@ -70,6 +71,13 @@ func (r *vpcIPUsageStorage) List(ctx context.Context, _ *metainternalversion.Lis
 	return merged, nil
 }
 
+// Watch polls List and sends the changed VPCIPAddressUsages as watch events.
+func (r *vpcIPUsageStorage) Watch(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	return newPollWatcher(ctx, func(ctx context.Context) (runtime.Object, error) {
+		return r.List(ctx, options)
+	}, options, watchPollInterval(r.store.CacheTTL())), nil
+}
+
 func (r *vpcIPUsageStorage) ConvertToTable(_ context.Context, object runtime.Object, _ runtime.Object) (*metav1.Table, error) {
 	table := &metav1.Table{ColumnDefinitions: vpcIPUsageColumns}
 	switch obj := object.(type) {
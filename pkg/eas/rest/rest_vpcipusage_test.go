@@ -24,7 +24,7 @@ import (
 func newVPCIPUsageREST() *vpcIPUsageStorage {
 	provider := fakeVPCInfoProvider{namespaces: []string{"ns1"}}
 	return NewVPCIPUsageStorage(
-		storage.NewVPCIPAddressUsageStorage(&nsx.Client{}, provider),
+		storage.NewVPCIPAddressUsageStorage(&nsx.Client{}, provider, nil),
 		provider,
 	)
 }
@@ -71,7 +71,7 @@ func TestVPCIPUsageStorage_List_CrossNamespace(t *testing.T) {
 func TestVPCIPUsageStorage_List_CrossNamespace_NoNamespaces(t *testing.T) {
 	// Provider has no namespaces → cross-namespace list returns empty list immediately.
 	r := NewVPCIPUsageStorage(
-		storage.NewVPCIPAddressUsageStorage(&nsx.Client{}, fakeVPCInfoProvider{}),
+		storage.NewVPCIPAddressUsageStorage(&nsx.Client{}, fakeVPCInfoProvider{}, nil),
 		fakeVPCInfoProvider{}, // ListAllVPCNamespaces returns nil
 	)
 	result, err := r.List(context.Background(), nil)
@@ -135,7 +135,7 @@ func TestVPCIPUsageStorage_List_CrossNamespace_ErrorSkipped(t *testing.T) {
 	nsxClient := &nsx.Client{}
 	nsxClient.IPAddressUsageClient = &fakeErrIPAddressUsageClient{}
 	r := NewVPCIPUsageStorage(
-		storage.NewVPCIPAddressUsageStorage(nsxClient, provider),
+		storage.NewVPCIPAddressUsageStorage(nsxClient, provider, nil),
 		provider,
 	)
 	// Cross-namespace list: no namespace in context.
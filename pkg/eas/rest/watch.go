@@ -0,0 +1,154 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+)
+
+// minWatchPollInterval is the shortest interval a watch lists the resources at, so a
+// short or disabled cache (EAS_CACHE_TTL=0) does not make every open watch query NSX
+// at a high rate.
+const minWatchPollInterval = 30 * time.Second
+
+// watchPollInterval returns how often a watch lists the resources to detect changes.
+// Lists within the cache TTL are served from the storage cache, so polling faster
+// than the TTL would not detect changes any sooner.
+func watchPollInterval(cacheTTL time.Duration) time.Duration {
+	if cacheTTL < minWatchPollInterval {
+		return minWatchPollInterval
+	}
+	return cacheTTL
+}
+
+// listFunc lists the resources of a storage, the result must be a list object.
+type listFunc func(ctx context.Context) (runtime.Object, error)
+
+// pollWatcher implements watch.Interface for the EAS resources, which are not
+// stored in etcd but fetched from NSX. It lists the resources periodically and
+// sends the differences to the previous list as watch events. The first list is
+// sent as ADDED events, as a watch without resourceVersion does.
+type pollWatcher struct {
+	list     listFunc
+	interval time.Duration
+	label    labels.Selector
+	field    fields.Selector
+	result   chan watch.Event
+	cancel   context.CancelFunc
+	// objects is the last listed objects keyed by namespace/name.
+	objects map[string]runtime.Object
+}
+
+// newPollWatcher starts a watch which is stopped when ctx is done or Stop is called.
+func newPollWatcher(ctx context.Context, list listFunc, options *metainternalversion.ListOptions, interval time.Duration) *pollWatcher {
+	label, field := labels.Everything(), fields.Everything()
+	if options != nil {
+		if options.LabelSelector != nil {
+			label = options.LabelSelector
+		}
+		if options.FieldSelector != nil {
+			field = options.FieldSelector
+		}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	w := &pollWatcher{
+		list:     list,
+		interval: interval,
+		label:    label,
+		field:    field,
+		result:   make(chan watch.Event),
+		cancel:   cancel,
+		objects:  make(map[string]runtime.Object),
+	}
+	go w.run(ctx)
+	return w
+}
+
+func (w *pollWatcher) Stop() {
+	w.cancel()
+}
+
+func (w *pollWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *pollWatcher) run(ctx context.Context) {
+	defer close(w.result)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if !w.poll(ctx) {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll lists the resources and sends the events, it returns false if the watch is stopped.
+func (w *pollWatcher) poll(ctx context.Context) bool {
+	listObj, err := w.list(ctx)
+	if err != nil {
+		// NSX may be unreachable for a while, keep the last objects and retry in the next poll.
+		logger.Log.Error(err, "Failed to list EAS resources for watch")
+		return ctx.Err() == nil
+	}
+	items, err := meta.ExtractList(listObj)
+	if err != nil {
+		logger.Log.Error(err, "Failed to extract EAS resources for watch")
+		return ctx.Err() == nil
+	}
+
+	current := make(map[string]runtime.Object, len(items))
+	var events []watch.Event
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			continue
+		}
+		if !w.label.Matches(labels.Set(accessor.GetLabels())) ||
+			!w.field.Matches(fields.Set{"metadata.name": accessor.GetName(), "metadata.namespace": accessor.GetNamespace()}) {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", accessor.GetNamespace(), accessor.GetName())
+		current[key] = item
+		previous, ok := w.objects[key]
+		switch {
+		case !ok:
+			events = append(events, watch.Event{Type: watch.Added, Object: item})
+		case !equality.Semantic.DeepEqual(previous, item):
+			events = append(events, watch.Event{Type: watch.Modified, Object: item})
+		}
+	}
+	for key, previous := range w.objects {
+		if _, ok := current[key]; !ok {
+			events = append(events, watch.Event{Type: watch.Deleted, Object: previous})
+		}
+	}
+	w.objects = current
+
+	for _, event := range events {
+		select {
+		case <-ctx.Done():
+			return false
+		case w.result <- event:
+		}
+	}
+	return true
+}
@@ -0,0 +1,121 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	apirest "k8s.io/apiserver/pkg/registry/rest"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+)
+
+func vpcIPUsage(name, percentage string) easv1alpha1.VPCIPAddressUsage {
+	return easv1alpha1.VPCIPAddressUsage{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns1"},
+		IPBlocks:   []easv1alpha1.VPCIPAddressBlock{{PercentageUsed: percentage}},
+	}
+}
+
+func nextEvent(t *testing.T, w watch.Interface) watch.Event {
+	t.Helper()
+	select {
+	case event, ok := <-w.ResultChan():
+		require.True(t, ok, "watch result channel closed")
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watch event")
+	}
+	return watch.Event{}
+}
+
+func TestPollWatcher(t *testing.T) {
+	lists := []*easv1alpha1.VPCIPAddressUsageList{
+		{Items: []easv1alpha1.VPCIPAddressUsage{vpcIPUsage("vpc-1", "10"), vpcIPUsage("vpc-2", "20")}},
+		nil, // NSX error, the watch keeps the last objects
+		{Items: []easv1alpha1.VPCIPAddressUsage{vpcIPUsage("vpc-1", "15"), vpcIPUsage("vpc-2", "20")}},
+		{Items: []easv1alpha1.VPCIPAddressUsage{vpcIPUsage("vpc-1", "15")}},
+	}
+	calls := 0
+	list := func(context.Context) (runtime.Object, error) {
+		idx := calls
+		if idx >= len(lists) {
+			idx = len(lists) - 1
+		}
+		calls++
+		if lists[idx] == nil {
+			return nil, errors.New("nsx error")
+		}
+		return lists[idx].DeepCopy(), nil
+	}
+
+	w := newPollWatcher(context.Background(), list, nil, time.Millisecond)
+	defer w.Stop()
+
+	added := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		event := nextEvent(t, w)
+		assert.Equal(t, watch.Added, event.Type)
+		added[event.Object.(*easv1alpha1.VPCIPAddressUsage).Name] = true
+	}
+	assert.Equal(t, map[string]bool{"vpc-1": true, "vpc-2": true}, added)
+
+	event := nextEvent(t, w)
+	assert.Equal(t, watch.Modified, event.Type)
+	assert.Equal(t, "15", event.Object.(*easv1alpha1.VPCIPAddressUsage).IPBlocks[0].PercentageUsed)
+
+	event = nextEvent(t, w)
+	assert.Equal(t, watch.Deleted, event.Type)
+	assert.Equal(t, "vpc-2", event.Object.(*easv1alpha1.VPCIPAddressUsage).Name)
+}
+
+func TestPollWatcher_StopAndFieldSelector(t *testing.T) {
+	list := func(context.Context) (runtime.Object, error) {
+		return &easv1alpha1.VPCIPAddressUsageList{
+			Items: []easv1alpha1.VPCIPAddressUsage{vpcIPUsage("vpc-1", "10"), vpcIPUsage("vpc-2", "20")},
+		}, nil
+	}
+	options := &metainternalversion.ListOptions{FieldSelector: fields.OneTermEqualSelector("metadata.name", "vpc-2")}
+	ctx, cancel := context.WithCancel(context.Background())
+	w := newPollWatcher(ctx, list, options, time.Millisecond)
+
+	event := nextEvent(t, w)
+	assert.Equal(t, watch.Added, event.Type)
+	assert.Equal(t, "vpc-2", event.Object.(*easv1alpha1.VPCIPAddressUsage).Name)
+
+	// The watch is stopped when the request context is done.
+	cancel()
+	for range w.ResultChan() {
+	}
+}
+
+func TestVPCIPUsageStorage_Watch(t *testing.T) {
+	var _ apirest.Watcher = &vpcIPUsageStorage{}
+	var _ apirest.Watcher = &ipBlockUsageStorage{}
+
+	r := newVPCIPUsageREST()
+	ctx := request.WithNamespace(context.Background(), "ns1")
+	w, err := r.Watch(ctx, &metainternalversion.ListOptions{})
+	require.NoError(t, err)
+	w.Stop()
+	for range w.ResultChan() {
+	}
+}
+
+func TestWatchPollInterval(t *testing.T) {
+	assert.Equal(t, minWatchPollInterval, watchPollInterval(0))
+	assert.Equal(t, minWatchPollInterval, watchPollInterval(10*time.Second))
+	assert.Equal(t, 2*time.Minute, watchPollInterval(2*time.Minute))
+}
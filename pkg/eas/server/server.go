@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"time"
 
 	openapinamer "k8s.io/apiserver/pkg/endpoints/openapi"
 	apirest "k8s.io/apiserver/pkg/registry/rest"
@@ -33,10 +34,15 @@ const (
 	defaultPort       = "9553"
 	easPortEnv        = "EAS_PORT"
 	easBindAddressEnv = "EAS_BIND_ADDRESS"
+	// easCacheTTLEnv overrides how long NSX responses are cached, e.g. "30s".
+	// "0" disables the cache.
+	easCacheTTLEnv = "EAS_CACHE_TTL"
 )
 
 // EASServer is an Extension API Server that serves EAS read-only resources by
-// fetching data from the NSX API on each request.  It is built on the generic
+// fetching data from the NSX API.  The NSX responses are kept in a TTL-bounded
+// cache shared by all resources, so concurrent and repeated requests within the
+// TTL do not reach NSX.  It is built on the generic
 // apiserver framework (k8s.io/apiserver/pkg/server), which provides delegated
 // authentication, delegated authorization, content negotiation, and table output.
 //
@@ -84,12 +90,13 @@ func NewEASServer(
 	kubeConfigFile string,
 	caCert []byte,
 ) *EASServer {
+	cache := storage.NewCache(cacheTTL())
 	return &EASServer{
 		vpcProvider:     vpcProvider,
-		vpcIPUsage:      storage.NewVPCIPAddressUsageStorage(nsxClient, vpcProvider),
-		ipBlockUsage:    storage.NewIPBlockUsageStorage(nsxClient, vpcProvider),
-		subnetIPPools:   storage.NewSubnetIPPoolsStorage(nsxClient, k8sClient),
-		subnetDHCPStats: storage.NewSubnetDHCPStatsStorage(nsxClient, k8sClient),
+		vpcIPUsage:      storage.NewVPCIPAddressUsageStorage(nsxClient, vpcProvider, cache),
+		ipBlockUsage:    storage.NewIPBlockUsageStorage(nsxClient, vpcProvider, cache),
+		subnetIPPools:   storage.NewSubnetIPPoolsStorage(nsxClient, k8sClient, cache),
+		subnetDHCPStats: storage.NewSubnetDHCPStatsStorage(nsxClient, k8sClient, cache),
 		// /readyz reports not-ready when NSX is unreachable, causing kube-proxy
 		// to stop routing traffic to this pod until connectivity is restored.
 		nsxHealthChecker: healthz.NamedCheck("nsx", nsxClient.NSXChecker.CheckNSXHealth),
@@ -263,3 +270,18 @@ func listenerConfig() (port int, bindAddr net.IP, certFile, keyFile string) {
 	keyFile = path.Join(config.WebhookCertDir, config.EASKeyFile)
 	return p, bindAddr, certFile, keyFile
 }
+
+// cacheTTL returns the TTL of the NSX response cache from the environment variable,
+// or storage.DefaultCacheTTL if it is not set or invalid.
+func cacheTTL() time.Duration {
+	ttlStr := os.Getenv(easCacheTTLEnv)
+	if ttlStr == "" {
+		return storage.DefaultCacheTTL
+	}
+	ttl, err := time.ParseDuration(ttlStr)
+	if err != nil || ttl < 0 {
+		logger.Log.Info("Invalid EAS cache TTL, using the default", "value", ttlStr, "default", storage.DefaultCacheTTL)
+		return storage.DefaultCacheTTL
+	}
+	return ttl
+}
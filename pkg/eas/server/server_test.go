@@ -5,6 +5,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,7 @@ import (
 
 	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/eas"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 )
 
@@ -94,3 +96,17 @@ func TestListenerConfig(t *testing.T) {
 		assert.Equal(t, 9553, port)
 	})
 }
+
+func TestCacheTTL(t *testing.T) {
+	t.Setenv(easCacheTTLEnv, "")
+	assert.Equal(t, storage.DefaultCacheTTL, cacheTTL())
+
+	t.Setenv(easCacheTTLEnv, "30s")
+	assert.Equal(t, 30*time.Second, cacheTTL())
+
+	t.Setenv(easCacheTTLEnv, "0")
+	assert.Equal(t, time.Duration(0), cacheTTL())
+
+	t.Setenv(easCacheTTLEnv, "not-a-duration")
+	assert.Equal(t, storage.DefaultCacheTTL, cacheTTL())
+}
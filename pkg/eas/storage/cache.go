@@ -0,0 +1,111 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultCacheTTL is how long an NSX response is served from the Cache before NSX is queried again.
+const DefaultCacheTTL = 10 * time.Second
+
+// Cache is a TTL-bounded cache of NSX API responses shared by the EAS storages.
+// Concurrent requests for the same key are coalesced, so a burst of requests, e.g.
+// dashboards polling vpcipaddressusages across many namespaces, results in a single
+// NSX call per key and TTL. Errors are not cached.
+type Cache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	group   singleflight.Group
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// NewCache creates a Cache whose entries expire after ttl.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// TTL returns how long the entries are cached.
+func (c *Cache) TTL() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return c.ttl
+}
+
+func (c *Cache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *Cache) set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	// Drop the expired entries, so that keys of deleted NSX resources don't accumulate.
+	for k, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{value: value, expires: now.Add(c.ttl)}
+}
+
+// cachedFetch returns the cached value of key, or calls fetch and caches its result.
+// Concurrent callers of the same key wait for the same fetch. A nil Cache or a
+// non-positive TTL disables caching, fetch is called on every request.
+func cachedFetch[T any](c *Cache, key string, fetch func() (T, error)) (T, error) {
+	if c == nil || c.ttl <= 0 {
+		return fetch()
+	}
+	if value, ok := c.get(key); ok {
+		return value.(T), nil
+	}
+	value, err, _ := c.group.Do(key, func() (interface{}, error) {
+		// Another caller may have filled the entry while waiting for the group.
+		if value, ok := c.get(key); ok {
+			return value, nil
+		}
+		value, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		c.set(key, value)
+		return value, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return value.(T), nil
+}
+
+// cacheKey joins the NSX resource kind and IDs into a Cache key.
+func cacheKey(kind string, ids ...string) string {
+	return kind + "/" + strings.Join(ids, "/")
+}
@@ -0,0 +1,146 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestCachedFetch_TTL(t *testing.T) {
+	c := NewCache(time.Minute)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		return "value", nil
+	}
+	for i := 0; i < 3; i++ {
+		v, err := cachedFetch(c, "key", fetch)
+		require.NoError(t, err)
+		assert.Equal(t, "value", v)
+	}
+	assert.Equal(t, 1, calls)
+
+	now = now.Add(time.Minute)
+	_, err := cachedFetch(c, "key", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestCachedFetch_ErrorNotCached(t *testing.T) {
+	c := NewCache(time.Minute)
+	calls := 0
+	fetch := func() (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, errors.New("nsx error")
+		}
+		return 1, nil
+	}
+	_, err := cachedFetch(c, "key", fetch)
+	assert.Error(t, err)
+	v, err := cachedFetch(c, "key", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 2, calls)
+}
+
+func TestCachedFetch_Disabled(t *testing.T) {
+	calls := 0
+	fetch := func() (int, error) {
+		calls++
+		return calls, nil
+	}
+	for _, c := range []*Cache{nil, NewCache(0)} {
+		v1, _ := cachedFetch(c, "key", fetch)
+		v2, _ := cachedFetch(c, "key", fetch)
+		assert.NotEqual(t, v1, v2)
+	}
+	assert.Equal(t, time.Duration(0), (*Cache)(nil).TTL())
+}
+
+func TestCachedFetch_Coalescing(t *testing.T) {
+	c := NewCache(time.Minute)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fetch := func() (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = cachedFetch(c, "key", fetch)
+		}(i)
+	}
+	// Give the goroutines time to join the in-flight fetch before it returns.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, v := range results {
+		assert.Equal(t, 42, v)
+	}
+}
+
+func TestCache_SharedSubnetList(t *testing.T) {
+	subnetCR := &vpcv1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: "subnet-1", Namespace: "ns1"},
+		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "proj:vpc-1"},
+	}
+	subnets := &countingSubnetsClient{fakeSubnetsClient: fakeSubnetsClient{results: model.VpcSubnetListResult{
+		Results: []model.VpcSubnet{{
+			Id:   strPtr("subnet-1"),
+			Tags: []model.Tag{{Scope: strPtr(common.TagScopeSubnetCRName), Tag: strPtr("subnet-1")}},
+		}},
+	}}}
+	c := &nsx.Client{
+		SubnetsClient:               subnets,
+		IPPoolClient:                &fakeIPPoolClient{},
+		DhcpServerConfigStatsClient: &fakeDHCPStatsClient{},
+	}
+	cache := NewCache(time.Minute)
+	k8sClient := newFakeK8sClient(subnetCR)
+
+	ipPools := NewSubnetIPPoolsStorage(c, k8sClient, cache)
+	_, err := ipPools.Get(context.TODO(), "ns1", "subnet-1")
+	require.NoError(t, err)
+	_, err = ipPools.Get(context.TODO(), "ns1", "subnet-1")
+	require.NoError(t, err)
+	// The DHCP stats storage reuses the subnets listed by the IP pools storage.
+	_, _ = NewSubnetDHCPStatsStorage(c, k8sClient, cache).Get(context.TODO(), "ns1", "subnet-1")
+	assert.Equal(t, 1, subnets.calls)
+}
+
+type countingSubnetsClient struct {
+	fakeSubnetsClient
+	calls int
+}
+
+func (f *countingSubnetsClient) List(orgID, projectID, vpcID string, cursor *string, includeMarkForDelete *bool, includedFields *string, pageSize *int64, sortAscending *bool, sortBy *string) (model.VpcSubnetListResult, error) {
+	f.calls++
+	return f.fakeSubnetsClient.List(orgID, projectID, vpcID, cursor, includeMarkForDelete, includedFields, pageSize, sortAscending, sortBy)
+}
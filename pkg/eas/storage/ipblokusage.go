@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -22,16 +23,24 @@ import (
 type IPBlockUsageStorage struct {
 	nsxClient  *nsx.Client
 	vpcService eas.VPCInfoProvider
+	cache      *Cache
 }
 
 // NewIPBlockUsageStorage creates a new storage instance.
-func NewIPBlockUsageStorage(nsxClient *nsx.Client, vpcService eas.VPCInfoProvider) *IPBlockUsageStorage {
+// cache may be nil, in which case NSX is queried on every request.
+func NewIPBlockUsageStorage(nsxClient *nsx.Client, vpcService eas.VPCInfoProvider, cache *Cache) *IPBlockUsageStorage {
 	return &IPBlockUsageStorage{
 		nsxClient:  nsxClient,
 		vpcService: vpcService,
+		cache:      cache,
 	}
 }
 
+// CacheTTL returns how long the NSX responses are cached, 0 if they are not.
+func (s *IPBlockUsageStorage) CacheTTL() time.Duration {
+	return s.cache.TTL()
+}
+
 // Get retrieves IP block usage for a single IP block identified by name.
 //
 // If name starts with ":", it is treated as an NSX infra IP block ID (global scope).
@@ -50,7 +59,9 @@ func (s *IPBlockUsageStorage) Get(_ context.Context, namespace, name string) (*e
 			return nil, fmt.Errorf("invalid infra IP block identifier %q: expected format ':<ipBlockID>'", name)
 		}
 		log.Debug("Fetching infra IP block usage from NSX", "namespace", namespace, "ipBlockID", blockID)
-		nsxUsage, err := s.nsxClient.InfraIPBlockUsageClient.Get(blockID)
+		nsxUsage, err := cachedFetch(s.cache, cacheKey("infraipblockusage", blockID), func() (model.IpAddressBlockUsage, error) {
+			return s.nsxClient.InfraIPBlockUsageClient.Get(blockID)
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to get infra IP block usage for block %s: %w", blockID, err)
 		}
@@ -74,7 +85,9 @@ func (s *IPBlockUsageStorage) Get(_ context.Context, namespace, name string) (*e
 		matchedProjectID, ok := s.resolveProjectBlock(orgID, projectID, vpcID, blockID)
 		if ok {
 			log.Debug("Fetching project IP block usage from NSX", "namespace", namespace, "projectID", matchedProjectID, "ipBlockID", blockID)
-			nsxUsage, err := s.nsxClient.ProjectIPBlockUsageClient.Get(orgID, matchedProjectID, blockID)
+			nsxUsage, err := cachedFetch(s.cache, cacheKey("projectipblockusage", orgID, matchedProjectID, blockID), func() (model.IpAddressBlockUsage, error) {
+				return s.nsxClient.ProjectIPBlockUsageClient.Get(orgID, matchedProjectID, blockID)
+			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to get IP block usage for project %s, block %s: %w", matchedProjectID, blockID, err)
 			}
@@ -91,11 +104,15 @@ func (s *IPBlockUsageStorage) Get(_ context.Context, namespace, name string) (*e
 // private IP block in the VPC's own project and the VPC's projectID is returned.
 func (s *IPBlockUsageStorage) resolveProjectBlock(orgID, projectID, vpcID, blockID string) (string, bool) {
 	// Fetch VPC attachments to get connectivity profile
-	attachments, err := s.nsxClient.VpcAttachmentClient.List(orgID, projectID, vpcID, nil, nil, nil, nil, nil, nil)
+	attachments, err := cachedFetch(s.cache, cacheKey("vpcattachments", orgID, projectID, vpcID), func() (model.VpcAttachmentListResult, error) {
+		return s.nsxClient.VpcAttachmentClient.List(orgID, projectID, vpcID, nil, nil, nil, nil, nil, nil)
+	})
 	if err == nil && len(attachments.Results) > 0 && attachments.Results[0].VpcConnectivityProfile != nil {
 		profilePath := *attachments.Results[0].VpcConnectivityProfile
 		profileName := policyPathLeaf(profilePath)
-		profile, err := s.nsxClient.VPCConnectivityProfilesClient.Get(orgID, projectID, profileName)
+		profile, err := cachedFetch(s.cache, cacheKey("vpcconnectivityprofile", orgID, projectID, profileName), func() (model.VpcConnectivityProfile, error) {
+			return s.nsxClient.VPCConnectivityProfilesClient.Get(orgID, projectID, profileName)
+		})
 		if err == nil {
 			for _, path := range profile.ExternalIpBlocks {
 				if policyPathLeaf(path) == blockID {
@@ -168,7 +185,9 @@ func (s *IPBlockUsageStorage) List(_ context.Context, namespace string) (*easv1a
 
 		orgID := entry.Info.OrgID
 		log.Debug("Fetching project IP block usage from NSX", "orgID", orgID, "projectID", pid)
-		nsxList, err := s.nsxClient.ProjectIPBlockUsageClient.List(orgID, pid, nil, nil, nil, nil, nil, nil, nil)
+		nsxList, err := cachedFetch(s.cache, cacheKey("projectipblockusages", orgID, pid), func() (model.IpAddressBlockUsageList, error) {
+			return s.nsxClient.ProjectIPBlockUsageClient.List(orgID, pid, nil, nil, nil, nil, nil, nil, nil)
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to list IP block usage for project %s: %w", pid, err)
 		}
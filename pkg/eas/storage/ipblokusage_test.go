@@ -183,14 +183,14 @@ func TestDerefCount(t *testing.T) {
 	assert.Equal(t, "0", derefCount(strPtr("0")))
 }
 func TestIPBlockUsageStorage_List_NoVPC(t *testing.T) {
-	s := NewIPBlockUsageStorage(&nsx.Client{}, emptyVPCProvider{})
+	s := NewIPBlockUsageStorage(&nsx.Client{}, emptyVPCProvider{}, nil)
 	list, err := s.List(context.Background(), "ns1")
 	require.NoError(t, err)
 	require.NotNil(t, list)
 	assert.Empty(t, list.Items)
 }
 func TestIPBlockUsageStorage_Get_InvalidFormat(t *testing.T) {
-	s := NewIPBlockUsageStorage(&nsx.Client{}, emptyVPCProvider{})
+	s := NewIPBlockUsageStorage(&nsx.Client{}, emptyVPCProvider{}, nil)
 	_, err := s.Get(context.Background(), "ns1", ":")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid")
@@ -198,7 +198,7 @@ func TestIPBlockUsageStorage_Get_InvalidFormat(t *testing.T) {
 func TestIPBlockUsageStorage_Get_Infra_OK(t *testing.T) {
 	c := &nsx.Client{}
 	c.InfraIPBlockUsageClient = &fakeInfraIPBlockUsageClient{}
-	s := NewIPBlockUsageStorage(c, emptyVPCProvider{})
+	s := NewIPBlockUsageStorage(c, emptyVPCProvider{}, nil)
 	result, err := s.Get(context.Background(), "ns1", ":block1")
 	require.NoError(t, err)
 	assert.Equal(t, ":block1", result.Name)
@@ -216,7 +216,7 @@ func TestIPBlockUsageStorage_Get_Project_OK(t *testing.T) {
 		ExternalIpBlocks: []string{blockPath},
 	}}
 	c.ProjectIPBlockUsageClient = &fakeProjectIPBlockUsageClient{getResult: model.IpAddressBlockUsage{}}
-	s := NewIPBlockUsageStorage(c, p)
+	s := NewIPBlockUsageStorage(c, p, nil)
 	result, err := s.Get(context.Background(), "ns1", "block1")
 	require.NoError(t, err)
 	assert.Equal(t, "block1", result.Name)
@@ -234,7 +234,7 @@ func TestIPBlockUsageStorage_Get_Project_OK_FromConnectivityProfile(t *testing.T
 		ExternalIpBlocks: []string{blockPath},
 	}}
 	c.ProjectIPBlockUsageClient = &fakeProjectIPBlockUsageClient{getResult: model.IpAddressBlockUsage{}}
-	s := NewIPBlockUsageStorage(c, p)
+	s := NewIPBlockUsageStorage(c, p, nil)
 	result, err := s.Get(context.Background(), "ns1", "block1")
 	require.NoError(t, err)
 	assert.Equal(t, "block1", result.Name)
@@ -242,7 +242,7 @@ func TestIPBlockUsageStorage_Get_Project_OK_FromConnectivityProfile(t *testing.T
 func TestIPBlockUsageStorage_Get_Infra_Error(t *testing.T) {
 	c := &nsx.Client{}
 	c.InfraIPBlockUsageClient = &fakeInfraIPBlockUsageClient{err: fmt.Errorf("nsx error")}
-	s := NewIPBlockUsageStorage(c, emptyVPCProvider{})
+	s := NewIPBlockUsageStorage(c, emptyVPCProvider{}, nil)
 	_, err := s.Get(context.Background(), "ns1", ":block1")
 	require.Error(t, err)
 }
@@ -256,7 +256,7 @@ func TestIPBlockUsageStorage_Get_Project_Error(t *testing.T) {
 	}}
 	c.VPCConnectivityProfilesClient = &fakeVpcConnectivityProfilesClient{}
 	c.ProjectIPBlockUsageClient = &fakeProjectIPBlockUsageClient{err: fmt.Errorf("nsx error")}
-	s := NewIPBlockUsageStorage(c, p)
+	s := NewIPBlockUsageStorage(c, p, nil)
 	_, err := s.Get(context.Background(), "ns1", "block1")
 	require.Error(t, err)
 }
@@ -265,7 +265,7 @@ func TestIPBlockUsageStorage_Get_Project_ErrorDoesNotAffectList(t *testing.T) {
 	p := singleVPCProvider{info: common.VPCResourceInfo{OrgID: "o1", ProjectID: "p1", VPCID: "vpc1"}}
 	c := &nsx.Client{}
 	c.ProjectIPBlockUsageClient = &fakeProjectIPBlockUsageClient{err: fmt.Errorf("nsx error")}
-	s := NewIPBlockUsageStorage(c, p)
+	s := NewIPBlockUsageStorage(c, p, nil)
 	_, err := s.List(context.Background(), "ns1")
 	require.Error(t, err)
 }
@@ -273,7 +273,7 @@ func TestIPBlockUsageStorage_List_OK(t *testing.T) {
 	p := singleVPCProvider{info: common.VPCResourceInfo{OrgID: "o1", ProjectID: "p1", VPCID: "vpc1"}}
 	c := &nsx.Client{}
 	c.ProjectIPBlockUsageClient = &fakeProjectIPBlockUsageClient{}
-	s := NewIPBlockUsageStorage(c, p)
+	s := NewIPBlockUsageStorage(c, p, nil)
 	list, err := s.List(context.Background(), "ns1")
 	require.NoError(t, err)
 	require.NotNil(t, list)
@@ -282,7 +282,7 @@ func TestIPBlockUsageStorage_List_Error(t *testing.T) {
 	p := singleVPCProvider{info: common.VPCResourceInfo{OrgID: "o1", ProjectID: "p1", VPCID: "vpc1"}}
 	c := &nsx.Client{}
 	c.ProjectIPBlockUsageClient = &fakeProjectIPBlockUsageClient{err: fmt.Errorf("nsx error")}
-	s := NewIPBlockUsageStorage(c, p)
+	s := NewIPBlockUsageStorage(c, p, nil)
 	_, err := s.List(context.Background(), "ns1")
 	require.Error(t, err)
 }
@@ -297,7 +297,7 @@ func TestIPBlockUsageStorage_List_WithResults(t *testing.T) {
 			Results: []model.IpAddressBlockUsage{{IntentPath: &intent}},
 		},
 	}
-	s := NewIPBlockUsageStorage(c, p)
+	s := NewIPBlockUsageStorage(c, p, nil)
 	list, err := s.List(context.Background(), "ns1")
 	require.NoError(t, err)
 	require.Len(t, list.Items, 1)
@@ -316,7 +316,7 @@ func TestIPBlockUsageStorage_List_DeduplicatesProjects(t *testing.T) {
 			Results: []model.IpAddressBlockUsage{{IntentPath: &intent}},
 		},
 	}
-	s := NewIPBlockUsageStorage(c, p)
+	s := NewIPBlockUsageStorage(c, p, nil)
 	list, err := s.List(context.Background(), "ns1")
 	require.NoError(t, err)
 	// Only one set of results because both VPCs share project p1.
@@ -23,13 +23,16 @@ import (
 type SubnetDHCPStatsStorage struct {
 	nsxClient *nsx.Client
 	k8sClient k8sclient.Client
+	cache     *Cache
 }
 
 // NewSubnetDHCPStatsStorage creates a new storage instance.
-func NewSubnetDHCPStatsStorage(nsxClient *nsx.Client, k8sClient k8sclient.Client) *SubnetDHCPStatsStorage {
+// cache may be nil, in which case NSX is queried on every request.
+func NewSubnetDHCPStatsStorage(nsxClient *nsx.Client, k8sClient k8sclient.Client, cache *Cache) *SubnetDHCPStatsStorage {
 	return &SubnetDHCPStatsStorage{
 		nsxClient: nsxClient,
 		k8sClient: k8sClient,
+		cache:     cache,
 	}
 }
 
@@ -55,8 +58,7 @@ func (s *SubnetDHCPStatsStorage) Get(ctx context.Context, namespace, name string
 	log.Debug("Fetching DHCP stats by name", "namespace", namespace, "name", name,
 		"projectID", projectID, "vpcID", vpcID)
 
-	subnets, err := s.nsxClient.SubnetsClient.List(orgID, projectID, vpcID,
-		nil, nil, nil, nil, nil, nil)
+	subnets, err := listNSXSubnets(s.nsxClient, s.cache, orgID, projectID, vpcID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list subnets from NSX: %w", err)
 	}
@@ -90,15 +92,25 @@ func (s *SubnetDHCPStatsStorage) Get(ctx context.Context, namespace, name string
 // fetchStats calls NSX for DHCP stats of a specific NSX subnet and returns the result
 // with metadata.name set to name (the Subnet CR name).
 func (s *SubnetDHCPStatsStorage) fetchStats(namespace, nsxSubnetID, name string, info nsxcommon.VPCResourceInfo) (*easv1alpha1.SubnetDHCPServerStats, error) {
-	nsxStats, err := s.nsxClient.DhcpServerConfigStatsClient.Get(
-		info.OrgID, info.ProjectID, info.VPCID, nsxSubnetID,
-		nil, nil, nil, nil, nil, nil, nil)
+	nsxStats, err := cachedFetch(s.cache, cacheKey("dhcpserverstats", info.OrgID, info.ProjectID, info.VPCID, nsxSubnetID), func() (model.DhcpServerStatistics, error) {
+		return s.nsxClient.DhcpServerConfigStatsClient.Get(
+			info.OrgID, info.ProjectID, info.VPCID, nsxSubnetID,
+			nil, nil, nil, nil, nil, nil, nil)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get DHCP server config stats from NSX: %w", err)
 	}
 	return ConvertDhcpServerStatistics(&nsxStats, name, namespace), nil
 }
 
+// listNSXSubnets lists the NSX subnets in the VPC. The result is cached per VPC and
+// shared by the SubnetIPPools and SubnetDHCPServerStats storages.
+func listNSXSubnets(nsxClient *nsx.Client, cache *Cache, orgID, projectID, vpcID string) (model.VpcSubnetListResult, error) {
+	return cachedFetch(cache, cacheKey("subnets", orgID, projectID, vpcID), func() (model.VpcSubnetListResult, error) {
+		return nsxClient.SubnetsClient.List(orgID, projectID, vpcID, nil, nil, nil, nil, nil, nil)
+	})
+}
+
 // nsxTagValue returns the tag value for the given scope from an NSX tags slice.
 func nsxTagValue(tags []model.Tag, scope string) string {
 	for _, t := range tags {
@@ -41,7 +41,7 @@ func TestConvertDhcpServerStatistics_WithPools(t *testing.T) {
 }
 func TestSubnetDHCPStatsStorage_Get_SubnetCRNotFound(t *testing.T) {
 	// No Subnet CR in k8s → error about missing CR.
-	s := NewSubnetDHCPStatsStorage(&nsx.Client{}, newFakeK8sClient())
+	s := NewSubnetDHCPStatsStorage(&nsx.Client{}, newFakeK8sClient(), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "subnet CR")
@@ -52,7 +52,7 @@ func TestSubnetDHCPStatsStorage_Get_EmptyVPCName(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		// VPCName intentionally empty
 	}
-	s := NewSubnetDHCPStatsStorage(&nsx.Client{}, newFakeK8sClient(subnetCR))
+	s := NewSubnetDHCPStatsStorage(&nsx.Client{}, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "empty spec.vpcName")
@@ -68,7 +68,7 @@ func TestSubnetDHCPStatsStorage_Get_SubnetNotFound(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
@@ -94,7 +94,7 @@ func TestSubnetDHCPStatsStorage_Get_OK(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR), nil)
 	result, err := s.Get(context.Background(), "ns1", "sub1")
 	require.NoError(t, err)
 	assert.Equal(t, "sub1", result.Name)
@@ -120,7 +120,7 @@ func TestSubnetDHCPStatsStorage_Get_FetchStatsError(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 }
@@ -131,7 +131,7 @@ func TestSubnetDHCPStatsStorage_Get_SubnetsError(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 }
@@ -155,7 +155,7 @@ func TestSubnetDHCPStatsStorage_Get_WithIDFallback(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: subnetID, Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR), nil)
 	result, err := s.Get(context.Background(), "ns1", subnetID)
 	require.NoError(t, err)
 	assert.Equal(t, subnetID, result.Name)
@@ -170,7 +170,7 @@ func TestSubnetDHCPStatsStorage_Get_SubnetNilID(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "anything", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "anything")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
@@ -196,7 +196,7 @@ func TestSubnetDHCPStatsStorage_Get_WrongDHCPMode(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "DHCP_DEACTIVATED")
@@ -222,7 +222,7 @@ func TestSubnetDHCPStatsStorage_Get_NilDHCPConfig(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetDHCPStatsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "unknown")
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -23,13 +24,16 @@ import (
 type SubnetIPPoolsStorage struct {
 	nsxClient *nsx.Client
 	k8sClient k8sclient.Client
+	cache     *Cache
 }
 
 // NewSubnetIPPoolsStorage creates a new storage instance.
-func NewSubnetIPPoolsStorage(nsxClient *nsx.Client, k8sClient k8sclient.Client) *SubnetIPPoolsStorage {
+// cache may be nil, in which case NSX is queried on every request.
+func NewSubnetIPPoolsStorage(nsxClient *nsx.Client, k8sClient k8sclient.Client, cache *Cache) *SubnetIPPoolsStorage {
 	return &SubnetIPPoolsStorage{
 		nsxClient: nsxClient,
 		k8sClient: k8sClient,
+		cache:     cache,
 	}
 }
 
@@ -55,8 +59,7 @@ func (s *SubnetIPPoolsStorage) Get(ctx context.Context, namespace, name string)
 	log.Debug("Fetching subnet IP pools by name", "namespace", namespace, "name", name,
 		"projectID", projectID, "vpcID", vpcID)
 
-	subnets, err := s.nsxClient.SubnetsClient.List(orgID, projectID, vpcID,
-		nil, nil, nil, nil, nil, nil)
+	subnets, err := listNSXSubnets(s.nsxClient, s.cache, orgID, projectID, vpcID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list subnets from NSX: %w", err)
 	}
@@ -87,8 +90,10 @@ func (s *SubnetIPPoolsStorage) Get(ctx context.Context, namespace, name string)
 // with metadata.name set to name (the Subnet CR name).
 func (s *SubnetIPPoolsStorage) fetchIPPools(namespace, nsxSubnetID, name string, info nsxcommon.VPCResourceInfo) (*easv1alpha1.SubnetIPPools, error) {
 	log := logger.Log
-	nsxPools, err := s.nsxClient.IPPoolClient.List(info.OrgID, info.ProjectID, info.VPCID, nsxSubnetID,
-		nil, nil, nil, nil, nil, nil)
+	nsxPools, err := cachedFetch(s.cache, cacheKey("subnetippools", info.OrgID, info.ProjectID, info.VPCID, nsxSubnetID), func() (model.IpAddressPoolListResult, error) {
+		return s.nsxClient.IPPoolClient.List(info.OrgID, info.ProjectID, info.VPCID, nsxSubnetID,
+			nil, nil, nil, nil, nil, nil)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get subnet IP pools from NSX: %w", err)
 	}
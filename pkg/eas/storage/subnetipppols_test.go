@@ -20,7 +20,7 @@ import (
 
 func TestSubnetIPPoolsStorage_Get_SubnetCRNotFound(t *testing.T) {
 	// No Subnet CR in k8s → error about missing CR.
-	s := NewSubnetIPPoolsStorage(&nsx.Client{}, newFakeK8sClient())
+	s := NewSubnetIPPoolsStorage(&nsx.Client{}, newFakeK8sClient(), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "subnet CR")
@@ -31,7 +31,7 @@ func TestSubnetIPPoolsStorage_Get_EmptyVPCName(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		// VPCName intentionally empty
 	}
-	s := NewSubnetIPPoolsStorage(&nsx.Client{}, newFakeK8sClient(subnetCR))
+	s := NewSubnetIPPoolsStorage(&nsx.Client{}, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "empty spec.vpcName")
@@ -47,7 +47,7 @@ func TestSubnetIPPoolsStorage_Get_SubnetNotFound(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
@@ -68,7 +68,7 @@ func TestSubnetIPPoolsStorage_Get_OK(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR), nil)
 	result, err := s.Get(context.Background(), "ns1", "sub1")
 	require.NoError(t, err)
 	assert.Equal(t, "sub1", result.Name)
@@ -107,7 +107,7 @@ func TestSubnetIPPoolsStorage_Get_WithPoolData(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR), nil)
 	result, err := s.Get(context.Background(), "ns1", "sub1")
 	require.NoError(t, err)
 	assert.Equal(t, "IPv4", result.IPAddressType)
@@ -133,7 +133,7 @@ func TestSubnetIPPoolsStorage_Get_FetchPoolError(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 }
@@ -144,7 +144,7 @@ func TestSubnetIPPoolsStorage_Get_SubnetsError(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 }
@@ -163,7 +163,7 @@ func TestSubnetIPPoolsStorage_Get_WithIDFallback(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: subnetID, Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR), nil)
 	result, err := s.Get(context.Background(), "ns1", subnetID)
 	require.NoError(t, err)
 	assert.Equal(t, subnetID, result.Name)
@@ -189,7 +189,7 @@ func TestSubnetIPPoolsStorage_Get_DHCPServerMode(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "ns1"},
 		Spec:       vpcv1alpha1.SubnetSpec{VPCName: "p1:vpc1"},
 	}
-	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR))
+	s := NewSubnetIPPoolsStorage(c, newFakeK8sClient(subnetCR), nil)
 	_, err := s.Get(context.Background(), "ns1", "sub1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "DHCP_SERVER")
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -16,22 +17,31 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/eas"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
 // VPCIPAddressUsageStorage implements REST operations for VPCIPAddressUsage.
 type VPCIPAddressUsageStorage struct {
 	nsxClient  *nsx.Client
 	vpcService eas.VPCInfoProvider
+	cache      *Cache
 }
 
 // NewVPCIPAddressUsageStorage creates a new storage instance.
-func NewVPCIPAddressUsageStorage(nsxClient *nsx.Client, vpcService eas.VPCInfoProvider) *VPCIPAddressUsageStorage {
+// cache may be nil, in which case NSX is queried on every request.
+func NewVPCIPAddressUsageStorage(nsxClient *nsx.Client, vpcService eas.VPCInfoProvider, cache *Cache) *VPCIPAddressUsageStorage {
 	return &VPCIPAddressUsageStorage{
 		nsxClient:  nsxClient,
 		vpcService: vpcService,
+		cache:      cache,
 	}
 }
 
+// CacheTTL returns how long the NSX responses are cached, 0 if they are not.
+func (s *VPCIPAddressUsageStorage) CacheTTL() time.Duration {
+	return s.cache.TTL()
+}
+
 // Get retrieves IP address usage for the VPC identified by vpcName within the namespace.
 // vpcName must be the NSX VPC ID (last segment of the VPC policy path, e.g. "sean-ns_2oq3d").
 // The returned object's metadata.name is the NSX VPC ID from the resolved VPC path.
@@ -50,7 +60,7 @@ func (s *VPCIPAddressUsageStorage) Get(_ context.Context, namespace, vpcName str
 		log.Debug("Fetching VPC IP address usage from NSX",
 			"namespace", namespace, "vpcName", vpcName,
 			"vpcID", info.VPCID, "projectID", info.ProjectID)
-		nsxBlocks, err := s.nsxClient.IPAddressUsageClient.Get(info.OrgID, info.ProjectID, info.VPCID)
+		nsxBlocks, err := s.getIPAddressUsage(info)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get VPC IP address usage from NSX: %w", err)
 		}
@@ -77,7 +87,7 @@ func (s *VPCIPAddressUsageStorage) List(_ context.Context, namespace string) (*e
 
 	for _, entry := range vpcEntries {
 		info := entry.Info
-		nsxBlocks, err := s.nsxClient.IPAddressUsageClient.Get(info.OrgID, info.ProjectID, info.VPCID)
+		nsxBlocks, err := s.getIPAddressUsage(info)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get VPC IP address usage for VPC %s: %w", info.VPCID, err)
 		}
@@ -88,6 +98,13 @@ func (s *VPCIPAddressUsageStorage) List(_ context.Context, namespace string) (*e
 	return list, nil
 }
 
+// getIPAddressUsage returns the IP address usage of the VPC, it is shared by Get and List.
+func (s *VPCIPAddressUsageStorage) getIPAddressUsage(info common.VPCResourceInfo) (model.VpcIpAddressBlocks, error) {
+	return cachedFetch(s.cache, cacheKey("vpcipaddressusage", info.OrgID, info.ProjectID, info.VPCID), func() (model.VpcIpAddressBlocks, error) {
+		return s.nsxClient.IPAddressUsageClient.Get(info.OrgID, info.ProjectID, info.VPCID)
+	})
+}
+
 // vpcMatchesByName returns true if the entry's NSX VPC ID matches name.
 func vpcMatchesByName(entry eas.VPCEntry, name string) bool {
 	return entry.Info.VPCID == name
@@ -182,13 +182,13 @@ func TestVpcIPAddressFromNSX_Paths(t *testing.T) {
 	assert.Empty(t, out.SubnetName)
 }
 func TestVPCIPAddressUsageStorage_Get_NoVPC(t *testing.T) {
-	s := NewVPCIPAddressUsageStorage(&nsx.Client{}, emptyVPCProvider{})
+	s := NewVPCIPAddressUsageStorage(&nsx.Client{}, emptyVPCProvider{}, nil)
 	_, err := s.Get(context.Background(), "ns1", "ignored")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no VPC found")
 }
 func TestVPCIPAddressUsageStorage_List_NoVPC(t *testing.T) {
-	s := NewVPCIPAddressUsageStorage(&nsx.Client{}, emptyVPCProvider{})
+	s := NewVPCIPAddressUsageStorage(&nsx.Client{}, emptyVPCProvider{}, nil)
 	list, err := s.List(context.Background(), "ns1")
 	require.NoError(t, err)
 	require.NotNil(t, list)
@@ -196,7 +196,7 @@ func TestVPCIPAddressUsageStorage_List_NoVPC(t *testing.T) {
 }
 func TestVPCIPAddressUsageStorage_Get_VPCNotFound(t *testing.T) {
 	p := singleVPCProvider{info: common.VPCResourceInfo{VPCID: "vpc1"}}
-	s := NewVPCIPAddressUsageStorage(&nsx.Client{}, p)
+	s := NewVPCIPAddressUsageStorage(&nsx.Client{}, p, nil)
 	_, err := s.Get(context.Background(), "ns1", "other-vpc")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
@@ -205,7 +205,7 @@ func TestVPCIPAddressUsageStorage_Get_OK(t *testing.T) {
 	p := singleVPCProvider{info: common.VPCResourceInfo{OrgID: "o1", ProjectID: "p1", VPCID: "vpc1"}}
 	c := &nsx.Client{}
 	c.IPAddressUsageClient = &fakeIPAddressUsageClient{}
-	s := NewVPCIPAddressUsageStorage(c, p)
+	s := NewVPCIPAddressUsageStorage(c, p, nil)
 	result, err := s.Get(context.Background(), "ns1", "vpc1")
 	require.NoError(t, err)
 	assert.Equal(t, "vpc1", result.Name)
@@ -214,7 +214,7 @@ func TestVPCIPAddressUsageStorage_List_OK(t *testing.T) {
 	p := singleVPCProvider{info: common.VPCResourceInfo{OrgID: "o1", ProjectID: "p1", VPCID: "vpc1"}}
 	c := &nsx.Client{}
 	c.IPAddressUsageClient = &fakeIPAddressUsageClient{}
-	s := NewVPCIPAddressUsageStorage(c, p)
+	s := NewVPCIPAddressUsageStorage(c, p, nil)
 	list, err := s.List(context.Background(), "ns1")
 	require.NoError(t, err)
 	require.Len(t, list.Items, 1)
@@ -224,7 +224,7 @@ func TestVPCIPAddressUsageStorage_List_Error(t *testing.T) {
 	p := singleVPCProvider{info: common.VPCResourceInfo{OrgID: "o1", ProjectID: "p1", VPCID: "vpc1"}}
 	c := &nsx.Client{}
 	c.IPAddressUsageClient = &fakeIPAddressUsageClient{err: fmt.Errorf("nsx error")}
-	s := NewVPCIPAddressUsageStorage(c, p)
+	s := NewVPCIPAddressUsageStorage(c, p, nil)
 	_, err := s.List(context.Background(), "ns1")
 	require.Error(t, err)
 }
@@ -232,7 +232,7 @@ func TestVPCIPAddressUsageStorage_Get_ClientError(t *testing.T) {
 	p := singleVPCProvider{info: common.VPCResourceInfo{OrgID: "o1", ProjectID: "p1", VPCID: "vpc1"}}
 	c := &nsx.Client{}
 	c.IPAddressUsageClient = &fakeIPAddressUsageClient{err: fmt.Errorf("nsx unavailable")}
-	s := NewVPCIPAddressUsageStorage(c, p)
+	s := NewVPCIPAddressUsageStorage(c, p, nil)
 	_, err := s.Get(context.Background(), "ns1", "vpc1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "nsx unavailable")
@@ -151,20 +151,13 @@ func ZapCustomLogger(cfDebug bool, cfLogLevel int) CustomLogger {
 		FieldsExclude: []string{"logger", "v"},
 	}
 
-	// Set the log level based on the calculated level
-	var zeroLogLevel zerolog.Level
-	switch logLevel {
-	case 2:
-		zeroLogLevel = zerolog.TraceLevel
-	case 1:
-		zeroLogLevel = zerolog.DebugLevel
-	default:
-		zeroLogLevel = zerolog.InfoLevel
-	}
+	// The level is set globally rather than on the logger, so that SetLogLevel can change
+	// it at runtime for the loggers already created from this one.
+	zerolog.SetGlobalLevel(toZerologLevel(logLevel))
 
 	// Create zerolog logger
 	zeroLogger := zerolog.New(consoleWriter).
-		Level(zeroLogLevel).
+		Level(zerolog.TraceLevel).
 		With().
 		Timestamp().
 		CallerWithSkipFrameCount(3).
@@ -174,3 +167,20 @@ func ZapCustomLogger(cfDebug bool, cfLogLevel int) CustomLogger {
 	logrLogger := zerologr.New(&zeroLogger)
 	return NewCustomLoggerWithZerolog(logrLogger, &zeroLogger)
 }
+
+// SetLogLevel changes the level of the loggers created by ZapCustomLogger, e.g. after
+// the debug option in the configuration file is reloaded.
+func SetLogLevel(cfDebug bool, cfLogLevel int) {
+	zerolog.SetGlobalLevel(toZerologLevel(getLogLevel(cfDebug, cfLogLevel)))
+}
+
+func toZerologLevel(logLevel int) zerolog.Level {
+	switch {
+	case logLevel >= 2:
+		return zerolog.TraceLevel
+	case logLevel == 1:
+		return zerolog.DebugLevel
+	default:
+		return zerolog.InfoLevel
+	}
+}
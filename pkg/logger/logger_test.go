@@ -3,6 +3,9 @@ package logger
 import (
 	"testing"
 	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestZapLoggerLevels(t *testing.T) {
@@ -79,3 +82,24 @@ func TestCustomLogger(t *testing.T) {
 
 	t.Log("CustomLogger test completed - verify all log levels are displayed with proper formatting and colors")
 }
+
+func TestSetLogLevel(t *testing.T) {
+	defer zerolog.SetGlobalLevel(zerolog.TraceLevel)
+	logger := ZapCustomLogger(false, 0).Logger
+	assert.True(t, logger.V(0).Enabled())
+	assert.False(t, logger.V(1).Enabled())
+
+	SetLogLevel(true, 0)
+	assert.Equal(t, zerolog.TraceLevel, zerolog.GlobalLevel())
+	assert.True(t, logger.V(1).Enabled())
+	assert.True(t, logger.V(2).Enabled())
+
+	SetLogLevel(false, 1)
+	assert.Equal(t, zerolog.DebugLevel, zerolog.GlobalLevel())
+	assert.True(t, logger.V(1).Enabled())
+	assert.False(t, logger.V(2).Enabled())
+
+	SetLogLevel(false, 0)
+	assert.Equal(t, zerolog.InfoLevel, zerolog.GlobalLevel())
+	assert.False(t, logger.V(1).Enabled())
+}
@@ -1,7 +1,9 @@
 package metrics
 
 import (
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -11,17 +13,29 @@ import (
 )
 
 const (
-	MetricNamespace                 = "nsx"
-	MetricSubsystem                 = "operator"
-	HealthKey                       = "health_status"
-	ControllerSyncTotalKey          = "controller_sync_total"
-	ControllerUpdateTotalKey        = "controller_update_total"
-	ControllerUpdateSuccessTotalKey = "controller_update_success_total"
-	ControllerUpdateFailTotalKey    = "controller_update_fail_total"
-	ControllerDeleteTotalKey        = "controller_delete_total"
-	ControllerDeleteSuccessTotalKey = "controller_delete_success_total"
-	ControllerDeleteFailTotalKey    = "controller_delete_fail_total"
-	ScrapeTimeout                   = 30
+	MetricNamespace                  = "nsx"
+	MetricSubsystem                  = "operator"
+	HealthKey                        = "health_status"
+	ControllerSyncTotalKey           = "controller_sync_total"
+	ControllerUpdateTotalKey         = "controller_update_total"
+	ControllerUpdateSuccessTotalKey  = "controller_update_success_total"
+	ControllerUpdateFailTotalKey     = "controller_update_fail_total"
+	ControllerDeleteTotalKey         = "controller_delete_total"
+	ControllerDeleteSuccessTotalKey  = "controller_delete_success_total"
+	ControllerDeleteFailTotalKey     = "controller_delete_fail_total"
+	ControllerReconcileDurationKey   = "controller_reconcile_duration_seconds"
+	NSXAPIRequestTotalKey            = "nsx_api_request_total"
+	NSXAPIRequestDurationKey         = "nsx_api_request_duration_seconds"
+	NSXAPIRateLimitKey               = "nsx_api_rate_limit"
+	NSXEndpointStatusKey             = "nsx_endpoint_status"
+	NSXEndpointBreakerStateKey       = "nsx_endpoint_breaker_state"
+	NSXEndpointBreakerTransitionsKey = "nsx_endpoint_breaker_transitions_total"
+	ResourceStoreSizeKey             = "resource_store_size"
+	ConfigReloadTotalKey             = "config_reload_total"
+	ScrapeTimeout                    = 30
+
+	// NSXAPIStatusError is the status label value of the NSX API requests failed without a response.
+	NSXAPIStatusError = "error"
 )
 
 var log = logger.Log
@@ -98,8 +112,116 @@ var (
 		},
 		[]string{"res_type"},
 	)
+	ControllerReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ControllerReconcileDurationKey,
+			Help:      "Duration in seconds of the reconciliations by NSX Operator controllers",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"res_type"},
+	)
+	NSXAPIRequestTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXAPIRequestTotalKey,
+			Help:      "Total number of NSX API requests sent by NSX Operator",
+		},
+		[]string{"endpoint", "method", "status"},
+	)
+	NSXAPIRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXAPIRequestDurationKey,
+			Help:      "Duration in seconds of the NSX API requests sent by NSX Operator, excluding the rate limiter wait time",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"endpoint", "method", "status"},
+	)
+	NSXAPIRateLimit = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXAPIRateLimitKey,
+			Help:      "Current rate limit of the NSX API requests per second for each NSX endpoint",
+		},
+		[]string{"endpoint"},
+	)
+	NSXEndpointStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXEndpointStatusKey,
+			Help:      "Status of the NSX endpoints, 1 for UP and 0 for DOWN",
+		},
+		[]string{"endpoint"},
+	)
+	NSXEndpointBreakerState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXEndpointBreakerStateKey,
+			Help:      "State of the circuit breaker of the NSX endpoints, 1 for the 'state' label with the current state",
+		},
+		[]string{"endpoint", "state"},
+	)
+	NSXEndpointBreakerTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXEndpointBreakerTransitionsKey,
+			Help:      "Total number of the circuit breaker state changes of the NSX endpoints, by new state",
+		},
+		[]string{"endpoint", "state"},
+	)
+	ConfigReloadTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ConfigReloadTotalKey,
+			Help:      "Total number of the configuration file reloads by NSX Operator, by result",
+		},
+		[]string{"result"},
+	)
+	ResourceStoreSize = &resourceStoreSizeCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(MetricNamespace, MetricSubsystem, ResourceStoreSizeKey),
+			"Current number of NSX resources cached in the stores of NSX Operator",
+			[]string{"res_type"}, nil,
+		),
+	}
 )
 
+// KeyLister is implemented by the NSX resource stores, e.g. common.ResourceStore.
+type KeyLister interface {
+	ListKeys() []string
+}
+
+// resourceStoreSizeCollector collects the sizes of the registered resource stores on scraping.
+// Several stores may cache the same NSX resource type, their sizes are summed up.
+type resourceStoreSizeCollector struct {
+	desc   *prometheus.Desc
+	stores sync.Map
+}
+
+func (c *resourceStoreSizeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *resourceStoreSizeCollector) Collect(ch chan<- prometheus.Metric) {
+	sizes := map[string]int{}
+	c.stores.Range(func(key, value any) bool {
+		sizes[value.(string)] += len(key.(KeyLister).ListKeys())
+		return true
+	})
+	for resType, size := range sizes {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(size), resType)
+	}
+}
+
 var registerMetrics sync.Once
 
 // Register all metrics.
@@ -121,11 +243,20 @@ func InitializePrometheusMetrics() {
 		ControllerDeleteTotal,
 		ControllerDeleteSuccessTotal,
 		ControllerDeleteFailTotal,
+		ControllerReconcileDuration,
+		NSXAPIRequestTotal,
+		NSXAPIRequestDuration,
+		NSXAPIRateLimit,
+		NSXEndpointStatus,
+		NSXEndpointBreakerState,
+		NSXEndpointBreakerTransitions,
+		ConfigReloadTotal,
+		ResourceStoreSize,
 	)
 }
 
 func AreMetricsExposed(cf *config.NSXOperatorConfig) bool {
-	return cf.EnforcementPoint == "vmc-enforcementpoint"
+	return cf.K8sConfig != nil && cf.EnablePromMetrics
 }
 
 func CounterInc(cf *config.NSXOperatorConfig, counter *prometheus.CounterVec, res_type string) {
@@ -133,3 +264,49 @@ func CounterInc(cf *config.NSXOperatorConfig, counter *prometheus.CounterVec, re
 		counter.WithLabelValues(res_type).Inc()
 	}
 }
+
+func HistogramObserveSince(cf *config.NSXOperatorConfig, histogram *prometheus.HistogramVec, res_type string, startTime time.Time) {
+	if AreMetricsExposed(cf) {
+		histogram.WithLabelValues(res_type).Observe(time.Since(startTime).Seconds())
+	}
+}
+
+// ObserveNSXAPIRequest records an NSX API request sent to the endpoint, statusCode 0 means
+// the request failed without a response.
+func ObserveNSXAPIRequest(endpoint string, method string, statusCode int, duration time.Duration) {
+	status := NSXAPIStatusError
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	NSXAPIRequestTotal.WithLabelValues(endpoint, method, status).Inc()
+	NSXAPIRequestDuration.WithLabelValues(endpoint, method, status).Observe(duration.Seconds())
+}
+
+func SetNSXAPIRateLimit(endpoint string, rate int) {
+	NSXAPIRateLimit.WithLabelValues(endpoint).Set(float64(rate))
+}
+
+func SetNSXEndpointStatus(endpoint string, up bool) {
+	value := 0.0
+	if up {
+		value = 1
+	}
+	NSXEndpointStatus.WithLabelValues(endpoint).Set(value)
+}
+
+// SetNSXEndpointBreakerState sets the circuit breaker state of the endpoint to state, one of states.
+func SetNSXEndpointBreakerState(endpoint string, state string, states []string) {
+	for _, s := range states {
+		value := 0.0
+		if s == state {
+			value = 1
+		}
+		NSXEndpointBreakerState.WithLabelValues(endpoint, s).Set(value)
+	}
+	NSXEndpointBreakerTransitions.WithLabelValues(endpoint, state).Inc()
+}
+
+// RegisterResourceStore exposes the size of the store caching the NSX resources of resType.
+func RegisterResourceStore(resType string, store KeyLister) {
+	ResourceStoreSize.stores.Store(store, resType)
+}
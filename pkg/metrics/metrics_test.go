@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+)
+
+type fakeStore struct {
+	keys []string
+}
+
+func (s *fakeStore) ListKeys() []string {
+	return s.keys
+}
+
+func TestAreMetricsExposed(t *testing.T) {
+	cf := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{EnforcementPoint: "vmc-enforcementpoint"}}
+	assert.False(t, AreMetricsExposed(cf))
+
+	cf.K8sConfig = &config.K8sConfig{EnablePromMetrics: false}
+	assert.False(t, AreMetricsExposed(cf))
+
+	cf.K8sConfig.EnablePromMetrics = true
+	assert.True(t, AreMetricsExposed(cf))
+}
+
+func TestHistogramObserveSince(t *testing.T) {
+	cf := &config.NSXOperatorConfig{K8sConfig: &config.K8sConfig{EnablePromMetrics: false}}
+	HistogramObserveSince(cf, ControllerReconcileDuration, "test-disabled", time.Now())
+	assert.Equal(t, 0, testutil.CollectAndCount(ControllerReconcileDuration, MetricNamespace+"_"+MetricSubsystem+"_"+ControllerReconcileDurationKey))
+
+	cf.K8sConfig.EnablePromMetrics = true
+	HistogramObserveSince(cf, ControllerReconcileDuration, "test", time.Now())
+	assert.Equal(t, 1, testutil.CollectAndCount(ControllerReconcileDuration, MetricNamespace+"_"+MetricSubsystem+"_"+ControllerReconcileDurationKey))
+}
+
+func TestObserveNSXAPIRequest(t *testing.T) {
+	ObserveNSXAPIRequest("10.0.0.1", "GET", 200, time.Millisecond)
+	ObserveNSXAPIRequest("10.0.0.1", "GET", 200, time.Millisecond)
+	ObserveNSXAPIRequest("10.0.0.1", "PATCH", 0, time.Millisecond)
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(NSXAPIRequestTotal.WithLabelValues("10.0.0.1", "GET", "200")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(NSXAPIRequestTotal.WithLabelValues("10.0.0.1", "PATCH", NSXAPIStatusError)))
+	assert.Equal(t, 2, testutil.CollectAndCount(NSXAPIRequestDuration))
+}
+
+func TestSetNSXEndpointMetrics(t *testing.T) {
+	SetNSXEndpointStatus("10.0.0.1", true)
+	assert.Equal(t, float64(1), testutil.ToFloat64(NSXEndpointStatus.WithLabelValues("10.0.0.1")))
+	SetNSXEndpointStatus("10.0.0.1", false)
+	assert.Equal(t, float64(0), testutil.ToFloat64(NSXEndpointStatus.WithLabelValues("10.0.0.1")))
+
+	SetNSXAPIRateLimit("10.0.0.1", 50)
+	assert.Equal(t, float64(50), testutil.ToFloat64(NSXAPIRateLimit.WithLabelValues("10.0.0.1")))
+}
+
+func TestResourceStoreSizeCollector(t *testing.T) {
+	groupStore1 := &fakeStore{keys: []string{"group-1", "group-2"}}
+	groupStore2 := &fakeStore{keys: []string{"group-3"}}
+	ruleStore := &fakeStore{}
+	RegisterResourceStore("Group", groupStore1)
+	RegisterResourceStore("Group", groupStore2)
+	// Registering the same store again should not count it twice.
+	RegisterResourceStore("Group", groupStore2)
+	RegisterResourceStore("Rule", ruleStore)
+
+	expected := `
+# HELP nsx_operator_resource_store_size Current number of NSX resources cached in the stores of NSX Operator
+# TYPE nsx_operator_resource_store_size gauge
+nsx_operator_resource_store_size{res_type="Group"} 3
+nsx_operator_resource_store_size{res_type="Rule"} 0
+`
+	assert.NoError(t, testutil.CollectAndCompare(ResourceStoreSize, strings.NewReader(expected)))
+
+	ruleStore.keys = []string{"rule-1"}
+	expected = strings.Replace(expected, `res_type="Rule"} 0`, `res_type="Rule"} 1`, 1)
+	assert.NoError(t, testutil.CollectAndCompare(ResourceStoreSize, strings.NewReader(expected)))
+}
@@ -7,6 +7,7 @@ package mockgateway
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	common "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	gomock "go.uber.org/mock/gomock"
@@ -121,6 +122,18 @@ func (mr *MockStatusUpdaterMockRecorder) IncreaseUpdateTotal() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseUpdateTotal", reflect.TypeOf((*MockStatusUpdater)(nil).IncreaseUpdateTotal))
 }
 
+// ObserveReconcileDuration mocks base method.
+func (m *MockStatusUpdater) ObserveReconcileDuration(arg0 time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveReconcileDuration", arg0)
+}
+
+// ObserveReconcileDuration indicates an expected call of ObserveReconcileDuration.
+func (mr *MockStatusUpdaterMockRecorder) ObserveReconcileDuration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveReconcileDuration", reflect.TypeOf((*MockStatusUpdater)(nil).ObserveReconcileDuration), arg0)
+}
+
 // UpdateFail mocks base method.
 func (m *MockStatusUpdater) UpdateFail(arg0 context.Context, arg1 client.Object, arg2 error, arg3 string, arg4 common.UpdateFailStatusFn, arg5 ...interface{}) {
 	m.ctrl.T.Helper()
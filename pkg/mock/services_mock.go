@@ -87,7 +87,7 @@ func (m *MockSubnetServiceProvider) GetSubnetsByIndex(key, value string) []*mode
 	return arg.Get(0).([]*model.VpcSubnet)
 }
 
-func (m *MockSubnetServiceProvider) CreateOrUpdateSubnet(obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
+func (m *MockSubnetServiceProvider) CreateOrUpdateSubnet(ctx context.Context, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
 	arg := m.Called(obj, vpcInfo, tags)
 	return arg.Get(0).(*model.VpcSubnet), arg.Error(1)
 }
@@ -164,6 +164,15 @@ func (m *MockSubnetPortServiceProvider) GetSubnetPathForSubnetPortFromStore(crUi
 func (m *MockSubnetPortServiceProvider) ResetSubnetTotalIP(path string) {
 }
 
+type MockSubnetPortSettingServiceProvider struct {
+	mock.Mock
+}
+
+func (m *MockSubnetPortSettingServiceProvider) GetPortConfigPath(namespace, name string) (string, error) {
+	args := m.Called(namespace, name)
+	return args.String(0), args.Error(1)
+}
+
 type MockIPAddressAllocationProvider struct {
 	mock.Mock
 }
@@ -180,11 +189,11 @@ func (m *MockIPAddressAllocationProvider) CreateIPAddressAllocationForAddressBin
 	return nil
 }
 
-func (m *MockIPAddressAllocationProvider) DeleteIPAddressAllocationForAddressBinding(obj metav1.Object) error {
+func (m *MockIPAddressAllocationProvider) DeleteIPAddressAllocationForAddressBinding(ctx context.Context, obj metav1.Object) error {
 	return nil
 }
 
-func (m *MockIPAddressAllocationProvider) DeleteIPAddressAllocationByNSXResource(nsxIPAddressAllocation *model.VpcIpAddressAllocation) error {
+func (m *MockIPAddressAllocationProvider) DeleteIPAddressAllocationByNSXResource(ctx context.Context, nsxIPAddressAllocation *model.VpcIpAddressAllocation) error {
 	return nil
 }
 
@@ -0,0 +1,266 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package nsx
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// BreakerState is the state of the circuit breaker of an endpoint.
+type BreakerState string
+
+const (
+	// BreakerClosed means the requests are sent to the endpoint.
+	BreakerClosed BreakerState = "CLOSED"
+	// BreakerOpen means the endpoint failed too many requests or was too slow, no request
+	// is sent to it until BreakerOpenDuration has passed.
+	BreakerOpen BreakerState = "OPEN"
+	// BreakerHalfOpen means a single probe request is sent to the endpoint to decide whether
+	// the breaker is closed or opened again.
+	BreakerHalfOpen BreakerState = "HALF_OPEN"
+)
+
+var (
+	// BreakerWindowSize is the number of the latest requests the error rate and latency are computed from.
+	BreakerWindowSize = 50
+	// BreakerMinRequests is the number of requests in the window needed to open the breaker.
+	BreakerMinRequests = 10
+	// BreakerErrorRateThreshold opens the breaker when the ratio of the failed requests reaches it.
+	BreakerErrorRateThreshold = 0.5
+	// BreakerLatencyThreshold opens the breaker when the BreakerLatencyPercentile latency exceeds it.
+	BreakerLatencyThreshold = 30 * time.Second
+	// BreakerLatencyPercentile is the percentile of the latency compared with BreakerLatencyThreshold.
+	BreakerLatencyPercentile = 0.9
+	// BreakerOpenDuration is how long the breaker stays open before a probe request is allowed.
+	BreakerOpenDuration = 30 * time.Second
+	// latencyEWMAWeight is the weight of the latest request in the latency moving average.
+	latencyEWMAWeight = 0.2
+)
+
+type requestOutcome struct {
+	latency time.Duration
+	failed  bool
+}
+
+// circuitBreaker tracks the outcome of the requests sent to an endpoint. A nil
+// circuitBreaker is always closed.
+type circuitBreaker struct {
+	sync.Mutex
+	state    BreakerState
+	openedAt time.Time
+	probing  bool
+	// window is a ring buffer of the latest request outcomes, next is the index of the next outcome.
+	window []requestOutcome
+	next   int
+	// latencyEWMA is the moving average of the latency, used to weight the endpoint selection.
+	latencyEWMA time.Duration
+	// onStateChange is called with the new state when the state changes, without the lock held.
+	onStateChange func(BreakerState)
+	now           func() time.Time
+}
+
+func newCircuitBreaker(onStateChange func(BreakerState)) *circuitBreaker {
+	return &circuitBreaker{state: BreakerClosed, onStateChange: onStateChange, now: time.Now}
+}
+
+// isFailedRequest returns true if the request counts as a failure of the endpoint:
+// it got no response or a 5xx response.
+func isFailedRequest(statusCode int) bool {
+	return statusCode == 0 || statusCode >= http.StatusInternalServerError
+}
+
+// State returns the state of the breaker, an open breaker whose open duration has passed
+// is reported as half-open.
+func (b *circuitBreaker) State() BreakerState {
+	if b == nil {
+		return BreakerClosed
+	}
+	b.Lock()
+	defer b.Unlock()
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= BreakerOpenDuration {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// available returns true if a request may be sent, without reserving the half-open probe.
+func (b *circuitBreaker) available() bool {
+	if b == nil {
+		return true
+	}
+	b.Lock()
+	defer b.Unlock()
+	switch b.state {
+	case BreakerOpen:
+		return b.now().Sub(b.openedAt) >= BreakerOpenDuration
+	case BreakerHalfOpen:
+		return !b.probing
+	}
+	return true
+}
+
+// allow returns true if a request may be sent. The open breaker turns half-open once its
+// open duration has passed, and the half-open breaker allows a single probe request.
+func (b *circuitBreaker) allow() bool {
+	if b == nil {
+		return true
+	}
+	b.Lock()
+	var changed bool
+	defer func() {
+		b.Unlock()
+		if changed {
+			b.notify(BreakerHalfOpen)
+		}
+	}()
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < BreakerOpenDuration {
+			return false
+		}
+		b.state = BreakerHalfOpen
+		b.probing = true
+		changed = true
+		return true
+	case BreakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+// record records the outcome of a request allowed by allow. The probe request of the
+// half-open breaker closes it on success and opens it again on failure, the closed breaker
+// opens if the error rate or the latency of the window exceeds the thresholds.
+func (b *circuitBreaker) record(latency time.Duration, failed bool) {
+	if b == nil {
+		return
+	}
+	b.Lock()
+	newState := b.state
+	if b.latencyEWMA == 0 {
+		b.latencyEWMA = latency
+	} else {
+		b.latencyEWMA = time.Duration(latencyEWMAWeight*float64(latency) + (1-latencyEWMAWeight)*float64(b.latencyEWMA))
+	}
+	switch b.state {
+	case BreakerHalfOpen:
+		b.probing = false
+		if failed {
+			newState = BreakerOpen
+		} else {
+			newState = BreakerClosed
+			b.window = b.window[:0]
+			b.next = 0
+		}
+	case BreakerClosed:
+		b.add(requestOutcome{latency: latency, failed: failed})
+		if b.shouldOpen() {
+			newState = BreakerOpen
+		}
+	}
+	changed := newState != b.state
+	if changed {
+		b.state = newState
+		if newState == BreakerOpen {
+			b.openedAt = b.now()
+		}
+	}
+	b.Unlock()
+	if changed {
+		b.notify(newState)
+	}
+}
+
+func (b *circuitBreaker) notify(state BreakerState) {
+	if b.onStateChange != nil {
+		b.onStateChange(state)
+	}
+}
+
+func (b *circuitBreaker) add(outcome requestOutcome) {
+	if len(b.window) < BreakerWindowSize {
+		b.window = append(b.window, outcome)
+		return
+	}
+	b.window[b.next] = outcome
+	b.next = (b.next + 1) % len(b.window)
+}
+
+func (b *circuitBreaker) shouldOpen() bool {
+	if len(b.window) < BreakerMinRequests {
+		return false
+	}
+	return b.errorRate() >= BreakerErrorRateThreshold || b.latencyPercentile(BreakerLatencyPercentile) > BreakerLatencyThreshold
+}
+
+func (b *circuitBreaker) errorRate() float64 {
+	if len(b.window) == 0 {
+		return 0
+	}
+	failed := 0
+	for _, outcome := range b.window {
+		if outcome.failed {
+			failed++
+		}
+	}
+	return float64(failed) / float64(len(b.window))
+}
+
+// latencyPercentile returns the latency percentile p, from 0 to 1, of the window.
+func (b *circuitBreaker) latencyPercentile(p float64) time.Duration {
+	if len(b.window) == 0 {
+		return 0
+	}
+	latencies := make([]time.Duration, len(b.window))
+	for i, outcome := range b.window {
+		latencies[i] = outcome.latency
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	index := int(p*float64(len(latencies))+0.5) - 1
+	return latencies[min(max(index, 0), len(latencies)-1)]
+}
+
+// BreakerStats is the snapshot of the circuit breaker of an endpoint.
+type BreakerStats struct {
+	State      BreakerState
+	ErrorRate  float64
+	LatencyP50 time.Duration
+	LatencyP99 time.Duration
+	// LatencyAverage is the moving average of the latency used to weight the endpoint selection.
+	LatencyAverage time.Duration
+}
+
+func (b *circuitBreaker) stats() BreakerStats {
+	if b == nil {
+		return BreakerStats{State: BreakerClosed}
+	}
+	state := b.State()
+	b.Lock()
+	defer b.Unlock()
+	return BreakerStats{
+		State:          state,
+		ErrorRate:      b.errorRate(),
+		LatencyP50:     b.latencyPercentile(0.5),
+		LatencyP99:     b.latencyPercentile(0.99),
+		LatencyAverage: b.latencyEWMA,
+	}
+}
+
+// latencyScore returns the moving average latency, at least a millisecond so that the
+// endpoints without any request yet are preferred but still weighted by their connections.
+func (b *circuitBreaker) latencyScore() time.Duration {
+	if b == nil {
+		return time.Millisecond
+	}
+	b.Lock()
+	defer b.Unlock()
+	return max(b.latencyEWMA, time.Millisecond)
+}
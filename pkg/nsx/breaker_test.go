@@ -0,0 +1,100 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package nsx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBreaker(now *time.Time) (*circuitBreaker, *[]BreakerState) {
+	var changes []BreakerState
+	b := newCircuitBreaker(func(state BreakerState) { changes = append(changes, state) })
+	b.now = func() time.Time { return *now }
+	return b, &changes
+}
+
+func TestCircuitBreaker_ErrorRate(t *testing.T) {
+	now := time.Now()
+	b, changes := newTestBreaker(&now)
+
+	// The breaker does not open before BreakerMinRequests requests.
+	for i := 0; i < BreakerMinRequests-1; i++ {
+		assert.True(t, b.allow())
+		b.record(time.Millisecond, true)
+	}
+	assert.Equal(t, BreakerClosed, b.State())
+	b.record(time.Millisecond, true)
+	assert.Equal(t, BreakerOpen, b.State())
+	assert.False(t, b.available())
+	assert.False(t, b.allow())
+
+	// A probe is allowed once the open duration has passed, a failed probe opens the breaker again.
+	now = now.Add(BreakerOpenDuration)
+	assert.Equal(t, BreakerHalfOpen, b.State())
+	assert.True(t, b.available())
+	assert.True(t, b.allow())
+	assert.False(t, b.allow())
+	b.record(time.Millisecond, true)
+	assert.Equal(t, BreakerOpen, b.State())
+
+	// A successful probe closes the breaker and resets the window.
+	now = now.Add(BreakerOpenDuration)
+	assert.True(t, b.allow())
+	b.record(time.Millisecond, false)
+	assert.Equal(t, BreakerClosed, b.State())
+	assert.Equal(t, 0.0, b.stats().ErrorRate)
+	assert.Equal(t, []BreakerState{BreakerOpen, BreakerHalfOpen, BreakerOpen, BreakerHalfOpen, BreakerClosed}, *changes)
+}
+
+func TestCircuitBreaker_Latency(t *testing.T) {
+	now := time.Now()
+	b, _ := newTestBreaker(&now)
+	for i := 0; i < BreakerMinRequests; i++ {
+		b.record(time.Second, false)
+	}
+	assert.Equal(t, BreakerClosed, b.State())
+	stats := b.stats()
+	assert.Equal(t, time.Second, stats.LatencyP50)
+	assert.Equal(t, time.Second, stats.LatencyAverage)
+
+	// The breaker opens when the latency percentile exceeds the threshold without any error.
+	for i := 0; i < BreakerWindowSize; i++ {
+		b.record(BreakerLatencyThreshold+time.Second, false)
+		if b.State() == BreakerOpen {
+			break
+		}
+	}
+	assert.Equal(t, BreakerOpen, b.State())
+	assert.Greater(t, b.stats().LatencyP99, BreakerLatencyThreshold)
+}
+
+func TestCircuitBreaker_Window(t *testing.T) {
+	now := time.Now()
+	b, _ := newTestBreaker(&now)
+	for i := 0; i < BreakerWindowSize*2; i++ {
+		failed := i%3 == 0
+		b.record(time.Duration(i)*time.Millisecond, failed)
+	}
+	assert.Equal(t, BreakerClosed, b.State())
+	assert.Len(t, b.window, BreakerWindowSize)
+	// Only the latest requests are kept.
+	assert.Equal(t, time.Duration(BreakerWindowSize*2-1)*time.Millisecond, b.latencyPercentile(1))
+	assert.Equal(t, time.Duration(BreakerWindowSize)*time.Millisecond, b.latencyPercentile(0))
+
+	var nilBreaker *circuitBreaker
+	assert.True(t, nilBreaker.allow())
+	assert.Equal(t, BreakerClosed, nilBreaker.State())
+	nilBreaker.record(time.Second, true)
+}
+
+func TestIsFailedRequest(t *testing.T) {
+	assert.True(t, isFailedRequest(0))
+	assert.True(t, isFailedRequest(503))
+	assert.False(t, isFailedRequest(429))
+	assert.False(t, isFailedRequest(404))
+	assert.False(t, isFailedRequest(200))
+}
@@ -41,8 +41,10 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/subnets/ip_pools"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/subnets/ports"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
+	"k8s.io/utils/lru"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
@@ -62,10 +64,12 @@ const (
 	StaticIPReservation
 	StatefulSetPod
 	IPv6
+	VPCEndpoint
+	SubnetPortSetting
 	AllFeatures
 )
 
-var FeaturesName = [AllFeatures]string{"VPC", "SECURITY_POLICY", "NSX_SERVICE_ACCOUNT", "NSX_SERVICE_ACCOUNT_RESTORE", "NSX_SERVICE_ACCOUNT_CERT_ROTATION", "STATIC_ROUTE", "VPC_PREFERRED_DEFAULT_SNAT_IP", "SUBNET_IP_RESERVATION", "SUBNET_MINIMAL_SIZE_8", "VTEP_LESS_MODE", "RESTORE_VIF", "STATIC_IP_RESERVATION", "STATEFULSET_POD", "IPV6"}
+var FeaturesName = [AllFeatures]string{"VPC", "SECURITY_POLICY", "NSX_SERVICE_ACCOUNT", "NSX_SERVICE_ACCOUNT_RESTORE", "NSX_SERVICE_ACCOUNT_CERT_ROTATION", "STATIC_ROUTE", "VPC_PREFERRED_DEFAULT_SNAT_IP", "SUBNET_IP_RESERVATION", "SUBNET_MINIMAL_SIZE_8", "VTEP_LESS_MODE", "RESTORE_VIF", "STATIC_IP_RESERVATION", "STATEFULSET_POD", "IPV6", "VPC_ENDPOINT", "SUBNET_PORT_SETTING"}
 
 type Client struct {
 	NsxConfig     *config.NSXOperatorConfig
@@ -100,9 +104,11 @@ type Client struct {
 	VPCConnectivityProfilesClient     projects.VpcConnectivityProfilesClient
 	IPBlockClient                     project_infra.IpBlocksClient
 	StaticRouteClient                 vpcs.StaticRoutesClient
+	VPCForwardingTableClient          vpcs.ForwardingTableClient
 	NATRuleClient                     nat.NatRulesClient
 	VpcGroupClient                    vpcs.GroupsClient
 	PortClient                        subnets.PortsClient
+	PortConfigClient                  subnets.PortConfigsClient
 	PortStateClient                   ports.StateClient
 	IPPoolClient                      subnets.IpPoolsClient
 	IPAllocationClient                ip_pools.IpAllocationsClient
@@ -128,6 +134,8 @@ type Client struct {
 	SubnetConnectionBindingMapsClient subnets.SubnetConnectionBindingMapsClient
 	DynamicIPReservationsClient       subnets.DynamicIpReservationsClient
 	StaticIPReservationsClient        subnets.StaticIpReservationsClient
+	VpcEndpointsClient                vpcs.VpcEndpointsClient
+	VpcServiceEndpointsClient         vpcs.VpcServiceEndpointsClient
 	NsxApiClient                      *nsxt.APIClient
 	VifsClient                        fabric.VifsClient
 	DnsZoneClient                     dns_services.ZonesClient
@@ -135,6 +143,12 @@ type Client struct {
 
 	NSXChecker    NSXHealthChecker
 	NSXVerChecker NSXVersionChecker
+
+	// restConnectorAllowOverwrite is the connector of the SDK clients updating the resources which may be overwritten.
+	restConnectorAllowOverwrite client.Connector
+	// contextClients caches the clients derived by WithContext, base is the client they are derived from.
+	contextClients *lru.Cache
+	base           *Client
 }
 
 var (
@@ -175,161 +189,50 @@ func restConnectorAllowOverwrite(c *Cluster) client.Connector {
 	return c.NewRestConnectorAllowOverwrite()
 }
 
+// defaultHTTPTimeout is the overall timeout in seconds for NSX client if http_timeout is not set.
+// NSX server does not have timeout, some of the request may take over one minute.
+const defaultHTTPTimeout = 180
+
 func GetClient(cf *config.NSXOperatorConfig) *Client {
 	// Set log level for vsphere-automation-sdk-go
 	logger := logrus.New()
 	vspherelog.SetLogger(logger)
-	// This is the overall timeout for NSX client
-	// NSX server does not have timeout, some of the request may take over one minute.
-	defaultHttpTimeout := 180
+	httpTimeout := defaultHTTPTimeout
 	if cf.HttpTimeout > 0 {
-		defaultHttpTimeout = cf.HttpTimeout
+		httpTimeout = cf.HttpTimeout
+	}
+	apiRateMode, err := ratelimiter.ParseType(cf.APIRateMode)
+	if err != nil {
+		log.Error(err, "Invalid API rate mode, using AIMD")
+	}
+	c := NewConfig(strings.Join(cf.NsxApiManagers, ","), cf.NsxApiUser, cf.NsxApiPassword, cf.CaFile, 10, 3, httpTimeout, 20, true, true, true,
+		apiRateMode, cf.GetTokenProvider(), nil, cf.Thumbprint)
+	c.APIRateLimit = cf.APIRateLimit
+	if len(cf.APIRateClasses) > 0 {
+		if c.APIRateClasses, err = ratelimiter.ParseClassBudgets(cf.APIRateClasses); err != nil {
+			log.Error(err, "Invalid API rate classes, the API classes share the endpoint rate")
+		}
 	}
-	c := NewConfig(strings.Join(cf.NsxApiManagers, ","), cf.NsxApiUser, cf.NsxApiPassword, cf.CaFile, 10, 3, defaultHttpTimeout, 20, true, true, true,
-		ratelimiter.AIMD, cf.GetTokenProvider(), nil, cf.Thumbprint)
 	c.EnvoyHost = cf.EnvoyHost
 	c.EnvoyPort = cf.EnvoyPort
+	c.EnableMetrics = metrics.AreMetricsExposed(cf)
 	cluster, _ := NewCluster(c)
 
-	connector := restConnector(cluster)
-	connectorAllowOverwrite := restConnectorAllowOverwrite(cluster)
-
-	queryClient := search.NewQueryClient(connector)
-	groupClient := domains.NewGroupsClient(connector)
-	securityClient := domains.NewSecurityPoliciesClient(connector)
-	ruleClient := security_policies.NewRulesClient(connector)
-	infraClient := nsx_policy.NewInfraClient(connector)
-	statusClient := restore.NewStatusClient(restConnector(cluster))
-
-	clusterControlPlanesClient := enforcement_points.NewClusterControlPlanesClient(connector)
-	hostTransportNodesClient := enforcement_points.NewHostTransportNodesClient(connector)
-	realizedEntitiesClient := infra_realized.NewRealizedEntitiesClient(connector)
-	realizedEntityClient := infra_realized.NewRealizedEntityClient(connector)
-	mpQueryClient := mpsearch.NewQueryClient(connector)
-	certificatesClient := trust_management.NewCertificatesClient(connector)
-	principalIdentitiesClient := trust_management.NewPrincipalIdentitiesClient(connector)
-	withCertificateClient := principal_identities.NewWithCertificateClient(connector)
-
-	lbAppProfileClient := infra.NewLbAppProfilesClient(connector)
-	lbPersistenceProfilesClient := infra.NewLbPersistenceProfilesClient(connector)
-	lbMonitorProfilesClient := infra.NewLbMonitorProfilesClient(connector)
-
-	orgRootClient := nsx_policy.NewOrgRootClient(connector)
-	projectInfraClient := projects.NewInfraClient(connector)
-	projectClient := orgs.NewProjectsClient(connector)
-	vpcClient := projects.NewVpcsClient(connector)
-	vpcStateClient := vpcs.NewStateClient(connector)
-	vpcConnectivityProfilesClient := projects.NewVpcConnectivityProfilesClient(connector)
-	ipBlockClient := project_infra.NewIpBlocksClient(connector)
-	staticRouteClient := vpcs.NewStaticRoutesClient(connector)
-	natRulesClient := nat.NewNatRulesClient(connector)
-	vpcGroupClient := vpcs.NewGroupsClient(connector)
-	portClient := subnets.NewPortsClient(connectorAllowOverwrite)
-	portStateClient := ports.NewStateClient(connector)
-	ipPoolClient := subnets.NewIpPoolsClient(connector)
-	ipAllocationClient := ip_pools.NewIpAllocationsClient(connector)
-	statsClient := dhcp_server_config.NewStatsClient(connector)
-	ipAddressUsageClient := vpcs.NewIpAddressUsageClient(connector)
-	vpcIpBlockUsageClient := vpc_ip_blocks.NewUsageClient(connector)
-	infraIPBlockUsageClient := infra_ip_blocks.NewUsageClient(connector)
-	projectIPBlockUsageClient := project_infra_ip_blocks.NewUsageClient(connector)
-	subnetsClient := vpcs.NewSubnetsClient(connector)
-	subnetStatusClient := subnets.NewStatusClient(connector)
-	ipAddressAllocationClient := vpcs.NewIpAddressAllocationsClient(connectorAllowOverwrite)
-	vpcLBSClient := vpcs.NewVpcLbsClient(connector)
-	vpcLbVirtualServersClient := vpcs.NewVpcLbVirtualServersClient(connector)
-	vpcLbPoolsClient := vpcs.NewVpcLbPoolsClient(connector)
-	vpcAttachmentClient := vpcs.NewAttachmentsClient(connector)
-
-	vpcSecurityClient := vpcs.NewSecurityPoliciesClient(connector)
-	vpcRuleClient := vpc_sp.NewRulesClient(connector)
-
-	transitGatewayClient := projects.NewTransitGatewaysClient(connector)
-	transitGatewayAttachmentClient := transit_gateways.NewAttachmentsClient(connector)
-	transitGatewayStateClient := transit_gateways.NewStateClient(connector)
-
-	subnetConnectionBindingMapsClient := subnets.NewSubnetConnectionBindingMapsClient(connector)
-	DynamicIPReservationsClient := subnets.NewDynamicIpReservationsClient(connector)
-	StaticIPReservationsClient := subnets.NewStaticIpReservationsClient(connector)
-
 	nsxApiClient, _ := CreateNsxtApiClient(cf, cluster.client)
-	vifsClient := fabric.NewVifsClient(connector)
-	dnsZoneClient := dns_services.NewZonesClient(connector)
-	dnsRecordsClient := projects.NewDnsRecordsClient(connector)
-
-	nsxChecker := &NSXHealthChecker{
-		cluster: cluster,
-	}
-	nsxVersionChecker := &NSXVersionChecker{
-		cluster:          cluster,
-		featureSupported: [AllFeatures]bool{},
-	}
-
 	nsxClient := &Client{
-		NsxConfig:                  cf,
-		RestConnector:              connector,
-		QueryClient:                queryClient,
-		GroupClient:                groupClient,
-		SecurityClient:             securityClient,
-		RuleClient:                 ruleClient,
-		InfraClient:                infraClient,
-		StatusClient:               statusClient,
-		Cluster:                    cluster,
-		ClusterControlPlanesClient: clusterControlPlanesClient,
-		HostTransPortNodesClient:   hostTransportNodesClient,
-		RealizedEntitiesClient:     realizedEntitiesClient,
-		RealizedEntityClient:       realizedEntityClient,
-		MPQueryClient:              mpQueryClient,
-		CertificatesClient:         certificatesClient,
-		PrincipalIdentitiesClient:  principalIdentitiesClient,
-		WithCertificateClient:      withCertificateClient,
-
-		// Health clients are now using REST API directly
-
-		OrgRootClient:                     orgRootClient,
-		ProjectInfraClient:                projectInfraClient,
-		VPCClient:                         vpcClient,
-		VPCStateClient:                    vpcStateClient,
-		VPCConnectivityProfilesClient:     vpcConnectivityProfilesClient,
-		IPBlockClient:                     ipBlockClient,
-		StaticRouteClient:                 staticRouteClient,
-		NATRuleClient:                     natRulesClient,
-		VpcGroupClient:                    vpcGroupClient,
-		PortClient:                        portClient,
-		PortStateClient:                   portStateClient,
-		SubnetStatusClient:                subnetStatusClient,
-		VPCSecurityClient:                 vpcSecurityClient,
-		VPCRuleClient:                     vpcRuleClient,
-		VPCLBSClient:                      vpcLBSClient,
-		VpcLbVirtualServersClient:         vpcLbVirtualServersClient,
-		VpcLbPoolsClient:                  vpcLbPoolsClient,
-		VpcAttachmentClient:               vpcAttachmentClient,
-		ProjectClient:                     projectClient,
-		NSXChecker:                        *nsxChecker,
-		NSXVerChecker:                     *nsxVersionChecker,
-		IPPoolClient:                      ipPoolClient,
-		IPAllocationClient:                ipAllocationClient,
-		DhcpServerConfigStatsClient:       statsClient,
-		IPAddressUsageClient:              ipAddressUsageClient,
-		VPCIPBlockUsageClient:             vpcIpBlockUsageClient,
-		InfraIPBlockUsageClient:           infraIPBlockUsageClient,
-		ProjectIPBlockUsageClient:         projectIPBlockUsageClient,
-		SubnetsClient:                     subnetsClient,
-		IPAddressAllocationClient:         ipAddressAllocationClient,
-		TransitGatewayClient:              transitGatewayClient,
-		TransitGatewayAttachmentClient:    transitGatewayAttachmentClient,
-		TransitGatewayStateClient:         transitGatewayStateClient,
-		SubnetConnectionBindingMapsClient: subnetConnectionBindingMapsClient,
-		DynamicIPReservationsClient:       DynamicIPReservationsClient,
-		StaticIPReservationsClient:        StaticIPReservationsClient,
-		LbAppProfileClient:                lbAppProfileClient,
-		LbPersistenceProfilesClient:       lbPersistenceProfilesClient,
-		LbMonitorProfilesClient:           lbMonitorProfilesClient,
-		NsxApiClient:                      nsxApiClient,
-		VifsClient:                        vifsClient,
-		DnsZoneClient:                     dnsZoneClient,
-		DnsRecordsClient:                  dnsRecordsClient,
+		NsxConfig:    cf,
+		Cluster:      cluster,
+		NsxApiClient: nsxApiClient,
+		NSXChecker: NSXHealthChecker{
+			cluster: cluster,
+		},
+		NSXVerChecker: NSXVersionChecker{
+			cluster:          cluster,
+			featureSupported: [AllFeatures]bool{},
+		},
 	}
+	nsxClient.setSDKClients(restConnector(cluster), restConnectorAllowOverwrite(cluster))
+	nsxClient.contextClients = lru.New(contextClientCacheSize)
 	nsxClient.Cluster.SetOnProductVersionChanged(func(oldVer, newVer string) {
 		nsxClient.resetNSXVersionFeatureCache()
 		log.Info("NSX product version changed; cleared cached feature support gates", "oldVersion", oldVer, "newVersion", newVer)
@@ -356,6 +259,71 @@ func GetClient(cf *config.NSXOperatorConfig) *Client {
 	return nsxClient
 }
 
+// setSDKClients creates the SDK clients of client with connector, the clients updating the
+// resources which may be overwritten use connectorAllowOverwrite.
+func (client *Client) setSDKClients(connector, connectorAllowOverwrite client.Connector) {
+	client.RestConnector = connector
+	client.restConnectorAllowOverwrite = connectorAllowOverwrite
+	client.QueryClient = search.NewQueryClient(connector)
+	client.GroupClient = domains.NewGroupsClient(connector)
+	client.SecurityClient = domains.NewSecurityPoliciesClient(connector)
+	client.RuleClient = security_policies.NewRulesClient(connector)
+	client.InfraClient = nsx_policy.NewInfraClient(connector)
+	client.StatusClient = restore.NewStatusClient(connector)
+	client.ClusterControlPlanesClient = enforcement_points.NewClusterControlPlanesClient(connector)
+	client.HostTransPortNodesClient = enforcement_points.NewHostTransportNodesClient(connector)
+	client.RealizedEntitiesClient = infra_realized.NewRealizedEntitiesClient(connector)
+	client.RealizedEntityClient = infra_realized.NewRealizedEntityClient(connector)
+	client.MPQueryClient = mpsearch.NewQueryClient(connector)
+	client.CertificatesClient = trust_management.NewCertificatesClient(connector)
+	client.PrincipalIdentitiesClient = trust_management.NewPrincipalIdentitiesClient(connector)
+	client.WithCertificateClient = principal_identities.NewWithCertificateClient(connector)
+	client.LbAppProfileClient = infra.NewLbAppProfilesClient(connector)
+	client.LbPersistenceProfilesClient = infra.NewLbPersistenceProfilesClient(connector)
+	client.LbMonitorProfilesClient = infra.NewLbMonitorProfilesClient(connector)
+	client.OrgRootClient = nsx_policy.NewOrgRootClient(connector)
+	client.ProjectInfraClient = projects.NewInfraClient(connector)
+	client.ProjectClient = orgs.NewProjectsClient(connector)
+	client.VPCClient = projects.NewVpcsClient(connector)
+	client.VPCStateClient = vpcs.NewStateClient(connector)
+	client.VPCConnectivityProfilesClient = projects.NewVpcConnectivityProfilesClient(connector)
+	client.IPBlockClient = project_infra.NewIpBlocksClient(connector)
+	client.StaticRouteClient = vpcs.NewStaticRoutesClient(connector)
+	client.VPCForwardingTableClient = vpcs.NewForwardingTableClient(connector)
+	client.NATRuleClient = nat.NewNatRulesClient(connector)
+	client.VpcGroupClient = vpcs.NewGroupsClient(connector)
+	client.PortClient = subnets.NewPortsClient(connectorAllowOverwrite)
+	client.PortConfigClient = subnets.NewPortConfigsClient(connector)
+	client.PortStateClient = ports.NewStateClient(connector)
+	client.IPPoolClient = subnets.NewIpPoolsClient(connector)
+	client.IPAllocationClient = ip_pools.NewIpAllocationsClient(connector)
+	client.DhcpServerConfigStatsClient = dhcp_server_config.NewStatsClient(connector)
+	client.IPAddressUsageClient = vpcs.NewIpAddressUsageClient(connector)
+	client.VPCIPBlockUsageClient = vpc_ip_blocks.NewUsageClient(connector)
+	client.InfraIPBlockUsageClient = infra_ip_blocks.NewUsageClient(connector)
+	client.ProjectIPBlockUsageClient = project_infra_ip_blocks.NewUsageClient(connector)
+	client.SubnetsClient = vpcs.NewSubnetsClient(connector)
+	client.SubnetStatusClient = subnets.NewStatusClient(connector)
+	client.IPAddressAllocationClient = vpcs.NewIpAddressAllocationsClient(connectorAllowOverwrite)
+	client.VPCLBSClient = vpcs.NewVpcLbsClient(connector)
+	client.VpcLbVirtualServersClient = vpcs.NewVpcLbVirtualServersClient(connector)
+	client.VpcLbPoolsClient = vpcs.NewVpcLbPoolsClient(connector)
+	client.VpcAttachmentClient = vpcs.NewAttachmentsClient(connector)
+	client.VPCSecurityClient = vpcs.NewSecurityPoliciesClient(connector)
+	client.VPCRuleClient = vpc_sp.NewRulesClient(connector)
+	client.TransitGatewayClient = projects.NewTransitGatewaysClient(connector)
+	client.TransitGatewayAttachmentClient = transit_gateways.NewAttachmentsClient(connector)
+	client.TransitGatewayStateClient = transit_gateways.NewStateClient(connector)
+	client.SubnetConnectionBindingMapsClient = subnets.NewSubnetConnectionBindingMapsClient(connector)
+	client.DynamicIPReservationsClient = subnets.NewDynamicIpReservationsClient(connector)
+	client.StaticIPReservationsClient = subnets.NewStaticIpReservationsClient(connector)
+	client.VpcEndpointsClient = vpcs.NewVpcEndpointsClient(connector)
+	client.VpcServiceEndpointsClient = vpcs.NewVpcServiceEndpointsClient(connector)
+	client.VifsClient = fabric.NewVifsClient(connector)
+	client.DnsZoneClient = dns_services.NewZonesClient(connector)
+	client.DnsRecordsClient = projects.NewDnsRecordsClient(connector)
+}
+
 func CreateNsxtApiClient(config *config.NSXOperatorConfig, client *http.Client) (*nsxt.APIClient, error) {
 	var defaultRetryOnStatusCodes = []int{
 		http.StatusRequestTimeout,     // 408
@@ -385,6 +353,8 @@ func CreateNsxtApiClient(config *config.NSXOperatorConfig, client *http.Client)
 		HTTPClient:           client,
 		// using jwt instead of session
 		SkipSessionAuth: true,
+		// the inventory is synchronized in the background, its requests must not delay the reconcilers
+		DefaultHeader: map[string]string{RateLimitClassHeader: string(ratelimiter.ClassBackground)},
 	}
 
 	nsxClient, err := nsxt.NewAPIClient(&cfg)
@@ -58,6 +58,8 @@ type Cluster struct {
 	transport        *Transport
 	client           *http.Client
 	noBalancerClient *http.Client
+	// endpointsLock guards endpoints and config, which are replaced by UpdateEndpoints.
+	endpointsLock sync.RWMutex
 	sync.Mutex
 	nsxVersion         *NsxVersion
 	lastTimeGetVersion time.Time
@@ -83,10 +85,10 @@ func NewCluster(config *Config) (*Cluster, error) {
 	cluster.config = config
 	cluster.nsxVersion = &NsxVersion{}
 	cluster.transport = cluster.createTransport(time.Duration(config.ConnIdleTimeout))
-	cluster.client = cluster.createHTTPClient(cluster.transport, time.Duration(config.HTTPTimeout))
+	cluster.client = cluster.createHTTPClient(cluster.transport)
 	cluster.noBalancerClient = cluster.createNoBalancerClient(time.Duration(config.HTTPTimeout), time.Duration(config.ConnIdleTimeout))
 
-	r := ratelimiter.NewRateLimiter(config.APIRateMode)
+	r := newRateLimiter(config)
 	eps, err := cluster.createEndpoints(config.APIManagers, cluster.client, cluster.noBalancerClient, r, config.TokenProvider)
 	if err != nil {
 		log.Error(err, "Failed to create cluster")
@@ -96,24 +98,89 @@ func NewCluster(config *Config) (*Cluster, error) {
 	cluster.endpoints = eps
 	cluster.transport.endpoints = eps
 	cluster.transport.config = cluster.config
-	cluster.loadCAforEnvoy()
-	for _, ep := range cluster.endpoints {
-		envoyUrl := cluster.CreateServerUrl(ep.Host(), ep.Scheme())
+	cluster.setupEndpoints(config, eps)
+	for _, ep := range eps {
+		go ep.KeepAlive()
+	}
+
+	return cluster, err
+}
+
+// setupEndpoints prepares the endpoints created from config for sending requests.
+func (cluster *Cluster) setupEndpoints(config *Config, eps []*Endpoint) {
+	loadCAforEnvoy(config, eps)
+	for _, ep := range eps {
+		envoyUrl := createServerUrl(config, ep.Host(), ep.Scheme())
 		ep.SetEnvoyUrl(envoyUrl)
 	}
-	cluster.createAuthSessions()
-	for _, ep := range cluster.endpoints {
+	createAuthSessions(config, eps)
+	for _, ep := range eps {
 		ep.setUserPassword(config.Username, config.Password)
 		ep.setup()
 		if ep.Status() == UP {
 			break
 		}
 	}
-	for _, ep := range cluster.endpoints {
+}
+
+// UpdateEndpoints replaces the NSX managers, credentials, CA files/thumbprints and HTTP timeout
+// used by the cluster, e.g. after the configuration file is reloaded. The new endpoints are set up
+// before they are used, the requests in flight complete on the old endpoints.
+func (cluster *Cluster) UpdateEndpoints(apiManagers []string, username, password string, caFile, thumbprint []string, httpTimeout int) error {
+	config := *cluster.getConfig()
+	config.APIManagers = apiManagers
+	config.Username = username
+	config.Password = password
+	config.CAFile = caFile
+	config.Thumbprint = thumbprint
+	config.HTTPTimeout = httpTimeout
+	if httpTimeout <= 0 {
+		config.HTTPTimeout = defaultHTTPTimeout
+	}
+
+	r := newRateLimiter(&config)
+	noBalancerClient := cluster.createNoBalancerClient(time.Duration(config.HTTPTimeout), time.Duration(config.ConnIdleTimeout))
+	eps, err := cluster.createEndpoints(config.APIManagers, cluster.client, noBalancerClient, r, config.TokenProvider)
+	if err != nil {
+		log.Error(err, "Failed to update cluster endpoints")
+		return err
+	}
+	cluster.setupEndpoints(&config, eps)
+
+	cluster.endpointsLock.Lock()
+	oldEps := cluster.endpoints
+	oldNoBalancerClient := cluster.noBalancerClient
+	cluster.config = &config
+	cluster.endpoints = eps
+	cluster.noBalancerClient = noBalancerClient
+	cluster.endpointsLock.Unlock()
+	cluster.transport.setEndpoints(eps, &config)
+
+	// The idle connections may be to the removed managers or verified with the old CA files/thumbprints.
+	if tr, ok := cluster.transport.Base.(*http.Transport); ok {
+		tr.CloseIdleConnections()
+	}
+	oldNoBalancerClient.CloseIdleConnections()
+	for _, ep := range oldEps {
+		close(ep.stop)
+	}
+	for _, ep := range eps {
 		go ep.KeepAlive()
 	}
+	log.Info("Updated cluster endpoints", "managers", apiManagers)
+	return nil
+}
 
-	return cluster, err
+func (cluster *Cluster) getConfig() *Config {
+	cluster.endpointsLock.RLock()
+	defer cluster.endpointsLock.RUnlock()
+	return cluster.config
+}
+
+func (cluster *Cluster) getEndpoints() []*Endpoint {
+	cluster.endpointsLock.RLock()
+	defer cluster.endpointsLock.RUnlock()
+	return cluster.endpoints
 }
 
 // Convert colon separated thumbprint to colon-free for envoy sidecar
@@ -122,14 +189,14 @@ func thumbprintToUrlPath(thumbprint string) string {
 }
 
 // loadCAforEnvoy should be called after endpoint is created
-func (cluster *Cluster) loadCAforEnvoy() {
-	if !cluster.UsingEnvoy() {
+func loadCAforEnvoy(config *Config, eps []*Endpoint) {
+	if config.EnvoyPort == 0 {
 		return
 	}
-	for i, caFile := range cluster.config.CAFile {
+	for i, caFile := range config.CAFile {
 		cert := util.CertPemBytesToHeader(caFile)
 		if cert != "" {
-			cluster.endpoints[i].caFile = cert
+			eps[i].caFile = cert
 			log.Info("Load CA for envoy sidecar", "caFile", caFile)
 			return
 		} else {
@@ -137,14 +204,18 @@ func (cluster *Cluster) loadCAforEnvoy() {
 		}
 	}
 
-	for i, thumbprint := range cluster.config.Thumbprint {
-		cluster.endpoints[i].Thumbprint = strings.ToLower(strings.TrimSpace(strings.ReplaceAll(thumbprint, ":", "")))
+	for i, thumbprint := range config.Thumbprint {
+		eps[i].Thumbprint = strings.ToLower(strings.TrimSpace(strings.ReplaceAll(thumbprint, ":", "")))
 	}
 }
 
 func (cluster *Cluster) CreateServerUrl(host string, scheme string) string {
+	return createServerUrl(cluster.getConfig(), host, scheme)
+}
+
+func createServerUrl(cf *Config, host string, scheme string) string {
 	serverUrl := ""
-	if cluster.UsingEnvoy() {
+	if cf.EnvoyPort != 0 {
 		envoyUrl := ""
 		index := strings.Index(host, ":")
 		mgrIP := ""
@@ -154,7 +225,6 @@ func (cluster *Cluster) CreateServerUrl(host string, scheme string) string {
 			mgrIP = strings.ReplaceAll(host, ":", "/")
 		}
 
-		cf := cluster.config
 		if len(cf.CAFile) > 0 {
 			envoyUrl = fmt.Sprintf(EnvoyUrlWithCert, cf.EnvoyHost, cf.EnvoyPort, mgrIP)
 		} else if len(cf.Thumbprint) > 0 {
@@ -175,7 +245,8 @@ func (cluster *Cluster) CreateServerUrl(host string, scheme string) string {
 
 // NewRestConnector creates a RestConnector used for SDK client.
 func (cluster *Cluster) NewRestConnector() policyclient.Connector {
-	nsxtUrl := cluster.CreateServerUrl(cluster.endpoints[0].Host(), cluster.endpoints[0].Scheme())
+	ep := cluster.getEndpoints()[0]
+	nsxtUrl := cluster.CreateServerUrl(ep.Host(), ep.Scheme())
 	connector := policyclient.NewConnector(nsxtUrl, policyclient.UsingRest(nil), policyclient.WithHttpClient(cluster.client))
 	connector.NewExecutionContext()
 	return connector
@@ -186,7 +257,8 @@ func SetAllowOverwriteHeader(req *http.Request) error {
 	return nil
 }
 func (cluster *Cluster) NewRestConnectorAllowOverwrite() policyclient.Connector {
-	nsxtUrl := cluster.CreateServerUrl(cluster.endpoints[0].Host(), cluster.endpoints[0].Scheme())
+	ep := cluster.getEndpoints()[0]
+	nsxtUrl := cluster.CreateServerUrl(ep.Host(), ep.Scheme())
 	policyclient.WithRequestProcessors()
 	connector := policyclient.NewConnector(nsxtUrl, policyclient.UsingRest(nil), policyclient.WithHttpClient(cluster.client), policyclient.WithRequestProcessors(SetAllowOverwriteHeader))
 	connector.NewExecutionContext()
@@ -194,12 +266,14 @@ func (cluster *Cluster) NewRestConnectorAllowOverwrite() policyclient.Connector
 }
 
 func (cluster *Cluster) UsingEnvoy() bool {
-	return cluster.config.EnvoyPort != 0
+	return cluster.getConfig().EnvoyPort != 0
 }
 
 func (cluster *Cluster) getThumbprint(addr string) string {
 	host := addr[:strings.Index(addr, ":")]
 	var thumbprint string
+	cluster.endpointsLock.RLock()
+	defer cluster.endpointsLock.RUnlock()
 	tpCount := len(cluster.config.Thumbprint)
 	if tpCount == 1 {
 		thumbprint = cluster.config.Thumbprint[0]
@@ -222,6 +296,8 @@ func (cluster *Cluster) getThumbprint(addr string) string {
 func (cluster *Cluster) getCaFile(addr string) string {
 	host := addr[:strings.Index(addr, ":")]
 	var cafile string
+	cluster.endpointsLock.RLock()
+	defer cluster.endpointsLock.RUnlock()
 	tpCount := len(cluster.config.CAFile)
 	if tpCount == 1 {
 		cafile = cluster.config.CAFile[0]
@@ -250,7 +326,7 @@ func (cluster *Cluster) createTransport(idle time.Duration) *Transport {
 		dial := func(ctx context.Context, network, addr string) (net.Conn, error) { // #nosec G402: ignore insecure options
 			var config *tls.Config
 			cafile := cluster.getCaFile(addr)
-			caCount := len(cluster.config.CAFile)
+			caCount := len(cluster.getConfig().CAFile)
 			log.Info("Create Transport", "ca file", cafile, "caCount", caCount)
 			if caCount > 0 {
 				caCert, err := os.ReadFile(cafile)
@@ -266,7 +342,7 @@ func (cluster *Cluster) createTransport(idle time.Duration) *Transport {
 				}
 			} else {
 				thumbprint := cluster.getThumbprint(addr)
-				tpCount := len(cluster.config.Thumbprint)
+				tpCount := len(cluster.getConfig().Thumbprint)
 				log.Info("Create Transport", "thumbprint", thumbprint, "tpCount", tpCount)
 				// #nosec G402: ignore insecure options
 				config = &tls.Config{
@@ -300,10 +376,11 @@ func (cluster *Cluster) createTransport(idle time.Duration) *Transport {
 	return &Transport{Base: tr}
 }
 
-func (cluster *Cluster) createHTTPClient(tr *Transport, timeout time.Duration) *http.Client {
+// createHTTPClient creates the client of the NSX API requests. The client has no timeout, the
+// transport applies the HTTP timeout of the cluster config, which may be updated at runtime.
+func (cluster *Cluster) createHTTPClient(tr *Transport) *http.Client {
 	return &http.Client{
 		Transport: tr,
-		Timeout:   timeout * time.Second,
 	}
 }
 
@@ -321,6 +398,15 @@ func (cluster *Cluster) createNoBalancerClient(timeout, idle time.Duration) *htt
 	return &noBClient
 }
 
+// newRateLimiter creates the rate limiter shared by the endpoints of the cluster.
+func newRateLimiter(config *Config) ratelimiter.RateLimiter {
+	return ratelimiter.NewRateLimiterWithOptions(ratelimiter.Options{
+		Type:    config.APIRateMode,
+		MaxRate: config.APIRateLimit,
+		Classes: config.APIRateClasses,
+	})
+}
+
 func (cluster *Cluster) createEndpoints(apiManagers []string, client *http.Client, noBClient *http.Client, r ratelimiter.RateLimiter, tokenProvider auth.TokenProvider) ([]*Endpoint, error) {
 	eps := make([]*Endpoint, len(apiManagers))
 	for i := range eps {
@@ -328,38 +414,65 @@ func (cluster *Cluster) createEndpoints(apiManagers []string, client *http.Clien
 		if err != nil {
 			return nil, err
 		}
+		ep.enableMetrics = cluster.config.EnableMetrics
 		eps[i] = ep
 	}
 	return eps, nil
 }
 
-func (cluster *Cluster) createAuthSessions() {
-	for _, ep := range cluster.endpoints {
-		ep.createAuthSession(cluster.config.ClientCertProvider, cluster.config.TokenProvider, cluster.config.Username, cluster.config.Password, jarCache)
+func createAuthSessions(config *Config, eps []*Endpoint) {
+	for _, ep := range eps {
+		ep.createAuthSession(config.ClientCertProvider, config.TokenProvider, config.Username, config.Password, jarCache)
 	}
 }
 
-// Health checks cluster health status.
+// Health checks cluster health status. An endpoint which is UP but whose circuit breaker
+// is open counts as down, and the cluster is not GREEN while any breaker is not closed.
 func (cluster *Cluster) Health() ClusterHealth {
 	down := 0
 	up := 0
-	for _, ep := range cluster.endpoints {
-		if ep.Status() == UP {
-			up++
-		} else {
+	endpoints := cluster.getEndpoints()
+	for _, ep := range endpoints {
+		switch {
+		case ep.Status() != UP || ep.breaker.State() == BreakerOpen:
 			down++
+		case ep.breaker.State() == BreakerClosed:
+			up++
 		}
 	}
 
-	if down == len(cluster.endpoints) {
+	if down == len(endpoints) {
 		return RED
 	}
-	if up == len(cluster.endpoints) {
+	if up == len(endpoints) {
 		return GREEN
 	}
 	return ORANGE
 }
 
+// EndpointHealth is the health of an NSX endpoint.
+type EndpointHealth struct {
+	Host        string
+	Status      EndpointStatus
+	Connections int
+	BreakerStats
+}
+
+// EndpointsHealth returns the status, connections and circuit breaker stats of the endpoints.
+func (cluster *Cluster) EndpointsHealth() []EndpointHealth {
+	endpoints := cluster.getEndpoints()
+	health := make([]EndpointHealth, 0, len(endpoints))
+	for _, ep := range endpoints {
+		health = append(health, EndpointHealth{
+			Host:         ep.Host(),
+			Status:       ep.Status(),
+			Connections:  ep.ConnNumber(),
+			BreakerStats: ep.BreakerStats(),
+		})
+	}
+	return health
+}
+
 // SetonProductVersionChanged registers a callback run after GetVersion successfully refreshes
 // from NSX and detects a non-empty product_version change. Used to invalidate client-side
 // feature caches. Safe to call once during operator init; fn may be nil to clear.
@@ -380,8 +493,8 @@ func (cluster *Cluster) GetVersion() (*NsxVersion, error) {
 		oldVersion = cluster.nsxVersion.ProductVersion
 	}
 
-	ep := cluster.endpoints[0]
-	serverUrl := cluster.CreateServerUrl(cluster.endpoints[0].Host(), cluster.endpoints[0].Scheme())
+	ep := cluster.getEndpoints()[0]
+	serverUrl := cluster.CreateServerUrl(ep.Host(), ep.Scheme())
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/node/version", serverUrl), nil)
 	if err != nil {
 		log.Error(err, "Failed to create HTTP request")
@@ -444,8 +557,8 @@ func (cluster *Cluster) HttpGetAndDecode(url string, result interface{}) error {
 }
 
 func (cluster *Cluster) httpAction(url, method string, requestBody ...interface{}) (*http.Response, error) {
-	ep := cluster.endpoints[0]
-	serverUrl := cluster.CreateServerUrl(cluster.endpoints[0].Host(), cluster.endpoints[0].Scheme())
+	ep := cluster.getEndpoints()[0]
+	serverUrl := cluster.CreateServerUrl(ep.Host(), ep.Scheme())
 	url = fmt.Sprintf("%s/%s", serverUrl, url)
 
 	var bodyReader io.Reader
@@ -573,6 +686,12 @@ func (nsxVersion *NsxVersion) featureSupported(feature int) bool {
 	case IPv6:
 		minVersion = nsx920Version
 		validFeature = true
+	case VPCEndpoint:
+		minVersion = nsx920Version
+		validFeature = true
+	case SubnetPortSetting:
+		minVersion = nsx920Version
+		validFeature = true
 	}
 
 	if validFeature {
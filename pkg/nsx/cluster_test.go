@@ -82,6 +82,49 @@ func TestCluster_getThumbprint(t *testing.T) {
 	assert.Equal(t, tb, "234")
 }
 
+func TestCluster_UpdateEndpoints(t *testing.T) {
+	result := `{
+		"healthy" : true,
+		"components_health" : "POLICY:UP, SEARCH:UP, MANAGER:UP, NODE_MGMT:UP, UI:UP"
+	  }`
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(result))
+	})
+	ts1 := httptest.NewTLSServer(handler)
+	defer ts1.Close()
+	ts2 := httptest.NewTLSServer(handler)
+	defer ts2.Close()
+	a1 := ts1.URL[strings.Index(ts1.URL, "//")+2:]
+	a2 := ts2.URL[strings.Index(ts2.URL, "//")+2:]
+	config := NewConfig(a1, "admin", "passw0rd", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.AIMD, nil, nil, []string{"123"})
+	cluster, err := NewCluster(config)
+	assert.NoError(t, err)
+	oldEps := cluster.getEndpoints()
+
+	err = cluster.UpdateEndpoints([]string{a2}, "admin1", "passw0rd1", nil, []string{"234"}, 30)
+	assert.NoError(t, err)
+	eps := cluster.getEndpoints()
+	assert.Len(t, eps, 1)
+	assert.Equal(t, a2, eps[0].Host())
+	assert.Equal(t, "admin1", eps[0].user)
+	assert.Equal(t, eps, cluster.transport.getEndpoints())
+	assert.Equal(t, []string{"234"}, cluster.transport.getConfig().Thumbprint)
+	assert.Equal(t, "passw0rd1", cluster.getConfig().Password)
+	assert.Equal(t, 30*time.Second, cluster.transport.httpTimeout())
+	assert.Equal(t, 30*time.Second, eps[0].noBalancerClient.Timeout)
+	// The original config is not changed.
+	assert.Equal(t, []string{a1}, config.APIManagers)
+	assert.Equal(t, GREEN, cluster.Health())
+	// The keepalive of the old endpoints is stopped.
+	_, ok := <-oldEps[0].stop
+	assert.False(t, ok)
+
+	err = cluster.UpdateEndpoints([]string{"http://[::1"}, "admin", "passw0rd", nil, nil, 30)
+	assert.Error(t, err)
+	assert.Equal(t, eps, cluster.getEndpoints())
+}
+
 func TestCluster_NewRestConnector(t *testing.T) {
 	result := `{
 		"healthy" : true,
@@ -144,6 +187,27 @@ func TestCluster_Health(t *testing.T) {
 	}
 	health = cluster.Health()
 	assert.Equal(t, health, RED)
+
+	// An UP endpoint with an open circuit breaker counts as down.
+	for _, ep := range cluster.endpoints {
+		ep.breaker = newCircuitBreaker(nil)
+		ep.setStatus(UP)
+	}
+	eps[0].breaker.state = BreakerOpen
+	eps[0].breaker.openedAt = time.Now()
+	assert.Equal(t, ORANGE, cluster.Health())
+	eps[1].breaker.state = BreakerHalfOpen
+	eps[2].breaker.state = BreakerOpen
+	eps[2].breaker.openedAt = time.Now()
+	assert.Equal(t, ORANGE, cluster.Health())
+	eps[1].breaker.state = BreakerOpen
+	eps[1].breaker.openedAt = time.Now()
+	assert.Equal(t, RED, cluster.Health())
+
+	endpointsHealth := cluster.EndpointsHealth()
+	assert.Len(t, endpointsHealth, 3)
+	assert.Equal(t, "10.0.0.1", endpointsHealth[0].Host)
+	assert.Equal(t, BreakerOpen, endpointsHealth[0].State)
 }
 
 func TestCluster_enableFeature(t *testing.T) {
@@ -200,9 +264,13 @@ func TestCluster_enableFeature(t *testing.T) {
 	assert.True(t, nsxVersion.featureSupported(ServiceAccountRestore))
 	assert.True(t, nsxVersion.featureSupported(ServiceAccountCertRotation))
 	assert.False(t, nsxVersion.featureSupported(IPv6))
+	assert.False(t, nsxVersion.featureSupported(VPCEndpoint))
+	assert.False(t, nsxVersion.featureSupported(SubnetPortSetting))
 
 	nsxVersion.ProductVersion = "9.2.0"
 	assert.True(t, nsxVersion.featureSupported(IPv6))
+	assert.True(t, nsxVersion.featureSupported(VPCEndpoint))
+	assert.True(t, nsxVersion.featureSupported(SubnetPortSetting))
 
 	// Test case for invalid feature
 	feature := 3
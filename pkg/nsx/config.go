@@ -50,6 +50,11 @@ type Config struct {
 	// sent, and will be decreased by half after 429/503 error for each period. The rate has hard max limit of
 	// min(100/s, param api_rate_limit_per_endpoint).
 	APIRateMode ratelimiter.Type
+	// The max API rate per endpoint, 0 means ratelimiter.MAXRATELIMIT.
+	APIRateLimit int
+	// The budgets of the API classes sharing the endpoint rate, the class of a request is set in its context by
+	// ratelimiter.WithClass. If nil, the requests of all the classes share the endpoint rate first come first served.
+	APIRateClasses map[ratelimiter.Class]ratelimiter.ClassBudget
 	// None, or instance of implemented AbstractJWTProvider which will return the JSON Web Token used in the requests
 	// in NSX for authorization.
 	TokenProvider auth.TokenProvider
@@ -57,6 +62,8 @@ type Config struct {
 	ClientCertProvider auth.ClientCertProvider
 	EnvoyHost          string
 	EnvoyPort          int
+	// If True, the NSX API request and endpoint metrics are recorded.
+	EnableMetrics bool
 }
 
 // NewConfig creates a nsx configuration. It provides default values for those items not in function parameters.
@@ -0,0 +1,88 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package nsx
+
+import (
+	"context"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	policyclient "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
+)
+
+// RateLimitClassHeader carries the API class of a request sent by a client which doesn't set
+// the request context, the Transport removes it before sending the request to NSX.
+const RateLimitClassHeader = "X-Nsx-Operator-Rate-Class"
+
+// contextClientCacheSize is the number of clients derived by Client.WithContext kept for reuse.
+const contextClientCacheSize = 128
+
+// contextConnector is a connector sending the requests of the SDK clients with the context ctx,
+// which is set as the request context by the REST protocol of the SDK.
+type contextConnector struct {
+	policyclient.Connector
+	ctx context.Context
+}
+
+func (c *contextConnector) NewExecutionContext() *core.ExecutionContext {
+	executionCtx := c.Connector.NewExecutionContext()
+	executionCtx.WithContext(c.ctx)
+	// Set default accepted response type as the SDK connector does.
+	executionCtx.SetConnectionMetadata(core.ResponseTypeKey, core.OnlyMonoResponse)
+	return executionCtx
+}
+
+// contextClientKey identifies the clients derived by Client.WithContext.
+type contextClientKey struct {
+	class      ratelimiter.Class
+	traceID    trace.TraceID
+	spanID     trace.SpanID
+	traceFlags trace.TraceFlags
+}
+
+// WithContext returns a copy of client whose SDK clients send the requests with the API class and
+// the span of ctx, the NSX API spans are then children of the span of ctx. It returns client itself
+// if ctx carries neither a class nor a span.
+// The requests don't inherit the cancellation of ctx. The copies share the connectors of client and
+// are cached by class and span, so the SDK clients are built once for the NSX requests of a span.
+func (client *Client) WithContext(ctx context.Context) *Client {
+	if client == nil || client.Cluster == nil {
+		return client
+	}
+	if client.base != nil {
+		client = client.base
+	}
+	class, hasClass := ratelimiter.ClassFromContext(ctx)
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !hasClass && !spanCtx.IsValid() {
+		return client
+	}
+	key := contextClientKey{class: class, traceID: spanCtx.TraceID(), spanID: spanCtx.SpanID(), traceFlags: spanCtx.TraceFlags()}
+	if client.contextClients != nil {
+		if cached, ok := client.contextClients.Get(key); ok {
+			return cached.(*Client)
+		}
+	}
+
+	requestCtx := trace.ContextWithSpanContext(context.Background(), spanCtx)
+	if hasClass {
+		requestCtx = ratelimiter.WithClass(requestCtx, class)
+	}
+	connector, connectorAllowOverwrite := client.RestConnector, client.restConnectorAllowOverwrite
+	if connector == nil {
+		connector = client.Cluster.NewRestConnector()
+	}
+	if connectorAllowOverwrite == nil {
+		connectorAllowOverwrite = client.Cluster.NewRestConnectorAllowOverwrite()
+	}
+	c := *client
+	c.base = client
+	c.setSDKClients(&contextConnector{Connector: connector, ctx: requestCtx}, &contextConnector{Connector: connectorAllowOverwrite, ctx: requestCtx})
+	if client.contextClients != nil {
+		client.contextClients.Add(key, &c)
+	}
+	return &c
+}
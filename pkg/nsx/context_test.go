@@ -0,0 +1,85 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package nsx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/utils/lru"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
+)
+
+func newContextTestClient(t testing.TB) *Client {
+	config := NewConfig("1.1.1.1", "1", "1", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.AIMD, nil, nil, []string{})
+	cluster, err := NewCluster(config)
+	assert.NoError(t, err)
+	client := &Client{Cluster: cluster}
+	client.setSDKClients(cluster.NewRestConnector(), cluster.NewRestConnectorAllowOverwrite())
+	client.contextClients = lru.New(contextClientCacheSize)
+	return client
+}
+
+func contextWithSpan(traceID byte, spanID byte) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{traceID},
+		SpanID:     trace.SpanID{spanID},
+		TraceFlags: trace.FlagsSampled,
+	}))
+}
+
+func TestClient_WithContext(t *testing.T) {
+	client := newContextTestClient(t)
+
+	assert.Same(t, client, client.WithContext(context.Background()))
+
+	ctx := ratelimiter.WithClass(contextWithSpan(1, 1), ratelimiter.ClassBackground)
+	derived := client.WithContext(ctx)
+	assert.NotSame(t, client, derived)
+	assert.Same(t, derived, client.WithContext(ctx))
+	assert.Same(t, derived, derived.WithContext(ctx))
+	assert.Same(t, client, derived.WithContext(context.Background()))
+	assert.NotSame(t, derived, client.WithContext(contextWithSpan(1, 1)))
+	assert.NotSame(t, derived, client.WithContext(ratelimiter.WithClass(contextWithSpan(1, 2), ratelimiter.ClassBackground)))
+
+	// The requests are sent with the class and the span of ctx, but not its cancellation.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	executionCtx := client.WithContext(cancelCtx).RestConnector.NewExecutionContext()
+	requestCtx := executionCtx.Context()
+	assert.NoError(t, requestCtx.Err())
+	class, ok := ratelimiter.ClassFromContext(requestCtx)
+	assert.True(t, ok)
+	assert.Equal(t, ratelimiter.ClassBackground, class)
+	assert.Equal(t, trace.SpanContextFromContext(ctx), trace.SpanContextFromContext(requestCtx))
+	responseType, err := executionCtx.ConnectionMetadata(core.ResponseTypeKey)
+	assert.NoError(t, err)
+	assert.Equal(t, core.OnlyMonoResponse, responseType)
+
+	// A client without cache derives a new client on every call.
+	client.contextClients = nil
+	assert.NotSame(t, client.WithContext(ctx), client.WithContext(ctx))
+}
+
+// BenchmarkClientWithContext measures the overhead of Client.WithContext for the NSX requests of a reconcile.
+func BenchmarkClientWithContext(b *testing.B) {
+	client := newContextTestClient(b)
+	ctx := ratelimiter.WithClass(contextWithSpan(1, 1), ratelimiter.ClassBackground)
+	b.Run("SameSpan", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = client.WithContext(ctx).VPCClient
+		}
+	})
+	b.Run("NewSpan", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = client.WithContext(contextWithSpan(1, byte(i))).VPCClient
+		}
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/auth"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
@@ -51,6 +52,10 @@ type Endpoint struct {
 	caFile        string
 	Thumbprint    string
 	envoyUrl      string
+	// Used to record the endpoint status and API rate limit metrics
+	enableMetrics bool
+	// breaker stops sending requests to the endpoint while it fails or is too slow.
+	breaker *circuitBreaker
 	sync.RWMutex
 	provider
 }
@@ -88,6 +93,7 @@ func NewEndpoint(url string, client *http.Client, noBClient *http.Client, r rate
 	addr.scheme = scheme
 	ep := Endpoint{client: client, noBalancerClient: noBClient, keepaliveperiod: ratelimiter.KeepAlivePeriod, ratelimiter: r, status: DOWN, tokenProvider: tokenProvider}
 	ep.provider = addr
+	ep.breaker = newCircuitBreaker(ep.onBreakerStateChange)
 	ep.stop = make(chan bool)
 	ep.lockWait = 120 * time.Second
 	return &ep, nil
@@ -217,6 +223,26 @@ func (ep *Endpoint) setStatus(s EndpointStatus) {
 		ep.status = s
 	}
 	ep.Unlock()
+	if ep.enableMetrics {
+		metrics.SetNSXEndpointStatus(ep.Host(), s == UP)
+	}
+}
+
+func (ep *Endpoint) onBreakerStateChange(state BreakerState) {
+	log.Info("Endpoint circuit breaker state is changing", "endpoint", ep.Host(), "newState", state)
+	if ep.enableMetrics {
+		metrics.SetNSXEndpointBreakerState(ep.Host(), string(state), []string{string(BreakerClosed), string(BreakerOpen), string(BreakerHalfOpen)})
+	}
+}
+
+// BreakerStats returns the circuit breaker state, error rate and latencies of the endpoint.
+func (ep *Endpoint) BreakerStats() BreakerStats {
+	return ep.breaker.stats()
+}
+
+// available returns true if the endpoint is UP and its circuit breaker lets requests through.
+func (ep *Endpoint) available() bool {
+	return ep.Status() == UP && ep.breaker.available()
 }
 
 func (ep *Endpoint) setXSRFToken(token string) {
@@ -239,12 +265,16 @@ func (ep *Endpoint) Status() EndpointStatus {
 	return ep.status
 }
 
-func (ep *Endpoint) wait() {
-	ep.ratelimiter.Wait()
+func (ep *Endpoint) wait(class ratelimiter.Class) {
+	ep.ratelimiter.WaitClass(class)
 }
 
 func (ep *Endpoint) adjustRate(wait time.Duration, status int) {
 	ep.ratelimiter.AdjustRate(wait, status)
+	if !ep.enableMetrics {
+		return
+	}
+	metrics.SetNSXAPIRateLimit(ep.Host(), ep.ratelimiter.Rate())
 }
 
 func (ep *Endpoint) setAliveTime(time time.Time) {
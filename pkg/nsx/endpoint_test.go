@@ -13,9 +13,11 @@ import (
 	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/auth"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/auth/jwt"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
@@ -116,7 +118,7 @@ func TestCreateAuthSession(t *testing.T) {
 	jar := NewJar()
 	cluster := &Cluster{config: &Config{}}
 	tr := cluster.createTransport(10)
-	client := cluster.createHTTPClient(tr, 30)
+	client := cluster.createHTTPClient(tr)
 	noBClient := cluster.createNoBalancerClient(90, 90)
 	rl := ratelimiter.NewFixRateLimiter(10)
 	ep, err := NewEndpoint("10.0.0.1", client, noBClient, rl, nil)
@@ -173,7 +175,7 @@ func TestKeepAlive(t *testing.T) {
 	defer ts.Close()
 	cluster := &Cluster{config: &Config{}}
 	tr := cluster.createTransport(10)
-	client := cluster.createHTTPClient(tr, 30)
+	client := cluster.createHTTPClient(tr)
 	noBClient := cluster.createNoBalancerClient(90, 90)
 	rl := ratelimiter.NewFixRateLimiter(10)
 	ep, err := NewEndpoint(ts.URL[len("http://"):], client, noBClient, rl, nil)
@@ -218,3 +220,25 @@ func TestKeepAlive(t *testing.T) {
 	ep.KeepAlive()
 	assert.Equal(ep.Status(), DOWN)
 }
+
+func TestEndpointMetrics(t *testing.T) {
+	rl := ratelimiter.NewFixRateLimiter(10)
+	ep, err := NewEndpoint("10.0.0.10", nil, nil, rl, nil)
+	assert.Nil(t, err)
+
+	// Metrics are not recorded unless they are enabled
+	ep.setStatus(UP)
+	ep.adjustRate(time.Millisecond, http.StatusOK)
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.NSXEndpointStatus.WithLabelValues("10.0.0.10")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.NSXAPIRateLimit.WithLabelValues("10.0.0.10")))
+
+	ep.enableMetrics = true
+	ep.setStatus(DOWN)
+	ep.setStatus(UP)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.NSXEndpointStatus.WithLabelValues("10.0.0.10")))
+	ep.setStatus(DOWN)
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.NSXEndpointStatus.WithLabelValues("10.0.0.10")))
+
+	ep.adjustRate(time.Millisecond, http.StatusOK)
+	assert.Equal(t, float64(10), testutil.ToFloat64(metrics.NSXAPIRateLimit.WithLabelValues("10.0.0.10")))
+}
@@ -0,0 +1,246 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ratelimiter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Class is the priority class of an API request, each class has its own budget and
+// weight when the classes compete for the rate of the endpoint.
+type Class string
+
+const (
+	// ClassInteractive is for the requests realizing the resources users wait for, e.g. the SubnetPorts of Pods.
+	ClassInteractive Class = "interactive"
+	// ClassBackground is for the bulk requests, e.g. the inventory sync and the garbage collection.
+	ClassBackground Class = "background"
+	// ClassSearch is for the search API requests.
+	ClassSearch Class = "search"
+)
+
+// Classes are all the API classes.
+var Classes = []Class{ClassInteractive, ClassBackground, ClassSearch}
+
+// ClassBudget is the budget of an API class.
+type ClassBudget struct {
+	// Rate is the maximum requests per second of the class, 0 means the class is only
+	// limited by the rate of the endpoint.
+	Rate int
+	// Weight is the share of the class of the endpoint rate when other classes are waiting.
+	Weight int
+}
+
+// DefaultClassBudgets are the budgets of the classes which are not configured.
+var DefaultClassBudgets = map[Class]ClassBudget{
+	ClassInteractive: {Rate: 0, Weight: 8},
+	ClassBackground:  {Rate: 20, Weight: 1},
+	ClassSearch:      {Rate: 30, Weight: 2},
+}
+
+type classKey struct{}
+
+// WithClass returns a copy of ctx carrying the API class of the requests sent with it.
+func WithClass(ctx context.Context, class Class) context.Context {
+	return context.WithValue(ctx, classKey{}, class)
+}
+
+// ClassFromContext returns the API class carried by ctx.
+func ClassFromContext(ctx context.Context) (Class, bool) {
+	class, ok := ctx.Value(classKey{}).(Class)
+	return class, ok
+}
+
+// ParseType parses the rate limiter type, "aimd" or "fixrate" case-insensitively. The
+// empty string is AIMD.
+func ParseType(s string) (Type, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "aimd":
+		return AIMD, nil
+	case "fixrate":
+		return FIXRATE, nil
+	}
+	return AIMD, fmt.Errorf("unknown rate limiter type %q, expected aimd or fixrate", s)
+}
+
+// ParseClassBudgets parses the class budgets as "<class>:<rate>:<weight>" and returns
+// them merged into DefaultClassBudgets.
+func ParseClassBudgets(values []string) (map[Class]ClassBudget, error) {
+	budgets := make(map[Class]ClassBudget, len(DefaultClassBudgets))
+	for class, budget := range DefaultClassBudgets {
+		budgets[class] = budget
+	}
+	for _, value := range values {
+		parts := strings.Split(strings.TrimSpace(value), ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid class budget %q, expected <class>:<rate>:<weight>", value)
+		}
+		class := Class(parts[0])
+		if _, ok := DefaultClassBudgets[class]; !ok {
+			return nil, fmt.Errorf("unknown class %q in class budget %q", parts[0], value)
+		}
+		r, err := strconv.Atoi(parts[1])
+		if err != nil || r < 0 || r > MAXRATELIMIT {
+			return nil, fmt.Errorf("invalid rate in class budget %q, expected 0 to %d", value, MAXRATELIMIT)
+		}
+		weight, err := strconv.Atoi(parts[2])
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("invalid weight in class budget %q, expected a positive integer", value)
+		}
+		budgets[class] = ClassBudget{Rate: r, Weight: weight}
+	}
+	return budgets, nil
+}
+
+// classQueue is the queue of the requests of a class waiting for the endpoint rate.
+type classQueue struct {
+	// limiter enforces the rate of the class budget, nil if the class has no rate.
+	limiter *rate.Limiter
+	// stride is the pass increment of the class, the inverse of its weight.
+	stride  float64
+	pass    float64
+	waiters []chan struct{}
+}
+
+// ClassRateLimiter is the rate limiter sharing the rate of the base rate limiter between
+// the API classes. A request first waits for the budget of its class, then for its turn
+// among the classes with waiting requests, which are served by stride scheduling, a
+// weighted fair queuing: each class gets the endpoint rate in proportion to its weight.
+type ClassRateLimiter struct {
+	base    RateLimiter
+	classes map[Class]*classQueue
+	// dispatching is true while a goroutine hands the tokens of base to the waiting requests.
+	dispatching bool
+	sync.Mutex
+}
+
+// NewClassRateLimiter creates the rate limiter sharing base between the classes with budgets.
+// The classes without budget use DefaultClassBudgets.
+func NewClassRateLimiter(base RateLimiter, budgets map[Class]ClassBudget) *ClassRateLimiter {
+	limiter := &ClassRateLimiter{base: base, classes: map[Class]*classQueue{}}
+	for _, class := range Classes {
+		budget, ok := budgets[class]
+		if !ok {
+			budget = DefaultClassBudgets[class]
+		}
+		q := &classQueue{stride: 1 / float64(max(budget.Weight, 1))}
+		if budget.Rate > 0 {
+			q.limiter = rate.NewLimiter(rate.Limit(budget.Rate), 1)
+		}
+		limiter.classes[class] = q
+	}
+	return limiter
+}
+
+// Wait blocks the caller of the interactive class until a token is gained.
+func (limiter *ClassRateLimiter) Wait() {
+	limiter.WaitClass(ClassInteractive)
+}
+
+// WaitClass blocks the caller of class until a token of the class budget and then a token
+// of the base rate limiter are gained. Like the other rate limiters, the caller is released
+// after RateLimiterTimeout without a token.
+func (limiter *ClassRateLimiter) WaitClass(class Class) {
+	q, ok := limiter.classes[class]
+	if !ok {
+		q = limiter.classes[ClassInteractive]
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*RateLimiterTimeout)
+	defer cancel()
+	if q.limiter != nil {
+		if err := q.limiter.WaitN(ctx, 1); err != nil {
+			log.Debug("Wait for class token timeout", "class", class, "error", err.Error())
+			return
+		}
+	}
+
+	ch := make(chan struct{})
+	limiter.Lock()
+	if len(q.waiters) == 0 {
+		// A class which was idle does not get the turns it missed.
+		q.pass = max(q.pass, limiter.minPass())
+	}
+	q.waiters = append(q.waiters, ch)
+	if !limiter.dispatching {
+		limiter.dispatching = true
+		go limiter.dispatch()
+	}
+	limiter.Unlock()
+
+	select {
+	case <-ch:
+	case <-ctx.Done():
+		log.Debug("Wait for token timeout", "class", class)
+		limiter.Lock()
+		for i, waiter := range q.waiters {
+			if waiter == ch {
+				q.waiters = append(q.waiters[:i], q.waiters[i+1:]...)
+				break
+			}
+		}
+		limiter.Unlock()
+	}
+}
+
+// dispatch hands each token of the base rate limiter to the first request of the class
+// with the lowest pass, until no request is waiting.
+func (limiter *ClassRateLimiter) dispatch() {
+	for {
+		limiter.Lock()
+		if limiter.next() == nil {
+			limiter.dispatching = false
+			limiter.Unlock()
+			return
+		}
+		limiter.Unlock()
+
+		limiter.base.Wait()
+
+		limiter.Lock()
+		// The waiters may have timed out meanwhile, then the token is dropped.
+		if q := limiter.next(); q != nil {
+			close(q.waiters[0])
+			q.waiters = q.waiters[1:]
+			q.pass += q.stride
+		}
+		limiter.Unlock()
+	}
+}
+
+// next returns the class with waiting requests and the lowest pass, it must be called with the lock held.
+func (limiter *ClassRateLimiter) next() *classQueue {
+	var next *classQueue
+	for _, class := range Classes {
+		q := limiter.classes[class]
+		if len(q.waiters) > 0 && (next == nil || q.pass < next.pass) {
+			next = q
+		}
+	}
+	return next
+}
+
+// minPass returns the lowest pass of the classes with waiting requests, it must be called with the lock held.
+func (limiter *ClassRateLimiter) minPass() float64 {
+	if q := limiter.next(); q != nil {
+		return q.pass
+	}
+	return 0
+}
+
+// AdjustRate adjusts the rate of the base rate limiter.
+func (limiter *ClassRateLimiter) AdjustRate(waitTime time.Duration, statusCode int) {
+	limiter.base.AdjustRate(waitTime, statusCode)
+}
+
+// Rate returns the rate of the base rate limiter.
+func (limiter *ClassRateLimiter) Rate() int {
+	return limiter.base.Rate()
+}
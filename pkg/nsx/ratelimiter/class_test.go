@@ -0,0 +1,162 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ratelimiter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenLimiter is a base rate limiter handing out the tokens sent to its channel.
+type tokenLimiter struct {
+	tokens chan struct{}
+}
+
+func (l *tokenLimiter) Wait()                         { <-l.tokens }
+func (l *tokenLimiter) WaitClass(Class)               { l.Wait() }
+func (l *tokenLimiter) AdjustRate(time.Duration, int) {}
+func (l *tokenLimiter) Rate() int                     { return 42 }
+
+func TestClassRateLimiter_WeightedFairQueuing(t *testing.T) {
+	base := &tokenLimiter{tokens: make(chan struct{})}
+	limiter := NewClassRateLimiter(base, map[Class]ClassBudget{
+		ClassInteractive: {Weight: 8},
+		ClassBackground:  {Weight: 1},
+	})
+	assert.Equal(t, 42, limiter.Rate())
+
+	var lock sync.Mutex
+	var granted []Class
+	var wg sync.WaitGroup
+	enqueue := func(class Class, n int) {
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				limiter.WaitClass(class)
+				lock.Lock()
+				granted = append(granted, class)
+				lock.Unlock()
+			}()
+		}
+		require.Eventually(t, func() bool {
+			limiter.Lock()
+			defer limiter.Unlock()
+			return len(limiter.classes[class].waiters) == n
+		}, time.Second, time.Millisecond)
+	}
+	enqueue(ClassInteractive, 10)
+	enqueue(ClassBackground, 10)
+
+	// countGranted hands out the tokens until n requests are granted and counts them by class.
+	countGranted := func(n int) map[Class]int {
+		lock.Lock()
+		sent := len(granted)
+		lock.Unlock()
+		for i := sent; i < n; i++ {
+			base.tokens <- struct{}{}
+		}
+		require.Eventually(t, func() bool {
+			lock.Lock()
+			defer lock.Unlock()
+			return len(granted) == n
+		}, time.Second, time.Millisecond)
+		lock.Lock()
+		defer lock.Unlock()
+		counts := map[Class]int{}
+		for _, class := range granted {
+			counts[class]++
+		}
+		return counts
+	}
+	assert.Equal(t, map[Class]int{ClassInteractive: 8, ClassBackground: 1}, countGranted(9))
+	// The interactive requests are served first, then the background requests get all the tokens.
+	assert.Equal(t, map[Class]int{ClassInteractive: 10, ClassBackground: 10}, countGranted(20))
+	wg.Wait()
+
+	limiter.Lock()
+	assert.False(t, limiter.dispatching)
+	limiter.Unlock()
+}
+
+func TestClassRateLimiter_ClassBudget(t *testing.T) {
+	limiter := NewRateLimiterWithOptions(Options{Type: FIXRATE, Classes: map[Class]ClassBudget{
+		ClassBackground: {Rate: 10, Weight: 1},
+	}})
+	_, ok := limiter.(*ClassRateLimiter)
+	require.True(t, ok)
+	assert.Equal(t, MAXRATELIMIT, limiter.Rate())
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		limiter.WaitClass(ClassBackground)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond)
+
+	// The interactive class is not capped by the background budget.
+	start = time.Now()
+	for i := 0; i < 6; i++ {
+		limiter.WaitClass(ClassInteractive)
+	}
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestNewRateLimiterWithOptions(t *testing.T) {
+	limiter := NewRateLimiterWithOptions(Options{Type: FIXRATE, MaxRate: 30})
+	_, ok := limiter.(*FixRateLimiter)
+	assert.True(t, ok)
+	assert.Equal(t, 30, limiter.Rate())
+
+	limiter = NewRateLimiterWithOptions(Options{Type: AIMD})
+	_, ok = limiter.(*AIMDRateLimter)
+	assert.True(t, ok)
+}
+
+func TestWithClass(t *testing.T) {
+	_, ok := ClassFromContext(context.Background())
+	assert.False(t, ok)
+	class, ok := ClassFromContext(WithClass(context.Background(), ClassSearch))
+	assert.True(t, ok)
+	assert.Equal(t, ClassSearch, class)
+}
+
+func TestParseType(t *testing.T) {
+	for _, tc := range []struct {
+		value   string
+		want    Type
+		wantErr bool
+	}{
+		{value: "", want: AIMD},
+		{value: "AIMD", want: AIMD},
+		{value: "fixrate", want: FIXRATE},
+		{value: "token-bucket", want: AIMD, wantErr: true},
+	} {
+		got, err := ParseType(tc.value)
+		assert.Equal(t, tc.wantErr, err != nil, tc.value)
+		assert.Equal(t, tc.want, got, tc.value)
+	}
+}
+
+func TestParseClassBudgets(t *testing.T) {
+	budgets, err := ParseClassBudgets(nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultClassBudgets, budgets)
+
+	budgets, err = ParseClassBudgets([]string{"background:5:2", " search:0:1"})
+	require.NoError(t, err)
+	assert.Equal(t, ClassBudget{Rate: 5, Weight: 2}, budgets[ClassBackground])
+	assert.Equal(t, ClassBudget{Rate: 0, Weight: 1}, budgets[ClassSearch])
+	assert.Equal(t, DefaultClassBudgets[ClassInteractive], budgets[ClassInteractive])
+	assert.Equal(t, ClassBudget{Rate: 20, Weight: 1}, DefaultClassBudgets[ClassBackground])
+
+	for _, value := range []string{"background:5", "bulk:5:1", "search:x:1", "search:101:1", "search:5:0"} {
+		_, err = ParseClassBudgets([]string{value})
+		assert.Error(t, err, value)
+	}
+}
@@ -49,8 +49,11 @@ const (
 // RateLimiter limits the REST API speed.
 type RateLimiter interface {
 	Wait()
+	// WaitClass blocks the caller until a token is gained for a request of the API class.
+	WaitClass(Class)
 	AdjustRate(time.Duration, int)
-	rate() int
+	// Rate returns the current rate limit, 0 means the rate limiter is disabled.
+	Rate() int
 }
 
 // FixRateLimiter is rate limiter which has fix rate.
@@ -80,6 +83,34 @@ func NewRateLimiter(rateLimiterType Type) RateLimiter {
 	return NewAIMDRateLimiter(MAXRATELIMIT, DEFAULTUPDATEPERIOD)
 }
 
+// Options are the options of the rate limiter created by NewRateLimiterWithOptions.
+type Options struct {
+	Type Type
+	// MaxRate is the maximum rate of the endpoint, 0 means MAXRATELIMIT.
+	MaxRate int
+	// Classes are the budgets of the API classes, nil means the API classes share the
+	// rate without budgets.
+	Classes map[Class]ClassBudget
+}
+
+// NewRateLimiterWithOptions creates the rate limiter based on opts.
+func NewRateLimiterWithOptions(opts Options) RateLimiter {
+	maxRate := opts.MaxRate
+	if maxRate <= 0 {
+		maxRate = MAXRATELIMIT
+	}
+	var base RateLimiter
+	if opts.Type == FIXRATE {
+		base = NewFixRateLimiter(maxRate)
+	} else {
+		base = NewAIMDRateLimiter(maxRate, DEFAULTUPDATEPERIOD)
+	}
+	if opts.Classes == nil {
+		return base
+	}
+	return NewClassRateLimiter(base, opts.Classes)
+}
+
 // NewFixRateLimiter creates AIMD rate limiter.
 // max ==0 disables rate limiter.
 func NewFixRateLimiter(max int) RateLimiter {
@@ -126,7 +157,12 @@ func (limiter *FixRateLimiter) Wait() {
 	}
 }
 
-func (limiter *FixRateLimiter) rate() int {
+// WaitClass blocks the caller until a token is gained, the class is ignored.
+func (limiter *FixRateLimiter) WaitClass(Class) {
+	limiter.Wait()
+}
+
+func (limiter *FixRateLimiter) Rate() int {
 	if limiter.disable {
 		return 0
 	}
@@ -151,6 +187,11 @@ func (limiter *AIMDRateLimter) Wait() {
 	}
 }
 
+// WaitClass blocks the caller until a token is gained, the class is ignored.
+func (limiter *AIMDRateLimter) WaitClass(Class) {
+	limiter.Wait()
+}
+
 // AdjustRate adjust upper limit for rate limiter.
 func (limiter *AIMDRateLimter) AdjustRate(waitTime time.Duration, statusCode int) {
 	if limiter.disable {
@@ -189,7 +230,7 @@ func (limiter *AIMDRateLimter) AdjustRate(waitTime time.Duration, statusCode int
 	}
 }
 
-func (limiter *AIMDRateLimter) rate() int {
+func (limiter *AIMDRateLimter) Rate() int {
 	if limiter.disable {
 		return 0
 	}
@@ -20,12 +20,12 @@ func TestAdjustRate(t *testing.T) {
 	// normal adjust case
 	time.Sleep(100 * time.Millisecond)
 	limiter.AdjustRate(waitTime, 200)
-	re := limiter.rate()
+	re := limiter.Rate()
 	assert.Equal(re, 2, "Set rate error.")
 
 	// the interval less than period, should not adjust
 	limiter.AdjustRate(time.Millisecond, 200)
-	re = limiter.rate()
+	re = limiter.Rate()
 	assert.Equal(re, 2, "Set rate error.")
 
 	// the upper rate should be equal to max
@@ -33,13 +33,13 @@ func TestAdjustRate(t *testing.T) {
 		time.Sleep(100 * time.Millisecond)
 		limiter.AdjustRate(waitTime, 201)
 	}
-	re = limiter.rate()
+	re = limiter.Rate()
 	assert.Equal(re, max, fmt.Sprintf("Rate should not be %d.\n", re))
 
 	// decrease the rate
 	time.Sleep(100 * time.Millisecond)
 	limiter.AdjustRate(0, 429)
-	re = limiter.rate()
+	re = limiter.Rate()
 	assert.Equal(re, max/2, "Set rate error.")
 }
 
@@ -78,29 +78,29 @@ func TestWait(t *testing.T) {
 
 func TestRateLimiter_NewFixRateLimiter(t *testing.T) {
 	limiter := NewFixRateLimiter(120)
-	assert.Equal(t, limiter.rate(), MAXRATELIMIT)
+	assert.Equal(t, limiter.Rate(), MAXRATELIMIT)
 
 	limiter = NewFixRateLimiter(80)
-	assert.Equal(t, limiter.rate(), 80)
+	assert.Equal(t, limiter.Rate(), 80)
 
 	limiter = NewFixRateLimiter(0)
 	l, ok := limiter.(*FixRateLimiter)
 	assert.Equal(t, ok, true)
-	assert.Equal(t, limiter.rate(), 0)
+	assert.Equal(t, limiter.Rate(), 0)
 	assert.Equal(t, l.disable, true)
 }
 
 func TestRateLimiter_NewAIMDRateLimiter(t *testing.T) {
 	limiter := NewAIMDRateLimiter(120, 1.0)
-	assert.Equal(t, limiter.rate(), 1)
+	assert.Equal(t, limiter.Rate(), 1)
 
 	limiter = NewAIMDRateLimiter(80, 1.0)
-	assert.Equal(t, limiter.rate(), 1)
+	assert.Equal(t, limiter.Rate(), 1)
 
 	limiter = NewAIMDRateLimiter(0, 1.0)
 	l, ok := limiter.(*AIMDRateLimter)
 	assert.Equal(t, ok, true)
-	assert.Equal(t, limiter.rate(), 0)
+	assert.Equal(t, limiter.Rate(), 0)
 	assert.Equal(t, l.disable, true)
 }
 
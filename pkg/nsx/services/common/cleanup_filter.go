@@ -0,0 +1,110 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type cleanupFilterKey struct{}
+
+// CleanupFilter limits the cleanup to the NSX resources of the given Kubernetes Namespaces, NSX VPCs and cleanup kinds.
+// An empty field matches everything, and the resources must match all the non-empty fields.
+type CleanupFilter struct {
+	// Namespaces is matched with the tag nsx-op/namespace or nsx-op/vm_namespace on the NSX resources.
+	Namespaces sets.Set[string]
+	// VPCPaths is matched with the path of the NSX resources, a resource matches if it is a VPC in VPCPaths or under one.
+	VPCPaths sets.Set[string]
+	// Kinds is matched with the kind of the cleaners, e.g., subnetport, securitypolicy or dnsrecord.
+	Kinds sets.Set[string]
+}
+
+func NewCleanupFilter(namespaces, vpcPaths, kinds []string) *CleanupFilter {
+	filter := &CleanupFilter{
+		Namespaces: sets.New[string](),
+		VPCPaths:   sets.New[string](),
+		Kinds:      sets.New[string](),
+	}
+	for _, ns := range namespaces {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			filter.Namespaces.Insert(ns)
+		}
+	}
+	for _, vpcPath := range vpcPaths {
+		if vpcPath = strings.TrimSuffix(strings.TrimSpace(vpcPath), "/"); vpcPath != "" {
+			filter.VPCPaths.Insert(vpcPath)
+		}
+	}
+	for _, kind := range kinds {
+		if kind = strings.ToLower(strings.TrimSpace(kind)); kind != "" {
+			filter.Kinds.Insert(kind)
+		}
+	}
+	return filter
+}
+
+func WithCleanupFilter(ctx context.Context, filter *CleanupFilter) context.Context {
+	return context.WithValue(ctx, cleanupFilterKey{}, filter)
+}
+
+// CleanupFilterFromContext returns the CleanupFilter in the context, nil is returned if the cleanup is not scoped.
+func CleanupFilterFromContext(ctx context.Context) *CleanupFilter {
+	filter, _ := ctx.Value(cleanupFilterKey{}).(*CleanupFilter)
+	return filter
+}
+
+// IsScoped returns true if the cleanup is limited by Namespaces or VPC paths, i.e., only a part of the NSX resources
+// of a cleaner are deleted.
+func (f *CleanupFilter) IsScoped() bool {
+	return f != nil && (f.Namespaces.Len() > 0 || f.VPCPaths.Len() > 0)
+}
+
+// MatchKind returns true if the cleaner of the given kind should run.
+func (f *CleanupFilter) MatchKind(kind string) bool {
+	return f == nil || f.Kinds.Len() == 0 || f.Kinds.Has(kind)
+}
+
+// MatchResource returns true if the NSX resource with the given path and tags should be deleted. The resources without
+// path or tags, e.g., the container cluster in the inventory, never match a scoped filter.
+func (f *CleanupFilter) MatchResource(path string, tags []model.Tag) bool {
+	if !f.IsScoped() {
+		return true
+	}
+	if f.VPCPaths.Len() > 0 && !f.matchVPCPath(path) {
+		return false
+	}
+	if f.Namespaces.Len() > 0 && !f.matchNamespace(tags) {
+		return false
+	}
+	return true
+}
+
+func (f *CleanupFilter) matchVPCPath(path string) bool {
+	if path == "" {
+		return false
+	}
+	vpcPath := vpcPathRegex.FindString(path)
+	return vpcPath != "" && f.VPCPaths.Has(vpcPath)
+}
+
+func (f *CleanupFilter) matchNamespace(tags []model.Tag) bool {
+	for _, tag := range tags {
+		if tag.Scope == nil || tag.Tag == nil {
+			continue
+		}
+		if (*tag.Scope == TagScopeNamespace || *tag.Scope == TagScopeVMNamespace) && f.Namespaces.Has(*tag.Tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsVPCPath returns true if path is the path of an NSX VPC, e.g., /orgs/default/projects/p1/vpcs/vpc1.
+func IsVPCPath(path string) bool {
+	return path != "" && vpcPathRegex.FindString(path) == path
+}
@@ -0,0 +1,137 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestNewCleanupFilter(t *testing.T) {
+	filter := NewCleanupFilter([]string{"ns-1", " ns-2", ""}, []string{"/orgs/default/projects/p1/vpcs/vpc-1/", ""}, []string{"SubnetPort", " dnsrecord "})
+	assert.Equal(t, sets.New[string]("ns-1", "ns-2"), filter.Namespaces)
+	assert.Equal(t, sets.New[string]("/orgs/default/projects/p1/vpcs/vpc-1"), filter.VPCPaths)
+	assert.Equal(t, sets.New[string]("subnetport", "dnsrecord"), filter.Kinds)
+
+	assert.Nil(t, CleanupFilterFromContext(context.Background()))
+	ctx := WithCleanupFilter(context.Background(), filter)
+	assert.Equal(t, filter, CleanupFilterFromContext(ctx))
+}
+
+func TestCleanupFilterMatchKind(t *testing.T) {
+	var filter *CleanupFilter
+	assert.True(t, filter.MatchKind("subnetport"))
+	assert.True(t, filter.MatchKind(""))
+
+	filter = NewCleanupFilter([]string{"ns-1"}, nil, nil)
+	assert.True(t, filter.MatchKind("subnetport"))
+	assert.True(t, filter.MatchKind(""))
+
+	filter = NewCleanupFilter(nil, nil, []string{"subnetport"})
+	assert.True(t, filter.MatchKind("subnetport"))
+	assert.False(t, filter.MatchKind("securitypolicy"))
+	assert.False(t, filter.MatchKind(""))
+}
+
+func TestCleanupFilterMatchResource(t *testing.T) {
+	path := "/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1/ports/port-1"
+	nsTags := []model.Tag{{Scope: String(TagScopeNamespace), Tag: String("ns-1")}}
+	vmNSTags := []model.Tag{{Scope: String(TagScopeVMNamespace), Tag: String("ns-1")}}
+	otherTags := []model.Tag{{Scope: String(TagScopeCluster), Tag: String("ns-1")}, {Scope: nil, Tag: String("ns-1")}}
+
+	for _, tc := range []struct {
+		name     string
+		filter   *CleanupFilter
+		path     string
+		tags     []model.Tag
+		expScope bool
+		expMatch bool
+	}{
+		{
+			name:     "nil filter",
+			path:     path,
+			expMatch: true,
+		},
+		{
+			name:     "filter with kinds only",
+			filter:   NewCleanupFilter(nil, nil, []string{"subnetport"}),
+			expMatch: true,
+		},
+		{
+			name:     "matched Namespace",
+			filter:   NewCleanupFilter([]string{"ns-1"}, nil, nil),
+			path:     path,
+			tags:     nsTags,
+			expScope: true,
+			expMatch: true,
+		},
+		{
+			name:     "matched VM Namespace",
+			filter:   NewCleanupFilter([]string{"ns-1"}, nil, nil),
+			tags:     vmNSTags,
+			expScope: true,
+			expMatch: true,
+		},
+		{
+			name:     "unmatched Namespace",
+			filter:   NewCleanupFilter([]string{"ns-2"}, nil, nil),
+			path:     path,
+			tags:     nsTags,
+			expScope: true,
+		},
+		{
+			name:     "no Namespace tag",
+			filter:   NewCleanupFilter([]string{"ns-1"}, nil, nil),
+			path:     path,
+			tags:     otherTags,
+			expScope: true,
+		},
+		{
+			name:     "matched VPC",
+			filter:   NewCleanupFilter(nil, []string{"/orgs/default/projects/p1/vpcs/vpc-1"}, nil),
+			path:     path,
+			expScope: true,
+			expMatch: true,
+		},
+		{
+			name:     "matched VPC itself",
+			filter:   NewCleanupFilter(nil, []string{"/orgs/default/projects/p1/vpcs/vpc-1"}, nil),
+			path:     "/orgs/default/projects/p1/vpcs/vpc-1",
+			expScope: true,
+			expMatch: true,
+		},
+		{
+			name:     "VPC with the same prefix",
+			filter:   NewCleanupFilter(nil, []string{"/orgs/default/projects/p1/vpcs/vpc"}, nil),
+			path:     path,
+			expScope: true,
+		},
+		{
+			name:     "resource not in VPC",
+			filter:   NewCleanupFilter(nil, []string{"/orgs/default/projects/p1/vpcs/vpc-1"}, nil),
+			path:     "/infra/shares/share-1",
+			expScope: true,
+		},
+		{
+			name:     "matched VPC with unmatched Namespace",
+			filter:   NewCleanupFilter([]string{"ns-2"}, []string{"/orgs/default/projects/p1/vpcs/vpc-1"}, nil),
+			path:     path,
+			tags:     nsTags,
+			expScope: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expScope, tc.filter.IsScoped())
+			assert.Equal(t, tc.expMatch, tc.filter.MatchResource(tc.path, tc.tags))
+		})
+	}
+}
+
+func TestIsVPCPath(t *testing.T) {
+	assert.True(t, IsVPCPath("/orgs/default/projects/p1/vpcs/vpc-1"))
+	assert.False(t, IsVPCPath("/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1"))
+	assert.False(t, IsVPCPath("/orgs/default/projects/p1"))
+	assert.False(t, IsVPCPath(""))
+}
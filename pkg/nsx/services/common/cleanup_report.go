@@ -0,0 +1,148 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+const (
+	CleanupStatusPlanned = "Planned"
+	CleanupStatusDeleted = "Deleted"
+	CleanupStatusFailed  = "Failed"
+
+	// CleanupReportNonVPC is used to group the resources which are not in any VPC, e.g., the resources under /infra.
+	CleanupReportNonVPC = "non-vpc"
+)
+
+var vpcPathRegex = regexp.MustCompile(`^/orgs/[^/]+/projects/[^/]+/vpcs/[^/]+`)
+
+type cleanupReportKey struct{}
+
+// CleanupItem is an NSX resource deleted or to be deleted by the cleanup.
+type CleanupItem struct {
+	ID     string `json:"id,omitempty"`
+	Path   string `json:"path,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type CleanupSummary struct {
+	Planned int `json:"planned"`
+	Deleted int `json:"deleted"`
+	Failed  int `json:"failed"`
+}
+
+// CleanupResult is the structured cleanup report, the resources are grouped by resource type and VPC path.
+type CleanupResult struct {
+	DryRun    bool                                `json:"dryRun"`
+	Summary   CleanupSummary                      `json:"summary"`
+	Resources map[string]map[string][]CleanupItem `json:"resources"`
+}
+
+// CleanupReport collects the NSX resources handled by the cleanup. It is passed to the cleaners with the context,
+// and a cleaner should only list the resources without deleting them if the report is in dry-run mode.
+type CleanupReport struct {
+	dryRun bool
+	lock   sync.Mutex
+	// items is keyed by resource type, VPC path and then resource path or ID, so that a resource retried by the
+	// cleanup is only reported with its last status.
+	items map[string]map[string]map[string]CleanupItem
+}
+
+func NewCleanupReport(dryRun bool) *CleanupReport {
+	return &CleanupReport{
+		dryRun: dryRun,
+		items:  make(map[string]map[string]map[string]CleanupItem),
+	}
+}
+
+func WithCleanupReport(ctx context.Context, report *CleanupReport) context.Context {
+	return context.WithValue(ctx, cleanupReportKey{}, report)
+}
+
+// CleanupReportFromContext returns the CleanupReport in the context, nil is returned if it is not a cleanup context.
+func CleanupReportFromContext(ctx context.Context) *CleanupReport {
+	report, _ := ctx.Value(cleanupReportKey{}).(*CleanupReport)
+	return report
+}
+
+// IsDryRun returns true if the resources should only be listed but not deleted.
+func (r *CleanupReport) IsDryRun() bool {
+	return r != nil && r.dryRun
+}
+
+// Record adds an NSX resource into the report. In dry-run mode the resource is recorded as planned to be deleted,
+// otherwise it is recorded as deleted, or failed if err is not nil.
+func (r *CleanupReport) Record(resourceType string, id string, path string, err error) {
+	if r == nil {
+		return
+	}
+	item := CleanupItem{ID: id, Path: path, Status: CleanupStatusDeleted}
+	if r.dryRun {
+		item.Status = CleanupStatusPlanned
+	} else if err != nil {
+		item.Status = CleanupStatusFailed
+		item.Error = err.Error()
+	}
+	vpcPath := vpcPathRegex.FindString(path)
+	if vpcPath == "" {
+		vpcPath = CleanupReportNonVPC
+	}
+	key := path
+	if key == "" {
+		key = id
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.items[resourceType] == nil {
+		r.items[resourceType] = make(map[string]map[string]CleanupItem)
+	}
+	if r.items[resourceType][vpcPath] == nil {
+		r.items[resourceType][vpcPath] = make(map[string]CleanupItem)
+	}
+	r.items[resourceType][vpcPath][key] = item
+}
+
+// Result returns the structured report with the resources sorted by path and ID.
+func (r *CleanupReport) Result() *CleanupResult {
+	result := &CleanupResult{
+		DryRun:    r.IsDryRun(),
+		Resources: make(map[string]map[string][]CleanupItem),
+	}
+	if r == nil {
+		return result
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for resourceType, vpcItems := range r.items {
+		result.Resources[resourceType] = make(map[string][]CleanupItem)
+		for vpcPath, items := range vpcItems {
+			list := make([]CleanupItem, 0, len(items))
+			for _, item := range items {
+				switch item.Status {
+				case CleanupStatusPlanned:
+					result.Summary.Planned++
+				case CleanupStatusDeleted:
+					result.Summary.Deleted++
+				case CleanupStatusFailed:
+					result.Summary.Failed++
+				}
+				list = append(list, item)
+			}
+			sort.Slice(list, func(i, j int) bool {
+				if list[i].Path != list[j].Path {
+					return list[i].Path < list[j].Path
+				}
+				return list[i].ID < list[j].ID
+			})
+			result.Resources[resourceType][vpcPath] = list
+		}
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanupReportFromContext(t *testing.T) {
+	report := CleanupReportFromContext(context.Background())
+	assert.Nil(t, report)
+	assert.False(t, report.IsDryRun())
+	// Record on a nil report should be a no-op.
+	report.Record(ResourceTypeVpc, "vpc1", "/orgs/default/projects/p1/vpcs/vpc1", nil)
+	assert.Equal(t, CleanupSummary{}, report.Result().Summary)
+
+	report = NewCleanupReport(true)
+	ctx := WithCleanupReport(context.Background(), report)
+	assert.Equal(t, report, CleanupReportFromContext(ctx))
+	assert.True(t, CleanupReportFromContext(ctx).IsDryRun())
+}
+
+func TestCleanupReport(t *testing.T) {
+	report := NewCleanupReport(false)
+	report.Record(ResourceTypeVpc, "vpc1", "/orgs/default/projects/p1/vpcs/vpc1", nil)
+	report.Record(ResourceTypeSubnet, "subnet2", "/orgs/default/projects/p1/vpcs/vpc1/subnets/subnet2", nil)
+	report.Record(ResourceTypeSubnet, "subnet1", "/orgs/default/projects/p1/vpcs/vpc1/subnets/subnet1", fmt.Errorf("NSX returned an error"))
+	report.Record(ResourceTypeSubnet, "subnet3", "/orgs/default/projects/p1/vpcs/vpc2/subnets/subnet3", fmt.Errorf("NSX returned an error"))
+	// The last status of a retried resource is reported.
+	report.Record(ResourceTypeSubnet, "subnet3", "/orgs/default/projects/p1/vpcs/vpc2/subnets/subnet3", nil)
+	report.Record(ResourceTypeShare, "share1", "/infra/shares/share1", nil)
+	report.Record("ContainerClusterStatus", "cluster1", "", nil)
+
+	result := report.Result()
+	assert.False(t, result.DryRun)
+	assert.Equal(t, CleanupSummary{Deleted: 5, Failed: 1}, result.Summary)
+	assert.Equal(t, map[string]map[string][]CleanupItem{
+		ResourceTypeVpc: {
+			"/orgs/default/projects/p1/vpcs/vpc1": {
+				{ID: "vpc1", Path: "/orgs/default/projects/p1/vpcs/vpc1", Status: CleanupStatusDeleted},
+			},
+		},
+		ResourceTypeSubnet: {
+			"/orgs/default/projects/p1/vpcs/vpc1": {
+				{ID: "subnet1", Path: "/orgs/default/projects/p1/vpcs/vpc1/subnets/subnet1", Status: CleanupStatusFailed, Error: "NSX returned an error"},
+				{ID: "subnet2", Path: "/orgs/default/projects/p1/vpcs/vpc1/subnets/subnet2", Status: CleanupStatusDeleted},
+			},
+			"/orgs/default/projects/p1/vpcs/vpc2": {
+				{ID: "subnet3", Path: "/orgs/default/projects/p1/vpcs/vpc2/subnets/subnet3", Status: CleanupStatusDeleted},
+			},
+		},
+		ResourceTypeShare: {
+			CleanupReportNonVPC: {
+				{ID: "share1", Path: "/infra/shares/share1", Status: CleanupStatusDeleted},
+			},
+		},
+		"ContainerClusterStatus": {
+			CleanupReportNonVPC: {
+				{ID: "cluster1", Status: CleanupStatusDeleted},
+			},
+		},
+	}, result.Resources)
+}
+
+func TestCleanupReportDryRun(t *testing.T) {
+	report := NewCleanupReport(true)
+	// The error is ignored in dry-run mode since nothing is deleted.
+	report.Record(ResourceTypeVpc, "vpc1", "/orgs/default/projects/p1/vpcs/vpc1", fmt.Errorf("unexpected error"))
+	report.Record(ResourceTypeVpc, "vpc2", "/orgs/default/projects/p1/vpcs/vpc2", nil)
+
+	result := report.Result()
+	assert.True(t, result.DryRun)
+	assert.Equal(t, CleanupSummary{Planned: 2}, result.Summary)
+	for _, items := range result.Resources[ResourceTypeVpc] {
+		for _, item := range items {
+			assert.Equal(t, CleanupStatusPlanned, item.Status)
+			assert.Empty(t, item.Error)
+		}
+	}
+}
@@ -8,10 +8,12 @@ import (
 
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 const (
@@ -21,6 +23,7 @@ const (
 type LeafDataWrapper[T any] func(leafData T) (*data.StructValue, error)
 type GetPath[T any] func(obj T) *string
 type GetId[T any] func(obj T) *string
+type GetTags[T any] func(obj T) []model.Tag
 
 func getNSXResourcePath[T any](obj T) *string {
 	switch v := any(obj).(type) {
@@ -54,6 +57,8 @@ func getNSXResourcePath[T any](obj T) *string {
 		return v.Path
 	case *model.TlsCertificate:
 		return v.Path
+	case *model.PolicyContextProfile:
+		return v.Path
 	case *model.SharedResource:
 		return v.Path
 	case *model.Domain:
@@ -62,6 +67,10 @@ func getNSXResourcePath[T any](obj T) *string {
 		return v.Path
 	case *model.StaticIpAddressReservation:
 		return v.Path
+	case *model.VpcEndpoint:
+		return v.Path
+	case *model.VpcServiceEndpoint:
+		return v.Path
 	default:
 		log.Error(nil, "Get NSX resource path", "unknown NSX resource type", v)
 		return nil
@@ -100,6 +109,8 @@ func getNSXResourceId[T any](obj T) *string {
 		return v.Id
 	case *model.TlsCertificate:
 		return v.Id
+	case *model.PolicyContextProfile:
+		return v.Id
 	case *model.SharedResource:
 		return v.Id
 	case *model.Domain:
@@ -108,12 +119,68 @@ func getNSXResourceId[T any](obj T) *string {
 		return v.Id
 	case *model.StaticIpAddressReservation:
 		return v.Id
+	case *model.VpcEndpoint:
+		return v.Id
+	case *model.VpcServiceEndpoint:
+		return v.Id
 	default:
 		log.Error(nil, "Get NSX resource ID", "unknown NSX resource type", v)
 		return nil
 	}
 }
 
+func getNSXResourceTags[T any](obj T) []model.Tag {
+	switch v := any(obj).(type) {
+	case *model.DnsRecord:
+		return v.Tags
+	case *model.VpcIpAddressAllocation:
+		return v.Tags
+	case *model.VpcSubnet:
+		return v.Tags
+	case *model.VpcSubnetPort:
+		return v.Tags
+	case *model.SubnetConnectionBindingMap:
+		return v.Tags
+	case *model.Vpc:
+		return v.Tags
+	case *model.StaticRoutes:
+		return v.Tags
+	case *model.SecurityPolicy:
+		return v.Tags
+	case *model.Group:
+		return v.Tags
+	case *model.Rule:
+		return v.Tags
+	case *model.Share:
+		return v.Tags
+	case *model.LBService:
+		return v.Tags
+	case *model.LBVirtualServer:
+		return v.Tags
+	case *model.LBPool:
+		return v.Tags
+	case *model.TlsCertificate:
+		return v.Tags
+	case *model.PolicyContextProfile:
+		return v.Tags
+	case *model.SharedResource:
+		return v.Tags
+	case *model.Domain:
+		return v.Tags
+	case *model.DynamicIpAddressReservation:
+		return v.Tags
+	case *model.StaticIpAddressReservation:
+		return v.Tags
+	case *model.VpcEndpoint:
+		return v.Tags
+	case *model.VpcServiceEndpoint:
+		return v.Tags
+	default:
+		log.Error(nil, "Get NSX resource tags", "unknown NSX resource type", v)
+		return nil
+	}
+}
+
 func getNSXResourceName[T any](obj T) *string {
 	switch v := any(obj).(type) {
 	case *model.DnsRecord:
@@ -146,6 +213,8 @@ func getNSXResourceName[T any](obj T) *string {
 		return v.DisplayName
 	case *model.TlsCertificate:
 		return v.DisplayName
+	case *model.PolicyContextProfile:
+		return v.DisplayName
 	case *model.SharedResource:
 		return v.DisplayName
 	case *model.Domain:
@@ -154,6 +223,10 @@ func getNSXResourceName[T any](obj T) *string {
 		return v.DisplayName
 	case *model.StaticIpAddressReservation:
 		return v.DisplayName
+	case *model.VpcEndpoint:
+		return v.DisplayName
+	case *model.VpcServiceEndpoint:
+		return v.DisplayName
 	default:
 		log.Error(nil, "Get NSX resource name", "unknown NSX resource type", v)
 		return nil
@@ -184,6 +257,8 @@ func leafWrapper[T any](obj T) (*data.StructValue, error) {
 		return WrapRule(v)
 	case *model.Share:
 		return WrapShare(v)
+	case *model.PolicyContextProfile:
+		return WrapPolicyContextProfile(v)
 	case *model.LBService:
 		return WrapLBService(v)
 	case *model.LBVirtualServer:
@@ -198,6 +273,10 @@ func leafWrapper[T any](obj T) (*data.StructValue, error) {
 		return WrapDynamicIpAddressReservation(v)
 	case *model.StaticIpAddressReservation:
 		return WrapStaticIpAddressReservation(v)
+	case *model.VpcEndpoint:
+		return WrapVpcEndpoint(v)
+	case *model.VpcServiceEndpoint:
+		return WrapVpcServiceEndpoint(v)
 	default:
 		log.Error(nil, "Leaf wrapper", "unknown NSX resource type", v)
 		return nil, fmt.Errorf("unsupported NSX resource type %v", v)
@@ -282,12 +361,15 @@ var (
 	PolicyResourceDomain                                                                               = PolicyResourceType{ModelKey: ResourceTypeDomain, PathKey: "domains"}
 	PolicyResourceShare                                                                                = PolicyResourceType{ModelKey: ResourceTypeShare, PathKey: "shares"}
 	PolicyResourceSharedResource                                                                       = PolicyResourceType{ModelKey: ResourceTypeSharedResource, PathKey: "resources"}
+	PolicyResourceContextProfile                                                                       = PolicyResourceType{ModelKey: ResourceTypePolicyContextProfile, PathKey: "context-profiles"}
 	PolicyResourceGroup                                                                                = PolicyResourceType{ModelKey: ResourceTypeGroup, PathKey: "groups"}
 	PolicyResourceRule                                                                                 = PolicyResourceType{ModelKey: ResourceTypeRule, PathKey: "rules"}
 	PolicyResourceSecurityPolicy                                                                       = PolicyResourceType{ModelKey: ResourceTypeSecurityPolicy, PathKey: "security-policies"}
 	PolicyResourceTlsCertificate                                                                       = PolicyResourceType{ModelKey: ResourceTypeTlsCertificate, PathKey: "certificates"}
 	PolicyResourceVpcDynamicIPReservation                                                              = PolicyResourceType{ModelKey: ResourceTypeDynamicIpAddressReservation, PathKey: "dynamic-ip-reservations"}
 	PolicyResourceVpcStaticIPReservation                                                               = PolicyResourceType{ModelKey: ResourceTypeStaticIpAddressReservation, PathKey: "static-ip-reservations"}
+	PolicyResourceVpcEndpoint                                                                          = PolicyResourceType{ModelKey: ResourceTypeVpcEndpoint, PathKey: "vpc-endpoints"}
+	PolicyResourceVpcServiceEndpoint                                                                   = PolicyResourceType{ModelKey: ResourceTypeVpcServiceEndpoint, PathKey: "vpc-service-endpoints"}
 	PolicyPathVpcSubnet                         PolicyResourcePath[*model.VpcSubnet]                   = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceVpc, PolicyResourceVpcSubnet}
 	PolicyPathVpcSubnetConnectionBindingMap     PolicyResourcePath[*model.SubnetConnectionBindingMap]  = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceVpc, PolicyResourceVpcSubnet, PolicyResourceVpcSubnetConnectionBindingMap}
 	PolicyPathVpcSubnetPort                     PolicyResourcePath[*model.VpcSubnetPort]               = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceVpc, PolicyResourceVpcSubnet, PolicyResourceVpcSubnetPort}
@@ -303,6 +385,8 @@ var (
 	PolicyPathProjectShare                      PolicyResourcePath[*model.Share]                       = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceInfra, PolicyResourceShare}
 	PolicyPathInfraGroup                        PolicyResourcePath[*model.Group]                       = []PolicyResourceType{PolicyResourceInfra, PolicyResourceDomain, PolicyResourceGroup}
 	PolicyPathInfraShare                        PolicyResourcePath[*model.Share]                       = []PolicyResourceType{PolicyResourceInfra, PolicyResourceShare}
+	PolicyPathProjectContextProfile             PolicyResourcePath[*model.PolicyContextProfile]        = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceInfra, PolicyResourceContextProfile}
+	PolicyPathInfraContextProfile               PolicyResourcePath[*model.PolicyContextProfile]        = []PolicyResourceType{PolicyResourceInfra, PolicyResourceContextProfile}
 	PolicyPathInfraSharedResource               PolicyResourcePath[*model.SharedResource]              = []PolicyResourceType{PolicyResourceInfra, PolicyResourceShare, PolicyResourceSharedResource}
 	PolicyPathInfraCert                         PolicyResourcePath[*model.TlsCertificate]              = []PolicyResourceType{PolicyResourceInfra, PolicyResourceTlsCertificate}
 	PolicyPathInfraLBVirtualServer              PolicyResourcePath[*model.LBVirtualServer]             = []PolicyResourceType{PolicyResourceInfra, PolicyResourceInfraLBVirtualServer}
@@ -311,6 +395,8 @@ var (
 	PolicyPathInfraDomain                       PolicyResourcePath[*model.Domain]                      = []PolicyResourceType{PolicyResourceInfra, PolicyResourceDomain}
 	PolicyPathVpcSubnetDynamicIPReservation     PolicyResourcePath[*model.DynamicIpAddressReservation] = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceVpc, PolicyResourceVpcSubnet, PolicyResourceVpcDynamicIPReservation}
 	PolicyPathVpcSubnetStaticIPReservation      PolicyResourcePath[*model.StaticIpAddressReservation]  = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceVpc, PolicyResourceVpcSubnet, PolicyResourceVpcStaticIPReservation}
+	PolicyPathVpcEndpoint                       PolicyResourcePath[*model.VpcEndpoint]                 = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceVpc, PolicyResourceVpcEndpoint}
+	PolicyPathVpcServiceEndpoint                PolicyResourcePath[*model.VpcServiceEndpoint]          = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceVpc, PolicyResourceVpcServiceEndpoint}
 	// PolicyPathDnsRecord is the OrgRoot hierarchy for *model.DnsRecord under a project.
 	// Path pattern: /orgs/{org}/projects/{project}/dns-records/{record-id}.
 	PolicyPathDnsRecord PolicyResourcePath[*model.DnsRecord] = []PolicyResourceType{PolicyResourceOrg, PolicyResourceProject, PolicyResourceDnsRecord}
@@ -383,6 +469,7 @@ type PolicyTreeBuilder[T any] struct {
 	leafWrapper LeafDataWrapper[T]
 	pathGetter  GetPath[T]
 	idGetter    GetId[T]
+	tagsGetter  GetTags[T]
 
 	pathFormat    []string
 	modelFormat   []string
@@ -522,10 +609,17 @@ func (b *PolicyTreeBuilder[T]) parsePathSegments(inputPath string) ([]string, er
 	return pathSegments, nil
 }
 
-func (b *PolicyTreeBuilder[T]) UpdateMultipleResourcesOnNSX(objects []T, nsxClient *nsx.Client) error {
+// UpdateMultipleResourcesOnNSX sends the H-API request of objects, traced in a child span of ctx.
+func (b *PolicyTreeBuilder[T]) UpdateMultipleResourcesOnNSX(ctx context.Context, objects []T, nsxClient *nsx.Client) (err error) {
 	if len(objects) == 0 {
 		return nil
 	}
+	ctx, span := tracing.Start(ctx, "PolicyTreeBuilder.UpdateMultipleResourcesOnNSX",
+		attribute.String("nsx.root_type", b.rootType),
+		attribute.String("nsx.resource_type", b.leafType),
+		attribute.Int("nsx.batch_size", len(objects)),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
 
 	enforceRevisionCheckParam := false
 	if b.rootType == ResourceTypeOrgRoot {
@@ -534,7 +628,7 @@ func (b *PolicyTreeBuilder[T]) UpdateMultipleResourcesOnNSX(objects []T, nsxClie
 			log.Error(err, "Failed to generate OrgRoot with multiple resources", "resourceType", b.leafType)
 			return err
 		}
-		if err = nsxClient.OrgRootClient.Patch(*orgRoot, &enforceRevisionCheckParam); err != nil {
+		if err = nsxClient.WithContext(ctx).OrgRootClient.Patch(*orgRoot, &enforceRevisionCheckParam); err != nil {
 			err = util.TransNSXApiError(err)
 			// Log failure for each resource
 			for _, obj := range objects {
@@ -576,7 +670,7 @@ func (b *PolicyTreeBuilder[T]) UpdateMultipleResourcesOnNSX(objects []T, nsxClie
 		log.Error(err, "Failed to generate Infra with multiple resources", "resourceType", b.leafType)
 		return err
 	}
-	if err = nsxClient.InfraClient.Patch(*infraRoot, &enforceRevisionCheckParam); err != nil {
+	if err = nsxClient.WithContext(ctx).InfraClient.Patch(*infraRoot, &enforceRevisionCheckParam); err != nil {
 		err = util.TransNSXApiError(err)
 		// Log failure for each resource
 		for _, obj := range objects {
@@ -647,6 +741,7 @@ func (p *PolicyResourcePath[T]) NewPolicyTreeBuilder() (*PolicyTreeBuilder[T], e
 		leafWrapper: leafWrapper[T],
 		pathGetter:  getNSXResourcePath[T],
 		idGetter:    getNSXResourceId[T],
+		tagsGetter:  getNSXResourceTags[T],
 	}, nil
 }
 
@@ -655,13 +750,34 @@ func (builder *PolicyTreeBuilder[T]) PagingUpdateResources(ctx context.Context,
 		return nil
 	}
 
+	if filter := CleanupFilterFromContext(ctx); filter.IsScoped() {
+		objs = builder.filterCleanup(filter, objs)
+		if len(objs) == 0 {
+			log.Info("No resources match the cleanup filter", "resourceType", builder.leafType)
+			return nil
+		}
+	}
+
 	totalCount := len(objs)
+	ctx, span := tracing.Start(ctx, "PolicyTreeBuilder.PagingUpdateResources",
+		attribute.String("nsx.resource_type", builder.leafType),
+		attribute.Int("nsx.total_resources", totalCount),
+		attribute.Int("nsx.page_size", pageSize),
+	)
+	var nsxErr error
+	defer func() { tracing.EndSpan(span, nsxErr) }()
+	report := CleanupReportFromContext(ctx)
+	if report.IsDryRun() {
+		log.Info("Skipping batch deletion in dry-run mode", "resourceType", builder.leafType, "totalResources", totalCount)
+		builder.recordCleanup(report, objs, nil)
+		return nil
+	}
+
 	pagedObjs := PagingNSXResources(objs, pageSize)
 	totalBatches := len(pagedObjs)
 
 	log.Info("Starting batch deletion", "resourceType", builder.leafType, "totalResources", totalCount, "totalBatches", totalBatches, "batchSize", pageSize)
 
-	var nsxErr error
 	successCount := 0
 	failedCount := 0
 
@@ -672,9 +788,11 @@ func (builder *PolicyTreeBuilder[T]) PagingUpdateResources(ctx context.Context,
 		select {
 		case <-ctx.Done():
 			log.Info("Batch deletion interrupted by context", "resourceType", builder.leafType, "processedBatches", currentBatch-1, "totalBatches", totalBatches, "successCount", successCount, "failedCount", failedCount)
-			return errors.Join(util.TimeoutFailed, ctx.Err())
+			nsxErr = errors.Join(util.TimeoutFailed, ctx.Err())
+			return nsxErr
 		default:
-			updateErr := builder.UpdateMultipleResourcesOnNSX(partialObjs, nsxClient)
+			updateErr := builder.UpdateMultipleResourcesOnNSX(ctx, partialObjs, nsxClient)
+			builder.recordCleanup(report, partialObjs, updateErr)
 			if updateErr == nil {
 				successCount += len(partialObjs)
 				log.Info("Batch update succeeded", "resourceType", builder.leafType, "batch", fmt.Sprintf("%d/%d", currentBatch, totalBatches), "batchResourceCount", len(partialObjs), "cumulativeSuccess", successCount)
@@ -692,3 +810,35 @@ func (builder *PolicyTreeBuilder[T]) PagingUpdateResources(ctx context.Context,
 	log.Info("Batch deletion completed", "resourceType", builder.leafType, "totalResources", totalCount, "successCount", successCount, "failedCount", failedCount)
 	return nsxErr
 }
+
+// filterCleanup returns the resources matching the cleanup filter.
+func (builder *PolicyTreeBuilder[T]) filterCleanup(filter *CleanupFilter, objs []T) []T {
+	matched := make([]T, 0, len(objs))
+	for _, obj := range objs {
+		var path string
+		if resPath := builder.pathGetter(obj); resPath != nil {
+			path = *resPath
+		}
+		if filter.MatchResource(path, builder.tagsGetter(obj)) {
+			matched = append(matched, obj)
+		}
+	}
+	return matched
+}
+
+// recordCleanup adds the resources deleted by the cleanup into the report, it is a no-op if report is nil.
+func (builder *PolicyTreeBuilder[T]) recordCleanup(report *CleanupReport, objs []T, err error) {
+	if report == nil {
+		return
+	}
+	for _, obj := range objs {
+		var id, path string
+		if resID := builder.idGetter(obj); resID != nil {
+			id = *resID
+		}
+		if resPath := builder.pathGetter(obj); resPath != nil {
+			path = *resPath
+		}
+		report.Record(builder.leafType, id, path, err)
+	}
+}
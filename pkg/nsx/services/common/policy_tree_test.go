@@ -69,6 +69,21 @@ func TestPagingDeleteResources(t *testing.T) {
 	t.Run("testPagingDeleteResourcesWithNSXFailure", func(t *testing.T) {
 		testPagingDeleteResourcesWithNSXFailure(t, targetSubnets)
 	})
+
+	// Verify the case that no NSX API is called in dry-run mode.
+	t.Run("testPagingDeleteResourcesWithDryRun", func(t *testing.T) {
+		testPagingDeleteResourcesWithDryRun(t, targetSubnets)
+	})
+
+	// Verify only the resources matching the cleanup filter are deleted.
+	t.Run("testPagingDeleteResourcesWithFilter", func(t *testing.T) {
+		testPagingDeleteResourcesWithFilter(t, targetSubnets)
+	})
+
+	// Verify the resources are reported with the deletion result.
+	t.Run("testPagingDeleteResourcesWithReport", func(t *testing.T) {
+		testPagingDeleteResourcesWithReport(t, targetSubnets)
+	})
 }
 
 func testPagingDeleteResourcesSucceeded(t *testing.T, targetSubnets []*model.VpcSubnet) {
@@ -163,6 +178,94 @@ func testPagingDeleteResourcesWithNSXFailure(t *testing.T, targetSubnets []*mode
 	assert.EqualError(t, err, "NSX returned an error")
 }
 
+func testPagingDeleteResourcesWithDryRun(t *testing.T, targetSubnets []*model.VpcSubnet) {
+	report := NewCleanupReport(true)
+	ctx := WithCleanupReport(context.Background(), report)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// No Patch is expected on the mock client.
+	nsxClient := &nsx.Client{
+		OrgRootClient: orgroot_mocks.NewMockOrgRootClient(ctrl),
+	}
+
+	builder, err := PolicyPathVpcSubnet.NewPolicyTreeBuilder()
+	require.NoError(t, err)
+	updated := false
+	err = builder.PagingUpdateResources(ctx, targetSubnets, 500, nsxClient, func(_ []*model.VpcSubnet) {
+		updated = true
+	})
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	result := report.Result()
+	assert.True(t, result.DryRun)
+	assert.Equal(t, CleanupSummary{Planned: len(targetSubnets)}, result.Summary)
+	items := result.Resources[ResourceTypeSubnet]["/orgs/default/projects/p1/vpcs/vpc1"]
+	require.Equal(t, len(targetSubnets), len(items))
+	assert.Equal(t, CleanupItem{ID: "id-0", Path: "/orgs/default/projects/p1/vpcs/vpc1/subnets/id-0", Status: CleanupStatusPlanned}, items[0])
+}
+
+func testPagingDeleteResourcesWithFilter(t *testing.T, targetSubnets []*model.VpcSubnet) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// No Patch is expected if no resource matches the filter.
+	nsxClient := &nsx.Client{
+		OrgRootClient: orgroot_mocks.NewMockOrgRootClient(ctrl),
+	}
+	builder, err := PolicyPathVpcSubnet.NewPolicyTreeBuilder()
+	require.NoError(t, err)
+	ctx := WithCleanupFilter(context.Background(), NewCleanupFilter(nil, []string{"/orgs/default/projects/p1/vpcs/vpc2"}, nil))
+	err = builder.PagingUpdateResources(ctx, targetSubnets, 500, nsxClient, func(_ []*model.VpcSubnet) {
+		assert.Fail(t, "no resource should be deleted")
+	})
+	require.NoError(t, err)
+
+	matched := &model.VpcSubnet{
+		Id:   String("matched"),
+		Path: String("/orgs/default/projects/p1/vpcs/vpc1/subnets/matched"),
+		Tags: []model.Tag{{Scope: String(TagScopeNamespace), Tag: String("ns1")}},
+	}
+	mockRootClient := orgroot_mocks.NewMockOrgRootClient(ctrl)
+	mockRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil)
+	nsxClient.OrgRootClient = mockRootClient
+	ctx = WithCleanupFilter(context.Background(), NewCleanupFilter([]string{"ns1"}, nil, nil))
+	var deleted []*model.VpcSubnet
+	err = builder.PagingUpdateResources(ctx, append([]*model.VpcSubnet{matched}, targetSubnets...), 500, nsxClient, func(objs []*model.VpcSubnet) {
+		deleted = append(deleted, objs...)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*model.VpcSubnet{matched}, deleted)
+}
+
+func testPagingDeleteResourcesWithReport(t *testing.T, targetSubnets []*model.VpcSubnet) {
+	report := NewCleanupReport(false)
+	ctx := WithCleanupReport(context.Background(), report)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRootClient := orgroot_mocks.NewMockOrgRootClient(ctrl)
+	gomock.InOrder(
+		mockRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil),
+		mockRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(fmt.Errorf("NSX returned an error")),
+	)
+	nsxClient := &nsx.Client{
+		OrgRootClient: mockRootClient,
+	}
+
+	builder, err := PolicyPathVpcSubnet.NewPolicyTreeBuilder()
+	require.NoError(t, err)
+	err = builder.PagingUpdateResources(ctx, targetSubnets, 5, nsxClient, nil)
+	assert.EqualError(t, err, "NSX returned an error")
+
+	result := report.Result()
+	assert.False(t, result.DryRun)
+	assert.Equal(t, CleanupSummary{Deleted: 5, Failed: 5}, result.Summary)
+}
+
 func testVPCResources(t *testing.T) {
 	cases := []struct {
 		name    string
@@ -525,7 +628,7 @@ func testProjectInfraResources(t *testing.T) {
 func testPolicyPathBuilderDeletion[T any](t *testing.T, resourcePath PolicyResourcePath[T], objects []T, nsxClient *nsx.Client) error {
 	builder, err := resourcePath.NewPolicyTreeBuilder()
 	require.Nil(t, err)
-	return builder.UpdateMultipleResourcesOnNSX(objects, nsxClient)
+	return builder.UpdateMultipleResourcesOnNSX(context.TODO(), objects, nsxClient)
 }
 
 func TestBuildRootNodePerformance(t *testing.T) {
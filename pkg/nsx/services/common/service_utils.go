@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"net"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	corev1 "k8s.io/api/core/v1"
@@ -29,3 +30,24 @@ func GetNamespaceUIDFromTag(tags []model.Tag) string {
 	}
 	return ""
 }
+
+// CountIPv4Addresses returns the number of IPv4 addresses of the NSX Subnets. The size of a Subnet
+// is taken from ipv4_subnet_size, or from its IPv4 CIDRs if the size is not set.
+func CountIPv4Addresses(nsxSubnets []*model.VpcSubnet) int {
+	count := 0
+	for _, nsxSubnet := range nsxSubnets {
+		if nsxSubnet.Ipv4SubnetSize != nil {
+			count += int(*nsxSubnet.Ipv4SubnetSize)
+			continue
+		}
+		for _, cidr := range nsxSubnet.IpAddresses {
+			_, ipNet, err := net.ParseCIDR(cidr)
+			if err != nil || ipNet.IP.To4() == nil {
+				continue
+			}
+			ones, bits := ipNet.Mask.Size()
+			count += 1 << (bits - ones)
+		}
+	}
+	return count
+}
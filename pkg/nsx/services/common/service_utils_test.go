@@ -0,0 +1,21 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestCountIPv4Addresses(t *testing.T) {
+	size := int64(32)
+	assert.Equal(t, 0, CountIPv4Addresses(nil))
+	assert.Equal(t, 32+16+256, CountIPv4Addresses([]*model.VpcSubnet{
+		{Ipv4SubnetSize: &size, IpAddresses: []string{"10.0.0.0/28"}},
+		{IpAddresses: []string{"10.0.1.0/28", "fd00::/64"}},
+		{IpAddresses: []string{"10.0.2.0/24", "invalid"}},
+	}))
+}
@@ -30,7 +30,7 @@ type SubnetServiceProvider interface {
 	GetSubnetByKey(key string) (*model.VpcSubnet, error)
 	GetSubnetByPath(path string, sharedSubnet bool) (*model.VpcSubnet, error)
 	GetSubnetsByIndex(key, value string) []*model.VpcSubnet
-	CreateOrUpdateSubnet(obj client.Object, vpcInfo VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error)
+	CreateOrUpdateSubnet(ctx context.Context, obj client.Object, vpcInfo VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error)
 	GenerateSubnetNSTags(obj client.Object) []model.Tag
 	ListSubnetByName(ns, name string) []*model.VpcSubnet
 	ListSubnetBySubnetSetName(ns, subnetSetName string) []*model.VpcSubnet
@@ -63,8 +63,12 @@ type IPBlocksInfoServiceProvider interface {
 type IPAddressAllocationServiceProvider interface {
 	GetIPAddressAllocationByOwner(owner metav1.Object) (*model.VpcIpAddressAllocation, error)
 	CreateIPAddressAllocationForAddressBinding(addressBinding *v1alpha1.AddressBinding, subnetPort *v1alpha1.SubnetPort, restoreMode bool) error
-	DeleteIPAddressAllocationForAddressBinding(obj metav1.Object) error
+	DeleteIPAddressAllocationForAddressBinding(ctx context.Context, obj metav1.Object) error
 	BuildIPAddressAllocationID(obj metav1.Object) string
-	DeleteIPAddressAllocationByNSXResource(nsxIPAddressAllocation *model.VpcIpAddressAllocation) error
+	DeleteIPAddressAllocationByNSXResource(ctx context.Context, nsxIPAddressAllocation *model.VpcIpAddressAllocation) error
 	ListIPAddressAllocationWithAddressBinding() []*model.VpcIpAddressAllocation
 }
+
+type SubnetPortSettingServiceProvider interface {
+	GetPortConfigPath(namespace, name string) (string, error)
+}
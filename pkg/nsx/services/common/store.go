@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
@@ -183,6 +184,9 @@ func (service *Service) SearchResource(resourceTypeValue string, queryParam stri
 // PopulateResourcetoStore is the method used by populating resources created not by nsx-operator
 func (service *Service) PopulateResourcetoStore(wg *sync.WaitGroup, fatalErrors chan error, resourceTypeValue string, queryParam string, store Store, filter Filter) {
 	defer wg.Done()
+	if keyLister, ok := store.(metrics.KeyLister); ok {
+		metrics.RegisterResourceStore(resourceTypeValue, keyLister)
+	}
 	count, err := service.SearchResource("", queryParam, store, filter)
 	if err != nil {
 		fatalErrors <- err
@@ -26,6 +26,7 @@ const (
 	VPCLbResourcePathMinSegments       int    = 8
 	PriorityNetworkPolicyAllowRule     int    = 2010
 	PriorityNetworkPolicyIsolationRule int    = 2090
+	PriorityBaselineAdminNetworkPolicy int    = 3000
 	TagScopeNCPCluster                 string = "ncp/cluster"
 	TagScopeNCPProjectUID              string = "ncp/project_uid"
 	TagScopeNCPCreateFor               string = "ncp/created_for"
@@ -43,6 +44,8 @@ const (
 	TagScopeSecurityPolicyUID          string = "nsx-op/security_policy_uid"
 	TagScopeNetworkPolicyName          string = "nsx-op/network_policy_name"
 	TagScopeNetworkPolicyUID           string = "nsx-op/network_policy_uid"
+	TagScopeAdminNetworkPolicyName     string = "nsx-op/admin_network_policy_name"
+	TagScopeAdminNetworkPolicyUID      string = "nsx-op/admin_network_policy_uid"
 	TagScopeStaticRouteCRName          string = "nsx-op/static_route_name"
 	TagScopeStaticRouteCRUID           string = "nsx-op/static_route_uid"
 	TagScopeRuleID                     string = "nsx-op/rule_id"
@@ -56,6 +59,7 @@ const (
 	TagScopeSubnetPortCRUID            string = "nsx-op/subnetport_uid"
 	TagScopeIPAddressAllocationCRName  string = "nsx-op/ipaddressallocation_name"
 	TagScopeIPAddressAllocationCRUID   string = "nsx-op/ipaddressallocation_uid"
+	TagScopeIPAddressAllocationPrimary string = "nsx-op/ipaddressallocation_primary"
 	TagScopeAddressBindingCRName       string = "nsx-op/addressbinding_name"
 	TagScopeAddressBindingCRUID        string = "nsx-op/addressbinding_uid"
 	TagScopeVMNamespaceUID             string = "nsx-op/vm_namespace_uid"
@@ -84,6 +88,12 @@ const (
 	TagScopeSubnetBindingCRUID         string = "nsx-op/subnetbinding_uid"
 	TagScopeSubnetIPReservationCRUID   string = "nsx-op/subnetipreservation_uid"
 	TagScopeSubnetIPReservationCRName  string = "nsx-op/subnetipreservation_name"
+	TagScopeVPCEndpointCRUID           string = "nsx-op/vpcendpoint_uid"
+	TagScopeVPCEndpointCRName          string = "nsx-op/vpcendpoint_name"
+	TagScopeServiceEndpointCRUID       string = "nsx-op/serviceendpoint_uid"
+	TagScopeServiceEndpointCRName      string = "nsx-op/serviceendpoint_name"
+	TagScopeSubnetPortSettingCRUID     string = "nsx-op/subnetportsetting_uid"
+	TagScopeSubnetPortSettingCRName    string = "nsx-op/subnetportsetting_name"
 	TagValueGroupScope                 string = "scope"
 	TagValueGroupSource                string = "source"
 	TagValueGroupDestination           string = "destination"
@@ -101,6 +111,7 @@ const (
 	AnnotationReconfigureNic           string = "nsx/reconfigure-nic"
 	AnnotationPodMAC                   string = "nsx.vmware.com/mac"
 	AnnotationAttachment               string = "nsx.vmware.com/attachment"
+	AnnotationPortSetting              string = "nsx.vmware.com/port-setting"
 	LabelCPVM                          string = "iaas.vmware.com/is-cpvm-subnetport"
 	TagScopePodName                    string = "nsx-op/pod_name"
 	TagScopePodUID                     string = "nsx-op/pod_uid"
@@ -108,7 +119,7 @@ const (
 	TagScopeStatefulSetUID             string = "nsx-op/sts_uid"
 
 	// Tags and annotations for DNS record use case.
-	TagScopeDNSRecordFor                string = "nsx-op/dns_for" // value: gateway, service, xxroutes
+	TagScopeDNSRecordFor                string = "nsx-op/dns_for" // value: gateway, service, ingress, virtualmachine, xxroutes
 	TagScopeDNSRecordGatewayIndexList   string = "nsx-op/dns_gateway_index_list"
 	TagScopeDNSRecordOwnerNamespace     string = "nsx-op/dns_owner_namespace"
 	TagScopeDNSRecordOwnerName          string = "nsx-op/dns_owner_name"
@@ -118,9 +129,14 @@ const (
 	TagValueDNSRecordForGRPCRoute       string = "grpcroute"
 	TagValueDNSRecordForTLSRoute        string = "tlsroute"
 	TagValueDNSRecordForService         string = "service"
+	TagValueDNSRecordForIngress         string = "ingress"
+	TagValueDNSRecordForVirtualMachine  string = "virtualmachine"
 	AnnotationDNSHostnameKey            string = "nsx.vmware.com/hostname"
 	AnnotationDNSHostnameSourceKey      string = "nsx.vmware.com/gateway-hostname-source"
 	AnnotationsDNSSkip                  string = "nsx.vmware.com/skip"
+	AnnotationDNSTTLKey                 string = "nsx.vmware.com/dns-ttl"
+	AnnotationDNSPTRKey                 string = "nsx.vmware.com/dns-ptr"
+	AnnotationDNSTXTOwnerKey            string = "nsx.vmware.com/dns-txt-owner"
 
 	// TagScopePodIndex is the NSX tag scope for Pod label apps.kubernetes.io/pod-index when synced onto the port (not set in BuildBasicTags).
 	TagScopePodIndex   string = "apps.kubernetes.io/pod-index"
@@ -162,6 +178,7 @@ const (
 	RuleActionAllow        = "allow"
 	RuleActionDrop         = "isolation"
 	RuleActionReject       = "reject"
+	RuleActionPass         = "pass"
 	RuleAnyPorts           = "all"
 	DefaultProject         = "default"
 	DefaultVpcAttachmentId = "default"
@@ -172,6 +189,8 @@ const (
 	DstGroupSuffix         = "dst"
 	IpSetGroupSuffix       = "ipset"
 	ShareSuffix            = "share"
+	FQDNProfileSuffix      = "fqdn"
+	DNSRuleSuffix          = "dns"
 
 	GatewayInterfaceId = "gateway-interface"
 	VPCKey             = "/orgs/%s/projects/%s/vpcs/%s"
@@ -179,6 +198,9 @@ const (
 	// PathSegmentDnsRecords is the NSX Policy URL path segment for project-scoped DNS records
 	// (full path: /orgs/{org}/projects/{project}/dns-records/{id}). Must match PolicyResourceDnsRecord.PathKey.
 	PathSegmentDnsRecords = "dns-records"
+
+	// IPBlocksInfoCRName is the name of the IPBlocksInfo CR with the CIDRs of the external and private TGW IP blocks.
+	IPBlocksInfoCRName = "ip-blocks-info"
 )
 
 var (
@@ -193,6 +215,8 @@ var (
 	ResourceTypeDomain                           = "Domain"
 	ResourceTypeSecurityPolicy                   = "SecurityPolicy"
 	ResourceTypeNetworkPolicy                    = "NetworkPolicy"
+	ResourceTypeAdminNetworkPolicy               = "AdminNetworkPolicy"
+	ResourceTypeBaselineAdminNetworkPolicy       = "BaselineAdminNetworkPolicy"
 	ResourceTypeGroup                            = "Group"
 	ResourceTypeRule                             = "Rule"
 	ResourceTypeIPBlock                          = "IpAddressBlock"
@@ -207,12 +231,14 @@ var (
 	ResourceTypeVpcAttachment                    = "VpcAttachment"
 	ResourceTypeShare                            = "Share"
 	ResourceTypeSharedResource                   = "SharedResource"
+	ResourceTypePolicyContextProfile             = "PolicyContextProfile"
 	ResourceTypeStaticRoutes                     = "StaticRoutes"
 	ResourceTypeChildLBPool                      = "ChildLBPool"
 	ResourceTypeChildLBService                   = "ChildLBService"
 	ResourceTypeChildLBVirtualServer             = "ChildLBVirtualServer"
 	ResourceTypeChildSharedResource              = "ChildSharedResource"
 	ResourceTypeChildShare                       = "ChildShare"
+	ResourceTypeChildPolicyContextProfile        = "ChildPolicyContextProfile"
 	ResourceTypeChildRule                        = "ChildRule"
 	ResourceTypeChildGroup                       = "ChildGroup"
 	ResourceTypeChildSecurityPolicy              = "ChildSecurityPolicy"
@@ -225,6 +251,8 @@ var (
 	ResourceTypeChildVpcSubnetPort               = "ChildVpcSubnetPort"
 	ResourceTypeChildDynamicIpAddressReservation = "ChildDynamicIpAddressReservation"
 	ResourceTypeChildStaticIpAddressReservation  = "ChildStaticIpAddressReservation"
+	ResourceTypeChildVpcEndpoint                 = "ChildVpcEndpoint"
+	ResourceTypeChildVpcServiceEndpoint          = "ChildVpcServiceEndpoint"
 	ResourceTypeChildResourceReference           = "ChildResourceReference"
 	ResourceTypeTlsCertificate                   = "TlsCertificate"
 	ResourceTypeLBHttpProfile                    = "LBHttpProfile"
@@ -239,6 +267,9 @@ var (
 	ResourceTypeSubnetConnectionBindingMap       = "SubnetConnectionBindingMap"
 	ResourceTypeDynamicIpAddressReservation      = "DynamicIpAddressReservation"
 	ResourceTypeStaticIpAddressReservation       = "StaticIpAddressReservation"
+	ResourceTypeVpcEndpoint                      = "VpcEndpoint"
+	ResourceTypeVpcServiceEndpoint               = "VpcServiceEndpoint"
+	ResourceTypeVpcSubnetPortConfig              = "VpcSubnetPortConfig"
 	ResourceTypeDnsRecord                        = "DnsRecord"
 
 	// ResourceTypeClusterControlPlane is used by NSXServiceAccountController
@@ -241,6 +241,21 @@ func WrapShare(share *model.Share) (*data.StructValue, error) {
 	return dataValue.(*data.StructValue), nil
 }
 
+func WrapPolicyContextProfile(profile *model.PolicyContextProfile) (*data.StructValue, error) {
+	profile.ResourceType = &ResourceTypePolicyContextProfile
+	childProfile := model.ChildPolicyContextProfile{
+		ResourceType:         ResourceTypeChildPolicyContextProfile,
+		Id:                   profile.Id,
+		MarkedForDelete:      profile.MarkedForDelete,
+		PolicyContextProfile: profile,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childProfile, childProfile.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
 func WrapLBService(lbService *model.LBService) (*data.StructValue, error) {
 	lbService.ResourceType = &ResourceTypeLBService
 	childLBService := model.ChildLBService{
@@ -361,6 +376,36 @@ func WrapStaticIpAddressReservation(ipr *model.StaticIpAddressReservation) (*dat
 	return dataValue.(*data.StructValue), nil
 }
 
+func WrapVpcEndpoint(ep *model.VpcEndpoint) (*data.StructValue, error) {
+	ep.ResourceType = &ResourceTypeVpcEndpoint
+	childVpcEndpoint := model.ChildVpcEndpoint{
+		Id:              ep.Id,
+		MarkedForDelete: ep.MarkedForDelete,
+		ResourceType:    ResourceTypeChildVpcEndpoint,
+		VpcEndpoint:     ep,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childVpcEndpoint, childVpcEndpoint.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func WrapVpcServiceEndpoint(sep *model.VpcServiceEndpoint) (*data.StructValue, error) {
+	sep.ResourceType = &ResourceTypeVpcServiceEndpoint
+	childVpcServiceEndpoint := model.ChildVpcServiceEndpoint{
+		Id:                 sep.Id,
+		MarkedForDelete:    sep.MarkedForDelete,
+		ResourceType:       ResourceTypeChildVpcServiceEndpoint,
+		VpcServiceEndpoint: sep,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childVpcServiceEndpoint, childVpcServiceEndpoint.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
 func buildInfraFromChildren(children []*data.StructValue) *model.Infra {
 	// This is the outermost layer of the hierarchy infra client.
 	// It doesn't need ID field.
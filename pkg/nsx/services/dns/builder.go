@@ -14,6 +14,9 @@ import (
 
 const (
 	DefaultRecordTtL = 300
+	// MinRecordTtL and MaxRecordTtL are the TTL range accepted by NSX DnsRecord.
+	MinRecordTtL = 30
+	MaxRecordTtL = 86400
 )
 
 // BuildDnsRecord builds one *model.DnsRecord for row using batchOwner or row.effectiveOwner.
@@ -79,6 +82,8 @@ func (r *EndpointRow) buildDNSRecord(basicTags []model.Tag) *model.DnsRecord {
 	return rec
 }
 
+// getNSXDnsRecordType maps an ExternalDNS record type to the NSX DnsRecord type, or "" if NSX does not support it
+// (e.g. SRV or MX).
 func getNSXDnsRecordType(recType string) string {
 	switch recType {
 	case extdns.RecordTypeAAAA:
@@ -89,11 +94,12 @@ func getNSXDnsRecordType(recType string) string {
 		return model.DnsRecord_RECORD_TYPE_NS
 	case extdns.RecordTypePTR:
 		return model.DnsRecord_RECORD_TYPE_PTR
+	case extdns.RecordTypeTXT:
+		return model.DnsRecord_RECORD_TYPE_TXT
 	case extdns.RecordTypeA:
 		return model.DnsRecord_RECORD_TYPE_A
 	default:
-		log.Info("Unknown record type is detected, using type A instead", "type", recType)
-		return model.DnsRecord_RECORD_TYPE_A
+		return ""
 	}
 }
 
@@ -124,7 +124,7 @@ func (s *DNSRecordService) syncDnsRecordsInNSX(ctx context.Context, toUpsert, to
 			realizeErrs = append(realizeErrs, perr)
 			continue
 		}
-		live, gerr := s.NSXClient.DnsRecordsClient.Get(orgID, projectID, recordID)
+		live, gerr := s.NSXClient.WithContext(ctx).DnsRecordsClient.Get(orgID, projectID, recordID)
 		gerr = nsxutil.TransNSXApiError(gerr)
 		if gerr != nil {
 			log.Error(gerr, "Failed to get realized DnsRecord from NSX", "Id", recordID)
@@ -783,8 +783,10 @@ func TestGetNSXDnsRecordType_table(t *testing.T) {
 		{extdns.RecordTypeCNAME, model.DnsRecord_RECORD_TYPE_CNAME},
 		{extdns.RecordTypeNS, model.DnsRecord_RECORD_TYPE_NS},
 		{extdns.RecordTypePTR, model.DnsRecord_RECORD_TYPE_PTR},
-		{"UNKNOWN", model.DnsRecord_RECORD_TYPE_A},
-		{"", model.DnsRecord_RECORD_TYPE_A},
+		{extdns.RecordTypeTXT, model.DnsRecord_RECORD_TYPE_TXT},
+		{extdns.RecordTypeSRV, ""},
+		{"UNKNOWN", ""},
+		{"", ""},
 	}
 	for _, tc := range tests {
 		t.Run(tc.input, func(t *testing.T) {
@@ -803,6 +805,8 @@ func TestResourceKindFromCreatedForTag_table(t *testing.T) {
 		{servicecommon.TagValueDNSRecordForTLSRoute, ResourceKindTLSRoute},
 		{servicecommon.TagValueDNSRecordForGateway, ResourceKindGateway},
 		{servicecommon.TagValueDNSRecordForService, ResourceKindService},
+		{servicecommon.TagValueDNSRecordForIngress, ResourceKindIngress},
+		{servicecommon.TagValueDNSRecordForVirtualMachine, ResourceKindVirtualMachine},
 		{"unknown_kind", ""},
 		{"", ""},
 	}
@@ -0,0 +1,135 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	extannotations "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/annotations"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+const hexDigits = "0123456789abcdef"
+
+// recordTTLForOwner returns the TTL from the owner's nsx.vmware.com/dns-ttl annotation; an invalid value, or one
+// outside the NSX range [MinRecordTtL, MaxRecordTtL], is logged and ignored so the records fall back to DefaultRecordTtL.
+func recordTTLForOwner(owner *ResourceRef) extdns.TTL {
+	ttl, err := extannotations.TTLFromAnnotations(owner.GetAnnotations(), common.AnnotationDNSTTLKey)
+	if err == nil && ttl.IsConfigured() && (ttl < MinRecordTtL || ttl > MaxRecordTtL) {
+		err = fmt.Errorf("TTL value %d must be between [%d, %d]", ttl, MinRecordTtL, MaxRecordTtL)
+		ttl = extdns.TTL(0)
+	}
+	if err != nil {
+		log.Info("Ignoring invalid DNS TTL annotation", "kind", owner.Kind, "namespace", owner.GetNamespace(),
+			"name", owner.GetName(), "error", err.Error())
+	}
+	return ttl
+}
+
+// reverseDNSEnabledForOwner reports whether the owner opts in PTR records with nsx.vmware.com/dns-ptr: "true".
+func reverseDNSEnabledForOwner(owner *ResourceRef) bool {
+	return boolAnnotationOfOwner(owner, common.AnnotationDNSPTRKey)
+}
+
+// boolAnnotationOfOwner reports whether the owner annotation key is set to a true value.
+func boolAnnotationOfOwner(owner *ResourceRef, key string) bool {
+	v, ok := owner.GetAnnotations()[key]
+	if !ok {
+		return false
+	}
+	enabled, err := strconv.ParseBool(strings.TrimSpace(v))
+	return err == nil && enabled
+}
+
+// reverseDNSName returns the in-addr.arpa (IPv4) or ip6.arpa (IPv6) name for ip, e.g. "10.0.0.1" →
+// "1.0.0.10.in-addr.arpa"; ok is false if ip is not an IP address.
+func reverseDNSName(ip string) (string, bool) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return "", false
+	}
+	addr = addr.Unmap()
+	var labels []string
+	if addr.Is4() {
+		b := addr.As4()
+		for i := len(b) - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(b[i])))
+		}
+		return strings.Join(labels, ".") + ".in-addr.arpa", true
+	}
+	b := addr.As16()
+	for i := len(b) - 1; i >= 0; i-- {
+		labels = append(labels, string(hexDigits[b[i]&0x0f]), string(hexDigits[b[i]>>4]))
+	}
+	return strings.Join(labels, ".") + ".ip6.arpa", true
+}
+
+// buildPTREndpoints returns one PTR endpoint per reverse name for the targets of the A/AAAA rows. NSX allows a
+// single value per PTR record, so the first hostname of an IP wins; the value is the FQDN with a trailing dot.
+// The TTL and labels come from the forward endpoint.
+func buildPTREndpoints(rows []EndpointRow) []*extdns.Endpoint {
+	var out []*extdns.Endpoint
+	seen := sets.New[string]()
+	for i := range rows {
+		ep := rows[i].Endpoint
+		if ep == nil || (ep.RecordType != extdns.RecordTypeA && ep.RecordType != extdns.RecordTypeAAAA) {
+			continue
+		}
+		hostname := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(ep.DNSName)), ".")
+		for _, target := range ep.Targets {
+			name, ok := reverseDNSName(target)
+			if !ok || seen.Has(name) {
+				continue
+			}
+			ptr := extdns.NewEndpointWithTTL(name, extdns.RecordTypePTR, ep.RecordTTL)
+			if ptr == nil {
+				continue
+			}
+			ptr.Targets = extdns.Targets{hostname + "."}
+			for k, v := range ep.Labels {
+				ptr.WithLabel(k, v)
+			}
+			seen.Insert(name)
+			out = append(out, ptr)
+		}
+	}
+	return out
+}
+
+// hostLabelPTRRows keeps the PTR rows whose record name is a single label in the reverse zone, which NSX requires
+// (e.g. "10" for 10.0.0.10 in zone 0.0.10.in-addr.arpa); the first rejected row is returned as error.
+func hostLabelPTRRows(rows []EndpointRow) ([]EndpointRow, error) {
+	var out []EndpointRow
+	var err error
+	for _, row := range rows {
+		if strings.Contains(row.nsxRecordName, ".") {
+			if err == nil {
+				err = &DNSZoneValidationError{Msg: fmt.Sprintf("reverse DNS zone %s for %s does not hold a single host label", row.zonePath, row.DNSName)}
+			}
+			continue
+		}
+		out = append(out, row)
+	}
+	return out, err
+}
+
+// reverseDNSZoneValidationError wraps a PTR zone mapping or conflict error for the owner.
+func reverseDNSZoneValidationError(err error) error {
+	return &DNSZoneValidationError{Msg: "failed to publish PTR records in the allowed reverse DNS zones", Cause: err}
+}
+
+// RecordAnnotationsChanged reports whether the nsx.vmware.com/dns-ttl, nsx.vmware.com/dns-ptr or
+// nsx.vmware.com/dns-txt-owner annotation differs, source update predicates use it to requeue owners whose
+// records need a new TTL, PTR or TXT rows.
+func RecordAnnotationsChanged(oldAnnotations, newAnnotations map[string]string) bool {
+	return oldAnnotations[common.AnnotationDNSTTLKey] != newAnnotations[common.AnnotationDNSTTLKey] ||
+		oldAnnotations[common.AnnotationDNSPTRKey] != newAnnotations[common.AnnotationDNSPTRKey] ||
+		oldAnnotations[common.AnnotationDNSTXTOwnerKey] != newAnnotations[common.AnnotationDNSTXTOwnerKey]
+}
@@ -0,0 +1,203 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	pkgmock "github.com/vmware-tanzu/nsx-operator/pkg/mock"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+const (
+	testDNSZonePathRev4  = "/orgs/org1/projects/proj1/dns-services/ds1/zones/zone-rev4"
+	testDNSZonePathRev16 = "/orgs/org1/projects/proj1/dns-services/ds1/zones/zone-rev16"
+)
+
+func TestReverseDNSName_table(t *testing.T) {
+	tests := []struct {
+		ip     string
+		want   string
+		wantOK bool
+	}{
+		{ip: "10.0.0.1", want: "1.0.0.10.in-addr.arpa", wantOK: true},
+		{ip: " 192.0.2.10 ", want: "10.2.0.192.in-addr.arpa", wantOK: true},
+		{ip: "::ffff:192.0.2.10", want: "10.2.0.192.in-addr.arpa", wantOK: true},
+		{ip: "2001:db8::567:89ab", want: "b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", wantOK: true},
+		{ip: "lb.example.com"},
+		{ip: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			got, ok := reverseDNSName(tt.ip)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildPTREndpoints(t *testing.T) {
+	a1 := extdns.NewEndpointWithTTL("Web.example.com", extdns.RecordTypeA, 60, "10.0.0.1", "10.0.0.2")
+	a1.WithLabel(EndpointLabelParentGateway, "ns/gw")
+	a2 := extdns.NewEndpoint("api.example.com", extdns.RecordTypeA, "10.0.0.1")
+	aaaa := extdns.NewEndpoint("web.example.com", extdns.RecordTypeAAAA, "2001:db8::1")
+	cname := extdns.NewEndpoint("alias.example.com", extdns.RecordTypeCNAME, "lb.example.com")
+	rows := []EndpointRow{
+		*NewEndpointRow(a1, testDNSZonePathT, "web"),
+		*NewEndpointRow(a2, testDNSZonePathT, "api"),
+		*NewEndpointRow(aaaa, testDNSZonePathT, "web"),
+		*NewEndpointRow(cname, testDNSZonePathT, "alias"),
+	}
+
+	eps := buildPTREndpoints(rows)
+	require.Len(t, eps, 3)
+	require.Equal(t, "1.0.0.10.in-addr.arpa", eps[0].DNSName)
+	require.Equal(t, extdns.RecordTypePTR, eps[0].RecordType)
+	require.Equal(t, []string{"web.example.com."}, []string(eps[0].Targets), "first hostname of an IP wins")
+	require.Equal(t, extdns.TTL(60), eps[0].RecordTTL)
+	require.Equal(t, "ns/gw", eps[0].Labels[EndpointLabelParentGateway])
+	require.Equal(t, "2.0.0.10.in-addr.arpa", eps[1].DNSName)
+	require.Equal(t, []string{"web.example.com."}, []string(eps[1].Targets))
+	require.Equal(t, "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", eps[2].DNSName)
+}
+
+func TestOwnerDNSAnnotations_table(t *testing.T) {
+	owner := func(annotations map[string]string) *ResourceRef {
+		return &ResourceRef{Kind: ResourceKindService, Object: &metav1.ObjectMeta{Namespace: "ns", Name: "svc", Annotations: annotations}}
+	}
+	tests := []struct {
+		name       string
+		annotation map[string]string
+		wantTTL    extdns.TTL
+		wantPTR    bool
+	}{
+		{name: "no_annotations"},
+		{name: "ttl_seconds", annotation: map[string]string{servicecommon.AnnotationDNSTTLKey: "60"}, wantTTL: 60},
+		{name: "ttl_duration", annotation: map[string]string{servicecommon.AnnotationDNSTTLKey: "1h"}, wantTTL: 3600},
+		{name: "invalid_ttl_ignored", annotation: map[string]string{servicecommon.AnnotationDNSTTLKey: "soon"}},
+		{name: "ttl_below_nsx_minimum_ignored", annotation: map[string]string{servicecommon.AnnotationDNSTTLKey: "10"}},
+		{name: "ttl_above_nsx_maximum_ignored", annotation: map[string]string{servicecommon.AnnotationDNSTTLKey: "48h"}},
+		{name: "ptr_true", annotation: map[string]string{servicecommon.AnnotationDNSPTRKey: "true"}, wantPTR: true},
+		{name: "ptr_false", annotation: map[string]string{servicecommon.AnnotationDNSPTRKey: "false"}},
+		{name: "ptr_invalid", annotation: map[string]string{servicecommon.AnnotationDNSPTRKey: "yes please"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := owner(tt.annotation)
+			require.Equal(t, tt.wantTTL, recordTTLForOwner(o))
+			require.Equal(t, tt.wantPTR, reverseDNSEnabledForOwner(o))
+		})
+	}
+}
+
+func TestValidateEndpointsByZone_ttlAndPTR_table(t *testing.T) {
+	zoneMap := map[string]string{
+		testDNSZonePathT:     "example.com",
+		testDNSZonePathRev4:  "0.0.10.in-addr.arpa",
+		testDNSZonePathRev16: "10.in-addr.arpa",
+	}
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		dnsZones      []string
+		eps           []*extdns.Endpoint
+		wantRows      map[string]string // DNSName → zone path
+		wantTTL       extdns.TTL
+		wantPTRTarget string
+		errSub        string
+	}{
+		{
+			name:        "ttl_annotation_applied",
+			annotations: map[string]string{servicecommon.AnnotationDNSTTLKey: "120"},
+			dnsZones:    []string{testDNSZonePathT, testDNSZonePathRev4},
+			eps:         []*extdns.Endpoint{extdns.NewEndpoint("svc.example.com", extdns.RecordTypeA, "10.0.0.1")},
+			wantRows:    map[string]string{"svc.example.com": testDNSZonePathT},
+			wantTTL:     120,
+		},
+		{
+			name:          "ptr_rows_in_reverse_zone",
+			annotations:   map[string]string{servicecommon.AnnotationDNSPTRKey: "true", servicecommon.AnnotationDNSTTLKey: "60"},
+			dnsZones:      []string{testDNSZonePathT, testDNSZonePathRev4},
+			eps:           extdns.EndpointsForHostname("svc.example.com", extdns.NewTargets("10.0.0.1", "10.0.0.2"), 0),
+			wantPTRTarget: "svc.example.com.",
+			wantTTL:       60,
+			wantRows: map[string]string{
+				"svc.example.com":       testDNSZonePathT,
+				"1.0.0.10.in-addr.arpa": testDNSZonePathRev4,
+				"2.0.0.10.in-addr.arpa": testDNSZonePathRev4,
+			},
+		},
+		{
+			name:        "ptr_without_reverse_zone_keeps_forward_rows",
+			annotations: map[string]string{servicecommon.AnnotationDNSPTRKey: "true"},
+			dnsZones:    []string{testDNSZonePathT},
+			eps:         []*extdns.Endpoint{extdns.NewEndpoint("svc.example.com", extdns.RecordTypeA, "10.0.0.1")},
+			wantRows:    map[string]string{"svc.example.com": testDNSZonePathT},
+			errSub:      "failed to publish PTR records",
+		},
+		{
+			name:        "ptr_in_reverse_zone_without_host_label_rejected",
+			annotations: map[string]string{servicecommon.AnnotationDNSPTRKey: "true"},
+			dnsZones:    []string{testDNSZonePathT, testDNSZonePathRev16},
+			eps:         []*extdns.Endpoint{extdns.NewEndpoint("svc.example.com", extdns.RecordTypeA, "10.0.0.1")},
+			wantRows:    map[string]string{"svc.example.com": testDNSZonePathT},
+			errSub:      "does not hold a single host label",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nc := testVPCNetworkConfiguration()
+			nc.Spec.DNSZones = tt.dnsZones
+			vpc := &pkgmock.MockVPCServiceProvider{}
+			vpc.On("GetVPCNetworkConfigByNamespace", mock.AnythingOfType("string")).Return(nc, nil).Once()
+			svc := &DNSRecordService{
+				Service: servicecommon.Service{
+					NSXConfig: &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "unit-test"}},
+				},
+				VPCService:     vpc,
+				DNSZoneMap:     NewDNSZoneCacheFromMap(zoneMap),
+				DNSRecordStore: BuildDNSRecordStore(),
+			}
+			owner := &ResourceRef{Kind: ResourceKindService, Object: &metav1.ObjectMeta{
+				Namespace: "tenant", Name: "svc", UID: "svc-uid", Annotations: tt.annotations}}
+
+			rows, _, err := svc.ValidateEndpointsByZone("tenant", owner, tt.eps)
+			if tt.errSub != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSub)
+				var zve *DNSZoneValidationError
+				require.ErrorAs(t, err, &zve)
+			} else {
+				require.NoError(t, err)
+			}
+			got := make(map[string]string, len(rows))
+			for _, row := range rows {
+				got[row.DNSName] = row.zonePath
+				require.Equal(t, tt.wantTTL, row.RecordTTL, row.DNSName)
+				if row.RecordType == extdns.RecordTypePTR {
+					require.Equal(t, []string{tt.wantPTRTarget}, []string(row.Targets))
+					rec := row.buildDNSRecord(nil)
+					require.Equal(t, "PTR", *rec.RecordType)
+					require.Equal(t, int64(tt.wantTTL), *rec.Ttl)
+				}
+			}
+			require.Equal(t, tt.wantRows, got)
+			vpc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRecordAnnotationsChanged(t *testing.T) {
+	base := map[string]string{servicecommon.AnnotationDNSHostnameKey: "a.example.com"}
+	require.False(t, RecordAnnotationsChanged(nil, base))
+	require.True(t, RecordAnnotationsChanged(base, map[string]string{servicecommon.AnnotationDNSTTLKey: "60"}))
+	require.True(t, RecordAnnotationsChanged(map[string]string{servicecommon.AnnotationDNSPTRKey: "true"}, base))
+	require.True(t, RecordAnnotationsChanged(base, map[string]string{servicecommon.AnnotationDNSTXTOwnerKey: "true"}))
+}
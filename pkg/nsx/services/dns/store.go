@@ -264,6 +264,10 @@ func resourceKindFromCreatedForTag(createdFor string) string {
 		return ResourceKindGateway
 	case common.TagValueDNSRecordForService:
 		return ResourceKindService
+	case common.TagValueDNSRecordForIngress:
+		return ResourceKindIngress
+	case common.TagValueDNSRecordForVirtualMachine:
+		return ResourceKindVirtualMachine
 	default:
 		return ""
 	}
@@ -282,6 +286,10 @@ func resourceKindToCreatedFor(kind string) string {
 		return common.TagValueDNSRecordForTLSRoute
 	case ResourceKindService:
 		return common.TagValueDNSRecordForService
+	case ResourceKindIngress:
+		return common.TagValueDNSRecordForIngress
+	case ResourceKindVirtualMachine:
+		return common.TagValueDNSRecordForVirtualMachine
 	default:
 		return ""
 	}
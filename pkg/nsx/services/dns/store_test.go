@@ -59,6 +59,8 @@ func TestResourceKindToCreatedFor_table(t *testing.T) {
 		{ResourceKindGRPCRoute, servicecommon.TagValueDNSRecordForGRPCRoute},
 		{ResourceKindTLSRoute, servicecommon.TagValueDNSRecordForTLSRoute},
 		{ResourceKindService, servicecommon.TagValueDNSRecordForService},
+		{ResourceKindIngress, servicecommon.TagValueDNSRecordForIngress},
+		{ResourceKindVirtualMachine, servicecommon.TagValueDNSRecordForVirtualMachine},
 		{"UnknownKind", ""},
 		{"", ""},
 	}
@@ -0,0 +1,62 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+// TXTRecordHeritage is the heritage and key prefix of the TXT ownership records. The records follow the layout of the
+// ExternalDNS TXT registry with nsx-operator in place of external-dns, i.e.
+// "heritage=nsx-operator,nsx-operator/owner=<cluster>,nsx-operator/resource=<kind>/<namespace>/<name>".
+const TXTRecordHeritage = "nsx-operator"
+
+// ownershipTXTEnabledForOwner reports whether the owner opts in TXT ownership records with nsx.vmware.com/dns-txt-owner: "true".
+func ownershipTXTEnabledForOwner(owner *ResourceRef) bool {
+	return boolAnnotationOfOwner(owner, common.AnnotationDNSTXTOwnerKey)
+}
+
+// ownershipTXTName returns the TXT ownership record name for a record of recordType at dnsName; the lower-case record
+// type prefix (as in the ExternalDNS TXT registry) keeps it apart from a CNAME at the same name, e.g. "a-svc.example.com".
+func ownershipTXTName(recordType, dnsName string) string {
+	return strings.ToLower(recordType) + "-" + strings.TrimSuffix(strings.ToLower(strings.TrimSpace(dnsName)), ".")
+}
+
+// ownershipTXTValue returns the TXT ownership value of owner in cluster.
+func ownershipTXTValue(cluster string, owner *ResourceRef) string {
+	return fmt.Sprintf("heritage=%s,%s/owner=%s,%s/resource=%s/%s/%s", TXTRecordHeritage, TXTRecordHeritage, cluster,
+		TXTRecordHeritage, resourceKindToCreatedFor(owner.Kind), owner.GetNamespace(), owner.GetName())
+}
+
+// buildOwnershipTXTEndpoints returns one TXT ownership endpoint per row the owner is primary of. Rows adopted from
+// another owner (shared FQDN) are skipped since the primary owner publishes their TXT record; a contributing owner
+// publishes it once it is promoted and reconciled.
+func buildOwnershipTXTEndpoints(cluster string, owner *ResourceRef, rows []EndpointRow) []*extdns.Endpoint {
+	value := ownershipTXTValue(cluster, owner)
+	var out []*extdns.Endpoint
+	for i := range rows {
+		row := rows[i]
+		if row.Endpoint == nil || row.effectiveOwner != nil || row.RecordType == extdns.RecordTypeTXT {
+			continue
+		}
+		txt := extdns.NewEndpointWithTTL(ownershipTXTName(row.RecordType, row.DNSName), extdns.RecordTypeTXT, row.RecordTTL, value)
+		if txt == nil {
+			continue
+		}
+		for k, v := range row.Labels {
+			txt.WithLabel(k, v)
+		}
+		out = append(out, txt)
+	}
+	return out
+}
+
+// ownershipTXTValidationError wraps a TXT zone mapping or conflict error for the owner.
+func ownershipTXTValidationError(err error) error {
+	return &DNSZoneValidationError{Msg: "failed to publish TXT ownership records", Cause: err}
+}
@@ -0,0 +1,116 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	pkgmock "github.com/vmware-tanzu/nsx-operator/pkg/mock"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+func TestBuildOwnershipTXTEndpoints(t *testing.T) {
+	owner := &ResourceRef{Kind: ResourceKindService, Object: &metav1.ObjectMeta{Namespace: "ns", Name: "svc"}}
+	a := extdns.NewEndpointWithTTL("Svc.example.com", extdns.RecordTypeA, 60, "10.0.0.1")
+	cname := extdns.NewEndpoint("alias.example.com", extdns.RecordTypeCNAME, "lb.example.com")
+	cname.WithLabel(EndpointLabelParentGateway, "ns/gw")
+	adopted := NewEndpointRow(extdns.NewEndpoint("shared.example.com", extdns.RecordTypeA, "10.0.0.2"), testDNSZonePathT, "shared")
+	adopted.effectiveOwner = &ResourceRef{Kind: ResourceKindService, Object: &metav1.ObjectMeta{Namespace: "ns", Name: "other"}}
+	rows := []EndpointRow{
+		*NewEndpointRow(a, testDNSZonePathT, "svc"),
+		*NewEndpointRow(cname, testDNSZonePathT, "alias"),
+		*adopted,
+	}
+
+	eps := buildOwnershipTXTEndpoints("cluster1", owner, rows)
+	require.Len(t, eps, 2)
+	wantValue := "heritage=nsx-operator,nsx-operator/owner=cluster1,nsx-operator/resource=service/ns/svc"
+	require.Equal(t, "a-svc.example.com", eps[0].DNSName)
+	require.Equal(t, extdns.RecordTypeTXT, eps[0].RecordType)
+	require.Equal(t, []string{wantValue}, []string(eps[0].Targets))
+	require.Equal(t, extdns.TTL(60), eps[0].RecordTTL)
+	require.Equal(t, "cname-alias.example.com", eps[1].DNSName)
+	require.Equal(t, "ns/gw", eps[1].Labels[EndpointLabelParentGateway])
+}
+
+func TestValidateEndpointsByZone_txtAndUnsupportedTypes_table(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		eps         []*extdns.Endpoint
+		wantRows    map[string]string // DNSName → record type
+		errSub      string
+	}{
+		{
+			name:        "txt_ownership_rows",
+			annotations: map[string]string{servicecommon.AnnotationDNSTXTOwnerKey: "true"},
+			eps:         extdns.EndpointsForHostname("svc.example.com", extdns.NewTargets("10.0.0.1", "2001:db8::1"), 0),
+			wantRows: map[string]string{
+				"svc.example.com":      extdns.RecordTypeA,
+				"a-svc.example.com":    extdns.RecordTypeTXT,
+				"aaaa-svc.example.com": extdns.RecordTypeTXT,
+			},
+		},
+		{
+			name:     "txt_not_requested",
+			eps:      []*extdns.Endpoint{extdns.NewEndpoint("svc.example.com", extdns.RecordTypeA, "10.0.0.1")},
+			wantRows: map[string]string{"svc.example.com": extdns.RecordTypeA},
+		},
+		{
+			name: "srv_not_supported_by_nsx",
+			eps: []*extdns.Endpoint{
+				extdns.NewEndpoint("_grpc._tcp.svc.example.com", extdns.RecordTypeSRV, "0 50 9090 svc.example.com"),
+				extdns.NewEndpoint("svc.example.com", extdns.RecordTypeA, "10.0.0.1"),
+			},
+			wantRows: map[string]string{"svc.example.com": extdns.RecordTypeA},
+			errSub:   `record type "SRV" of _grpc._tcp.svc.example.com is not supported by NSX DNS`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vpc := &pkgmock.MockVPCServiceProvider{}
+			vpc.On("GetVPCNetworkConfigByNamespace", mock.AnythingOfType("string")).Return(testVPCNetworkConfiguration(), nil).Once()
+			svc := &DNSRecordService{
+				Service: servicecommon.Service{
+					NSXConfig: &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "unit-test"}},
+				},
+				VPCService:     vpc,
+				DNSZoneMap:     NewDNSZoneCacheFromMap(testDNSZoneMapForVPCFixture()),
+				DNSRecordStore: BuildDNSRecordStore(),
+			}
+			owner := &ResourceRef{Kind: ResourceKindService, Object: &metav1.ObjectMeta{
+				Namespace: "tenant", Name: "svc", UID: "svc-uid", Annotations: tt.annotations}}
+
+			rows, _, err := svc.ValidateEndpointsByZone("tenant", owner, tt.eps)
+			if tt.errSub != "" {
+				require.ErrorContains(t, err, tt.errSub)
+				var zve *DNSZoneValidationError
+				require.ErrorAs(t, err, &zve)
+			} else {
+				require.NoError(t, err)
+			}
+			got := make(map[string]string, len(rows))
+			for _, row := range rows {
+				if row.RecordType == extdns.RecordTypeAAAA {
+					continue
+				}
+				got[row.DNSName] = row.RecordType
+				if row.RecordType == extdns.RecordTypeTXT {
+					require.Equal(t, []string{"heritage=nsx-operator,nsx-operator/owner=unit-test,nsx-operator/resource=service/tenant/svc"}, []string(row.Targets))
+					rec := row.buildDNSRecord(nil)
+					require.Equal(t, "TXT", *rec.RecordType)
+					require.Contains(t, []string{"a-svc", "aaaa-svc"}, *rec.RecordName)
+				}
+			}
+			require.Equal(t, tt.wantRows, got)
+			vpc.AssertExpectations(t)
+		})
+	}
+}
@@ -15,11 +15,13 @@ import (
 )
 
 const (
-	ResourceKindGateway   = "Gateway"
-	ResourceKindHTTPRoute = "HTTPRoute"
-	ResourceKindGRPCRoute = "GRPCRoute"
-	ResourceKindTLSRoute  = "TLSRoute"
-	ResourceKindService   = "Service"
+	ResourceKindGateway        = "Gateway"
+	ResourceKindHTTPRoute      = "HTTPRoute"
+	ResourceKindGRPCRoute      = "GRPCRoute"
+	ResourceKindTLSRoute       = "TLSRoute"
+	ResourceKindService        = "Service"
+	ResourceKindIngress        = "Ingress"
+	ResourceKindVirtualMachine = "VirtualMachine"
 	// DNSRecordPathSegment is the NSX Policy path segment for project-scoped DnsRecord (same as common.PathSegmentDnsRecords).
 	DNSRecordPathSegment = common.PathSegmentDnsRecords
 )
@@ -68,7 +70,7 @@ func NewOwnerScopedAggregatedRouteDNS(scopeOwner *ResourceRef, rows []EndpointRo
 	}
 }
 
-// DNSRecordProvider is the DNS record API for Gateway Route and LoadBalancer Service DNS; *DNSRecordService implements it.
+// DNSRecordProvider is the DNS record API for Gateway, Route, LoadBalancer Service, Ingress and VirtualMachine DNS; *DNSRecordService implements it.
 type DNSRecordProvider interface {
 	CreateOrUpdateRecords(ctx context.Context, batch *AggregatedDNSEndpoints) (bool, error)
 	DeleteRecordByOwnerNN(ctx context.Context, kind, namespace, name string) (bool, error)
@@ -75,6 +75,9 @@ func (s *DNSRecordService) getZonePathForHostname(z extprovider.ZoneIDName, host
 }
 
 // ValidateEndpointsByZone maps each endpoint to a zone and row; returns validated rows, invalid rows, path→domain map for permitted zones (from sync), and err. owner must be non-nil.
+// The owner's nsx.vmware.com/dns-ttl annotation sets the TTL of endpoints without one, nsx.vmware.com/dns-ptr
+// adds PTR rows in the allowed reverse DNS zones for the validated A/AAAA rows, and nsx.vmware.com/dns-txt-owner
+// adds TXT ownership rows for the validated rows the owner is primary of.
 func (s *DNSRecordService) ValidateEndpointsByZone(namespace string, owner *ResourceRef, eps []*extdns.Endpoint) ([]EndpointRow, map[string]string, error) {
 	log.Info("Validating DNS endpoints by zone", "namespace", namespace,
 		"owner", owner.GetName(), "endpoints", len(eps))
@@ -92,6 +95,38 @@ func (s *DNSRecordService) ValidateEndpointsByZone(namespace string, owner *Reso
 	}
 	z := generateZoneIdFromMap(allowedZones)
 
+	ttl := recordTTLForOwner(owner)
+	for _, ep := range eps {
+		if !ep.RecordTTL.IsConfigured() {
+			ep.RecordTTL = ttl
+		}
+	}
+	validRows, validationErr := s.validateEndpointRows(z, owner, eps)
+	forwardRows := validRows
+	if ownershipTXTEnabledForOwner(owner) {
+		txtRows, txtErr := s.validateEndpointRows(z, owner, buildOwnershipTXTEndpoints(getCluster(s), owner, forwardRows))
+		validRows = append(validRows, txtRows...)
+		if validationErr == nil && txtErr != nil {
+			validationErr = ownershipTXTValidationError(txtErr)
+		}
+	}
+	if reverseDNSEnabledForOwner(owner) {
+		ptrRows, ptrErr := s.validateEndpointRows(z, owner, buildPTREndpoints(forwardRows))
+		ptrRows, labelErr := hostLabelPTRRows(ptrRows)
+		validRows = append(validRows, ptrRows...)
+		if ptrErr == nil {
+			ptrErr = labelErr
+		}
+		if validationErr == nil && ptrErr != nil {
+			validationErr = reverseDNSZoneValidationError(ptrErr)
+		}
+	}
+	return validRows, allowedZones, validationErr
+}
+
+// validateEndpointRows maps eps to rows in the zones of z; the first zone or conflict error is returned as a
+// *DNSZoneValidationError together with the rows which are valid.
+func (s *DNSRecordService) validateEndpointRows(z extprovider.ZoneIDName, owner *ResourceRef, eps []*extdns.Endpoint) ([]EndpointRow, error) {
 	var validRows []EndpointRow
 	var validationErr error
 
@@ -101,6 +136,12 @@ func (s *DNSRecordService) ValidateEndpointsByZone(namespace string, owner *Reso
 			log.Info("Skipping DNS endpoint: wildcard DNS names are not supported for NSX DNS records", "dnsName", ep.DNSName)
 			continue
 		}
+		if getNSXDnsRecordType(ep.RecordType) == "" {
+			if validationErr == nil {
+				validationErr = &DNSZoneValidationError{Msg: fmt.Sprintf("record type %q of %s is not supported by NSX DNS", ep.RecordType, ep.DNSName)}
+			}
+			continue
+		}
 		recName, zonePath, parseErr := s.getZonePathForHostname(z, ep.DNSName)
 		if parseErr != nil {
 			if validationErr == nil {
@@ -118,7 +159,7 @@ func (s *DNSRecordService) ValidateEndpointsByZone(namespace string, owner *Reso
 		}
 		validRows = append(validRows, *row)
 	}
-	return validRows, allowedZones, validationErr
+	return validRows, validationErr
 }
 
 // SyncDNSZonesByVpcNetworkConfig ensures DNSZoneMap entries for vpcConfig.Spec.DNSZones; returns path→domain map or err.
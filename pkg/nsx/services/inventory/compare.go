@@ -115,6 +115,11 @@ func compareContainerIngressPolicy(pre interface{}, cur interface{}, property ma
 	if pre == nil || !reflect.DeepEqual(preIngressPolicy.ContainerApplicationIds, curIngressPolicy.ContainerApplicationIds) {
 		property["container_application_ids"] = curIngressPolicy.ContainerApplicationIds
 	}
+	// Ingresses have no origin properties, Gateway API objects record their kind there.
+	if (len(preIngressPolicy.OriginProperties) > 0 || len(curIngressPolicy.OriginProperties) > 0) &&
+		!reflect.DeepEqual(preIngressPolicy.OriginProperties, curIngressPolicy.OriginProperties) {
+		property["origin_properties"] = curIngressPolicy.OriginProperties
+	}
 }
 
 func isIPChanged(pre containerinventory.ContainerApplicationInstance, cur containerinventory.ContainerApplicationInstance) bool {
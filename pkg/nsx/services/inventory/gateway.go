@@ -0,0 +1,351 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+var gatewayAPIKinds = map[InventoryType]string{
+	ContainerGateway:   "Gateway",
+	ContainerHTTPRoute: "HTTPRoute",
+	ContainerGRPCRoute: "GRPCRoute",
+	ContainerTLSRoute:  "TLSRoute",
+}
+
+// gatewayInventorySpec is the spec reported for a Gateway, the Gateway spec with the routes attached to it.
+type gatewayInventorySpec struct {
+	gatewayv1.GatewaySpec `yaml:",inline"`
+	AttachedRoutes        []string `yaml:"attachedroutes,omitempty"`
+}
+
+// GatewayAPIKind returns the Kubernetes kind of the Gateway API inventory type, or "" for other types.
+func GatewayAPIKind(inventoryType InventoryType) string {
+	return gatewayAPIKinds[inventoryType]
+}
+
+// NewGatewayAPIObject returns an empty object of the Gateway API inventory type, or nil for other types.
+func NewGatewayAPIObject(inventoryType InventoryType) client.Object {
+	switch inventoryType {
+	case ContainerGateway:
+		return &gatewayv1.Gateway{}
+	case ContainerHTTPRoute:
+		return &gatewayv1.HTTPRoute{}
+	case ContainerGRPCRoute:
+		return &gatewayv1.GRPCRoute{}
+	case ContainerTLSRoute:
+		return &gatewayv1.TLSRoute{}
+	}
+	return nil
+}
+
+func gatewayAPIInventoryType(kind string) (InventoryType, bool) {
+	for inventoryType, k := range gatewayAPIKinds {
+		if k == kind {
+			return inventoryType, true
+		}
+	}
+	return "", false
+}
+
+func originPropertyValue(properties []common.KeyValuePair, key string) string {
+	for _, prop := range properties {
+		if prop.Key == key {
+			return prop.Value
+		}
+	}
+	return ""
+}
+
+func (s *InventoryService) SyncContainerGatewayAPIObject(name string, namespace string, key InventoryKey) *InventoryKey {
+	obj := NewGatewayAPIObject(key.InventoryType)
+	externalId := key.ExternalId
+	if deleted := s.IsGatewayAPIObjectDeleted(namespace, name, externalId, obj); deleted {
+		err := s.DeleteResource(externalId, ContainerIngressPolicy)
+		if err != nil {
+			log.Error(err, "Delete ContainerIngressPolicy Resource error", "key", key)
+			return &key
+		}
+	} else if obj.GetUID() == types.UID(externalId) {
+		retry := s.BuildGatewayAPIObject(obj)
+		if retry {
+			return &key
+		}
+	} else {
+		log.Error(errors.New("no Gateway API object found"), "Unexpected error is found while processing Gateway API object", "key", key)
+	}
+	return nil
+}
+
+// IsGatewayAPIObjectDeleted returns true if the object is not found, or the Gateway API CRD is not installed any more.
+func (s *InventoryService) IsGatewayAPIObjectDeleted(namespace, name, externalId string, obj client.Object) bool {
+	err := s.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, obj)
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) ||
+		((err == nil) && (string(obj.GetUID()) != externalId)) {
+		return true
+	}
+	if err != nil {
+		log.Error(err, "Check Gateway API object deleted", "Name", name, "Namespace", namespace, "External id", externalId)
+	}
+	return false
+}
+
+// BuildGatewayAPIObject builds a ContainerIngressPolicy from a Gateway, HTTPRoute, GRPCRoute or TLSRoute.
+func (s *InventoryService) BuildGatewayAPIObject(obj client.Object) (retry bool) {
+	switch o := obj.(type) {
+	case *gatewayv1.Gateway:
+		routes, err := s.getAttachedRoutes(o)
+		if err != nil {
+			log.Error(err, "Failed to list routes attached to Gateway", "Gateway", types.NamespacedName{Namespace: o.Namespace, Name: o.Name})
+			return true
+		}
+		spec := gatewayInventorySpec{GatewaySpec: o.Spec}
+		services := sets.New[types.NamespacedName]()
+		for _, route := range routes {
+			spec.AttachedRoutes = append(spec.AttachedRoutes, fmt.Sprintf("%s/%s/%s", routeKind(route), route.GetNamespace(), route.GetName()))
+			services.Insert(GetRouteBackendServices(route)...)
+		}
+		sort.Strings(spec.AttachedRoutes)
+		return s.buildGatewayAPIIngressPolicy(o, "Gateway", spec, sortedNamespacedNames(services), o.Status.Conditions)
+	case *gatewayv1.HTTPRoute:
+		return s.buildGatewayAPIIngressPolicy(o, "HTTPRoute", o.Spec, GetRouteBackendServices(o), routeParentConditions(o.Status.Parents))
+	case *gatewayv1.GRPCRoute:
+		return s.buildGatewayAPIIngressPolicy(o, "GRPCRoute", o.Spec, GetRouteBackendServices(o), routeParentConditions(o.Status.Parents))
+	case *gatewayv1.TLSRoute:
+		return s.buildGatewayAPIIngressPolicy(o, "TLSRoute", o.Spec, GetRouteBackendServices(o), routeParentConditions(o.Status.Parents))
+	}
+	log.Error(errors.New("unsupported object"), "Cannot build inventory object", "object", obj)
+	return false
+}
+
+func (s *InventoryService) buildGatewayAPIIngressPolicy(obj client.Object, kind string, objSpec interface{}, services []types.NamespacedName, conditions []metav1.Condition) (retry bool) {
+	log.Trace("Add Gateway API object", "Kind", kind, "Name", obj.GetName(), "Namespace", obj.GetNamespace())
+	namespace, err := s.GetNamespace(obj.GetNamespace())
+	retry = true
+	if err != nil {
+		log.Error(err, "Cannot find namespace for Gateway API object", "Kind", kind, "Name", obj.GetName(), "Namespace", obj.GetNamespace())
+		return
+	}
+	spec, err := yaml.Marshal(objSpec)
+	if err != nil {
+		log.Error(err, "Failed to dump spec for Gateway API object", "Kind", kind, "Name", obj.GetName(), "Namespace", obj.GetNamespace())
+		return
+	}
+
+	prePolicy := s.IngressPolicyStore.GetByKey(string(obj.GetUID()))
+	if prePolicy != nil {
+		prePolicy = *prePolicy.(*containerinventory.ContainerIngressPolicy)
+	}
+
+	// Initialize as empty slice to ensure NSX receives [] instead of null when clearing errors
+	networkErrors := make([]common.NetworkError, 0)
+	networkStatus := NetworkStatusHealthy
+	uniqueErrors := make(map[string]bool)
+	for _, cond := range conditions {
+		if cond.Status != metav1.ConditionFalse {
+			continue
+		}
+		networkStatus = NetworkStatusUnhealthy
+		errorMessage := cond.Type + ":" + cond.Message
+		if !uniqueErrors[errorMessage] {
+			uniqueErrors[errorMessage] = true
+			networkErrors = append(networkErrors, common.NetworkError{
+				ErrorMessage: errorMessage,
+			})
+		}
+	}
+
+	containerIngress := containerinventory.ContainerIngressPolicy{
+		DisplayName:             obj.GetName(),
+		ResourceType:            string(ContainerIngressPolicy),
+		Tags:                    GetTagsFromLabels(obj.GetLabels()),
+		ContainerApplicationIds: nil,
+		ContainerClusterId:      util.GetClusterUUID(s.NSXConfig.Cluster).String(),
+		ContainerProjectId:      string(namespace.UID),
+		ExternalId:              string(obj.GetUID()),
+		NetworkErrors:           networkErrors,
+		NetworkStatus:           networkStatus,
+		OriginProperties:        []common.KeyValuePair{{Key: OriginPropertyKind, Value: kind}},
+		Spec:                    string(spec),
+	}
+	appIDs := s.getServiceUIDs(services)
+	if len(appIDs) > 0 {
+		containerIngress.ContainerApplicationIds = appIDs
+	}
+	log.Trace("Build Gateway API object", "current instance", containerIngress, "pre instance", prePolicy)
+	operation, _ := s.compareAndMergeUpdate(prePolicy, containerIngress)
+	if operation != operationNone {
+		s.pendingAdd[containerIngress.ExternalId] = &containerIngress
+	}
+	retry = false
+	return
+}
+
+// getServiceUIDs returns the sorted UIDs of the existing Services.
+func (s *InventoryService) getServiceUIDs(services []types.NamespacedName) []string {
+	result := []string{}
+	for _, serviceName := range services {
+		service := &corev1.Service{}
+		err := s.Client.Get(context.TODO(), serviceName, service)
+		if err != nil {
+			log.Error(err, "Failed to get service", "service", serviceName)
+			continue
+		}
+		result = append(result, string(service.UID))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// getAttachedRoutes lists the HTTPRoutes, GRPCRoutes and TLSRoutes referring to the Gateway,
+// skipping the route kinds whose CRDs are not installed.
+func (s *InventoryService) getAttachedRoutes(gateway *gatewayv1.Gateway) ([]client.Object, error) {
+	gatewayName := types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}
+	var routes []client.Object
+	for _, list := range []client.ObjectList{&gatewayv1.HTTPRouteList{}, &gatewayv1.GRPCRouteList{}, &gatewayv1.TLSRouteList{}} {
+		if err := s.Client.List(context.TODO(), list); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			route, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			for _, parent := range GetRouteParentGateways(route) {
+				if parent == gatewayName {
+					routes = append(routes, route)
+					break
+				}
+			}
+		}
+	}
+	return routes, nil
+}
+
+func routeKind(route client.Object) string {
+	switch route.(type) {
+	case *gatewayv1.HTTPRoute:
+		return "HTTPRoute"
+	case *gatewayv1.GRPCRoute:
+		return "GRPCRoute"
+	case *gatewayv1.TLSRoute:
+		return "TLSRoute"
+	}
+	return ""
+}
+
+func routeParentRefs(route client.Object) []gatewayv1.ParentReference {
+	switch r := route.(type) {
+	case *gatewayv1.HTTPRoute:
+		return r.Spec.ParentRefs
+	case *gatewayv1.GRPCRoute:
+		return r.Spec.ParentRefs
+	case *gatewayv1.TLSRoute:
+		return r.Spec.ParentRefs
+	}
+	return nil
+}
+
+func routeBackendRefs(route client.Object) []gatewayv1.BackendObjectReference {
+	var refs []gatewayv1.BackendObjectReference
+	switch r := route.(type) {
+	case *gatewayv1.HTTPRoute:
+		for _, rule := range r.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				refs = append(refs, ref.BackendObjectReference)
+			}
+		}
+	case *gatewayv1.GRPCRoute:
+		for _, rule := range r.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				refs = append(refs, ref.BackendObjectReference)
+			}
+		}
+	case *gatewayv1.TLSRoute:
+		for _, rule := range r.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				refs = append(refs, ref.BackendObjectReference)
+			}
+		}
+	}
+	return refs
+}
+
+func routeParentConditions(parents []gatewayv1.RouteParentStatus) []metav1.Condition {
+	var conditions []metav1.Condition
+	for _, parent := range parents {
+		conditions = append(conditions, parent.Conditions...)
+	}
+	return conditions
+}
+
+// GetRouteParentGateways returns the Gateways referred by the parentRefs of the route.
+func GetRouteParentGateways(route client.Object) []types.NamespacedName {
+	var gateways []types.NamespacedName
+	for _, ref := range routeParentRefs(route) {
+		if ref.Group != nil && string(*ref.Group) != gatewayv1.GroupName {
+			continue
+		}
+		if ref.Kind != nil && string(*ref.Kind) != "Gateway" {
+			continue
+		}
+		namespace := route.GetNamespace()
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		gateways = append(gateways, types.NamespacedName{Namespace: namespace, Name: string(ref.Name)})
+	}
+	return gateways
+}
+
+// GetRouteBackendServices returns the deduplicated Services referred by the backendRefs of the route.
+func GetRouteBackendServices(route client.Object) []types.NamespacedName {
+	services := sets.New[types.NamespacedName]()
+	for _, ref := range routeBackendRefs(route) {
+		if ref.Group != nil && *ref.Group != "" {
+			continue
+		}
+		if ref.Kind != nil && string(*ref.Kind) != "Service" {
+			continue
+		}
+		namespace := route.GetNamespace()
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		services.Insert(types.NamespacedName{Namespace: namespace, Name: string(ref.Name)})
+	}
+	return sortedNamespacedNames(services)
+}
+
+func sortedNamespacedNames(names sets.Set[types.NamespacedName]) []types.NamespacedName {
+	result := names.UnsortedList()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+	return result
+}
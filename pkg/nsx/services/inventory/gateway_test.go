@@ -0,0 +1,196 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inventory
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func newGatewayTestObjects() (*gatewayv1.Gateway, *gatewayv1.HTTPRoute, []client.Object) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "ns1-uid"}}
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "svc1", UID: "svc1-uid"}}
+	hostname := gatewayv1.Hostname("www.example.com")
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "gw1", UID: "gw1-uid", Labels: map[string]string{"app": "web"}},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: "nsx",
+			Listeners: []gatewayv1.Listener{{
+				Name:     "http",
+				Hostname: &hostname,
+				Port:     80,
+				Protocol: gatewayv1.HTTPProtocolType,
+			}},
+		},
+	}
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "route1", UID: "route1-uid"},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw1"}},
+			},
+			Hostnames: []gatewayv1.Hostname{hostname},
+			Rules: []gatewayv1.HTTPRouteRule{{
+				BackendRefs: []gatewayv1.HTTPBackendRef{
+					{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc1"}}},
+					{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc-missing"}}},
+				},
+			}},
+		},
+	}
+	return gateway, route, []client.Object{namespace, service, gateway, route}
+}
+
+func createGatewayTestService(t *testing.T, objs ...client.Object) *InventoryService {
+	inventoryService, _ := createService(t)
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, gatewayv1.Install(scheme))
+	inventoryService.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	return inventoryService
+}
+
+func TestBuildGatewayAPIObject(t *testing.T) {
+	gateway, route, objs := newGatewayTestObjects()
+
+	t.Run("Gateway", func(t *testing.T) {
+		inventoryService := createGatewayTestService(t, objs...)
+		retry := inventoryService.BuildGatewayAPIObject(gateway)
+		assert.False(t, retry)
+		require.Contains(t, inventoryService.pendingAdd, "gw1-uid")
+		policy := inventoryService.pendingAdd["gw1-uid"].(*containerinventory.ContainerIngressPolicy)
+		assert.Equal(t, "gw1", policy.DisplayName)
+		assert.Equal(t, string(ContainerIngressPolicy), policy.ResourceType)
+		assert.Equal(t, "ns1-uid", policy.ContainerProjectId)
+		assert.Equal(t, []common.KeyValuePair{{Key: OriginPropertyKind, Value: "Gateway"}}, policy.OriginProperties)
+		assert.Equal(t, []string{"svc1-uid"}, policy.ContainerApplicationIds)
+		assert.Equal(t, NetworkStatusHealthy, policy.NetworkStatus)
+		assert.Contains(t, policy.Spec, "hostname: www.example.com")
+		assert.Contains(t, policy.Spec, "HTTPRoute/ns1/route1")
+		require.Len(t, inventoryService.requestBuffer, 1)
+		assert.Equal(t, operationCreate, inventoryService.requestBuffer[0].ObjectUpdateType)
+
+		// No update is sent if nothing changed.
+		require.NoError(t, inventoryService.IngressPolicyStore.Add(policy))
+		inventoryService.pendingAdd = make(map[string]interface{})
+		inventoryService.requestBuffer = nil
+		retry = inventoryService.BuildGatewayAPIObject(gateway)
+		assert.False(t, retry)
+		assert.Empty(t, inventoryService.pendingAdd)
+		assert.Empty(t, inventoryService.requestBuffer)
+	})
+
+	t.Run("HTTPRoute not accepted", func(t *testing.T) {
+		inventoryService := createGatewayTestService(t, objs...)
+		notAccepted := route.DeepCopy()
+		notAccepted.Status.Parents = []gatewayv1.RouteParentStatus{{
+			ParentRef: gatewayv1.ParentReference{Name: "gw1"},
+			Conditions: []metav1.Condition{
+				{Type: string(gatewayv1.RouteConditionAccepted), Status: metav1.ConditionFalse, Message: "no matching listener"},
+				{Type: string(gatewayv1.RouteConditionResolvedRefs), Status: metav1.ConditionTrue},
+			},
+		}}
+		retry := inventoryService.BuildGatewayAPIObject(notAccepted)
+		assert.False(t, retry)
+		policy := inventoryService.pendingAdd["route1-uid"].(*containerinventory.ContainerIngressPolicy)
+		assert.Equal(t, "HTTPRoute", originPropertyValue(policy.OriginProperties, OriginPropertyKind))
+		assert.Equal(t, []string{"svc1-uid"}, policy.ContainerApplicationIds)
+		assert.Equal(t, NetworkStatusUnhealthy, policy.NetworkStatus)
+		assert.Equal(t, []common.NetworkError{{ErrorMessage: "Accepted:no matching listener"}}, policy.NetworkErrors)
+		assert.True(t, strings.Contains(policy.Spec, "www.example.com"))
+	})
+
+	t.Run("Namespace not found", func(t *testing.T) {
+		inventoryService := createGatewayTestService(t)
+		retry := inventoryService.BuildGatewayAPIObject(route)
+		assert.True(t, retry)
+		assert.Empty(t, inventoryService.pendingAdd)
+	})
+}
+
+func TestSyncContainerGatewayAPIObject(t *testing.T) {
+	gateway, _, objs := newGatewayTestObjects()
+	inventoryService := createGatewayTestService(t, objs...)
+
+	key := InventoryKey{InventoryType: ContainerGateway, ExternalId: "gw1-uid", Key: "ns1/gw1"}
+	assert.Nil(t, inventoryService.SyncContainerGatewayAPIObject(gateway.Name, gateway.Namespace, key))
+	require.Contains(t, inventoryService.pendingAdd, "gw1-uid")
+	require.NoError(t, inventoryService.IngressPolicyStore.Add(inventoryService.pendingAdd["gw1-uid"]))
+
+	// The HTTPRoute with the stale UID is deleted from the inventory.
+	inventoryService.pendingAdd = make(map[string]interface{})
+	require.NoError(t, inventoryService.IngressPolicyStore.Add(&containerinventory.ContainerIngressPolicy{
+		DisplayName: "route1", ResourceType: string(ContainerIngressPolicy), ExternalId: "old-route1-uid",
+		OriginProperties: []common.KeyValuePair{{Key: OriginPropertyKind, Value: "HTTPRoute"}},
+	}))
+	key = InventoryKey{InventoryType: ContainerHTTPRoute, ExternalId: "old-route1-uid", Key: "ns1/route1"}
+	assert.Nil(t, inventoryService.SyncContainerGatewayAPIObject("route1", "ns1", key))
+	assert.Contains(t, inventoryService.pendingDelete, "old-route1-uid")
+	assert.NotContains(t, inventoryService.pendingDelete, "gw1-uid")
+}
+
+func TestCleanStaleInventoryIngressPolicy_GatewayAPI(t *testing.T) {
+	_, _, objs := newGatewayTestObjects()
+	inventoryService := createGatewayTestService(t, objs...)
+	require.NoError(t, inventoryService.ProjectStore.Add(&containerinventory.ContainerProject{
+		DisplayName: "ns1", ExternalId: "ns1-uid", ResourceType: string(ContainerProject),
+	}))
+	for _, policy := range []*containerinventory.ContainerIngressPolicy{
+		{DisplayName: "gw1", ExternalId: "gw1-uid", ContainerProjectId: "ns1-uid", ResourceType: string(ContainerIngressPolicy),
+			OriginProperties: []common.KeyValuePair{{Key: OriginPropertyKind, Value: "Gateway"}}},
+		{DisplayName: "route2", ExternalId: "route2-uid", ContainerProjectId: "ns1-uid", ResourceType: string(ContainerIngressPolicy),
+			OriginProperties: []common.KeyValuePair{{Key: OriginPropertyKind, Value: "GRPCRoute"}}},
+	} {
+		require.NoError(t, inventoryService.IngressPolicyStore.Add(policy))
+	}
+
+	// Only deletions are buffered, no request is sent to NSX.
+	inventoryService.NSXClient = nil
+	err := inventoryService.CleanStaleInventoryIngressPolicy()
+	assert.NoError(t, err)
+	assert.Contains(t, inventoryService.pendingDelete, "route2-uid")
+	assert.NotContains(t, inventoryService.pendingDelete, "gw1-uid")
+}
+
+func TestRouteReferences(t *testing.T) {
+	otherNamespace := gatewayv1.Namespace("ns2")
+	serviceKind := gatewayv1.Kind("Service")
+	otherKind := gatewayv1.Kind("ListenerSet")
+	otherGroup := gatewayv1.Group("example.com")
+	route := &gatewayv1.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tls1"},
+		Spec: gatewayv1.TLSRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{
+					{Name: "gw1"},
+					{Name: "gw2", Namespace: &otherNamespace},
+					{Name: "ls1", Kind: &otherKind},
+				},
+			},
+			Rules: []gatewayv1.TLSRouteRule{{
+				BackendRefs: []gatewayv1.BackendRef{
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc2", Namespace: &otherNamespace, Kind: &serviceKind}},
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc1"}},
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc1"}},
+					{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "bucket", Group: &otherGroup}},
+				},
+			}},
+		},
+	}
+	assert.Equal(t, []types.NamespacedName{{Namespace: "ns1", Name: "gw1"}, {Namespace: "ns2", Name: "gw2"}}, GetRouteParentGateways(route))
+	assert.Equal(t, []types.NamespacedName{{Namespace: "ns1", Name: "svc1"}, {Namespace: "ns2", Name: "svc2"}}, GetRouteBackendServices(route))
+}
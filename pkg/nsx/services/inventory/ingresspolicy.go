@@ -122,7 +122,7 @@ func (s *InventoryService) CleanStaleInventoryIngressPolicy() error {
 				log.Error(err, "Clean stale InventoryIngressPolicy", "External Id", ingress.ExternalId)
 				return err
 			}
-		} else if s.IsIngressDeleted(project.(*containerinventory.ContainerProject).DisplayName, ingress.DisplayName, ingress.ExternalId, nil) {
+		} else if s.isIngressPolicySourceDeleted(project.(*containerinventory.ContainerProject).DisplayName, ingress) {
 			log.Info("Clean stale InventoryIngressPolicy", "Name", ingress.DisplayName, "External Id", ingress.ExternalId)
 			err := s.DeleteResource(ingress.ExternalId, ContainerIngressPolicy)
 			if err != nil {
@@ -133,3 +133,12 @@ func (s *InventoryService) CleanStaleInventoryIngressPolicy() error {
 	}
 	return nil
 }
+
+// isIngressPolicySourceDeleted checks the Ingress, or the Gateway API object recorded in the
+// origin properties, the ContainerIngressPolicy was built from.
+func (s *InventoryService) isIngressPolicySourceDeleted(namespace string, ingressPolicy *containerinventory.ContainerIngressPolicy) bool {
+	if inventoryType, ok := gatewayAPIInventoryType(originPropertyValue(ingressPolicy.OriginProperties, OriginPropertyKind)); ok {
+		return s.IsGatewayAPIObjectDeleted(namespace, ingressPolicy.DisplayName, ingressPolicy.ExternalId, NewGatewayAPIObject(inventoryType))
+	}
+	return s.IsIngressDeleted(namespace, ingressPolicy.DisplayName, ingressPolicy.ExternalId, nil)
+}
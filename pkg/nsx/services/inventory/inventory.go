@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	commonservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	nsx_util "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
@@ -196,6 +197,11 @@ func (s *InventoryService) SyncInventoryObject(bufferedKeys sets.Set[InventoryKe
 			if retryKey != nil {
 				retryKeys.Insert(*retryKey)
 			}
+		case ContainerGateway, ContainerHTTPRoute, ContainerGRPCRoute, ContainerTLSRoute:
+			retryKey := s.SyncContainerGatewayAPIObject(name, namespace, key)
+			if retryKey != nil {
+				retryKeys.Insert(*retryKey)
+			}
 		case ContainerClusterNode:
 			retryKey := s.SyncContainerClusterNode(name, key)
 			if retryKey != nil {
@@ -209,7 +215,7 @@ func (s *InventoryService) SyncInventoryObject(bufferedKeys sets.Set[InventoryKe
 		}
 	}
 
-	err := s.sendNSXRequestAndUpdateInventoryStore(context.Background())
+	err := s.sendNSXRequestAndUpdateInventoryStore(ratelimiter.WithClass(context.Background(), ratelimiter.ClassBackground))
 	if err != nil {
 		return bufferedKeys, err
 	}
@@ -412,11 +418,22 @@ func (s *InventoryService) CleanupBeforeVPCDeletion(ctx context.Context) error {
 		log.Info("No inventory cluster found while cleanup inventory cluster", "count", 0)
 		return nil
 	}
+	// The inventory cluster is not in any Namespace or VPC, so it is kept in a scoped cleanup.
+	if commonservice.CleanupFilterFromContext(ctx).IsScoped() {
+		log.Info("Skipping inventory cluster cleanup in scoped cleanup")
+		return nil
+	}
 	cluster := clusters[0].(*containerinventory.ContainerCluster)
 	clusterID := cluster.ExternalId
 	clusterName := cluster.DisplayName
+	report := commonservice.CleanupReportFromContext(ctx)
+	if report.IsDryRun() {
+		report.Record(string(ContainerCluster), clusterID, "", nil)
+		return nil
+	}
 	log.Info("Attempting to delete inventory cluster", "clusterID", clusterID, "clusterName", clusterName)
 	err := s.DeleteContainerCluster(clusterID, ctx)
+	report.Record(string(ContainerCluster), clusterID, "", err)
 	if err != nil {
 		log.Error(err, "Failed to delete inventory cluster from NSX", "clusterID", clusterID, "clusterName", clusterName, "status", "failed")
 		return err
@@ -22,6 +22,13 @@ const (
 	// typically mapping to Kubernetes network policies.
 	ContainerNetworkPolicy InventoryType = "ContainerNetworkPolicy"
 	ContainerIngressPolicy InventoryType = "ContainerIngressPolicy"
+	// ContainerGateway, ContainerHTTPRoute, ContainerGRPCRoute and ContainerTLSRoute are the
+	// inventory keys for Gateway API resources. NSX has no dedicated inventory types for them,
+	// so they are reported as ContainerIngressPolicy objects with the kind in origin properties.
+	ContainerGateway   InventoryType = "ContainerGateway"
+	ContainerHTTPRoute InventoryType = "ContainerHTTPRoute"
+	ContainerGRPCRoute InventoryType = "ContainerGRPCRoute"
+	ContainerTLSRoute  InventoryType = "ContainerTLSRoute"
 
 	InventoryClusterTypeSupervisor = "SupervisorCluster"
 	InventoryClusterCNIType        = "NCP"
@@ -48,6 +55,10 @@ const (
 	InventoryStatusDown    = "DOWN"
 	InventoryStatusUnknown = "UNKNOWN"
 
+	// OriginPropertyKind is the origin property key carrying the Kubernetes kind
+	// of a ContainerIngressPolicy built from a Gateway API resource.
+	OriginPropertyKind = "kind"
+
 	NcpLbError        = "ncp/error.loadbalancer"
 	NcpLbPortError    = "ncp/error.loadbalancer.unrealized_ports"
 	NcpLbEpError      = "ncp/error.loadbalancer_endpoints"
@@ -116,6 +116,20 @@ func (service *IPAddressAllocationService) BuildIPAddressAllocation(obj metav1.O
 	return vpcIpAddressAllocation, nil
 }
 
+// buildAdditionalIPAddressAllocation builds an additional NSX IPAddressAllocation for the grown IPAddressAllocation CR.
+// It is tagged with the ID of the primary NSX IPAddressAllocation and allocated from the same IPBlocks.
+// The caller sets the ID and the allocation_size or allocation_ips.
+func (service *IPAddressAllocationService) buildAdditionalIPAddressAllocation(obj *v1alpha1.IPAddressAllocation, primary *model.VpcIpAddressAllocation) *model.VpcIpAddressAllocation {
+	tags := service.buildIPAddressAllocationTags(obj)
+	tags = append(tags, model.Tag{Scope: String(common.TagScopeIPAddressAllocationPrimary), Tag: String(*primary.Id)})
+	return &model.VpcIpAddressAllocation{
+		DisplayName:              String(service.buildIPAddressAllocationName(obj)),
+		Tags:                     tags,
+		IpAddressType:            primary.IpAddressType,
+		IpAddressBlockVisibility: primary.IpAddressBlockVisibility,
+	}
+}
+
 func (service *IPAddressAllocationService) BuildIPAddressAllocationID(obj metav1.Object) string {
 	return common.BuildUniqueIDWithRandomUUID(obj, util.GenerateIDByObject, service.allocationIdExists)
 }
@@ -1,7 +1,11 @@
 package ipaddressallocation
 
 import (
+	"context"
 	"fmt"
+	"math/bits"
+	"net"
+	"slices"
 	"sync"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -65,14 +69,26 @@ func InitializeIPAddressAllocation(service common.Service, vpcService common.VPC
 }
 
 func (service *IPAddressAllocationService) CreateOrUpdateIPAddressAllocation(obj *v1alpha1.IPAddressAllocation, restoreMode bool) (bool, error) {
-	nsxIPAddressAllocation, err := service.BuildIPAddressAllocation(obj, nil, restoreMode)
+	primary, updated, err := service.createOrUpdatePrimaryIPAddressAllocation(obj, restoreMode)
+	if err != nil {
+		return false, err
+	}
+	additionalUpdated, err := service.createAdditionalIPAddressAllocations(obj, primary, restoreMode)
 	if err != nil {
 		return false, err
 	}
+	return updated || additionalUpdated, nil
+}
+
+func (service *IPAddressAllocationService) createOrUpdatePrimaryIPAddressAllocation(obj *v1alpha1.IPAddressAllocation, restoreMode bool) (*model.VpcIpAddressAllocation, bool, error) {
+	nsxIPAddressAllocation, err := service.BuildIPAddressAllocation(obj, nil, restoreMode)
+	if err != nil {
+		return nil, false, err
+	}
 	existingIPAddressAllocation, err := service.indexedIPAddressAllocation(obj.UID)
 	if err != nil {
 		log.Error(err, "Failed to get ipaddressallocation", "UID", obj.UID)
-		return false, err
+		return nil, false, err
 	}
 	log.Debug("Existing ipaddressallocation", "ipaddressallocation", existingIPAddressAllocation)
 
@@ -88,6 +104,11 @@ func (service *IPAddressAllocationService) CreateOrUpdateIPAddressAllocation(obj
 			nsxIPAddressAllocation.Ipv6AllocationPrefixLength = nil
 			nsxIPAddressAllocation.AllocationIps = existingIPAddressAllocation.AllocationIps
 		}
+		if existingIPAddressAllocation.AllocationSize != nil && nsxIPAddressAllocation.AllocationSize != nil {
+			// The allocation_size of the existing NSX VPC IPAddressAllocation can not be modified,
+			// the increased allocationSize is allocated by the additional NSX VPC IPAddressAllocations.
+			nsxIPAddressAllocation.AllocationSize = existingIPAddressAllocation.AllocationSize
+		}
 		// Use the existing NSX resource's id and display_name.
 		nsxIPAddressAllocation.Id = String(*existingIPAddressAllocation.Id)
 		nsxIPAddressAllocation.DisplayName = String(*existingIPAddressAllocation.DisplayName)
@@ -101,39 +122,132 @@ func (service *IPAddressAllocationService) CreateOrUpdateIPAddressAllocation(obj
 		// we need to trigger the status update when IPAddressAllocation matching the spec exists.
 		if obj.Status.AllocationIPs != *existingIPAddressAllocation.AllocationIps {
 			obj.Status.AllocationIPs = *existingIPAddressAllocation.AllocationIps
-			return true, nil
+			return existingIPAddressAllocation, true, nil
 		}
-		return false, nil
+		return existingIPAddressAllocation, false, nil
 	}
 
 	if err := service.Apply(nsxIPAddressAllocation); err != nil {
-		return false, err
+		return nil, false, err
 	}
 
 	createdIPAddressAllocation, err := service.indexedIPAddressAllocation(obj.UID)
 	if err != nil {
 		log.Error(err, "Failed to get created ipaddressallocation", "UID", obj.UID)
-		return false, err
+		return nil, false, err
 	}
 	allocation_ips := createdIPAddressAllocation.AllocationIps
 	if allocation_ips == nil {
-		return false, fmt.Errorf("ipaddressallocation %s didn't realize available allocation_ips", obj.UID)
+		return nil, false, fmt.Errorf("ipaddressallocation %s didn't realize available allocation_ips", obj.UID)
 	}
 	if restoreMode {
 		if obj.Status.AllocationIPs == *allocation_ips {
 			log.Info("Successfully restored IPAddressAllocation CR", "Name", obj.Name, "Namespace", obj.Namespace)
-			return false, nil
+			return createdIPAddressAllocation, false, nil
 		} else {
 			err = fmt.Errorf("IP mismatches for the restored IPAddressAllocation CR %s: got %s, expecting %s", obj.GetUID(), *allocation_ips, obj.Status.AllocationIPs)
-			return false, err
+			return nil, false, err
 		}
 	}
 	if obj.Status.AllocationIPs != *allocation_ips {
 		log.Info("IPAddressAllocation IPs updated", "IPAddressAllocation", obj.UID, "OldIPs", obj.Status.AllocationIPs, "NewIPs", *allocation_ips)
 		obj.Status.AllocationIPs = *allocation_ips
-		return true, nil
+		return createdIPAddressAllocation, true, nil
+	}
+	return createdIPAddressAllocation, false, nil
+}
+
+// createAdditionalIPAddressAllocations creates the additional NSX VPC IPAddressAllocations when the allocationSize of
+// the IPAddressAllocation CR is increased, one for each power of 2 of the increase, and updates the CR status with their
+// allocation_ips. In restore mode, the additional NSX VPC IPAddressAllocations are recreated from the CR status.
+func (service *IPAddressAllocationService) createAdditionalIPAddressAllocations(obj *v1alpha1.IPAddressAllocation, primary *model.VpcIpAddressAllocation, restoreMode bool) (bool, error) {
+	additional := service.ipAddressAllocationStore.GetAdditionalByUID(obj.UID)
+	var nsxIPAddressAllocations []*model.VpcIpAddressAllocation
+	if restoreMode {
+		existingIPs := sets.New[string]()
+		for _, allocation := range additional {
+			if allocation.AllocationIps != nil {
+				existingIPs.Insert(*allocation.AllocationIps)
+			}
+		}
+		for _, allocationIPs := range obj.Status.AdditionalAllocationIPs {
+			if existingIPs.Has(allocationIPs) {
+				continue
+			}
+			nsxIPAddressAllocation := service.buildAdditionalIPAddressAllocation(obj, primary)
+			nsxIPAddressAllocation.AllocationIps = String(allocationIPs)
+			nsxIPAddressAllocations = append(nsxIPAddressAllocations, nsxIPAddressAllocation)
+		}
+	} else if obj.Spec.AllocationSize > 0 {
+		allocatedSize := allocationSizeOf(primary)
+		for _, allocation := range additional {
+			allocatedSize += allocationSizeOf(allocation)
+		}
+		for _, size := range splitAllocationSize(obj.Spec.AllocationSize - allocatedSize) {
+			nsxIPAddressAllocation := service.buildAdditionalIPAddressAllocation(obj, primary)
+			nsxIPAddressAllocation.AllocationSize = Int64(int64(size))
+			nsxIPAddressAllocations = append(nsxIPAddressAllocations, nsxIPAddressAllocation)
+		}
+	}
+	for _, nsxIPAddressAllocation := range nsxIPAddressAllocations {
+		// The ID is generated after the previous additional NSX VPC IPAddressAllocation is added to the store.
+		nsxIPAddressAllocation.Id = String(service.nextAdditionalIPAddressAllocationID(primary))
+		if err := service.Apply(nsxIPAddressAllocation); err != nil {
+			log.Error(err, "Failed to create additional ipaddressallocation", "IPAddressAllocation", obj.UID, "ID", *nsxIPAddressAllocation.Id)
+			return false, err
+		}
+		log.Info("Created additional ipaddressallocation", "IPAddressAllocation", obj.UID, "ID", *nsxIPAddressAllocation.Id)
+	}
+
+	var additionalAllocationIPs []string
+	for _, allocation := range service.ipAddressAllocationStore.GetAdditionalByUID(obj.UID) {
+		if allocation.AllocationIps != nil {
+			additionalAllocationIPs = append(additionalAllocationIPs, *allocation.AllocationIps)
+		}
+	}
+	if slices.Equal(obj.Status.AdditionalAllocationIPs, additionalAllocationIPs) {
+		return false, nil
+	}
+	log.Info("IPAddressAllocation additional IPs updated", "IPAddressAllocation", obj.UID, "OldIPs", obj.Status.AdditionalAllocationIPs, "NewIPs", additionalAllocationIPs)
+	obj.Status.AdditionalAllocationIPs = additionalAllocationIPs
+	return true, nil
+}
+
+// nextAdditionalIPAddressAllocationID returns the first unused ID of the form <primary ID>-<index>.
+func (service *IPAddressAllocationService) nextAdditionalIPAddressAllocationID(primary *model.VpcIpAddressAllocation) string {
+	for i := 1; ; i++ {
+		id := fmt.Sprintf("%s-%d", *primary.Id, i)
+		if !service.allocationIdExists(id) {
+			return id
+		}
 	}
-	return false, nil
+}
+
+// allocationSizeOf returns the number of IP addresses of the NSX VPC IPAddressAllocation. The restored
+// NSX VPC IPAddressAllocation has no allocation_size, so it is calculated from the allocation_ips.
+func allocationSizeOf(allocation *model.VpcIpAddressAllocation) int {
+	if allocation.AllocationSize != nil {
+		return int(*allocation.AllocationSize)
+	}
+	if allocation.AllocationIps == nil {
+		return 0
+	}
+	if _, ipNet, err := net.ParseCIDR(*allocation.AllocationIps); err == nil {
+		ones, bits := ipNet.Mask.Size()
+		return 1 << (bits - ones)
+	}
+	return 1
+}
+
+// splitAllocationSize splits size into powers of 2, the largest first, e.g. 48 -> [32, 16].
+func splitAllocationSize(size int) []int {
+	var sizes []int
+	for bit := bits.Len(uint(max(size, 0))) - 1; bit >= 0; bit-- {
+		if size&(1<<bit) != 0 {
+			sizes = append(sizes, 1<<bit)
+		}
+	}
+	return sizes
 }
 
 // CreateIPAddressAllocationForAddressBinding is only for the restore of external address binding.
@@ -173,7 +287,7 @@ func (service *IPAddressAllocationService) CreateIPAddressAllocationForAddressBi
 
 // DeleteIPAddressAllocationForAddressBinding is to delete the NSX IPAddressAllocation for the AddressBinding generated during restore mode.
 // It's only invoked when the AddressBinding CR or SubnetPort CR is deleted.
-func (service *IPAddressAllocationService) DeleteIPAddressAllocationForAddressBinding(owner metav1.Object) error {
+func (service *IPAddressAllocationService) DeleteIPAddressAllocationForAddressBinding(ctx context.Context, owner metav1.Object) error {
 	nsxIPAddressAllocation, err := service.GetIPAddressAllocationByOwner(owner)
 	if err != nil {
 		log.Error(err, "Failed to delete possible NSX IPAddressAllocation", "owner", owner)
@@ -183,19 +297,19 @@ func (service *IPAddressAllocationService) DeleteIPAddressAllocationForAddressBi
 		log.Debug("NSX IPAddressAllocation for AddressBinding not found", "owner", owner)
 		return nil
 	}
-	err = service.DeleteIPAddressAllocationByNSXResource(nsxIPAddressAllocation)
+	err = service.DeleteIPAddressAllocationByNSXResource(ctx, nsxIPAddressAllocation)
 	if err == nil {
 		log.Info("Successfully deleted NSX IPAddressAllocation", "nsxIPAddressAllocation", nsxIPAddressAllocation)
 	}
 	return err
 }
 
-func (service *IPAddressAllocationService) DeleteIPAddressAllocationByNSXResource(nsxIPAddressAllocation *model.VpcIpAddressAllocation) error {
+func (service *IPAddressAllocationService) DeleteIPAddressAllocationByNSXResource(ctx context.Context, nsxIPAddressAllocation *model.VpcIpAddressAllocation) error {
 	vpcResourceInfo, err := common.ParseVPCResourcePath(*nsxIPAddressAllocation.Path)
 	if err != nil {
 		return err
 	}
-	err = service.NSXClient.IPAddressAllocationClient.Delete(vpcResourceInfo.OrgID, vpcResourceInfo.ProjectID, vpcResourceInfo.VPCID, *nsxIPAddressAllocation.Id)
+	err = service.NSXClient.WithContext(ctx).IPAddressAllocationClient.Delete(vpcResourceInfo.OrgID, vpcResourceInfo.ProjectID, vpcResourceInfo.VPCID, *nsxIPAddressAllocation.Id)
 	if err != nil {
 		return err
 	}
@@ -240,16 +354,19 @@ func (service *IPAddressAllocationService) Apply(nsxIPAddressAllocation *model.V
 	return nil
 }
 
-func (service *IPAddressAllocationService) DeleteIPAddressAllocation(obj interface{}) error {
+func (service *IPAddressAllocationService) DeleteIPAddressAllocation(ctx context.Context, obj interface{}) error {
 	var err error
 	var nsxIPAddressAllocation *model.VpcIpAddressAllocation
+	var uid types.UID
 	switch o := obj.(type) {
 	case *v1alpha1.IPAddressAllocation:
+		uid = o.UID
 		nsxIPAddressAllocation, err = service.indexedIPAddressAllocation(o.UID)
 		if err != nil {
 			log.Error(err, "Failed to get ipaddressallocation", "IPAddressAllocation", o)
 		}
 	case types.UID:
+		uid = o
 		nsxIPAddressAllocation, err = service.indexedIPAddressAllocation(o)
 		if err != nil {
 			log.Error(err, "Failed to get ipaddressallocation by UID", "UID", o)
@@ -264,18 +381,26 @@ func (service *IPAddressAllocationService) DeleteIPAddressAllocation(obj interfa
 	case model.VpcIpAddressAllocation:
 		nsxIPAddressAllocation = &o
 	}
+	if uid != "" {
+		for _, additional := range service.ipAddressAllocationStore.GetAdditionalByUID(uid) {
+			if err := service.DeleteIPAddressAllocationByNSXResource(ctx, additional); err != nil {
+				log.Error(err, "Failed to delete additional ipaddressallocation", "ID", *additional.Id)
+				return err
+			}
+		}
+	}
 	if nsxIPAddressAllocation == nil {
 		log.Error(nil, "Failed to get ipaddressallocation from store, skip")
 		return nil
 	}
-	err = service.DeleteIPAddressAllocationByNSXResource(nsxIPAddressAllocation)
+	err = service.DeleteIPAddressAllocationByNSXResource(ctx, nsxIPAddressAllocation)
 	if err == nil {
 		log.Info("Successfully deleted nsxIPAddressAllocation", "nsxIPAddressAllocation", nsxIPAddressAllocation)
 	}
 	return err
 }
 
-func (service *IPAddressAllocationService) DeleteIPAddressAllocationByNamespacedName(namespace, name string) error {
+func (service *IPAddressAllocationService) DeleteIPAddressAllocationByNamespacedName(ctx context.Context, namespace, name string) error {
 	// NamespacedName is a unique identity in store as only one worker can deal with the NamespacedName at a time
 	allIPAddressAllocations := service.ipAddressAllocationStore.List()
 	var targetIPAddressAllocation *model.VpcIpAddressAllocation
@@ -298,7 +423,7 @@ func (service *IPAddressAllocationService) DeleteIPAddressAllocationByNamespaced
 
 		if namespaceMatch && nameMatch {
 			targetIPAddressAllocation = ipAddressAllocation
-			err := service.DeleteIPAddressAllocation(*targetIPAddressAllocation)
+			err := service.DeleteIPAddressAllocation(ctx, *targetIPAddressAllocation)
 			if err != nil {
 				log.Error(err, "Failed to delete IPAddressAllocation", "Namespace", namespace, "Name", name)
 				return err
@@ -148,14 +148,14 @@ func TestIPAddressAllocationService_DeleteIPAddressAllocation(t *testing.T) {
 
 	// no record found
 	mockVPCIPAddressAllocationclient.EXPECT().Delete(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Times(0)
-	err = returnservice.DeleteIPAddressAllocation(srObj)
+	err = returnservice.DeleteIPAddressAllocation(context.TODO(), srObj)
 	assert.Nil(t, err)
 
 	returnservice.ipAddressAllocationStore.Add(sr1)
 
 	// delete record
 	mockVPCIPAddressAllocationclient.EXPECT().Delete("default", "project-1", "vpc-1", id).Return(nil).Times(1)
-	err = returnservice.DeleteIPAddressAllocation(srObj)
+	err = returnservice.DeleteIPAddressAllocation(context.TODO(), srObj)
 	assert.Nil(t, err)
 	srs := returnservice.ipAddressAllocationStore.List()
 	assert.Equal(t, 0, len(srs))
@@ -645,7 +645,7 @@ func TestIPAddressAllocationService_DeleteIPAddressAllocation_Errors(t *testing.
 			return common.VPCResourceInfo{}, fmt.Errorf("parse error")
 		})
 
-	err = returnservice.DeleteIPAddressAllocation(id)
+	err = returnservice.DeleteIPAddressAllocation(context.TODO(), id)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "parse error")
 	patchParseVPCResourcePath.Reset()
@@ -654,7 +654,7 @@ func TestIPAddressAllocationService_DeleteIPAddressAllocation_Errors(t *testing.
 	mockVPCIPAddressAllocationclient.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(fmt.Errorf("delete error")).Times(1)
 
-	err = returnservice.DeleteIPAddressAllocation(id)
+	err = returnservice.DeleteIPAddressAllocation(context.TODO(), id)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "delete error")
 
@@ -678,20 +678,20 @@ func TestIPAddressAllocationService_DeleteIPAddressAllocationByNamespacedName(t
 
 	service.ipAddressAllocationStore.Add(sr1)
 	// Successful deletion
-	patches := gomonkey.ApplyFunc((*IPAddressAllocationService).DeleteIPAddressAllocation, func(service *IPAddressAllocationService, obj interface{}) error {
+	patches := gomonkey.ApplyFunc((*IPAddressAllocationService).DeleteIPAddressAllocation, func(service *IPAddressAllocationService, _ context.Context, obj interface{}) error {
 		ipAddressAllocation, ok := obj.(model.VpcIpAddressAllocation)
 		assert.True(t, ok)
 		assert.Equal(t, id, *ipAddressAllocation.Id)
 		return nil
 	})
-	err := service.DeleteIPAddressAllocationByNamespacedName("ns-1", "ipa-1")
+	err := service.DeleteIPAddressAllocationByNamespacedName(context.TODO(), "ns-1", "ipa-1")
 	assert.Nil(t, err)
 	patches.Reset()
 	// failed deletion
-	patches = gomonkey.ApplyFunc((*IPAddressAllocationService).DeleteIPAddressAllocation, func(service *IPAddressAllocationService, obj interface{}) error {
+	patches = gomonkey.ApplyFunc((*IPAddressAllocationService).DeleteIPAddressAllocation, func(service *IPAddressAllocationService, _ context.Context, obj interface{}) error {
 		return fmt.Errorf("delete error")
 	})
-	err = service.DeleteIPAddressAllocationByNamespacedName("ns-1", "ipa-1")
+	err = service.DeleteIPAddressAllocationByNamespacedName(context.TODO(), "ns-1", "ipa-1")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "delete error")
 	patches.Reset()
@@ -811,3 +811,91 @@ func TestIPAddressAllocationService_CreateIPAddressAllocationForAddressBinding(t
 	assert.Nil(t, err)
 	patches.Reset()
 }
+
+func TestSplitAllocationSize(t *testing.T) {
+	assert.Nil(t, splitAllocationSize(0))
+	assert.Nil(t, splitAllocationSize(-16))
+	assert.Equal(t, []int{16}, splitAllocationSize(16))
+	assert.Equal(t, []int{32, 16}, splitAllocationSize(48))
+	assert.Equal(t, []int{64, 4, 1}, splitAllocationSize(69))
+}
+
+func TestAllocationSizeOf(t *testing.T) {
+	assert.Equal(t, 16, allocationSizeOf(&model.VpcIpAddressAllocation{AllocationSize: Int64(16), AllocationIps: String("10.0.0.0/24")}))
+	assert.Equal(t, 256, allocationSizeOf(&model.VpcIpAddressAllocation{AllocationIps: String("10.0.0.0/24")}))
+	assert.Equal(t, 1, allocationSizeOf(&model.VpcIpAddressAllocation{AllocationIps: String("10.0.0.4")}))
+	assert.Equal(t, 0, allocationSizeOf(&model.VpcIpAddressAllocation{}))
+}
+
+func TestIPAddressAllocationService_GrowIPAddressAllocation(t *testing.T) {
+	service, mockController, mockVPCIPAddressAllocationclient := createIPAddressAllocationService(t)
+	defer mockController.Finish()
+	service.VPCService = &vpc.VPCService{}
+
+	vpcPath := "/orgs/default/projects/project-1/vpcs/vpc-1"
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&service.Service), "GetNamespaceUID", func(s *common.Service, ns string) types.UID {
+		return "nsUuid"
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(service.VPCService), "ListVPCInfo", func(_ *vpc.VPCService, ns string) []common.VPCResourceInfo {
+		return []common.VPCResourceInfo{{OrgID: "default", ProjectID: "project-1", VPCID: "vpc-1", ID: "vpc-1"}}
+	})
+
+	ipa := &v1alpha1.IPAddressAllocation{
+		ObjectMeta: v1.ObjectMeta{Name: "ipa", Namespace: "ns-1", UID: "ipa-uid"},
+		Spec: v1alpha1.IPAddressAllocationSpec{
+			AllocationSize:           64,
+			IPAddressBlockVisibility: "Private",
+		},
+		Status: v1alpha1.IPAddressAllocationStatus{AllocationIPs: "10.0.0.0/28"},
+	}
+	primary := &model.VpcIpAddressAllocation{
+		Id:                       String("ipa_primary"),
+		DisplayName:              String("ipa"),
+		Path:                     String(vpcPath + "/ip-address-allocations/ipa_primary"),
+		Tags:                     service.buildIPAddressAllocationTags(ipa),
+		AllocationSize:           Int64(16),
+		AllocationIps:            String("10.0.0.0/28"),
+		IpAddressBlockVisibility: String("PRIVATE"),
+		IpAddressType:            String(model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4),
+	}
+	assert.NoError(t, service.ipAddressAllocationStore.Apply(primary))
+
+	allocatedIPs := map[int64]string{32: "10.0.1.0/27", 16: "10.0.2.0/28"}
+	patched := map[string]model.VpcIpAddressAllocation{}
+	mockVPCIPAddressAllocationclient.EXPECT().Patch("default", "project-1", "vpc-1", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_, _, _, id string, alloc model.VpcIpAddressAllocation) error {
+			alloc.AllocationIps = String(allocatedIPs[*alloc.AllocationSize])
+			alloc.Path = String(vpcPath + "/ip-address-allocations/" + id)
+			patched[id] = alloc
+			return nil
+		}).Times(2)
+	mockVPCIPAddressAllocationclient.EXPECT().Get("default", "project-1", "vpc-1", gomock.Any()).DoAndReturn(
+		func(_, _, _, id string) (model.VpcIpAddressAllocation, error) {
+			return patched[id], nil
+		}).Times(2)
+
+	updated, err := service.CreateOrUpdateIPAddressAllocation(ipa, false)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, []string{"10.0.1.0/27", "10.0.2.0/28"}, ipa.Status.AdditionalAllocationIPs)
+	assert.Equal(t, int64(32), *patched["ipa_primary-1"].AllocationSize)
+	assert.Equal(t, int64(16), *patched["ipa_primary-2"].AllocationSize)
+	assert.Equal(t, []string{"ipa_primary"}, filterTag(patched["ipa_primary-1"].Tags, common.TagScopeIPAddressAllocationPrimary))
+	// The primary NSX IPAddressAllocation is still returned by the CR UID.
+	got, err := service.ipAddressAllocationStore.GetByUID(ipa.UID)
+	assert.NoError(t, err)
+	assert.Equal(t, "ipa_primary", *got.Id)
+
+	// The allocation is not grown again.
+	updated, err = service.CreateOrUpdateIPAddressAllocation(ipa, false)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+
+	// The additional NSX IPAddressAllocations are deleted with the CR.
+	mockVPCIPAddressAllocationclient.EXPECT().Delete("default", "project-1", "vpc-1", "ipa_primary-1").Return(nil)
+	mockVPCIPAddressAllocationclient.EXPECT().Delete("default", "project-1", "vpc-1", "ipa_primary-2").Return(nil)
+	mockVPCIPAddressAllocationclient.EXPECT().Delete("default", "project-1", "vpc-1", "ipa_primary").Return(nil)
+	assert.NoError(t, service.DeleteIPAddressAllocation(context.TODO(), ipa))
+	assert.Empty(t, service.ipAddressAllocationStore.List())
+}
@@ -2,6 +2,8 @@ package ipaddressallocation
 
 import (
 	"errors"
+	"slices"
+	"sort"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"k8s.io/apimachinery/pkg/types"
@@ -116,6 +118,10 @@ func (ipAddressAllocationStore *IPAddressAllocationStore) GetByUID(uid types.UID
 		indexResults = append(indexResults, indexResult...)
 	}
 
+	// The additional NSX IPAddressAllocations created for a grown IPAddressAllocation CR are not returned.
+	indexResults = slices.DeleteFunc(indexResults, func(obj interface{}) bool {
+		return isAdditionalIPAddressAllocation(obj.(*model.VpcIpAddressAllocation))
+	})
 	if len(indexResults) > 0 {
 		t := indexResults[0].(*model.VpcIpAddressAllocation)
 		nsxIPAddressAllocation = t
@@ -126,6 +132,30 @@ func (ipAddressAllocationStore *IPAddressAllocationStore) GetByUID(uid types.UID
 	return nsxIPAddressAllocation, nil
 }
 
+// GetAdditionalByUID returns the additional NSX IPAddressAllocations of the IPAddressAllocation CR sorted by ID.
+func (ipAddressAllocationStore *IPAddressAllocationStore) GetAdditionalByUID(uid types.UID) []*model.VpcIpAddressAllocation {
+	objs, err := ipAddressAllocationStore.ResourceStore.ByIndex(common.TagScopeIPAddressAllocationCRUID, string(uid))
+	if err != nil {
+		log.Error(err, "Failed to get additional ipaddressallocations", "UID", string(uid))
+		return nil
+	}
+	var allocations []*model.VpcIpAddressAllocation
+	for _, obj := range objs {
+		allocation := obj.(*model.VpcIpAddressAllocation)
+		if isAdditionalIPAddressAllocation(allocation) {
+			allocations = append(allocations, allocation)
+		}
+	}
+	sort.Slice(allocations, func(i, j int) bool {
+		return *allocations[i].Id < *allocations[j].Id
+	})
+	return allocations
+}
+
+func isAdditionalIPAddressAllocation(allocation *model.VpcIpAddressAllocation) bool {
+	return len(filterTag(allocation.Tags, common.TagScopeIPAddressAllocationPrimary)) > 0
+}
+
 func (ipAddressAllocationStore *IPAddressAllocationStore) GetByVPCPath(vpcPath string) ([]*model.VpcIpAddressAllocation, error) {
 	objs, err := ipAddressAllocationStore.ResourceStore.ByIndex(common.IndexByVPCPathFuncKey, vpcPath)
 	if err != nil {
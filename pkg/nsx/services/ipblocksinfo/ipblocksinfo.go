@@ -23,7 +23,7 @@ import (
 
 var (
 	log                 = logger.Log
-	ipBlocksInfoCRDName = "ip-blocks-info"
+	ipBlocksInfoCRDName = common.IPBlocksInfoCRName
 	syncInterval        = 10 * time.Minute
 	retryInterval       = 30 * time.Second
 	updateLock          = &sync.Mutex{}
@@ -166,7 +166,7 @@ func (s *NSXServiceAccountService) CreateOrUpdateNSXServiceAccount(ctx context.C
 	obj.Status.Phase = v1alpha1.NSXServiceAccountPhaseRealized
 	obj.Status.Reason = "Success"
 	obj.Status.Conditions = GenerateNSXServiceAccountConditions(obj.Status.Conditions, obj.Generation, metav1.ConditionTrue, v1alpha1.ConditionReasonRealizationSuccess, "Success.")
-	obj.Status.NSXManagers = s.NSXConfig.NsxApiManagers
+	obj.Status.NSXManagers = s.NSXConfig.GetNSXManagers()
 	obj.Status.ClusterID = clusterId
 	obj.Status.ClusterName = normalizedClusterName
 	obj.Status.Secrets = []v1alpha1.NSXSecret{{
@@ -376,13 +376,13 @@ func (s *NSXServiceAccountService) DeleteNSXServiceAccount(ctx context.Context,
 	// delete PI
 	if piobj := s.PrincipalIdentityStore.GetByKey(normalizedClusterName); isDeletePI && (piobj != nil) {
 		pi := piobj.(*mpmodel.PrincipalIdentity)
-		if err := s.NSXClient.PrincipalIdentitiesClient.Delete(*pi.Id); err != nil {
+		if err := s.NSXClient.WithContext(ctx).PrincipalIdentitiesClient.Delete(*pi.Id); err != nil {
 			err = nsxutil.TransNSXApiError(err)
 			log.Error(err, "failed to delete", "PrincipalIdentity", *pi.Name)
 			return err
 		}
 		if pi.CertificateId != nil && *pi.CertificateId != "" {
-			if err := s.NSXClient.CertificatesClient.Delete(*pi.CertificateId); err != nil {
+			if err := s.NSXClient.WithContext(ctx).CertificatesClient.Delete(*pi.CertificateId); err != nil {
 				err = nsxutil.TransNSXApiError(err)
 				log.Error(err, "failed to delete", "PrincipalIdentity", *pi.Name, "Certificate", *pi.CertificateId)
 				return err
@@ -670,6 +670,8 @@ func (s *NSXServiceAccountService) CleanupBeforeVPCDeletion(ctx context.Context)
 	}
 	// Delete ClusterControlPlanes sequentially.
 	// We cannot use PagingUpdateResources to delete ClusterControlPlanes in a batch because we need to pass a cascade=true request parameter.
+	report := common.CleanupReportFromContext(ctx)
+	filter := common.CleanupFilterFromContext(ctx)
 	successCount := 0
 	for i := range ccpList {
 		ccp := ccpList[i].(*model.ClusterControlPlane)
@@ -678,8 +680,20 @@ func (s *NSXServiceAccountService) CleanupBeforeVPCDeletion(ctx context.Context)
 		if ccp.DisplayName != nil {
 			ccpName = *ccp.DisplayName
 		}
+		var ccpPath string
+		if ccp.Path != nil {
+			ccpPath = *ccp.Path
+		}
+		if !filter.MatchResource(ccpPath, ccp.Tags) {
+			continue
+		}
+		if report.IsDryRun() {
+			report.Record(common.ResourceTypeClusterControlPlane, ccpID, ccpPath, nil)
+			continue
+		}
 		log.Info("Attempting to delete cluster control plane", "ccpID", ccpID, "ccpName", ccpName, "index", i+1, "total", len(ccpList))
 		err := s.DeleteClusterControlPlane(ctx, ccpID)
+		report.Record(common.ResourceTypeClusterControlPlane, ccpID, ccpPath, err)
 		if err != nil {
 			log.Error(err, "Failed to delete cluster control plane from NSX", "ccpID", ccpID, "ccpName", ccpName, "status", "failed")
 			return err
@@ -699,6 +713,6 @@ func (s *NSXServiceAccountService) CleanupBeforeVPCDeletion(ctx context.Context)
 func (s *NSXServiceAccountService) DeleteClusterControlPlane(ctx context.Context, ccpID string) error {
 	log.Info("Send request to NSX to delete cluster control plane", "ClusterControlPlane", ccpID)
 	cascade := true
-	err := s.NSXClient.ClusterControlPlanesClient.Delete(siteId, enforcementpointId, ccpID, &cascade)
+	err := s.NSXClient.WithContext(ctx).ClusterControlPlanesClient.Delete(siteId, enforcementpointId, ccpID, &cascade)
 	return err
 }
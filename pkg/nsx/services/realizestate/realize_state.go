@@ -4,16 +4,19 @@
 package realizestate
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var log = logger.Log
@@ -31,13 +34,22 @@ func InitializeRealizeState(service common.Service) *RealizeStateService {
 // CheckRealizeState allows the caller to check realize status of intentPath with retries.
 // Backoff defines the maximum retries and the wait interval between two retries.
 // Check all the entities, all entities should be in the REALIZED state to be treated as REALIZED
-func (service *RealizeStateService) CheckRealizeState(backoff wait.Backoff, intentPath string, extraIds []string) error {
+// The polling is traced in a child span of ctx recording the number of polls.
+func (service *RealizeStateService) CheckRealizeState(ctx context.Context, backoff wait.Backoff, intentPath string, extraIds []string) (err error) {
+	ctx, span := tracing.Start(ctx, "realizestate.CheckRealizeState", attribute.String("nsx.intent_path", intentPath))
+	polls := 0
+	defer func() {
+		span.SetAttributes(attribute.Int("nsx.realize_polls", polls))
+		tracing.EndSpan(span, err)
+	}()
+	realizedEntitiesClient := service.NSXClient.WithContext(ctx).RealizedEntitiesClient
 	// TODO， ask NSX if there were multiple realize states could we check only the latest one?
 	return retry.OnError(backoff, func(err error) bool {
 		// Won't retry when realized state is `ERROR`.
 		return !nsxutil.IsRealizeStateError(err)
 	}, func() error {
-		results, err := service.NSXClient.RealizedEntitiesClient.List(intentPath, nil)
+		polls++
+		results, err := realizedEntitiesClient.List(intentPath, nil)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			return err
@@ -1,21 +1,34 @@
 package realizestate
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
-
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 type fakeRealizedEntitiesClient struct{}
@@ -84,14 +97,14 @@ func TestRealizeStateService_CheckRealizeState(t *testing.T) {
 		Steps:    6,
 	}
 	// default project
-	err := s.CheckRealizeState(backoff, "/orgs/default/projects/default/vpcs/vpc/subnets/subnet/ports/port", []string{})
+	err := s.CheckRealizeState(context.TODO(), backoff, "/orgs/default/projects/default/vpcs/vpc/subnets/subnet/ports/port", []string{})
 
 	realizeStateError, ok := err.(*nsxutil.RealizeStateError)
 	assert.True(t, ok)
 	assert.Equal(t, realizeStateError.Error(), "/orgs/default/projects/default/vpcs/vpc/subnets/subnet/ports/port realized with errors: [mocked error]")
 
 	// non default project
-	err = s.CheckRealizeState(backoff, "/orgs/default/projects/project-quality/vpcs/vpc/subnets/subnet/ports/port", []string{})
+	err = s.CheckRealizeState(context.TODO(), backoff, "/orgs/default/projects/project-quality/vpcs/vpc/subnets/subnet/ports/port", []string{})
 
 	realizeStateError, ok = err.(*nsxutil.RealizeStateError)
 	assert.True(t, ok)
@@ -117,7 +130,7 @@ func TestRealizeStateService_CheckRealizeState(t *testing.T) {
 			},
 		}, nil
 	})
-	err = s.CheckRealizeState(backoff, "/orgs/default/projects/project-quality/vpcs/vpc", []string{common.GatewayInterfaceId})
+	err = s.CheckRealizeState(context.TODO(), backoff, "/orgs/default/projects/project-quality/vpcs/vpc", []string{common.GatewayInterfaceId})
 	assert.Equal(t, err, nil)
 
 	// for lbs, realized with ProviderNotReady and need retry
@@ -148,7 +161,7 @@ func TestRealizeStateService_CheckRealizeState(t *testing.T) {
 		Jitter:   0,
 		Steps:    1,
 	}
-	err = s.CheckRealizeState(backoff, "/orgs/default/projects/default/vpcs/vpc/vpc-lbs/default", []string{})
+	err = s.CheckRealizeState(context.TODO(), backoff, "/orgs/default/projects/default/vpcs/vpc/vpc-lbs/default", []string{})
 	assert.NotEqual(t, err, nil)
 	_, ok = err.(*nsxutil.RetryRealizeError)
 	assert.Equal(t, ok, true)
@@ -181,7 +194,7 @@ func TestRealizeStateService_CheckRealizeState(t *testing.T) {
 			},
 		}, nil
 	})
-	err = s.CheckRealizeState(backoff, "/orgs/default/projects/project-quality/vpcs/vpc/subnets/subnet/", []string{})
+	err = s.CheckRealizeState(context.TODO(), backoff, "/orgs/default/projects/project-quality/vpcs/vpc/subnets/subnet/", []string{})
 
 	realizeStateError, ok = err.(*nsxutil.RealizeStateError)
 	assert.True(t, ok)
@@ -211,7 +224,7 @@ func TestRealizeStateService_CheckRealizeState(t *testing.T) {
 			},
 		}, nil
 	})
-	err = s.CheckRealizeState(backoff, "/orgs/default/projects/project-quality/vpcs/vpc/subnets/subnet/", []string{})
+	err = s.CheckRealizeState(context.TODO(), backoff, "/orgs/default/projects/project-quality/vpcs/vpc/subnets/subnet/", []string{})
 	assert.Equal(t, err, nil)
 
 	// for subnet, need retry
@@ -244,7 +257,7 @@ func TestRealizeStateService_CheckRealizeState(t *testing.T) {
 		Jitter:   0,
 		Steps:    1,
 	}
-	err = s.CheckRealizeState(backoff, "/orgs/default/projects/project-quality/vpcs/vpc/subnets/subnet/", []string{})
+	err = s.CheckRealizeState(context.TODO(), backoff, "/orgs/default/projects/project-quality/vpcs/vpc/subnets/subnet/", []string{})
 	assert.NotEqual(t, err, nil)
 	_, ok = err.(*nsxutil.RealizeStateError)
 	assert.Equal(t, ok, false)
@@ -277,7 +290,7 @@ func TestRealizeStateService_CheckRealizeState(t *testing.T) {
 		Jitter:   0,
 		Steps:    1,
 	}
-	err = s.CheckRealizeState(backoff, "/orgs/default/projects/default/vpcs/vpc/vpc-lbs/default", []string{})
+	err = s.CheckRealizeState(context.TODO(), backoff, "/orgs/default/projects/default/vpcs/vpc/vpc-lbs/default", []string{})
 	assert.NotEqual(t, err, nil)
 	realizedError, ok := err.(*nsxutil.RealizeStateError)
 	assert.Equal(t, ok, true)
@@ -314,7 +327,7 @@ func TestRealizeStateService_CheckRealizeState(t *testing.T) {
 		Jitter:   0,
 		Steps:    1,
 	}
-	err = s.CheckRealizeState(backoff, "/orgs/default/projects/project-quality/vpcs/vpc", []string{})
+	err = s.CheckRealizeState(context.TODO(), backoff, "/orgs/default/projects/project-quality/vpcs/vpc", []string{})
 
 	realizeStateError, ok = err.(*nsxutil.RealizeStateError)
 	assert.True(t, ok)
@@ -323,6 +336,55 @@ func TestRealizeStateService_CheckRealizeState(t *testing.T) {
 	patches.Reset()
 }
 
+func TestRealizeStateService_CheckRealizeStateTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	}()
+
+	var traceParent string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "realized-state") {
+			traceParent = r.Header.Get("traceparent")
+			w.Write([]byte(`{"results": [{"id": "vpc", "state": "REALIZED"}]}`))
+			return
+		}
+		w.Write([]byte(`{"healthy" : true}`))
+	}))
+	defer ts.Close()
+	nsxConfig := nsx.NewConfig(strings.TrimPrefix(ts.URL, "https://"), "admin", "passw0rd", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.AIMD, nil, nil, []string{})
+	cluster, err := nsx.NewCluster(nsxConfig)
+	require.NoError(t, err)
+	s := &RealizeStateService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{Cluster: cluster, RealizedEntitiesClient: realized_state.NewRealizedEntitiesClient(cluster.NewRestConnector())},
+		},
+	}
+
+	r := tracing.NewReconciler("NetworkInfo", reconcile.Func(func(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+		return ctrl.Result{}, s.CheckRealizeState(ctx, wait.Backoff{Duration: 10 * time.Millisecond, Factor: 1, Steps: 1}, "/orgs/default/projects/default/vpcs/vpc", []string{})
+	}))
+	_, err = r.Reconcile(context.Background(), ctrl.Request{})
+	require.NoError(t, err)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	reconcileSpan, realizeSpan, roundTripSpan := spans["NetworkInfo.Reconcile"], spans["realizestate.CheckRealizeState"], spans["nsx.RoundTrip"]
+	require.NotNil(t, reconcileSpan)
+	require.NotNil(t, realizeSpan)
+	require.NotNil(t, roundTripSpan)
+	assert.Equal(t, reconcileSpan.SpanContext().SpanID(), realizeSpan.Parent().SpanID())
+	assert.Equal(t, realizeSpan.SpanContext().SpanID(), roundTripSpan.Parent().SpanID())
+	assert.Equal(t, reconcileSpan.SpanContext().TraceID(), roundTripSpan.SpanContext().TraceID())
+	assert.Contains(t, traceParent, roundTripSpan.SpanContext().SpanID().String())
+}
+
 func TestGetPolicyInterfaceIPs(t *testing.T) {
 	realizedPath := "/orgs/default/projects/default/realized-state/enforcement-points/default/vpcs/vpc1/gateway-interface"
 
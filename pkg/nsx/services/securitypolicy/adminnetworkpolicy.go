@@ -0,0 +1,411 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// The NetworkPolicies and SecurityPolicy CRs are realized in the default Application category of NSX DFW. The
+// AdminNetworkPolicies are realized in the Environment category which is enforced before the Application category,
+// and the BaselineAdminNetworkPolicy is realized in the Application category after the NetworkPolicy sections.
+const (
+	securityPolicyCategoryEnvironment = "Environment"
+	securityPolicyCategoryApplication = "Application"
+
+	// nsxRuleActionJumpToApplication skips the remaining rules in the Environment category.
+	nsxRuleActionJumpToApplication = "JUMP_TO_APPLICATION"
+
+	adminNetworkPolicySuffix         = "anp"
+	baselineAdminNetworkPolicySuffix = "banp"
+	adminNetworkPolicyConnector      = "."
+)
+
+var ruleActionPass = v1alpha1.RuleAction(policyv1alpha1.AdminNetworkPolicyRuleActionPass)
+
+func getSecurityPolicyCategory(createdFor string) string {
+	switch createdFor {
+	case common.ResourceTypeAdminNetworkPolicy:
+		return securityPolicyCategoryEnvironment
+	case common.ResourceTypeBaselineAdminNetworkPolicy:
+		return securityPolicyCategoryApplication
+	default:
+		return ""
+	}
+}
+
+func getAdminNetworkPolicySuffix(createdFor string) string {
+	if createdFor == common.ResourceTypeBaselineAdminNetworkPolicy {
+		return baselineAdminNetworkPolicySuffix
+	}
+	return adminNetworkPolicySuffix
+}
+
+// BuildAdminNetworkPolicyID returns the UID of the internal SecurityPolicy created for an AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy in one of its subject Namespaces, in the format of ${CR.UID}.${Namespace}_anp or
+// ${CR.UID}.${Namespace}_banp.
+func (service *SecurityPolicyService) BuildAdminNetworkPolicyID(uid, namespace, createdFor string) string {
+	return strings.Join([]string{uid + adminNetworkPolicyConnector + namespace, getAdminNetworkPolicySuffix(createdFor)}, common.ConnectorUnderline)
+}
+
+// ParseAdminNetworkPolicyID returns the CR UID, the Namespace and the resource type of the CR from the internal
+// SecurityPolicy UID built by BuildAdminNetworkPolicyID.
+func ParseAdminNetworkPolicyID(id string) (string, string, string) {
+	spUID, suffix := parseSuffixInUid(types.UID(id))
+	createdFor := common.ResourceTypeAdminNetworkPolicy
+	if suffix == baselineAdminNetworkPolicySuffix {
+		createdFor = common.ResourceTypeBaselineAdminNetworkPolicy
+	}
+	uid, namespace, _ := strings.Cut(spUID, adminNetworkPolicyConnector)
+	return uid, namespace, createdFor
+}
+
+func convertAdminNetworkPolicyRuleAction(action string) (v1alpha1.RuleAction, error) {
+	switch action {
+	case string(policyv1alpha1.AdminNetworkPolicyRuleActionAllow):
+		return v1alpha1.RuleActionAllow, nil
+	case string(policyv1alpha1.AdminNetworkPolicyRuleActionDeny):
+		return v1alpha1.RuleActionDrop, nil
+	case string(policyv1alpha1.AdminNetworkPolicyRuleActionPass):
+		return ruleActionPass, nil
+	default:
+		return "", &nsxutil.ValidationError{Desc: fmt.Sprintf("unsupported AdminNetworkPolicy rule action %s", action)}
+	}
+}
+
+func convertAdminNetworkPolicyPeer(namespaces *metav1.LabelSelector, pods *policyv1alpha1.NamespacedPod) (*v1alpha1.SecurityPolicyPeer, error) {
+	if namespaces != nil && pods == nil {
+		return &v1alpha1.SecurityPolicyPeer{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{},
+			},
+			NamespaceSelector: namespaces,
+		}, nil
+	} else if namespaces == nil && pods != nil {
+		return &v1alpha1.SecurityPolicyPeer{
+			PodSelector:       &pods.PodSelector,
+			NamespaceSelector: &pods.NamespaceSelector,
+		}, nil
+	}
+	return nil, &nsxutil.ValidationError{Desc: "exactly one of namespaces and pods must be set in AdminNetworkPolicy peer"}
+}
+
+func convertAdminNetworkPolicyEgressPeer(peer *policyv1alpha1.AdminNetworkPolicyEgressPeer) (*v1alpha1.SecurityPolicyPeer, error) {
+	if peer.Nodes != nil {
+		return nil, &nsxutil.ValidationError{Desc: "nodes peer is not supported in AdminNetworkPolicy"}
+	}
+	if len(peer.Networks) > 0 {
+		if peer.Namespaces != nil || peer.Pods != nil {
+			return nil, &nsxutil.ValidationError{Desc: "networks peer can not be set with namespaces or pods in AdminNetworkPolicy"}
+		}
+		spPeer := &v1alpha1.SecurityPolicyPeer{}
+		for _, cidr := range peer.Networks {
+			spPeer.IPBlocks = append(spPeer.IPBlocks, v1alpha1.IPBlock{CIDR: string(cidr)})
+		}
+		return spPeer, nil
+	}
+	return convertAdminNetworkPolicyPeer(peer.Namespaces, peer.Pods)
+}
+
+func convertAdminNetworkPolicyPorts(ports *[]policyv1alpha1.AdminNetworkPolicyPort) ([]v1alpha1.SecurityPolicyPort, error) {
+	if ports == nil {
+		return nil, nil
+	}
+	var spPorts []v1alpha1.SecurityPolicyPort
+	for _, port := range *ports {
+		switch {
+		case port.PortNumber != nil:
+			spPorts = append(spPorts, v1alpha1.SecurityPolicyPort{
+				Protocol: port.PortNumber.Protocol,
+				Port:     intstr.FromInt32(port.PortNumber.Port),
+			})
+		case port.PortRange != nil:
+			spPorts = append(spPorts, v1alpha1.SecurityPolicyPort{
+				Protocol: port.PortRange.Protocol,
+				Port:     intstr.FromInt32(port.PortRange.Start),
+				EndPort:  int(port.PortRange.End),
+			})
+		case port.NamedPort != nil:
+			return nil, &nsxutil.ValidationError{Desc: fmt.Sprintf("named port %s is not supported in AdminNetworkPolicy", *port.NamedPort)}
+		}
+	}
+	return spPorts, nil
+}
+
+// adminNetworkPolicyRuleType is the ingress or egress rule type of AdminNetworkPolicy or BaselineAdminNetworkPolicy.
+type adminNetworkPolicyRuleType interface {
+	policyv1alpha1.AdminNetworkPolicyIngressRule | policyv1alpha1.AdminNetworkPolicyEgressRule |
+		policyv1alpha1.BaselineAdminNetworkPolicyIngressRule | policyv1alpha1.BaselineAdminNetworkPolicyEgressRule
+}
+
+// adminNetworkPolicyRule holds the fields shared by the rule types of AdminNetworkPolicy and
+// BaselineAdminNetworkPolicy, which only differ in the type of the action.
+type adminNetworkPolicyRule struct {
+	name      string
+	action    string
+	direction v1alpha1.RuleDirection
+	from      []policyv1alpha1.AdminNetworkPolicyIngressPeer
+	to        []policyv1alpha1.AdminNetworkPolicyEgressPeer
+	ports     *[]policyv1alpha1.AdminNetworkPolicyPort
+}
+
+func toAdminNetworkPolicyRule[R adminNetworkPolicyRuleType](rule *R) adminNetworkPolicyRule {
+	switch r := any(rule).(type) {
+	case *policyv1alpha1.AdminNetworkPolicyIngressRule:
+		return adminNetworkPolicyRule{name: r.Name, action: string(r.Action), direction: v1alpha1.RuleDirectionIn, from: r.From, ports: r.Ports}
+	case *policyv1alpha1.AdminNetworkPolicyEgressRule:
+		return adminNetworkPolicyRule{name: r.Name, action: string(r.Action), direction: v1alpha1.RuleDirectionOut, to: r.To, ports: r.Ports}
+	case *policyv1alpha1.BaselineAdminNetworkPolicyIngressRule:
+		return adminNetworkPolicyRule{name: r.Name, action: string(r.Action), direction: v1alpha1.RuleDirectionIn, from: r.From, ports: r.Ports}
+	case *policyv1alpha1.BaselineAdminNetworkPolicyEgressRule:
+		return adminNetworkPolicyRule{name: r.Name, action: string(r.Action), direction: v1alpha1.RuleDirectionOut, to: r.To, ports: r.Ports}
+	}
+	return adminNetworkPolicyRule{}
+}
+
+func buildAdminNetworkPolicyRule(anpRule adminNetworkPolicyRule) (*v1alpha1.SecurityPolicyRule, error) {
+	ruleAction, err := convertAdminNetworkPolicyRuleAction(anpRule.action)
+	if err != nil {
+		return nil, err
+	}
+	spPorts, err := convertAdminNetworkPolicyPorts(anpRule.ports)
+	if err != nil {
+		return nil, err
+	}
+	rule := &v1alpha1.SecurityPolicyRule{
+		Name:      anpRule.name,
+		Action:    &ruleAction,
+		Direction: &anpRule.direction,
+		Ports:     spPorts,
+	}
+	if anpRule.direction == v1alpha1.RuleDirectionIn {
+		rule.From, err = convertAdminNetworkPolicyIngressPeers(anpRule.from)
+	} else {
+		rule.To, err = convertAdminNetworkPolicyEgressPeers(anpRule.to)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func convertAdminNetworkPolicyIngressPeers(from []policyv1alpha1.AdminNetworkPolicyIngressPeer) ([]v1alpha1.SecurityPolicyPeer, error) {
+	var peers []v1alpha1.SecurityPolicyPeer
+	for _, p := range from {
+		peer, err := convertAdminNetworkPolicyPeer(p.Namespaces, p.Pods)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, *peer)
+	}
+	return peers, nil
+}
+
+func convertAdminNetworkPolicyEgressPeers(to []policyv1alpha1.AdminNetworkPolicyEgressPeer) ([]v1alpha1.SecurityPolicyPeer, error) {
+	var peers []v1alpha1.SecurityPolicyPeer
+	for i := range to {
+		peer, err := convertAdminNetworkPolicyEgressPeer(&to[i])
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, *peer)
+	}
+	return peers, nil
+}
+
+// convertAdminNetworkPolicyRules converts the ingress and egress rules of AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy to the SecurityPolicy spec with the given priority. The rules are evaluated in order in
+// the NSX SecurityPolicy.
+func convertAdminNetworkPolicyRules[I, E adminNetworkPolicyRuleType](priority int, ingress []I, egress []E) (*v1alpha1.SecurityPolicySpec, error) {
+	spec := &v1alpha1.SecurityPolicySpec{Priority: priority}
+	anpRules := make([]adminNetworkPolicyRule, 0, len(ingress)+len(egress))
+	for i := range ingress {
+		anpRules = append(anpRules, toAdminNetworkPolicyRule(&ingress[i]))
+	}
+	for i := range egress {
+		anpRules = append(anpRules, toAdminNetworkPolicyRule(&egress[i]))
+	}
+	for _, anpRule := range anpRules {
+		rule, err := buildAdminNetworkPolicyRule(anpRule)
+		if err != nil {
+			return nil, err
+		}
+		spec.Rules = append(spec.Rules, *rule)
+	}
+	return spec, nil
+}
+
+// convertAdminNetworkPolicySpec converts the AdminNetworkPolicy rules to the SecurityPolicy rules, the
+// AdminNetworkPolicy priority is used as the sequence number of the NSX SecurityPolicy in the Environment category.
+func convertAdminNetworkPolicySpec(anp *policyv1alpha1.AdminNetworkPolicy) (*v1alpha1.SecurityPolicySpec, error) {
+	return convertAdminNetworkPolicyRules(int(anp.Spec.Priority), anp.Spec.Ingress, anp.Spec.Egress)
+}
+
+// convertBaselineAdminNetworkPolicySpec converts the BaselineAdminNetworkPolicy rules to the SecurityPolicy rules,
+// the NSX SecurityPolicy is placed after the NetworkPolicy sections in the Application category.
+func convertBaselineAdminNetworkPolicySpec(banp *policyv1alpha1.BaselineAdminNetworkPolicy) (*v1alpha1.SecurityPolicySpec, error) {
+	return convertAdminNetworkPolicyRules(common.PriorityBaselineAdminNetworkPolicy, banp.Spec.Ingress, banp.Spec.Egress)
+}
+
+// listSubjectNamespaces returns the VPC Namespaces selected by the AdminNetworkPolicy subject, the selected system
+// Namespaces where the policy is not enforced, and the Pod selector to apply the policy in the Namespaces.
+func (service *SecurityPolicyService) listSubjectNamespaces(subject *policyv1alpha1.AdminNetworkPolicySubject) ([]string, []string, *metav1.LabelSelector, error) {
+	var nsSelector, podSelector *metav1.LabelSelector
+	if subject.Namespaces != nil && subject.Pods == nil {
+		nsSelector = subject.Namespaces
+		podSelector = &metav1.LabelSelector{}
+	} else if subject.Namespaces == nil && subject.Pods != nil {
+		nsSelector = &subject.Pods.NamespaceSelector
+		podSelector = &subject.Pods.PodSelector
+	} else {
+		return nil, nil, nil, &nsxutil.ValidationError{Desc: "exactly one of namespaces and pods must be set in AdminNetworkPolicy subject"}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(nsSelector)
+	if err != nil {
+		return nil, nil, nil, &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid namespace selector in AdminNetworkPolicy subject: %v", err)}
+	}
+	nsList := &v1.NamespaceList{}
+	if err := service.Client.List(context.Background(), nsList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		log.Error(err, "Failed to list Namespaces for AdminNetworkPolicy subject", "selector", selector.String())
+		return nil, nil, nil, err
+	}
+
+	var namespaces, skippedNamespaces []string
+	for i := range nsList.Items {
+		ns := &nsList.Items[i]
+		if !ns.DeletionTimestamp.IsZero() || !config.IsVPCNamespace(ns) {
+			continue
+		}
+		if isSysNs, err := util.IsSystemNamespace(nil, "", ns, true); err != nil {
+			return nil, nil, nil, err
+		} else if isSysNs {
+			log.Debug("Skip system Namespace for AdminNetworkPolicy subject", "namespace", ns.Name)
+			skippedNamespaces = append(skippedNamespaces, ns.Name)
+			continue
+		}
+		namespaces = append(namespaces, ns.Name)
+	}
+	sort.Strings(namespaces)
+	sort.Strings(skippedNamespaces)
+	return namespaces, skippedNamespaces, podSelector, nil
+}
+
+// ListSkippedSubjectNamespaces returns the system Namespaces selected by the AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy subject, the policy is not enforced in them.
+func (service *SecurityPolicyService) ListSkippedSubjectNamespaces(subject *policyv1alpha1.AdminNetworkPolicySubject) ([]string, error) {
+	_, skippedNamespaces, _, err := service.listSubjectNamespaces(subject)
+	return skippedNamespaces, err
+}
+
+// listAdminNetworkPolicyIDsByCRUID returns the UIDs of the internal SecurityPolicies created for the CR.
+func (service *SecurityPolicyService) listAdminNetworkPolicyIDsByCRUID(uid, createdFor string) sets.Set[string] {
+	ids := sets.New[string]()
+	for id := range service.ListAdminNetworkPolicyID(createdFor) {
+		if crUID, _, _ := ParseAdminNetworkPolicyID(id); crUID == uid {
+			ids.Insert(id)
+		}
+	}
+	return ids
+}
+
+// createOrUpdateAdminNetworkPolicy realizes the AdminNetworkPolicy or BaselineAdminNetworkPolicy as one NSX
+// SecurityPolicy in the VPC of each subject Namespace, and deletes the NSX SecurityPolicies in the Namespaces which
+// are no longer selected by the subject.
+func (service *SecurityPolicyService) createOrUpdateAdminNetworkPolicy(ctx context.Context, obj metav1.Object, subject *policyv1alpha1.AdminNetworkPolicySubject,
+	spec *v1alpha1.SecurityPolicySpec, createdFor string,
+) error {
+	namespaces, _, podSelector, err := service.listSubjectNamespaces(subject)
+	if err != nil {
+		return err
+	}
+
+	expectedIDs := sets.New[string]()
+	var pendingNamespaces []string
+	for _, ns := range namespaces {
+		uid := service.BuildAdminNetworkPolicyID(string(obj.GetUID()), ns, createdFor)
+		expectedIDs.Insert(uid)
+		if len(service.vpcService.ListVPCInfo(ns)) == 0 {
+			log.Info("VPC of the Namespace is not ready, retry AdminNetworkPolicy later", "namespace", ns, "name", obj.GetName(), "createdFor", createdFor)
+			pendingNamespaces = append(pendingNamespaces, ns)
+			continue
+		}
+		internalSecurityPolicy := &v1alpha1.SecurityPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      obj.GetName(),
+				UID:       types.UID(uid),
+			},
+			Spec: *spec.DeepCopy(),
+		}
+		internalSecurityPolicy.Spec.AppliedTo = []v1alpha1.SecurityPolicyTarget{
+			{
+				PodSelector: podSelector,
+			},
+		}
+		if err := service.createOrUpdateVPCSecurityPolicy(ctx, internalSecurityPolicy, createdFor); err != nil {
+			return err
+		}
+	}
+
+	staleIDs := service.listAdminNetworkPolicyIDsByCRUID(string(obj.GetUID()), createdFor).Difference(expectedIDs)
+	for id := range staleIDs {
+		log.Info("Deleting NSX SecurityPolicy for the Namespace not selected by subject", "nsxSecurityPolicyUID", id, "createdFor", createdFor)
+		if err := service.deleteVPCSecurityPolicy(ctx, types.UID(id), false, createdFor); err != nil {
+			return err
+		}
+	}
+
+	if len(pendingNamespaces) > 0 {
+		return fmt.Errorf("VPC is not ready for Namespaces %v", pendingNamespaces)
+	}
+	return nil
+}
+
+// ListAdminNetworkPolicyID returns the UIDs of the internal SecurityPolicies created for the AdminNetworkPolicies or
+// the BaselineAdminNetworkPolicies.
+func (service *SecurityPolicyService) ListAdminNetworkPolicyID(createdFor string) sets.Set[string] {
+	ids := sets.New[string]()
+	for id := range service.getGCSecurityPolicyIDSet(common.TagScopeAdminNetworkPolicyUID) {
+		if _, _, idCreatedFor := ParseAdminNetworkPolicyID(id); idCreatedFor == createdFor {
+			ids.Insert(id)
+		}
+	}
+	return ids
+}
+
+// ListAdminNetworkPolicyByName returns the NSX SecurityPolicies created for the AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy with the given name.
+func (service *SecurityPolicyService) ListAdminNetworkPolicyByName(name, createdFor string) []*model.SecurityPolicy {
+	var result []*model.SecurityPolicy
+	for _, obj := range service.securityPolicyStore.List() {
+		securityPolicy := obj.(*model.SecurityPolicy)
+		if nsxutil.FindTag(securityPolicy.Tags, common.TagScopeAdminNetworkPolicyName) != name {
+			continue
+		}
+		uid := nsxutil.FindTag(securityPolicy.Tags, common.TagScopeAdminNetworkPolicyUID)
+		if _, _, idCreatedFor := ParseAdminNetworkPolicyID(uid); idCreatedFor == createdFor {
+			result = append(result, securityPolicy)
+		}
+	}
+	return result
+}
@@ -0,0 +1,361 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/mock"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+func Test_BuildAndParseAdminNetworkPolicyID(t *testing.T) {
+	s := &SecurityPolicyService{}
+	id := s.BuildAdminNetworkPolicyID("uid1", "ns1", common.ResourceTypeAdminNetworkPolicy)
+	assert.Equal(t, "uid1.ns1_anp", id)
+	uid, ns, createdFor := ParseAdminNetworkPolicyID(id)
+	assert.Equal(t, "uid1", uid)
+	assert.Equal(t, "ns1", ns)
+	assert.Equal(t, common.ResourceTypeAdminNetworkPolicy, createdFor)
+
+	id = s.BuildAdminNetworkPolicyID("uid2", "ns2", common.ResourceTypeBaselineAdminNetworkPolicy)
+	assert.Equal(t, "uid2.ns2_banp", id)
+	uid, ns, createdFor = ParseAdminNetworkPolicyID(id)
+	assert.Equal(t, "uid2", uid)
+	assert.Equal(t, "ns2", ns)
+	assert.Equal(t, common.ResourceTypeBaselineAdminNetworkPolicy, createdFor)
+}
+
+func Test_getSecurityPolicyCategory(t *testing.T) {
+	assert.Equal(t, securityPolicyCategoryEnvironment, getSecurityPolicyCategory(common.ResourceTypeAdminNetworkPolicy))
+	assert.Equal(t, securityPolicyCategoryApplication, getSecurityPolicyCategory(common.ResourceTypeBaselineAdminNetworkPolicy))
+	assert.Equal(t, "", getSecurityPolicyCategory(common.ResourceTypeNetworkPolicy))
+	assert.Equal(t, "", getSecurityPolicyCategory(common.ResourceTypeSecurityPolicy))
+}
+
+func Test_getRuleActionPass(t *testing.T) {
+	rule := &v1alpha1.SecurityPolicyRule{Action: &ruleActionPass}
+	action, err := getRuleAction(rule, common.ResourceTypeAdminNetworkPolicy)
+	assert.NoError(t, err)
+	assert.Equal(t, nsxRuleActionJumpToApplication, action)
+
+	_, err = getRuleAction(rule, common.ResourceTypeBaselineAdminNetworkPolicy)
+	assert.Error(t, err)
+	_, err = getRuleAction(rule, common.ResourceTypeSecurityPolicy)
+	assert.Error(t, err)
+}
+
+func Test_convertAdminNetworkPolicySpec(t *testing.T) {
+	port := int32(80)
+	namedPort := "http"
+	nsSelector := metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	podSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}
+
+	tests := []struct {
+		name     string
+		anp      *policyv1alpha1.AdminNetworkPolicy
+		wantSpec *v1alpha1.SecurityPolicySpec
+		wantErr  bool
+	}{
+		{
+			name: "ingress and egress rules",
+			anp: &policyv1alpha1.AdminNetworkPolicy{
+				Spec: policyv1alpha1.AdminNetworkPolicySpec{
+					Priority: 10,
+					Ingress: []policyv1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Name:   "allow-from-team-a",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionAllow,
+							From:   []policyv1alpha1.AdminNetworkPolicyIngressPeer{{Namespaces: &nsSelector}},
+							Ports: &[]policyv1alpha1.AdminNetworkPolicyPort{
+								{PortNumber: &policyv1alpha1.Port{Protocol: corev1.ProtocolTCP, Port: port}},
+							},
+						},
+					},
+					Egress: []policyv1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Name:   "pass-to-db",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionPass,
+							To: []policyv1alpha1.AdminNetworkPolicyEgressPeer{
+								{Pods: &policyv1alpha1.NamespacedPod{NamespaceSelector: nsSelector, PodSelector: podSelector}},
+							},
+							Ports: &[]policyv1alpha1.AdminNetworkPolicyPort{
+								{PortRange: &policyv1alpha1.PortRange{Protocol: corev1.ProtocolUDP, Start: 1000, End: 2000}},
+							},
+						},
+						{
+							Name:   "deny-to-networks",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionDeny,
+							To: []policyv1alpha1.AdminNetworkPolicyEgressPeer{
+								{Networks: []policyv1alpha1.CIDR{"10.0.0.0/8", "192.168.0.0/16"}},
+							},
+						},
+					},
+				},
+			},
+			wantSpec: &v1alpha1.SecurityPolicySpec{
+				Priority: 10,
+				Rules: []v1alpha1.SecurityPolicyRule{
+					{
+						Name:      "allow-from-team-a",
+						Action:    &allowAction,
+						Direction: &directionIn,
+						From: []v1alpha1.SecurityPolicyPeer{
+							{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{}}, NamespaceSelector: &nsSelector},
+						},
+						Ports: []v1alpha1.SecurityPolicyPort{{Protocol: corev1.ProtocolTCP, Port: intstr.FromInt32(port)}},
+					},
+					{
+						Name:      "pass-to-db",
+						Action:    &ruleActionPass,
+						Direction: &directionOut,
+						To: []v1alpha1.SecurityPolicyPeer{
+							{PodSelector: &podSelector, NamespaceSelector: &nsSelector},
+						},
+						Ports: []v1alpha1.SecurityPolicyPort{{Protocol: corev1.ProtocolUDP, Port: intstr.FromInt32(1000), EndPort: 2000}},
+					},
+					{
+						Name:      "deny-to-networks",
+						Action:    &allowDrop,
+						Direction: &directionOut,
+						To: []v1alpha1.SecurityPolicyPeer{
+							{IPBlocks: []v1alpha1.IPBlock{{CIDR: "10.0.0.0/8"}, {CIDR: "192.168.0.0/16"}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "nodes peer is not supported",
+			anp: &policyv1alpha1.AdminNetworkPolicy{
+				Spec: policyv1alpha1.AdminNetworkPolicySpec{
+					Egress: []policyv1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionDeny,
+							To:     []policyv1alpha1.AdminNetworkPolicyEgressPeer{{Nodes: &metav1.LabelSelector{}}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "named port is not supported",
+			anp: &policyv1alpha1.AdminNetworkPolicy{
+				Spec: policyv1alpha1.AdminNetworkPolicySpec{
+					Ingress: []policyv1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionAllow,
+							From:   []policyv1alpha1.AdminNetworkPolicyIngressPeer{{Namespaces: &nsSelector}},
+							Ports:  &[]policyv1alpha1.AdminNetworkPolicyPort{{NamedPort: &namedPort}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "peer without namespaces and pods",
+			anp: &policyv1alpha1.AdminNetworkPolicy{
+				Spec: policyv1alpha1.AdminNetworkPolicySpec{
+					Ingress: []policyv1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionAllow,
+							From:   []policyv1alpha1.AdminNetworkPolicyIngressPeer{{}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := convertAdminNetworkPolicySpec(tt.anp)
+			if tt.wantErr {
+				var validationErr *nsxutil.ValidationError
+				assert.ErrorAs(t, err, &validationErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSpec, spec)
+		})
+	}
+}
+
+func Test_convertBaselineAdminNetworkPolicySpec(t *testing.T) {
+	nsSelector := metav1.LabelSelector{}
+	banp := &policyv1alpha1.BaselineAdminNetworkPolicy{
+		Spec: policyv1alpha1.BaselineAdminNetworkPolicySpec{
+			Ingress: []policyv1alpha1.BaselineAdminNetworkPolicyIngressRule{
+				{
+					Name:   "default-deny",
+					Action: policyv1alpha1.BaselineAdminNetworkPolicyRuleActionDeny,
+					From:   []policyv1alpha1.AdminNetworkPolicyIngressPeer{{Namespaces: &nsSelector}},
+				},
+			},
+		},
+	}
+	spec, err := convertBaselineAdminNetworkPolicySpec(banp)
+	assert.NoError(t, err)
+	assert.Equal(t, common.PriorityBaselineAdminNetworkPolicy, spec.Priority)
+	assert.Len(t, spec.Rules, 1)
+	assert.Equal(t, allowDrop, *spec.Rules[0].Action)
+	assert.Equal(t, directionIn, *spec.Rules[0].Direction)
+}
+
+func Test_listSubjectNamespaces(t *testing.T) {
+	patches := gomonkey.ApplyFunc(config.IsVPCNamespace, func(_ *corev1.Namespace) bool {
+		return true
+	})
+	defer patches.Reset()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, corev1.AddToScheme(scheme))
+	now := metav1.Now()
+	objs := []runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns2", Labels: map[string]string{"team": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: map[string]string{"team": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns3", Labels: map[string]string{"team": "b"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "ns4", Labels: map[string]string{"team": "a"}, DeletionTimestamp: &now, Finalizers: []string{"test"},
+		}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "kube-system", Labels: map[string]string{"team": "a"}, Annotations: map[string]string{common.AnnotationSharedVPCNamespace: "kube-system"},
+		}},
+	}
+	s := fakeSecurityPolicyService()
+	s.Client = fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+
+	podSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	namespaces, skipped, selector, err := s.listSubjectNamespaces(&policyv1alpha1.AdminNetworkPolicySubject{
+		Pods: &policyv1alpha1.NamespacedPod{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			PodSelector:       podSelector,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns1", "ns2"}, namespaces)
+	assert.Equal(t, []string{"kube-system"}, skipped)
+	assert.Equal(t, &podSelector, selector)
+
+	skipped, err = s.ListSkippedSubjectNamespaces(&policyv1alpha1.AdminNetworkPolicySubject{
+		Namespaces: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"kube-system"}, skipped)
+
+	namespaces, skipped, selector, err = s.listSubjectNamespaces(&policyv1alpha1.AdminNetworkPolicySubject{
+		Namespaces: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns3"}, namespaces)
+	assert.Empty(t, skipped)
+	assert.Equal(t, &metav1.LabelSelector{}, selector)
+
+	_, _, _, err = s.listSubjectNamespaces(&policyv1alpha1.AdminNetworkPolicySubject{})
+	var validationErr *nsxutil.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func Test_createOrUpdateAdminNetworkPolicy(t *testing.T) {
+	s := fakeSecurityPolicyService()
+	s.NSXConfig.EnableVPCNetwork = true
+	mockVPCService := &mock.MockVPCServiceProvider{}
+	mockVPCService.On("ListVPCInfo", "ns1").Return([]common.VPCResourceInfo{{OrgID: "default", ProjectID: "p1", VPCID: "vpc1"}})
+	mockVPCService.On("ListVPCInfo", "ns2").Return([]common.VPCResourceInfo{})
+	s.vpcService = mockVPCService
+	s.setUpStore(common.TagValueScopeSecurityPolicyUID, false)
+
+	// The NSX SecurityPolicy in ns3 is stale since ns3 is no longer selected by the subject.
+	s.securityPolicyStore.Apply(&model.SecurityPolicy{
+		Id: common.String("anp1-stale"),
+		Tags: []model.Tag{
+			{Scope: util.Ptr(common.TagScopeAdminNetworkPolicyName), Tag: util.Ptr("anp1")},
+			{Scope: util.Ptr(common.TagScopeAdminNetworkPolicyUID), Tag: util.Ptr("uid1.ns3_anp")},
+		},
+	})
+
+	createdUIDs := sets.New[string]()
+	deletedUIDs := sets.New[string]()
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(s), "listSubjectNamespaces",
+		func(_ *SecurityPolicyService, _ *policyv1alpha1.AdminNetworkPolicySubject) ([]string, []string, *metav1.LabelSelector, error) {
+			return []string{"ns1", "ns2"}, nil, &metav1.LabelSelector{}, nil
+		})
+	patches.ApplyPrivateMethod(reflect.TypeOf(s), "createOrUpdateVPCSecurityPolicy",
+		func(_ *SecurityPolicyService, _ context.Context, obj *v1alpha1.SecurityPolicy, createdFor string) error {
+			assert.Equal(t, common.ResourceTypeAdminNetworkPolicy, createdFor)
+			assert.Equal(t, "anp1", obj.Name)
+			assert.Len(t, obj.Spec.AppliedTo, 1)
+			createdUIDs.Insert(string(obj.UID))
+			return nil
+		})
+	patches.ApplyPrivateMethod(reflect.TypeOf(s), "deleteVPCSecurityPolicy",
+		func(_ *SecurityPolicyService, _ context.Context, uid types.UID, isGC bool, createdFor string) error {
+			deletedUIDs.Insert(string(uid))
+			return nil
+		})
+	defer patches.Reset()
+
+	anp := &policyv1alpha1.AdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "anp1", UID: "uid1"},
+	}
+	err := s.createOrUpdateAdminNetworkPolicy(context.TODO(), anp, &anp.Spec.Subject, &v1alpha1.SecurityPolicySpec{}, common.ResourceTypeAdminNetworkPolicy)
+	assert.ErrorContains(t, err, "ns2")
+	assert.Equal(t, sets.New[string]("uid1.ns1_anp"), createdUIDs)
+	assert.Equal(t, sets.New[string]("uid1.ns3_anp"), deletedUIDs)
+}
+
+func Test_ListAdminNetworkPolicy(t *testing.T) {
+	s := fakeSecurityPolicyService()
+	s.NSXConfig.EnableVPCNetwork = true
+	s.setUpStore(common.TagValueScopeSecurityPolicyUID, false)
+
+	for _, sp := range []struct{ id, name, uid string }{
+		{"sp1", "anp1", "uid1.ns1_anp"},
+		{"sp2", "anp1", "uid1.ns2_anp"},
+		{"sp3", "anp1", "uid3.ns1_banp"},
+		{"sp4", "anp2", "uid2.ns1_anp"},
+	} {
+		s.securityPolicyStore.Apply(&model.SecurityPolicy{
+			Id: common.String(sp.id),
+			Tags: []model.Tag{
+				{Scope: util.Ptr(common.TagScopeAdminNetworkPolicyName), Tag: util.Ptr(sp.name)},
+				{Scope: util.Ptr(common.TagScopeAdminNetworkPolicyUID), Tag: util.Ptr(sp.uid)},
+			},
+		})
+	}
+	s.securityPolicyStore.Apply(&model.SecurityPolicy{
+		Id: common.String("sp5"),
+		Tags: []model.Tag{
+			{Scope: util.Ptr(common.TagScopeNetworkPolicyName), Tag: util.Ptr("anp1")},
+			{Scope: util.Ptr(common.TagScopeNetworkPolicyUID), Tag: util.Ptr("uid5_allow")},
+		},
+	})
+
+	assert.Equal(t, sets.New[string]("uid1.ns1_anp", "uid1.ns2_anp", "uid2.ns1_anp"), s.ListAdminNetworkPolicyID(common.ResourceTypeAdminNetworkPolicy))
+	assert.Equal(t, sets.New[string]("uid3.ns1_banp"), s.ListAdminNetworkPolicyID(common.ResourceTypeBaselineAdminNetworkPolicy))
+	assert.Equal(t, sets.New[string]("uid1.ns1_anp", "uid1.ns2_anp"), s.listAdminNetworkPolicyIDsByCRUID("uid1", common.ResourceTypeAdminNetworkPolicy))
+
+	assert.Len(t, s.ListAdminNetworkPolicyByName("anp1", common.ResourceTypeAdminNetworkPolicy), 2)
+	assert.Len(t, s.ListAdminNetworkPolicyByName("anp1", common.ResourceTypeBaselineAdminNetworkPolicy), 1)
+	assert.Len(t, s.ListAdminNetworkPolicyByName("nonexistent", common.ResourceTypeAdminNetworkPolicy), 0)
+}
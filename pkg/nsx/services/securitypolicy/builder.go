@@ -53,11 +53,21 @@ func (service *SecurityPolicyService) buildSecurityPolicyName(obj *v1alpha1.Secu
 	return util.GenerateTruncName(common.MaxNameLength, obj.Name, "", "", "", "")
 }
 
-func (service *SecurityPolicyService) buildSecurityPolicyIDAndName(obj *v1alpha1.SecurityPolicy, createdFor string) (string, string) {
-	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
-		indexScope = common.TagScopeNetworkPolicyUID
+// getIndexScope returns the tag scope of the CR UID which the NSX resources are created for, it is also the key of
+// the store indexer to get the NSX resources by the CR UID.
+func getIndexScope(createdFor string) string {
+	switch createdFor {
+	case common.ResourceTypeNetworkPolicy:
+		return common.TagScopeNetworkPolicyUID
+	case common.ResourceTypeAdminNetworkPolicy, common.ResourceTypeBaselineAdminNetworkPolicy:
+		return common.TagScopeAdminNetworkPolicyUID
+	default:
+		return common.TagValueScopeSecurityPolicyUID
 	}
+}
+
+func (service *SecurityPolicyService) buildSecurityPolicyIDAndName(obj *v1alpha1.SecurityPolicy, createdFor string) (string, string) {
+	indexScope := getIndexScope(createdFor)
 	existingSecurityPolicies := service.securityPolicyStore.GetByIndex(indexScope, string(obj.GetUID()))
 	if len(existingSecurityPolicies) > 0 {
 		policy := existingSecurityPolicies[0]
@@ -103,17 +113,18 @@ func getRuleDestinationPeers(rule *v1alpha1.SecurityPolicyRule) []v1alpha1.Secur
 	return rule.Destinations //nolint:staticcheck
 }
 
-func (service *SecurityPolicyService) buildSecurityPolicy(obj *v1alpha1.SecurityPolicy, createdFor string, vpcInfo *common.VPCResourceInfo, isDefaultProject bool) (*model.SecurityPolicy, *[]model.Group, *[]GroupShare, error) {
+func (service *SecurityPolicyService) buildSecurityPolicy(obj *v1alpha1.SecurityPolicy, createdFor string, vpcInfo *common.VPCResourceInfo, isDefaultProject bool) (*model.SecurityPolicy, *[]model.Group, *[]GroupShare, *[]model.PolicyContextProfile, error) {
 	var nsxRules []model.Rule
 	var nsxGroups []model.Group
 	var nsxShareGroups []model.Group
 	var nsxShares []model.Share
 	var nsxGroupShares []GroupShare
+	var nsxContextProfiles []model.PolicyContextProfile
 
 	log.Debug("Building the model SecurityPolicy from CR SecurityPolicy", "object", *obj)
 	if IsVPCEnabled(service) {
 		if vpcInfo == nil {
-			return nil, nil, nil, fmt.Errorf("vpcInfo is nil when building SecurityPolicy %s", obj.GetName())
+			return nil, nil, nil, nil, fmt.Errorf("vpcInfo is nil when building SecurityPolicy %s", obj.GetName())
 		}
 	}
 
@@ -125,11 +136,14 @@ func (service *SecurityPolicyService) buildSecurityPolicy(obj *v1alpha1.Security
 	nsxSecurityPolicy.DisplayName = String(policyName)
 	// TODO: confirm the sequence number: offset
 	nsxSecurityPolicy.SequenceNumber = Int64(int64(obj.Spec.Priority))
+	if category := getSecurityPolicyCategory(createdFor); category != "" {
+		nsxSecurityPolicy.Category = String(category)
+	}
 
 	policyGroup, policyGroupPath, err := service.buildPolicyGroup(obj, createdFor, vpcInfo)
 	if err != nil {
 		log.Error(err, "Failed to build policy group", "policy", *obj)
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	nsxSecurityPolicy.Scope = []string{policyGroupPath}
@@ -140,10 +154,13 @@ func (service *SecurityPolicyService) buildSecurityPolicy(obj *v1alpha1.Security
 	for ruleIdx, r := range obj.Spec.Rules {
 		rule := r
 		// A rule containing named port may be expanded to multiple rules if the named ports map to multiple port numbers.
-		expandRules, buildGroups, buildGroupShares, err := service.buildRuleAndGroups(obj, &rule, ruleIdx, createdFor, policyGroupPath, vpcInfo, isDefaultProject)
+		expandRules, buildGroups, buildGroupShares, buildContextProfile, err := service.buildRuleAndGroups(obj, &rule, ruleIdx, createdFor, policyGroupPath, vpcInfo, isDefaultProject)
 		if err != nil {
 			log.Error(err, "Failed to build rule and groups", "rule", rule, "ruleIndex", ruleIdx)
-			return nil, nil, nil, err
+			return nil, nil, nil, nil, &RuleError{Index: ruleIdx, Err: err}
+		}
+		if buildContextProfile != nil {
+			nsxContextProfiles = append(nsxContextProfiles, *buildContextProfile)
 		}
 
 		for _, nsxRule := range expandRules {
@@ -182,9 +199,9 @@ func (service *SecurityPolicyService) buildSecurityPolicy(obj *v1alpha1.Security
 	nsxSecurityPolicy.Tags = tags
 	// nsxRules info are included in nsxSecurityPolicy obj
 	log.Info("Built nsxSecurityPolicy", "nsxSecurityPolicy", nsxSecurityPolicy, "nsxGroups", nsxGroups,
-		"nsxShareGroups", nsxShareGroups, "nsxShares", nsxShares)
+		"nsxShareGroups", nsxShareGroups, "nsxShares", nsxShares, "nsxContextProfiles", nsxContextProfiles)
 
-	return nsxSecurityPolicy, &nsxGroups, &nsxGroupShares, nil
+	return nsxSecurityPolicy, &nsxGroups, &nsxGroupShares, &nsxContextProfiles, nil
 }
 
 func (service *SecurityPolicyService) buildPolicyGroup(obj *v1alpha1.SecurityPolicy, createdFor string, vpcInfo *common.VPCResourceInfo) (*model.Group, string, error) {
@@ -290,11 +307,13 @@ func (service *SecurityPolicyService) buildTargetTags(obj *v1alpha1.SecurityPoli
 
 func (service *SecurityPolicyService) buildBasicTags(obj *v1alpha1.SecurityPolicy, createdFor string) []model.Tag {
 	scopeOwnerName := common.TagValueScopeSecurityPolicyName
-	scopeOwnerUID := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
+	switch createdFor {
+	case common.ResourceTypeNetworkPolicy:
 		scopeOwnerName = common.TagScopeNetworkPolicyName
-		scopeOwnerUID = common.TagScopeNetworkPolicyUID
+	case common.ResourceTypeAdminNetworkPolicy, common.ResourceTypeBaselineAdminNetworkPolicy:
+		scopeOwnerName = common.TagScopeAdminNetworkPolicyName
 	}
+	scopeOwnerUID := getIndexScope(createdFor)
 
 	tags := util.BuildBasicTags(getCluster(service), obj, service.Service.GetNamespaceUID(obj.ObjectMeta.Namespace))
 	tags = append(tags, []model.Tag{
@@ -463,7 +482,7 @@ func (service *SecurityPolicyService) buildAppliedGroupName(obj *v1alpha1.Securi
 
 func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityPolicy, rule *v1alpha1.SecurityPolicyRule,
 	ruleIdx int, createdFor string, policyGroupPath string, vpcInfo *common.VPCResourceInfo, isDefaultProject bool,
-) ([]*model.Rule, []*model.Group, []*GroupShare, error) {
+) ([]*model.Rule, []*model.Group, []*GroupShare, *model.PolicyContextProfile, error) {
 	var ruleGroups []*model.Group
 	var nsxRuleAppliedGroup *model.Group
 	var nsxRuleSrcGroup *model.Group
@@ -477,15 +496,20 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 
 	ruleDirection, err := getRuleDirection(rule)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	// Since a named port may map to multiple port numbers, then it would return multiple rules.
 	// We use the destination port number of service entry to group the rules.
 	ruleBaseID := service.buildRuleID(obj, ruleIdx, createdFor)
+	// The FQDN peers are validated before expanding the rule, and are realized as a context profile referred by the rule.
+	nsxContextProfile, err := service.buildRuleContextProfile(obj, rule, ruleIdx, ruleBaseID, createdFor, vpcInfo, isDefaultProject)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
 	ipSetGroups, nsxRules, err := service.expandRule(obj, rule, ruleIdx, ruleBaseID, createdFor, vpcInfo)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	ruleGroups = append(ruleGroups, ipSetGroups...)
 
@@ -495,7 +519,7 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 			nsxRuleSrcGroup, nsxRuleSrcGroupPath, nsxRuleDstGroupPath, nsxGroupShare, err = service.buildRuleInGroup(
 				obj, rule, nsxRule, ruleIdx, ruleBaseID, createdFor, vpcInfo, isDefaultProject)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, nil, err
 			}
 
 			if nsxRuleSrcGroup != nil {
@@ -505,7 +529,7 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 			nsxRuleDstGroup, nsxRuleSrcGroupPath, nsxRuleDstGroupPath, nsxGroupShare, err = service.buildRuleOutGroup(
 				obj, rule, nsxRule, ruleIdx, ruleBaseID, createdFor, vpcInfo, isDefaultProject)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, nil, err
 			}
 
 			if nsxRuleDstGroup != nil {
@@ -522,12 +546,22 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 		nsxRuleAppliedGroup, nsxRuleAppliedGroupPath, err = service.buildRuleAppliedToGroup(
 			obj, rule, ruleIdx, nsxRuleSrcGroupPath, nsxRuleDstGroupPath, createdFor, policyGroupPath, ruleBaseID, vpcInfo)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, nil, err
 		}
 		ruleGroups = append(ruleGroups, nsxRuleAppliedGroup)
 		nsxRule.Scope = []string{nsxRuleAppliedGroupPath}
+		if nsxContextProfile != nil {
+			nsxRule.Profiles = []string{*nsxContextProfile.Path}
+		}
+	}
+	// The FQDN allow rule, which is never expanded since named ports are not supported with FQDN peers,
+	// needs a DNS snooping rule before it to resolve the domain names.
+	if nsxContextProfile != nil && len(nsxRules) > 0 {
+		if dnsRule := service.buildDNSSnoopingRule(nsxRules[0], ruleBaseID); dnsRule != nil {
+			nsxRules = append([]*model.Rule{dnsRule}, nsxRules...)
+		}
 	}
-	return nsxRules, ruleGroups, nsxGroupShares, nil
+	return nsxRules, ruleGroups, nsxGroupShares, nsxContextProfile, nil
 }
 
 func buildRuleServiceEntries(port v1alpha1.SecurityPolicyPort) *data.StructValue {
@@ -623,6 +657,9 @@ func (service *SecurityPolicyService) buildRuleOutGroup(obj *v1alpha1.SecurityPo
 	var err error
 	if len(nsxRule.DestinationGroups) > 0 {
 		nsxRuleDstGroupPath = nsxRule.DestinationGroups[0]
+	} else if ruleHasFQDNPeers(rule) {
+		// The destinations are matched by the FQDN context profile of the rule.
+		nsxRuleDstGroupPath = "ANY"
 	} else {
 		destinations := getRuleDestinationPeers(rule)
 		if len(destinations) > 0 {
@@ -690,7 +727,7 @@ func (service *SecurityPolicyService) buildRuleDisplayName(rule *v1alpha1.Securi
 	if err != nil {
 		return "", err
 	}
-	ruleAction, err := getRuleAction(rule)
+	ruleAction, err := getRuleAction(rule, createdFor)
 	if err != nil {
 		return "", err
 	}
@@ -702,6 +739,8 @@ func (service *SecurityPolicyService) buildRuleDisplayName(rule *v1alpha1.Securi
 		ruleAct = common.RuleActionDrop
 	case util.ToUpper(v1alpha1.RuleActionReject):
 		ruleAct = common.RuleActionReject
+	case nsxRuleActionJumpToApplication:
+		ruleAct = common.RuleActionPass
 	}
 	ruleDir := common.RuleEgress
 	if ruleDirection == "IN" {
@@ -1033,7 +1072,7 @@ func (service *SecurityPolicyService) dedupBlocks(rulePeers []v1alpha1.SecurityP
 func (service *SecurityPolicyService) buildRuleBasicInfo(obj *v1alpha1.SecurityPolicy, rule *v1alpha1.SecurityPolicyRule, ruleIdx int,
 	ruleBaseID, createdFor string, namedPortInfo *portInfo,
 ) (*model.Rule, error) {
-	ruleAction, err := getRuleAction(rule)
+	ruleAction, err := getRuleAction(rule, createdFor)
 	if err != nil {
 		return nil, err
 	}
@@ -1046,6 +1085,8 @@ func (service *SecurityPolicyService) buildRuleBasicInfo(obj *v1alpha1.SecurityP
 		log.Error(err, "Failed to build rule's display name", "securityPolicyUID", obj.UID, "rule", rule, "createdFor", createdFor)
 	}
 
+	logged, logLabel := getRuleLogging(obj, rule)
+
 	basicTags := service.buildBasicTags(obj, createdFor)
 	if IsVPCEnabled(service) {
 		ruleIndexHash := service.buildRuleHashString(&(obj.Spec.Rules[ruleIdx]))
@@ -1070,6 +1111,9 @@ func (service *SecurityPolicyService) buildRuleBasicInfo(obj *v1alpha1.SecurityP
 		Action:         &ruleAction,
 		Services:       []string{"ANY"},
 		Tags:           basicTags,
+		// Logged and Tag are always set, so that disabling the logging or removing the label is updated to NSX.
+		Logged: &logged,
+		Tag:    &logLabel,
 	}
 	log.Debug("Built rule basic info", "ruleBaseID", ruleBaseID, "nsxRule", nsxRule)
 	return &nsxRule, nil
@@ -2016,7 +2060,17 @@ func (service *SecurityPolicyService) buildRulePortsNumberString(ports []v1alpha
 }
 
 func (service *SecurityPolicyService) buildRuleHashString(rule *v1alpha1.SecurityPolicyRule) string {
-	serializedBytes, _ := json.Marshal(rule)
+	return RuleHash(rule)
+}
+
+// RuleHash returns the hash of the SecurityPolicy rule, which is tagged with nsx-op/rule_hash on the NSX rules in VPC
+// mode. It excludes the rule logging settings, so that the rule ID is kept and the NSX rule is updated in place when
+// the logging settings are changed.
+func RuleHash(rule *v1alpha1.SecurityPolicyRule) string {
+	hashedRule := *rule
+	hashedRule.Logging = nil
+	hashedRule.LogLabel = ""
+	serializedBytes, _ := json.Marshal(hashedRule)
 	return util.Sha1(string(serializedBytes))
 }
 
@@ -2062,10 +2116,7 @@ func (service *SecurityPolicyService) getPolicyAppliedGroupByCRUID(indexScope, u
 }
 
 func (service *SecurityPolicyService) getAppliedGroupByRuleID(createdFor, uid string, ruleID string) *model.Group {
-	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
-		indexScope = common.TagScopeNetworkPolicyUID
-	}
+	indexScope := getIndexScope(createdFor)
 
 	if ruleID == "" {
 		return service.getPolicyAppliedGroupByCRUID(indexScope, uid)
@@ -2121,8 +2172,11 @@ func (service *SecurityPolicyService) getPeerGroupByRuleID(ruleID string, isSour
 func (service *SecurityPolicyService) getRuleIDByUUIDAndRuleHash(uuid types.UID, ruleHash string, createdFor string) *string {
 	var rules []*model.Rule
 	indexKey := SPIndexByUUIDAndRuleHashFuncKey
-	if createdFor == common.ResourceTypeNetworkPolicy {
+	switch createdFor {
+	case common.ResourceTypeNetworkPolicy:
 		indexKey = NPIndexByUUIDAndRuleHashFuncKey
+	case common.ResourceTypeAdminNetworkPolicy, common.ResourceTypeBaselineAdminNetworkPolicy:
+		indexKey = ANPIndexByUUIDAndRuleHashFuncKey
 	}
 
 	rules = service.ruleStore.GetByIndexUUIDAndHash(indexKey, string(uuid), ruleHash)
@@ -76,6 +76,8 @@ func Test_BuildSecurityPolicyForT1(t *testing.T) {
 						Scope:             []string{"/infra/domains/k8scl-one/groups/sp_uidA_0_scope"},
 						SequenceNumber:    &seq0,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"/infra/domains/k8scl-one/groups/sp_uidA_0_src"},
 						Action:            &nsxRuleActionAllow,
 						Tags:              basicTags,
@@ -88,6 +90,8 @@ func Test_BuildSecurityPolicyForT1(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq1,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"/infra/domains/k8scl-one/groups/sp_uidA_1_src"},
 						Action:            &nsxRuleActionAllow,
 						ServiceEntries:    []*data.StructValue{serviceEntry},
@@ -114,6 +118,8 @@ func Test_BuildSecurityPolicyForT1(t *testing.T) {
 						Scope:             []string{"/infra/domains/k8scl-one/groups/sp_uidB_0_scope"},
 						SequenceNumber:    &seq0,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              basicTagsForSpWithVMSelector,
@@ -126,6 +132,8 @@ func Test_BuildSecurityPolicyForT1(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq1,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              basicTagsForSpWithVMSelector,
@@ -139,6 +147,8 @@ func Test_BuildSecurityPolicyForT1(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq2,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              basicTagsForSpWithVMSelector,
@@ -168,7 +178,7 @@ func Test_BuildSecurityPolicyForT1(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			observedPolicy, _, _, _ := s.buildSecurityPolicy(tt.inputPolicy, common.ResourceTypeSecurityPolicy, nil, false)
+			observedPolicy, _, _, _, _ := s.buildSecurityPolicy(tt.inputPolicy, common.ResourceTypeSecurityPolicy, nil, false)
 			assert.Equal(t, tt.expectedPolicy, observedPolicy)
 		})
 	}
@@ -262,6 +272,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"/orgs/default/projects/projectQuality/vpcs/vpc1/groups/spA-1e510e8ad94c103f4727e2bfc28bc637cb752831-scope_re0bz"},
 						SequenceNumber:    &seq0,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"/orgs/default/projects/projectQuality/infra/domains/default/groups/spA-1e510e8ad94c103f4727e2bfc28bc637cb752831-src_re0bz"},
 						Action:            &nsxRuleActionAllow,
 						Tags:              vpcRuleTags1,
@@ -274,6 +286,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq1,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"/orgs/default/projects/projectQuality/infra/domains/default/groups/spA-304ea84a85a12eae03f799a546ec5bfe1ffaaa64-src_re0bz"},
 						Action:            &nsxRuleActionAllow,
 						ServiceEntries:    []*data.StructValue{serviceEntry},
@@ -301,6 +315,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"/orgs/default/projects/projectQuality/vpcs/vpc1/groups/spB-db8116ce8304671a055501343fa850eb93b76604-scope_9u8w9"},
 						SequenceNumber:    &seq0,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              vpcRuleTags3,
@@ -313,6 +329,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq1,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              vpcRuleTags4,
@@ -326,6 +344,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq2,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              vpcRuleTags5,
@@ -353,6 +373,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"/orgs/default/projects/default/vpcs/vpc1/groups/spA-1e510e8ad94c103f4727e2bfc28bc637cb752831-scope_re0bz"},
 						SequenceNumber:    &seq0,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"/infra/domains/default/groups/spA-1e510e8ad94c103f4727e2bfc28bc637cb752831-src_re0bz"},
 						Action:            &nsxRuleActionAllow,
 						Tags:              vpcRuleTags1,
@@ -365,6 +387,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq1,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"/infra/domains/default/groups/spA-304ea84a85a12eae03f799a546ec5bfe1ffaaa64-src_re0bz"},
 						Action:            &nsxRuleActionAllow,
 						ServiceEntries:    []*data.StructValue{serviceEntry},
@@ -393,6 +417,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"/orgs/default/projects/default/vpcs/vpc1/groups/spB-db8116ce8304671a055501343fa850eb93b76604-scope_9u8w9"},
 						SequenceNumber:    &seq0,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              vpcRuleTags3,
@@ -405,6 +431,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq1,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              vpcRuleTags4,
@@ -418,6 +446,8 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq2,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              vpcRuleTags5,
@@ -430,7 +460,7 @@ func Test_BuildSecurityPolicyForVPC(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			observedPolicy, _, _, _ := fakeService.buildSecurityPolicy(tt.inputPolicy, common.ResourceTypeSecurityPolicy, tt.vpcInfo, tt.isDefaultProject)
+			observedPolicy, _, _, _, _ := fakeService.buildSecurityPolicy(tt.inputPolicy, common.ResourceTypeSecurityPolicy, tt.vpcInfo, tt.isDefaultProject)
 			assert.Equal(t, tt.expectedPolicy, observedPolicy)
 		})
 	}
@@ -1421,6 +1451,51 @@ func Test_BuildExpandedRuleID(t *testing.T) {
 	}
 }
 
+func Test_BuildRuleBasicInfoWithLogging(t *testing.T) {
+	svc := &SecurityPolicyService{
+		Service: common.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster: "cluster1",
+				},
+			},
+		},
+	}
+	config.SetMixedModeStateForTest(true, false)
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&svc.Service), "GetNamespaceUID",
+		func(s *common.Service, ns string) types.UID {
+			return types.UID(tagValueNSUID)
+		})
+	defer patches.Reset()
+
+	ruleAction := v1alpha1.RuleActionAllow
+	ruleDirection := v1alpha1.RuleDirectionIn
+	obj := &v1alpha1.SecurityPolicy{
+		ObjectMeta: v1.ObjectMeta{Namespace: "ns1", Name: "spLogging", UID: "uidA"},
+		Spec: v1alpha1.SecurityPolicySpec{
+			Rules: []v1alpha1.SecurityPolicyRule{
+				{Action: &ruleAction, Direction: &ruleDirection, Name: "rule1"},
+			},
+		},
+	}
+	ruleBaseID := svc.buildRuleID(obj, 0, common.ResourceTypeSecurityPolicy)
+	nsxRule, err := svc.buildRuleBasicInfo(obj, &obj.Spec.Rules[0], 0, ruleBaseID, common.ResourceTypeSecurityPolicy, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, common.Bool(false), nsxRule.Logged)
+	assert.Equal(t, common.String(""), nsxRule.Tag)
+
+	// Changing the logging settings keeps the rule ID, so that the NSX rule is updated in place.
+	obj.Spec.Logging = true
+	obj.Spec.LogLabel = "tenant-a"
+	obj.Spec.Rules[0].LogLabel = "tenant-a-web"
+	assert.Equal(t, ruleBaseID, svc.buildRuleID(obj, 0, common.ResourceTypeSecurityPolicy))
+	loggedRule, err := svc.buildRuleBasicInfo(obj, &obj.Spec.Rules[0], 0, ruleBaseID, common.ResourceTypeSecurityPolicy, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, nsxRule.Id, loggedRule.Id)
+	assert.Equal(t, common.Bool(true), loggedRule.Logged)
+	assert.Equal(t, common.String("tenant-a-web"), loggedRule.Tag)
+}
+
 func Test_BuildSecurityPolicyIDAndName(t *testing.T) {
 	svc := &SecurityPolicyService{
 		Service: common.Service{
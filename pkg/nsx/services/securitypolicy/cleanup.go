@@ -2,6 +2,7 @@ package securitypolicy
 
 import (
 	"context"
+	"strings"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
@@ -155,6 +156,49 @@ func (service *SecurityPolicyService) CleanupInfraResources(ctx context.Context)
 			return err
 		}
 	}
+	return service.cleanContextProfiles(ctx)
+}
+
+// cleanContextProfiles deletes the FQDN context profiles created under the NSX infra or the project infra.
+func (service *SecurityPolicyService) cleanContextProfiles(ctx context.Context) error {
+	cachedObjs := service.contextProfileStore.List()
+	if len(cachedObjs) == 0 {
+		return nil
+	}
+	log.Info("Cleaning up ContextProfiles", "Count", len(cachedObjs))
+
+	infraProfiles := make([]*model.PolicyContextProfile, 0)
+	projectProfiles := make([]*model.PolicyContextProfile, 0)
+	for _, obj := range cachedObjs {
+		profile := obj.(*model.PolicyContextProfile)
+		profile.MarkedForDelete = &MarkedForDelete
+		if profile.Path != nil && strings.HasPrefix(*profile.Path, "/orgs/") {
+			projectProfiles = append(projectProfiles, profile)
+		} else {
+			infraProfiles = append(infraProfiles, profile)
+		}
+	}
+	for _, config := range []struct {
+		profiles []*model.PolicyContextProfile
+		builder  *common.PolicyTreeBuilder[*model.PolicyContextProfile]
+	}{
+		{
+			profiles: projectProfiles,
+			builder:  service.projectContextProfileBuilder,
+		}, {
+			profiles: infraProfiles,
+			builder:  service.infraContextProfileBuilder,
+		},
+	} {
+		if len(config.profiles) == 0 {
+			continue
+		}
+		if err := config.builder.PagingUpdateResources(ctx, config.profiles, common.DefaultHAPIChildrenCount, service.NSXClient, func(deletedObjs []*model.PolicyContextProfile) {
+			service.contextProfileStore.DeleteMultipleObjects(deletedObjs)
+		}); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
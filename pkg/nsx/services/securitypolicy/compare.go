@@ -15,6 +15,7 @@ type (
 	Rule           model.Rule
 	Group          model.Group
 	Share          model.Share
+	ContextProfile model.PolicyContextProfile
 )
 
 type Comparable = common.Comparable
@@ -35,6 +36,10 @@ func (share *Share) Key() string {
 	return *share.Id
 }
 
+func (profile *ContextProfile) Key() string {
+	return *profile.Id
+}
+
 func (sp *SecurityPolicy) Value() data.DataValue {
 	s := &SecurityPolicy{
 		Id:             sp.Id,
@@ -60,6 +65,9 @@ func (rule *Rule) Value() data.DataValue {
 		ServiceEntries:    rule.ServiceEntries,
 		DestinationGroups: rule.DestinationGroups,
 		SourceGroups:      rule.SourceGroups,
+		Profiles:          normalizeRuleProfiles(rule.Profiles),
+		Logged:            normalizeRuleLogged(rule.Logged),
+		Tag:               normalizeRuleTag(rule.Tag),
 	}
 	dataValue, _ := ComparableToRule(r).GetDataValue__()
 	return dataValue
@@ -88,6 +96,42 @@ func (share *Share) Value() data.DataValue {
 	return dataValue
 }
 
+func (profile *ContextProfile) Value() data.DataValue {
+	p := &ContextProfile{
+		Id:          profile.Id,
+		DisplayName: profile.DisplayName,
+		Tags:        profile.Tags,
+		Attributes:  profile.Attributes,
+	}
+	dataValue, _ := ComparableToContextProfile(p).GetDataValue__()
+	return dataValue
+}
+
+// normalizeRuleProfiles treats the NSX default profiles ["ANY"] the same as no profiles, so that the rules read from
+// NSX are equal to the built rules without FQDN context profiles.
+func normalizeRuleProfiles(profiles []string) []string {
+	if len(profiles) == 1 && profiles[0] == "ANY" {
+		return nil
+	}
+	return profiles
+}
+
+// normalizeRuleLogged treats the unset logging the same as disabled, which is the NSX default.
+func normalizeRuleLogged(logged *bool) *bool {
+	if logged == nil {
+		return common.Bool(false)
+	}
+	return logged
+}
+
+// normalizeRuleTag treats the empty log label the same as no label.
+func normalizeRuleTag(tag *string) *string {
+	if tag != nil && *tag == "" {
+		return nil
+	}
+	return tag
+}
+
 func SecurityPolicyPtrToComparable(sp *model.SecurityPolicy) Comparable {
 	return (*SecurityPolicy)(sp)
 }
@@ -183,3 +227,31 @@ func ComparableToShares(shares []Comparable) []model.Share {
 func ComparableToShare(share Comparable) *model.Share {
 	return (*model.Share)(share.(*Share))
 }
+
+func ContextProfilesPtrToComparable(profiles []*model.PolicyContextProfile) []Comparable {
+	res := make([]Comparable, 0, len(profiles))
+	for i := range profiles {
+		res = append(res, (*ContextProfile)(profiles[i]))
+	}
+	return res
+}
+
+func ContextProfilesToComparable(profiles []model.PolicyContextProfile) []Comparable {
+	res := make([]Comparable, 0, len(profiles))
+	for i := range profiles {
+		res = append(res, (*ContextProfile)(&(profiles[i])))
+	}
+	return res
+}
+
+func ComparableToContextProfiles(profiles []Comparable) []model.PolicyContextProfile {
+	res := make([]model.PolicyContextProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		res = append(res, (model.PolicyContextProfile)(*(profile.(*ContextProfile))))
+	}
+	return res
+}
+
+func ComparableToContextProfile(profile Comparable) *model.PolicyContextProfile {
+	return (*model.PolicyContextProfile)(profile.(*ContextProfile))
+}
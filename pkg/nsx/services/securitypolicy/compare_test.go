@@ -149,6 +149,47 @@ func Test_RulesEqual(t *testing.T) {
 			expectedResult1: []model.Rule{},
 			expectedResult2: []model.Rule{},
 		},
+		{
+			name: "rule-with-default-logging",
+			inputRule1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(false),
+					Tag:    common.String(""),
+				},
+			},
+			inputRule2: []model.Rule{
+				{
+					Id: &ruleID0,
+				},
+			},
+			expectedResult1: []model.Rule{},
+			expectedResult2: []model.Rule{},
+		},
+		{
+			name: "rule-with-logging-changed",
+			inputRule1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(false),
+				},
+			},
+			inputRule2: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+					Tag:    common.String("tenant-a"),
+				},
+			},
+			expectedResult1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+					Tag:    common.String("tenant-a"),
+				},
+			},
+			expectedResult2: []model.Rule{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -477,6 +477,8 @@ func Test_ExpandRule(t *testing.T) {
 					SequenceNumber: Int64(int64(0)),
 					Action:         common.String(string("ALLOW")),
 					Services:       []string{"ANY"},
+					Logged:         common.Bool(false),
+					Tag:            common.String(""),
 					Tags:           npRuleTags1,
 				},
 			},
@@ -493,6 +495,8 @@ func Test_ExpandRule(t *testing.T) {
 					SequenceNumber: Int64(int64(1)),
 					Action:         common.String(string("ALLOW")),
 					Services:       []string{"ANY"},
+					Logged:         common.Bool(false),
+					Tag:            common.String(""),
 					ServiceEntries: []*data.StructValue{
 						getRuleServiceEntries(1000, 0, "TCP"),
 						getRuleServiceEntries(1234, 1235, "UDP"),
@@ -516,6 +520,8 @@ func Test_ExpandRule(t *testing.T) {
 					SequenceNumber:    Int64(int64(2)),
 					Action:            common.String("ALLOW"),
 					Services:          []string{"ANY"},
+					Logged:            common.Bool(false),
+					Tag:               common.String(""),
 					ServiceEntries:    []*data.StructValue{getRuleServiceEntries(8080, 0, "TCP")},
 					Tags:              npRuleTags3,
 					DestinationGroups: []string{"/orgs/default/projects/pro1/vpcs/vpc1/groups/p1-94b44028488f3e719879abbc27c75e5cb44872b7_ogcol_8080_ipset"},
@@ -526,6 +532,8 @@ func Test_ExpandRule(t *testing.T) {
 					SequenceNumber: Int64(int64(2)),
 					Action:         common.String("ALLOW"),
 					Services:       []string{"ANY"},
+					Logged:         common.Bool(false),
+					Tag:            common.String(""),
 					ServiceEntries: []*data.StructValue{getRuleServiceEntries(1236, 1237, "UDP")},
 					Tags:           npRuleTags3,
 				},
@@ -546,6 +554,8 @@ func Test_ExpandRule(t *testing.T) {
 					SequenceNumber:    Int64(int64(2)),
 					Action:            common.String("ALLOW"),
 					Services:          []string{"ANY"},
+					Logged:            common.Bool(false),
+					Tag:               common.String(""),
 					ServiceEntries:    []*data.StructValue{getRuleServiceEntries(8080, 0, "TCP")},
 					Tags:              spVPCRuleTags1,
 					DestinationGroups: []string{"/orgs/default/projects/pro1/vpcs/vpc1/groups/p1-94b44028488f3e719879abbc27c75e5cb44872b7_ogcol_8080_ipset"},
@@ -556,6 +566,8 @@ func Test_ExpandRule(t *testing.T) {
 					SequenceNumber: Int64(int64(2)),
 					Action:         common.String("ALLOW"),
 					Services:       []string{"ANY"},
+					Logged:         common.Bool(false),
+					Tag:            common.String(""),
 					ServiceEntries: []*data.StructValue{getRuleServiceEntries(1236, 1237, "UDP")},
 					Tags:           spVPCRuleTags1,
 				},
@@ -576,6 +588,8 @@ func Test_ExpandRule(t *testing.T) {
 					SequenceNumber:    Int64(int64(2)),
 					Action:            common.String("ALLOW"),
 					Services:          []string{"ANY"},
+					Logged:            common.Bool(false),
+					Tag:               common.String(""),
 					ServiceEntries:    []*data.StructValue{getRuleServiceEntries(8080, 0, "TCP")},
 					Tags:              spT1RuleTags,
 					DestinationGroups: []string{"/infra/domains/k8scl-one/groups/sp_uid1_94b44028488f3e719879abbc27c75e5cb44872b7_2_0_0_ipset"},
@@ -586,6 +600,8 @@ func Test_ExpandRule(t *testing.T) {
 					SequenceNumber: Int64(int64(2)),
 					Action:         common.String("ALLOW"),
 					Services:       []string{"ANY"},
+					Logged:         common.Bool(false),
+					Tag:            common.String(""),
 					ServiceEntries: []*data.StructValue{getRuleServiceEntries(1236, 1237, "UDP")},
 					Tags:           spT1RuleTags,
 				},
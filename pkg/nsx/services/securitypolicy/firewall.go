@@ -4,6 +4,7 @@
 package securitypolicy
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -35,6 +37,7 @@ var (
 	ResourceTypeRule           = common.ResourceTypeRule
 	ResourceTypeGroup          = common.ResourceTypeGroup
 	ResourceTypeShare          = common.ResourceTypeShare
+	ResourceTypeContextProfile = common.ResourceTypePolicyContextProfile
 	NewConverter               = common.NewConverter
 )
 
@@ -47,6 +50,7 @@ type SecurityPolicyService struct {
 	infraShareStore     *ShareStore
 	projectGroupStore   *GroupStore
 	projectShareStore   *ShareStore
+	contextProfileStore *ContextProfileStore
 	vpcService          common.VPCServiceProvider
 
 	securityPolicyBuilder *common.PolicyTreeBuilder[*model.SecurityPolicy]
@@ -56,6 +60,9 @@ type SecurityPolicyService struct {
 	projectGroupBuilder   *common.PolicyTreeBuilder[*model.Group]
 	infraShareBuilder     *common.PolicyTreeBuilder[*model.Share]
 	projectShareBuilder   *common.PolicyTreeBuilder[*model.Share]
+
+	infraContextProfileBuilder   *common.PolicyTreeBuilder[*model.PolicyContextProfile]
+	projectContextProfileBuilder *common.PolicyTreeBuilder[*model.PolicyContextProfile]
 }
 
 type GroupShare struct {
@@ -90,7 +97,7 @@ func InitializeSecurityPolicy(service common.Service, vpcService common.VPCServi
 	wgDone := make(chan bool)
 	fatalErrors := make(chan error)
 
-	wg.Add(7)
+	wg.Add(8)
 
 	securityPolicyService := &SecurityPolicyService{
 		Service: service,
@@ -104,6 +111,8 @@ func InitializeSecurityPolicy(service common.Service, vpcService common.VPCServi
 		securityPolicyService.projectShareBuilder, _ = common.PolicyPathProjectShare.NewPolicyTreeBuilder()
 		securityPolicyService.projectGroupBuilder, _ = common.PolicyPathProjectGroup.NewPolicyTreeBuilder()
 		securityPolicyService.infraGroupBuilder, _ = common.PolicyPathInfraGroup.NewPolicyTreeBuilder()
+		securityPolicyService.infraContextProfileBuilder, _ = common.PolicyPathInfraContextProfile.NewPolicyTreeBuilder()
+		securityPolicyService.projectContextProfileBuilder, _ = common.PolicyPathProjectContextProfile.NewPolicyTreeBuilder()
 	}
 
 	if IsVPCEnabled(securityPolicyService) {
@@ -145,6 +154,7 @@ func InitializeSecurityPolicy(service common.Service, vpcService common.VPCServi
 	}
 	go securityPolicyService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSecurityPolicy, nil, securityPolicyService.securityPolicyStore)
 	go securityPolicyService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeRule, nil, securityPolicyService.ruleStore)
+	go securityPolicyService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeContextProfile, nil, securityPolicyService.contextProfileStore)
 
 	go func() {
 		wg.Wait()
@@ -165,6 +175,7 @@ func (s *SecurityPolicyService) setUpStore(indexScope string, indexWithVPCPath b
 	vpcResourceIndexWrapper := func(indexers cache.Indexers) cache.Indexers {
 		indexers[indexScope] = indexBySecurityPolicyUID
 		indexers[common.TagScopeNetworkPolicyUID] = indexByNetworkPolicyUID
+		indexers[common.TagScopeAdminNetworkPolicyUID] = indexByAdminNetworkPolicyUID
 		// Note: we can't use indexer `common.IndexByVPCPathFuncKey` with group/rule stores by default because the
 		// caller may not use the object read from NSX to apply on the store which is possibly not set with path or
 		// the parent path. But for cleanup logic, indexWithVPCPath is always set true and the store is re-built from
@@ -190,45 +201,59 @@ func (s *SecurityPolicyService) setUpStore(indexScope string, indexWithVPCPath b
 	}}
 	s.ruleStore = &RuleStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, vpcResourceIndexWrapper(cache.Indexers{
-			SPIndexByUUIDAndRuleHashFuncKey: indexSPByUUIDAndRuleHash,
-			NPIndexByUUIDAndRuleHashFuncKey: indexNPByUUIDAndRuleHash,
-			common.TagScopeRuleID:           indexRuleFunc,
+			SPIndexByUUIDAndRuleHashFuncKey:  indexSPByUUIDAndRuleHash,
+			NPIndexByUUIDAndRuleHashFuncKey:  indexNPByUUIDAndRuleHash,
+			ANPIndexByUUIDAndRuleHashFuncKey: indexANPByUUIDAndRuleHash,
+			common.TagScopeRuleID:            indexRuleFunc,
 		})),
 		BindingType: model.RuleBindingType(),
 	}}
 	s.infraGroupStore = &GroupStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
-			indexScope:                      indexBySecurityPolicyUID,
-			common.TagScopeNetworkPolicyUID: indexByNetworkPolicyUID,
-			common.TagScopeRuleID:           indexGroupFunc,
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
+			common.TagScopeRuleID:                indexGroupFunc,
 		}),
 		BindingType: model.GroupBindingType(),
 	}}
 	s.infraShareStore = &ShareStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
-			indexScope:                      indexBySecurityPolicyUID,
-			common.TagScopeNetworkPolicyUID: indexByNetworkPolicyUID,
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
 		}),
 		BindingType: model.ShareBindingType(),
 	}}
 	s.projectGroupStore = &GroupStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
-			indexScope:                      indexBySecurityPolicyUID,
-			common.TagScopeNetworkPolicyUID: indexByNetworkPolicyUID,
-			common.TagScopeRuleID:           indexGroupFunc,
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
+			common.TagScopeRuleID:                indexGroupFunc,
 		}),
 		BindingType: model.GroupBindingType(),
 	}}
 	s.projectShareStore = &ShareStore{ResourceStore: common.ResourceStore{
 		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
-			indexScope:                      indexBySecurityPolicyUID,
-			common.TagScopeNetworkPolicyUID: indexByNetworkPolicyUID,
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
 		}),
 		BindingType: model.ShareBindingType(),
 	}}
+	s.contextProfileStore = &ContextProfileStore{ResourceStore: common.ResourceStore{
+		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
+			indexScope:                           indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID:      indexByNetworkPolicyUID,
+			common.TagScopeAdminNetworkPolicyUID: indexByAdminNetworkPolicyUID,
+			common.TagScopeRuleID:                indexContextProfileFunc,
+		}),
+		BindingType: model.PolicyContextProfileBindingType(),
+	}}
 }
 
-func (service *SecurityPolicyService) CreateOrUpdateSecurityPolicy(obj interface{}) error {
+func (service *SecurityPolicyService) CreateOrUpdateSecurityPolicy(ctx context.Context, obj interface{}) error {
 	if !nsxutil.GetDFWLicense() {
 		log.Warn("No DFW license, skip creating SecurityPolicy.")
 		return nsxutil.RestrictionError{Desc: "no DFW license"}
@@ -242,17 +267,29 @@ func (service *SecurityPolicyService) CreateOrUpdateSecurityPolicy(obj interface
 			return err
 		}
 		for _, internalSecurityPolicy := range internalSecurityPolicies {
-			err = service.createOrUpdateVPCSecurityPolicy(internalSecurityPolicy, common.ResourceTypeNetworkPolicy)
+			err = service.createOrUpdateVPCSecurityPolicy(ctx, internalSecurityPolicy, common.ResourceTypeNetworkPolicy)
 			if err != nil {
 				return err
 			}
 		}
+	case *policyv1alpha1.AdminNetworkPolicy:
+		spec, err := convertAdminNetworkPolicySpec(obj)
+		if err != nil {
+			return err
+		}
+		return service.createOrUpdateAdminNetworkPolicy(ctx, obj, &obj.Spec.Subject, spec, common.ResourceTypeAdminNetworkPolicy)
+	case *policyv1alpha1.BaselineAdminNetworkPolicy:
+		spec, err := convertBaselineAdminNetworkPolicySpec(obj)
+		if err != nil {
+			return err
+		}
+		return service.createOrUpdateAdminNetworkPolicy(ctx, obj, &obj.Spec.Subject, spec, common.ResourceTypeBaselineAdminNetworkPolicy)
 	case *v1alpha1.SecurityPolicy:
 		if IsVPCEnabled(service) {
-			err = service.createOrUpdateVPCSecurityPolicy(obj, common.ResourceTypeSecurityPolicy)
+			err = service.createOrUpdateVPCSecurityPolicy(ctx, obj, common.ResourceTypeSecurityPolicy)
 		} else {
 			// For T1 network SecurityPolicy create/update
-			err = service.createOrUpdateT1SecurityPolicy(obj, common.ResourceTypeSecurityPolicy)
+			err = service.createOrUpdateT1SecurityPolicy(ctx, obj, common.ResourceTypeSecurityPolicy)
 		}
 	}
 	return err
@@ -488,25 +525,22 @@ func (service *SecurityPolicyService) getFinalVPCShareResources(obj *v1alpha1.Se
 	return finalShares, finalShareGroups
 }
 
-func (service *SecurityPolicyService) getFinalSecurityPolicyResource(obj *v1alpha1.SecurityPolicy, createdFor string, vpcInfo *common.VPCResourceInfo, isDefaultProject bool) (*model.SecurityPolicy, []model.Group, []model.Share, []model.Group, bool, error) {
+func (service *SecurityPolicyService) getFinalSecurityPolicyResource(obj *v1alpha1.SecurityPolicy, createdFor string, vpcInfo *common.VPCResourceInfo, isDefaultProject bool) (*model.SecurityPolicy, []model.Group, []model.Share, []model.Group, []model.PolicyContextProfile, bool, error) {
 	securityPolicyStore, ruleStore, groupStore := service.getSecurityPolicyResourceStores()
 
 	// Normalize rule peers so that deprecated Sources/Destinations are migrated into From/To
 	normalizeSecurityPolicyRules(obj)
 
-	nsxSecurityPolicy, nsxGroups, nsxGroupShares, err := service.buildSecurityPolicy(obj, createdFor, vpcInfo, isDefaultProject)
+	nsxSecurityPolicy, nsxGroups, nsxGroupShares, nsxContextProfiles, err := service.buildSecurityPolicy(obj, createdFor, vpcInfo, isDefaultProject)
 	if err != nil {
 		log.Error(err, "Failed to build SecurityPolicy from CR", "securityPolicyUID", obj.UID)
-		return nil, nil, nil, nil, false, err
+		return nil, nil, nil, nil, nil, false, err
 	}
 
 	if len(nsxSecurityPolicy.Scope) == 0 {
 		log.Info("SecurityPolicy has empty policy-level appliedTo field")
 	}
-	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
-		indexScope = common.TagScopeNetworkPolicyUID
-	}
+	indexScope := getIndexScope(createdFor)
 
 	existingSecurityPolicies := securityPolicyStore.GetByIndex(indexScope, string(obj.GetUID()))
 	isChanged := true
@@ -526,11 +560,14 @@ func (service *SecurityPolicyService) getFinalSecurityPolicyResource(obj *v1alph
 	existingGroups := groupStore.GetByIndex(indexScope, string(obj.UID))
 	finalGroups := service.getUpdateGroups(existingGroups, *nsxGroups)
 
+	existingContextProfiles := service.contextProfileStore.GetByIndex(indexScope, string(obj.UID))
+	finalContextProfiles := service.getUpdateContextProfiles(existingContextProfiles, *nsxContextProfiles)
+
 	if IsVPCEnabled(service) {
 		finalShares, finalShareGroups := service.getFinalVPCShareResources(obj, indexScope, nsxGroupShares, isDefaultProject)
-		return finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, isChanged, nil
+		return finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, finalContextProfiles, isChanged, nil
 	} else {
-		return finalSecurityPolicy, finalGroups, nil, nil, isChanged, nil
+		return finalSecurityPolicy, finalGroups, nil, nil, finalContextProfiles, isChanged, nil
 	}
 }
 
@@ -559,8 +596,8 @@ func normalizeSecurityPolicyRules(obj *v1alpha1.SecurityPolicy) {
 	}
 }
 
-func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(obj *v1alpha1.SecurityPolicy, createdFor string) error {
-	finalSecurityPolicy, finalGroups, _, _, isChanged, err := service.getFinalSecurityPolicyResource(obj, createdFor, nil, false)
+func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(ctx context.Context, obj *v1alpha1.SecurityPolicy, createdFor string) error {
+	finalSecurityPolicy, finalGroups, _, _, finalContextProfiles, isChanged, err := service.getFinalSecurityPolicyResource(obj, createdFor, nil, false)
 	if err != nil {
 		log.Error(err, "Failed to get SecurityPolicy resources from CR", "securityPolicyUID", obj.UID)
 		return err
@@ -570,24 +607,24 @@ func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(obj *v1alph
 	// so we need to make a copy for the rules store update.
 	finalRules := finalSecurityPolicy.Rules
 
-	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 {
-		log.Info("SecurityPolicy, rules, groups are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
+	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(finalContextProfiles) == 0 {
+		log.Info("SecurityPolicy, rules, groups and context profiles are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return nil
 	}
 
-	infraSecurityPolicy, err := service.WrapHierarchySecurityPolicy(finalSecurityPolicy, finalGroups)
+	infraSecurityPolicy, err := service.WrapHierarchySecurityPolicy(finalSecurityPolicy, finalGroups, finalContextProfiles)
 	if err != nil {
 		log.Error(err, "Failed to wrap SecurityPolicy", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return err
 	}
-	err = service.NSXClient.InfraClient.Patch(*infraSecurityPolicy, &EnforceRevisionCheckParam)
+	err = service.NSXClient.WithContext(ctx).InfraClient.Patch(*infraSecurityPolicy, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to create or update SecurityPolicy", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return err
 	}
 	// Get SecurityPolicy from NSX after HAPI call as NSX renders several fields like `path`/`parent_path`.
-	finalGetNSXSecurityPolicy, err := service.NSXClient.SecurityClient.Get(getDomain(service), *finalSecurityPolicy.Id)
+	finalGetNSXSecurityPolicy, err := service.NSXClient.WithContext(ctx).SecurityClient.Get(getDomain(service), *finalSecurityPolicy.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to get SecurityPolicy", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
@@ -614,11 +651,16 @@ func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(obj *v1alph
 		log.Error(err, "Failed to apply store", "nsxGroups", finalGroups)
 		return err
 	}
+	err = service.contextProfileStore.Apply(&finalContextProfiles)
+	if err != nil {
+		log.Error(err, "Failed to apply store", "nsxContextProfiles", finalContextProfiles)
+		return err
+	}
 	log.Info("Successfully created or updated NSX SecurityPolicy", "nsxSecurityPolicy", finalGetNSXSecurityPolicy)
 	return nil
 }
 
-func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alpha1.SecurityPolicy, createdFor string) error {
+func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(ctx context.Context, obj *v1alpha1.SecurityPolicy, createdFor string) error {
 	var err error
 	var finalGetNSXSecurityPolicy *model.SecurityPolicy
 
@@ -632,7 +674,7 @@ func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alp
 		return err
 	}
 
-	finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, isChanged, err := service.getFinalSecurityPolicyResource(obj, createdFor, vpcInfo, isDefaultProject)
+	finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, finalContextProfiles, isChanged, err := service.getFinalSecurityPolicyResource(obj, createdFor, vpcInfo, isDefaultProject)
 	if err != nil {
 		log.Error(err, "Failed to get SecurityPolicy resources from CR", "securityPolicyUID", obj.UID)
 		return err
@@ -642,14 +684,14 @@ func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alp
 	// so we need to make a copy for the rules store update.
 	finalRules := finalSecurityPolicy.Rules
 
-	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(finalShares) == 0 {
-		log.Info("SecurityPolicy, rules, groups and shares are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
+	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(finalShares) == 0 && len(finalContextProfiles) == 0 {
+		log.Info("SecurityPolicy, rules, groups, shares and context profiles are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return nil
 	}
 	if !isDefaultProject {
-		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicy(finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, vpcInfo)
+		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicy(ctx, finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, finalContextProfiles, vpcInfo)
 	} else {
-		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicyForDefaultProject(finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, vpcInfo)
+		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicyForDefaultProject(ctx, finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, finalContextProfiles, vpcInfo)
 	}
 	if err != nil {
 		return err
@@ -663,24 +705,29 @@ func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alp
 	if err != nil {
 		return err
 	}
+	err = service.contextProfileStore.Apply(&finalContextProfiles)
+	if err != nil {
+		log.Error(err, "Failed to apply store", "nsxContextProfiles", finalContextProfiles)
+		return err
+	}
 
 	log.Info("Successfully created or updated NSX SecurityPolicy resources in VPC", "nsxSecurityPolicy", *finalGetNSXSecurityPolicy)
 	return nil
 }
 
-func (service *SecurityPolicyService) DeleteSecurityPolicy(spUid types.UID, isGC bool, createdFor string) error {
+func (service *SecurityPolicyService) DeleteSecurityPolicy(ctx context.Context, spUid types.UID, isGC bool, createdFor string) error {
 	var err error
 	// For VPC network, SecurityPolicy normal deletion, GC deletion and cleanup
 	if IsVPCEnabled(service) {
-		err = service.deleteVPCSecurityPolicy(spUid, isGC, createdFor)
+		err = service.deleteVPCSecurityPolicy(ctx, spUid, isGC, createdFor)
 	} else {
 		// For T1 network, SecurityPolicy normal deletion and GC deletion
-		err = service.deleteT1SecurityPolicy(spUid)
+		err = service.deleteT1SecurityPolicy(ctx, spUid)
 	}
 	return err
 }
 
-func (service *SecurityPolicyService) deleteT1SecurityPolicy(spUid types.UID) error {
+func (service *SecurityPolicyService) deleteT1SecurityPolicy(ctx context.Context, spUid types.UID) error {
 	var nsxSecurityPolicy *model.SecurityPolicy
 	var err error
 
@@ -713,16 +760,19 @@ func (service *SecurityPolicyService) deleteT1SecurityPolicy(spUid types.UID) er
 	nsxRules := service.getMarkDeleteRules(existingRules, spUid)
 	nsxSecurityPolicy.Rules = nsxRules
 
+	existingContextProfiles := service.contextProfileStore.GetByIndex(indexScope, string(spUid))
+	nsxContextProfiles := service.getMarkDeleteContextProfiles(existingContextProfiles, spUid)
+
 	// WrapHierarchySecurityPolicy will modify the input security policy, so we need to make a copy for the following store update.
 	finalSecurityPolicyCopy := *nsxSecurityPolicy
 	finalSecurityPolicyCopy.Rules = nsxSecurityPolicy.Rules
 
-	infraSecurityPolicy, err := service.WrapHierarchySecurityPolicy(nsxSecurityPolicy, nsxGroups)
+	infraSecurityPolicy, err := service.WrapHierarchySecurityPolicy(nsxSecurityPolicy, nsxGroups, nsxContextProfiles)
 	if err != nil {
 		log.Error(err, "Failed to wrap SecurityPolicy", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
 		return err
 	}
-	err = service.NSXClient.InfraClient.Patch(*infraSecurityPolicy, &EnforceRevisionCheckParam)
+	err = service.NSXClient.WithContext(ctx).InfraClient.Patch(*infraSecurityPolicy, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to delete SecurityPolicy", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -744,16 +794,18 @@ func (service *SecurityPolicyService) deleteT1SecurityPolicy(spUid types.UID) er
 		log.Error(err, "Failed to apply store", "nsxGroups", nsxGroups)
 		return err
 	}
+	err = service.contextProfileStore.Apply(&nsxContextProfiles)
+	if err != nil {
+		log.Error(err, "Failed to apply store", "nsxContextProfiles", nsxContextProfiles)
+		return err
+	}
 
 	log.Info("Successfully deleted NSX SecurityPolicy", "nsxSecurityPolicy", finalSecurityPolicyCopy)
 	return nil
 }
 
-func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, isGC bool, createdFor string) error {
-	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
-		indexScope = common.TagScopeNetworkPolicyUID
-	}
+func (service *SecurityPolicyService) deleteVPCSecurityPolicy(ctx context.Context, spUID types.UID, isGC bool, createdFor string) error {
+	indexScope := getIndexScope(createdFor)
 
 	// For normal SecurityPolicy deletion process, which means that SecurityPolicy has a corresponding NSX SecurityPolicy object.
 	// And for SecurityPolicy GC or cleanup process, which means that SecurityPolicy doesn't exist in K8s any more,
@@ -765,6 +817,8 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 		log.Error(err, "Failed to mark SecurityPolicy resources delete in VPC", "nsxSecurityPolicyUID", spUID)
 		return err
 	}
+	existingContextProfiles := service.contextProfileStore.GetByIndex(indexScope, string(spUID))
+	nsxContextProfiles := service.getMarkDeleteContextProfiles(existingContextProfiles, spUID)
 
 	isDefaultProject := false
 	// For GC case, it usually will follow the normal deletion process.
@@ -793,7 +847,7 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 	}
 
 	if nsxSecurityPolicy != nil {
-		err = service.deleteNSXSecurityPolicy(nsxSecurityPolicy, &vpcInfo)
+		err = service.deleteNSXSecurityPolicy(ctx, nsxSecurityPolicy, &vpcInfo)
 		if err != nil {
 			log.Error(err, "Failed to delete NSX SecurityPolicy and rules in VPC", "nsxSecurityPolicyUID", spUID)
 			return err
@@ -806,9 +860,9 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 	}
 
 	if !isDefaultProject {
-		err = service.deleteNSXSecurityPolicyGroupShare(nsxGroups, nsxProjectShares, nsxProjectShareGroups, &vpcInfo)
+		err = service.deleteNSXSecurityPolicyGroupShare(ctx, nsxGroups, nsxProjectShares, nsxProjectShareGroups, nsxContextProfiles, &vpcInfo)
 	} else {
-		err = service.deleteNSXSecurityPolicyGroupShareForDefaultProject(nsxGroups, nsxInfraShares, nsxInfraShareGroups, &vpcInfo)
+		err = service.deleteNSXSecurityPolicyGroupShareForDefaultProject(ctx, nsxGroups, nsxInfraShares, nsxInfraShareGroups, nsxContextProfiles, &vpcInfo)
 	}
 	// Ignore error here to make groups/shares to be deleted in GC.
 	// Because NSX SecurityPolicy is deleted, it's unable to get SecurityPolicyUID from SecurityPolicyStore for fetching groups/shares even by requeuing the error.
@@ -826,6 +880,11 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 	if err != nil {
 		return err
 	}
+	err = service.contextProfileStore.Apply(&nsxContextProfiles)
+	if err != nil {
+		log.Error(err, "Failed to apply store", "nsxContextProfiles", nsxContextProfiles)
+		return err
+	}
 
 	if isGC {
 		log.Info("Successfully GC NSX SecurityPolicy, rules, groups and shares in VPC", "nsxSecurityPolicyUID", spUID)
@@ -1039,15 +1098,15 @@ func (service *SecurityPolicyService) markSecurityPolicyResourcesDelete(indexSco
 }
 
 // createOrUpdateNSXSecurityPolicy uses hierarchy API call to create/update SecurityPolicy on the whole resource tree for non-Default Project.
-func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurityPolicy *model.SecurityPolicy, nsxGroups []model.Group,
-	nsxShares []model.Share, nsxShareGroups []model.Group, vpcInfo *common.VPCResourceInfo,
+func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(ctx context.Context, nsxSecurityPolicy *model.SecurityPolicy, nsxGroups []model.Group,
+	nsxShares []model.Share, nsxShareGroups []model.Group, nsxContextProfiles []model.PolicyContextProfile, vpcInfo *common.VPCResourceInfo,
 ) (*model.SecurityPolicy, error) {
 	var err error
 	var projectInfraResource []*data.StructValue
 
-	if len(nsxShares) != 0 {
-		// Wrap project groups and shares into project child infra.
-		projectInfraResource, err = service.wrapHierarchyProjectResources(nsxShares, nsxShareGroups)
+	if len(nsxShares) != 0 || len(nsxContextProfiles) != 0 {
+		// Wrap project groups, shares and context profiles into project child infra.
+		projectInfraResource, err = service.wrapHierarchyProjectResources(nsxShares, nsxShareGroups, nsxContextProfiles)
 		if err != nil {
 			log.Error(err, "Failed to wrap NSX project groups and shares", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
 			return nil, err
@@ -1061,7 +1120,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurit
 		return nil, err
 	}
 	// Create/update SecurityPolicy together with groups, rules under VPC level and project groups, shares.
-	err = service.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+	err = service.NSXClient.WithContext(ctx).OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to create or update NSX SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1069,7 +1128,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurit
 	}
 
 	// Get SecurityPolicy from NSX after HAPI call as NSX renders several fields like `path`/`parent_path`.
-	nsxGetSecurityPolicy, err := service.NSXClient.VPCSecurityClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
+	nsxGetSecurityPolicy, err := service.NSXClient.WithContext(ctx).VPCSecurityClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to get NSX SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1077,7 +1136,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurit
 	}
 
 	// Check SecurityPolicy realization state
-	err = service.checkSecurityPolicyRealizationState(&nsxGetSecurityPolicy, *(nsxGetSecurityPolicy.Path))
+	err = service.checkSecurityPolicyRealizationState(ctx, &nsxGetSecurityPolicy, *(nsxGetSecurityPolicy.Path))
 	if err != nil {
 		return nil, err
 	}
@@ -1086,8 +1145,8 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurit
 }
 
 // createOrUpdateNSXSecurityPolicyForDefaultProject uses hierarchy API call to create/update SecurityPolicy on the whole resource tree for Default Project.
-func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultProject(nsxSecurityPolicy *model.SecurityPolicy, nsxGroups []model.Group,
-	nsxShares []model.Share, nsxShareGroups []model.Group, vpcInfo *common.VPCResourceInfo,
+func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultProject(ctx context.Context, nsxSecurityPolicy *model.SecurityPolicy, nsxGroups []model.Group,
+	nsxShares []model.Share, nsxShareGroups []model.Group, nsxContextProfiles []model.PolicyContextProfile, vpcInfo *common.VPCResourceInfo,
 ) (*model.SecurityPolicy, error) {
 	var err error
 	var infraResource *model.Infra
@@ -1096,17 +1155,18 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 
 	finalStaleShares, finalChangedShares := service.getStaleUpdateShares(nsxShares)
 	finalStaleShareGroups, finalChangedShareGroups := service.getStaleUpdateGroups(nsxShareGroups)
+	finalStaleContextProfiles, finalChangedContextProfiles := service.getStaleUpdateContextProfiles(nsxContextProfiles)
 
 	// It's needed to create/update the infra resources before these resources are referred by VPC resources.
-	if len(finalChangedShares) != 0 || len(finalChangedShareGroups) != 0 {
-		// Wrap infra groups and shares into infra child infra.
-		infraResource, err = service.wrapHierarchyInfraResources(finalChangedShares, finalChangedShareGroups)
+	if len(finalChangedShares) != 0 || len(finalChangedShareGroups) != 0 || len(finalChangedContextProfiles) != 0 {
+		// Wrap infra groups, shares and context profiles into infra child infra.
+		infraResource, err = service.wrapHierarchyInfraResources(finalChangedShares, finalChangedShareGroups, finalChangedContextProfiles)
 		if err != nil {
 			log.Error(err, "Failed to wrap NSX infra changed groups and shares", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
 			return nil, err
 		}
 
-		err = service.NSXClient.InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
+		err = service.NSXClient.WithContext(ctx).InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			log.Error(err, "Failed to create or update NSX infra resource", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1122,7 +1182,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 	}
 
 	// Create/update SecurityPolicy together with groups, rules under VPC level.
-	err = service.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+	err = service.NSXClient.WithContext(ctx).OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to create or update SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1130,14 +1190,14 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 	}
 
 	// The infra share resources can be deleted only after the rules under VPC level which are referring the share resources have been deleted.
-	if len(finalStaleShares) != 0 || len(finalStaleShareGroups) != 0 {
-		// Wrap infra groups and shares into infra child infra.
-		infraResource, err = service.wrapHierarchyInfraResources(finalStaleShares, finalStaleShareGroups)
+	if len(finalStaleShares) != 0 || len(finalStaleShareGroups) != 0 || len(finalStaleContextProfiles) != 0 {
+		// Wrap infra groups, shares and context profiles into infra child infra.
+		infraResource, err = service.wrapHierarchyInfraResources(finalStaleShares, finalStaleShareGroups, finalStaleContextProfiles)
 		if err != nil {
 			log.Error(err, "Failed to wrap NSX infra stale groups and shares", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
 			return nil, err
 		}
-		err = service.NSXClient.InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
+		err = service.NSXClient.WithContext(ctx).InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			log.Error(err, "Failed to delete NSX infra Resource", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1146,7 +1206,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 	}
 
 	// Get SecurityPolicy from NSX after HAPI call as NSX renders several fields like `path`/`parent_path`.
-	nsxGetSecurityPolicy, err = service.NSXClient.VPCSecurityClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
+	nsxGetSecurityPolicy, err = service.NSXClient.WithContext(ctx).VPCSecurityClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to get SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1154,7 +1214,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 	}
 
 	// Check SecurityPolicy realization state
-	err = service.checkSecurityPolicyRealizationState(&nsxGetSecurityPolicy, *(nsxGetSecurityPolicy.Path))
+	err = service.checkSecurityPolicyRealizationState(ctx, &nsxGetSecurityPolicy, *(nsxGetSecurityPolicy.Path))
 	if err != nil {
 		return nil, err
 	}
@@ -1163,11 +1223,11 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 }
 
 // deleteNSXSecurityPolicy deletes NSX SecurityPolicy, rules and the groups/shares for both NSX Default Project and non-Default Project.
-func (service *SecurityPolicyService) deleteNSXSecurityPolicy(nsxSecurityPolicy *model.SecurityPolicy, vpcInfo *common.VPCResourceInfo) error {
+func (service *SecurityPolicyService) deleteNSXSecurityPolicy(ctx context.Context, nsxSecurityPolicy *model.SecurityPolicy, vpcInfo *common.VPCResourceInfo) error {
 	var err error
 
 	// Delete NSX SecurityPolicy and rules only.
-	err = service.NSXClient.VPCSecurityClient.Delete(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
+	err = service.NSXClient.WithContext(ctx).VPCSecurityClient.Delete(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to delete NSX SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1178,15 +1238,15 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicy(nsxSecurityPolicy
 }
 
 // deleteNSXSecurityPolicyGroupShare deletes NSX SecurityPolicy associated the groups/shares for non-Default Project.
-func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShare(nsxGroups []model.Group,
-	nsxShares []model.Share, nsxShareGroups []model.Group, vpcInfo *common.VPCResourceInfo,
+func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShare(ctx context.Context, nsxGroups []model.Group,
+	nsxShares []model.Share, nsxShareGroups []model.Group, nsxContextProfiles []model.PolicyContextProfile, vpcInfo *common.VPCResourceInfo,
 ) error {
 	var err error
 	var projectInfraResource []*data.StructValue
 
-	if len(nsxShares) != 0 {
-		// Wrap project groups and shares into project child infra.
-		projectInfraResource, err = service.wrapHierarchyProjectResources(nsxShares, nsxShareGroups)
+	if len(nsxShares) != 0 || len(nsxContextProfiles) != 0 {
+		// Wrap project groups, shares and context profiles into project child infra.
+		projectInfraResource, err = service.wrapHierarchyProjectResources(nsxShares, nsxShareGroups, nsxContextProfiles)
 		if err != nil {
 			log.Error(err, "Failed to wrap NSX project groups and shares")
 			return err
@@ -1200,7 +1260,7 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShare(nsxGroup
 		return err
 	}
 	// Delete groups under VPC level together with project groups, shares.
-	err = service.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+	err = service.NSXClient.WithContext(ctx).OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to delete NSX groups and shares in VPC")
@@ -1211,8 +1271,8 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShare(nsxGroup
 }
 
 // deleteNSXSecurityPolicyGroupShareForDefaultProject deletes NSX SecurityPolicy associated the groups/shares for Default Project.
-func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShareForDefaultProject(nsxGroups []model.Group,
-	nsxShares []model.Share, nsxShareGroups []model.Group, vpcInfo *common.VPCResourceInfo,
+func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShareForDefaultProject(ctx context.Context, nsxGroups []model.Group,
+	nsxShares []model.Share, nsxShareGroups []model.Group, nsxContextProfiles []model.PolicyContextProfile, vpcInfo *common.VPCResourceInfo,
 ) error {
 	var projectInfraResource []*data.StructValue
 
@@ -1225,7 +1285,7 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShareForDefaul
 		}
 
 		// Delete groups under VPC level.
-		err = service.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+		err = service.NSXClient.WithContext(ctx).OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			log.Error(err, "Failed to delete NSX groups in VPC")
@@ -1233,15 +1293,15 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShareForDefaul
 		}
 	}
 
-	if len(nsxShares) != 0 {
-		// Wrap infra groups and shares into infra child infra.
-		infraResource, err := service.wrapHierarchyInfraResources(nsxShares, nsxShareGroups)
+	if len(nsxShares) != 0 || len(nsxContextProfiles) != 0 {
+		// Wrap infra groups, shares and context profiles into infra child infra.
+		infraResource, err := service.wrapHierarchyInfraResources(nsxShares, nsxShareGroups, nsxContextProfiles)
 		if err != nil {
 			log.Error(err, "Failed to wrap NSX infra groups and shares")
 			return err
 		}
 		// Delete infra groups and shares.
-		err = service.NSXClient.InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
+		err = service.NSXClient.WithContext(ctx).InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			log.Error(err, "Failed to delete NSX infra groups and shares")
@@ -1368,10 +1428,10 @@ func (service *SecurityPolicyService) getVPCInfo(spNameSpace string) (*common.VP
 	return &vpcInfo[0], nil
 }
 
-func (service *SecurityPolicyService) checkSecurityPolicyRealizationState(sp *model.SecurityPolicy, spPath string) error {
+func (service *SecurityPolicyService) checkSecurityPolicyRealizationState(ctx context.Context, sp *model.SecurityPolicy, spPath string) error {
 	log.Trace("Check NSX SecurityPolicy realization state", "nsxSecurityPolicyId", *sp.Id)
 	realizeService := realizestate.InitializeRealizeState(service.Service)
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, spPath, []string{}); err != nil {
+	if err := realizeService.CheckRealizeState(ctx, util.NSXTRealizeRetry, spPath, []string{}); err != nil {
 		log.Error(err, "Failed to check NSX SecurityPolicy realization state", "nsxSecurityPolicyId", *sp.Id)
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "The created SecurityPolicy is in error realization state", "nsxSecurityPolicyId", *sp.Id)
@@ -4,6 +4,7 @@
 package securitypolicy
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -1254,7 +1255,7 @@ func Test_DeleteSecurityPolicy(t *testing.T) {
 				})
 			defer patches.Reset()
 
-			if err := fakeService.DeleteSecurityPolicy(tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.DeleteSecurityPolicy(context.TODO(), tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("DeleteSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, tt.wantSecurityPolicyStoreCount, len(fakeService.securityPolicyStore.ListKeys()))
@@ -1438,7 +1439,7 @@ func Test_deleteT1SecurityPolicy(t *testing.T) {
 			patches := tt.prepareFunc(t, fakeService)
 			defer patches.Reset()
 
-			if err := fakeService.deleteT1SecurityPolicy(tt.args.uid); (err != nil) != tt.wantErr {
+			if err := fakeService.deleteT1SecurityPolicy(context.TODO(), tt.args.uid); (err != nil) != tt.wantErr {
 				t.Errorf("deleteT1SecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, tt.wantSecurityPolicyStoreCount, len(fakeService.securityPolicyStore.ListKeys()))
@@ -1706,7 +1707,7 @@ func Test_deleteVPCSecurityPolicy(t *testing.T) {
 				})
 			defer patches.Reset()
 
-			if err := fakeService.deleteVPCSecurityPolicy(tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.deleteVPCSecurityPolicy(context.TODO(), tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("deleteVPCSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, tt.wantSecurityPolicyStoreCount, len(fakeService.securityPolicyStore.ListKeys()))
@@ -2105,7 +2106,7 @@ func Test_deleteVPCSecurityPolicyInDefaultProject(t *testing.T) {
 				})
 			defer patches.Reset()
 
-			if err := fakeService.deleteVPCSecurityPolicy(tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.deleteVPCSecurityPolicy(context.TODO(), tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("deleteVPCSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, tt.wantSecurityPolicyStoreCount, len(fakeService.securityPolicyStore.ListKeys()))
@@ -2241,12 +2242,12 @@ func Test_CreateOrUpdateSecurityPolicy(t *testing.T) {
 				Times:  1,
 			}})
 			patches.ApplyPrivateMethod(reflect.TypeOf(fakeService), "checkSecurityPolicyRealizationState",
-				func(s *SecurityPolicyService, sp *model.SecurityPolicy, spPath string) error {
+				func(s *SecurityPolicyService, _ context.Context, sp *model.SecurityPolicy, spPath string) error {
 					return nil
 				})
 			defer patches.Reset()
 
-			if err := fakeService.CreateOrUpdateSecurityPolicy(tt.args.spObj); (err != nil) != tt.wantErr {
+			if err := fakeService.CreateOrUpdateSecurityPolicy(context.TODO(), tt.args.spObj); (err != nil) != tt.wantErr {
 				t.Errorf("CreateOrUpdateSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -2368,7 +2369,7 @@ func Test_CreateOrUpdateSecurityPolicyFromNetworkPolicy(t *testing.T) {
 				},
 			})
 			patches.ApplyPrivateMethod(reflect.TypeOf(fakeService), "checkSecurityPolicyRealizationState",
-				func(s *SecurityPolicyService, sp *model.SecurityPolicy, spPath string) error {
+				func(s *SecurityPolicyService, _ context.Context, sp *model.SecurityPolicy, spPath string) error {
 					return nil
 				})
 			defer patches.Reset()
@@ -2376,7 +2377,7 @@ func Test_CreateOrUpdateSecurityPolicyFromNetworkPolicy(t *testing.T) {
 			assert.Equal(t, tt.wantSPStoreCountBeforeCreate, len(fakeService.securityPolicyStore.ListKeys()))
 			assert.Equal(t, tt.wantRuleStoreCountBeforeCreate, len(fakeService.ruleStore.ListKeys()))
 
-			if err := fakeService.CreateOrUpdateSecurityPolicy(tt.npObj); (err != nil) != tt.wantErr {
+			if err := fakeService.CreateOrUpdateSecurityPolicy(context.TODO(), tt.npObj); (err != nil) != tt.wantErr {
 				t.Errorf("CreateOrUpdateSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -2521,7 +2522,7 @@ func Test_createOrUpdateT1SecurityPolicy(t *testing.T) {
 			}})
 			defer patches.Reset()
 
-			if err := fakeService.createOrUpdateT1SecurityPolicy(tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.createOrUpdateT1SecurityPolicy(context.TODO(), tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("createOrUpdateT1SecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -2688,12 +2689,12 @@ func Test_createOrUpdateVPCSecurityPolicy(t *testing.T) {
 				Times:  1,
 			}})
 			patches.ApplyPrivateMethod(reflect.TypeOf(fakeService), "checkSecurityPolicyRealizationState",
-				func(s *SecurityPolicyService, sp *model.SecurityPolicy, spPath string) error {
+				func(s *SecurityPolicyService, _ context.Context, sp *model.SecurityPolicy, spPath string) error {
 					return nil
 				})
 			defer patches.Reset()
 
-			if err := fakeService.createOrUpdateVPCSecurityPolicy(tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.createOrUpdateVPCSecurityPolicy(context.TODO(), tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("createOrUpdateVPCSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -2869,12 +2870,12 @@ func Test_createOrUpdateVPCSecurityPolicyInDefaultProject(t *testing.T) {
 				Values: gomonkey.Params{*(tt.expectedPolicy), nil},
 				Times:  1,
 			}})
-			patches.ApplyPrivateMethod(reflect.TypeOf(fakeService), "checkSecurityPolicyRealizationState", func(_ *SecurityPolicyService, sp *model.SecurityPolicy, spPath string) error {
+			patches.ApplyPrivateMethod(reflect.TypeOf(fakeService), "checkSecurityPolicyRealizationState", func(_ *SecurityPolicyService, _ context.Context, sp *model.SecurityPolicy, spPath string) error {
 				return nil
 			})
 			defer patches.Reset()
 
-			if err := fakeService.createOrUpdateVPCSecurityPolicy(tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.createOrUpdateVPCSecurityPolicy(context.TODO(), tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("createOrUpdateVPCSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -2959,7 +2960,7 @@ func Test_GetFinalSecurityPolicyResourceForT1(t *testing.T) {
 			var isChanged bool
 			var err error
 
-			if finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, isChanged, err = fakeService.getFinalSecurityPolicyResource(tt.args.spObj, tt.args.createdFor, nil, false); (err != nil) != tt.wantErr {
+			if finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, _, isChanged, err = fakeService.getFinalSecurityPolicyResource(tt.args.spObj, tt.args.createdFor, nil, false); (err != nil) != tt.wantErr {
 				t.Errorf("getFinalSecurityPolicyResource error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -3044,6 +3045,8 @@ func Test_GetFinalSecurityPolicyResourceForVPC(t *testing.T) {
 						Scope:             []string{"/orgs/default/projects/projectQuality/vpcs/vpc1/groups/spA-1e510e8ad94c103f4727e2bfc28bc637cb752831-scope_re0bz"},
 						SequenceNumber:    &seq0,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"/orgs/default/projects/projectQuality/infra/domains/default/groups/spA-1e510e8ad94c103f4727e2bfc28bc637cb752831-src_re0bz"},
 						Action:            &nsxRuleActionAllow,
 						Tags:              ruleTags1,
@@ -3056,6 +3059,8 @@ func Test_GetFinalSecurityPolicyResourceForVPC(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq1,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"/orgs/default/projects/projectQuality/infra/domains/default/groups/spA-304ea84a85a12eae03f799a546ec5bfe1ffaaa64-src_re0bz"},
 						Action:            &nsxRuleActionAllow,
 						ServiceEntries:    []*data.StructValue{serviceEntry},
@@ -3090,7 +3095,7 @@ func Test_GetFinalSecurityPolicyResourceForVPC(t *testing.T) {
 			var isChanged bool
 			var err error
 
-			if finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, isChanged, err = fakeService.getFinalSecurityPolicyResource(tt.args.spObj, tt.args.createdFor, &VPCInfo[0], false); (err != nil) != tt.wantErr {
+			if finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, _, isChanged, err = fakeService.getFinalSecurityPolicyResource(tt.args.spObj, tt.args.createdFor, &VPCInfo[0], false); (err != nil) != tt.wantErr {
 				t.Errorf("getFinalSecurityPolicyResource error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -3295,6 +3300,8 @@ func Test_GetFinalSecurityPolicyResourceFromNetworkPolicy(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq0,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"/orgs/default/projects/projectQuality/infra/domains/default/groups/np-app-access-allow-411340818595b23e32e713f0737ff9ec3e777dd2-src_aoqj8"},
 						Action:            &nsxRuleActionAllow,
 						ServiceEntries:    []*data.StructValue{ingressServiceEntry},
@@ -3308,6 +3315,8 @@ func Test_GetFinalSecurityPolicyResourceFromNetworkPolicy(t *testing.T) {
 						Scope:             []string{"ANY"},
 						SequenceNumber:    &seq1,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionAllow,
 						ServiceEntries:    []*data.StructValue{egressServiceEntry},
@@ -3330,6 +3339,8 @@ func Test_GetFinalSecurityPolicyResourceFromNetworkPolicy(t *testing.T) {
 						Scope:             []string{"/orgs/default/projects/projectQuality/vpcs/vpc1/groups/np-app-access-isolation-scope_aoqj8"},
 						SequenceNumber:    &seq0,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              isolationRuleTags1,
@@ -3342,6 +3353,8 @@ func Test_GetFinalSecurityPolicyResourceFromNetworkPolicy(t *testing.T) {
 						Scope:             []string{"/orgs/default/projects/projectQuality/vpcs/vpc1/groups/np-app-access-isolation-scope_aoqj8"},
 						SequenceNumber:    &seq1,
 						Services:          []string{"ANY"},
+						Logged:            common.Bool(false),
+						Tag:               common.String(""),
 						SourceGroups:      []string{"ANY"},
 						Action:            &nsxRuleActionDrop,
 						Tags:              isolationRuleTags2,
@@ -3377,7 +3390,7 @@ func Test_GetFinalSecurityPolicyResourceFromNetworkPolicy(t *testing.T) {
 			convertSecurityPolicy, err := fakeService.convertNetworkPolicyToInternalSecurityPolicies(tt.npObj)
 			assert.Equal(t, nil, err)
 
-			if finalAllowSecurityPolicy, finalGroups, finalShares, finalShareGroups, isChanged, err = fakeService.getFinalSecurityPolicyResource(convertSecurityPolicy[0], common.ResourceTypeNetworkPolicy, &VPCInfo[0], false); (err != nil) != tt.wantErr {
+			if finalAllowSecurityPolicy, finalGroups, finalShares, finalShareGroups, _, isChanged, err = fakeService.getFinalSecurityPolicyResource(convertSecurityPolicy[0], common.ResourceTypeNetworkPolicy, &VPCInfo[0], false); (err != nil) != tt.wantErr {
 				t.Errorf("getFinalSecurityPolicyResource error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, *tt.expAllowPolicy.Id, *finalAllowSecurityPolicy.Id)
@@ -3389,7 +3402,7 @@ func Test_GetFinalSecurityPolicyResourceFromNetworkPolicy(t *testing.T) {
 			assert.Equal(t, tt.wantAllowPolicyShareGroupStoreCount, len(finalShareGroups))
 			assert.ElementsMatch(t, tt.expAllowPolicy.Rules, finalAllowSecurityPolicy.Rules)
 
-			if finalIsolationSecurityPolicy, finalGroups, finalShares, finalShareGroups, isChanged, err = fakeService.getFinalSecurityPolicyResource(convertSecurityPolicy[1], common.ResourceTypeNetworkPolicy, &VPCInfo[0], false); (err != nil) != tt.wantErr {
+			if finalIsolationSecurityPolicy, finalGroups, finalShares, finalShareGroups, _, isChanged, err = fakeService.getFinalSecurityPolicyResource(convertSecurityPolicy[1], common.ResourceTypeNetworkPolicy, &VPCInfo[0], false); (err != nil) != tt.wantErr {
 				t.Errorf("getFinalSecurityPolicyResource error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, *tt.expIsolationPolicy.Id, *finalIsolationSecurityPolicy.Id)
@@ -3719,7 +3732,7 @@ func Test_deleteVPCSecurityPolicyGC(t *testing.T) {
 			patches := tt.prepareFunc(t, fakeService)
 			defer patches.Reset()
 
-			if err := fakeService.deleteVPCSecurityPolicy(tt.args.uid, true, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.deleteVPCSecurityPolicy(context.TODO(), tt.args.uid, true, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("deleteVPCSecurityPolicyGC error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -0,0 +1,319 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+const (
+	// ContextProfileAttributeDomainName is the NSX context profile attribute key matching traffic by FQDN.
+	ContextProfileAttributeDomainName = "DOMAIN_NAME"
+	// MaxFQDNLength is the maximum length of a domain name defined by RFC 1035.
+	MaxFQDNLength      = 253
+	fqdnWildcardPrefix = "*."
+	// dnsContextProfilePath is the system defined NSX context profile matching the DNS App ID.
+	dnsContextProfilePath = "/infra/context-profiles/DNS"
+)
+
+// dnsServices are the system defined NSX services of the DNS traffic over TCP and UDP.
+var dnsServices = []string{"/infra/services/DNS", "/infra/services/DNS-UDP"}
+
+var fqdnLabelRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// peerHasFQDNs returns true if the peer matches traffic by domain names.
+func peerHasFQDNs(peer *v1alpha1.SecurityPolicyPeer) bool {
+	return len(peer.FQDNs) > 0
+}
+
+// peerHasSelectorOrIPBlock returns true if the peer matches traffic by label selectors or IP CIDRs.
+func peerHasSelectorOrIPBlock(peer *v1alpha1.SecurityPolicyPeer) bool {
+	return peer.VMSelector != nil || peer.PodSelector != nil || peer.NamespaceSelector != nil || len(peer.IPBlocks) > 0
+}
+
+// ruleHasFQDNPeers returns true if any destination peer of the rule is an FQDN peer.
+func ruleHasFQDNPeers(rule *v1alpha1.SecurityPolicyRule) bool {
+	peers := getRuleDestinationPeers(rule)
+	for i := range peers {
+		if peerHasFQDNs(&peers[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRuleFQDNs returns the normalized, deduplicated and sorted domain names of the rule destination peers.
+func getRuleFQDNs(rule *v1alpha1.SecurityPolicyRule) []string {
+	fqdnSet := sets.New[string]()
+	for _, peer := range getRuleDestinationPeers(rule) {
+		for _, fqdn := range peer.FQDNs {
+			fqdnSet.Insert(normalizeFQDN(fqdn))
+		}
+	}
+	fqdns := fqdnSet.UnsortedList()
+	sort.Strings(fqdns)
+	return fqdns
+}
+
+func normalizeFQDN(fqdn string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(fqdn)), ".")
+}
+
+// validateFQDN checks the domain name against the forms supported by the NSX DOMAIN_NAME attribute, that is
+// a fully qualified domain name, optionally with a single leading wildcard label such as "*.example.com".
+func validateFQDN(fqdn string) error {
+	name := normalizeFQDN(fqdn)
+	if name == "" {
+		return &nsxutil.ValidationError{Desc: "FQDN must not be empty"}
+	}
+	if len(name) > MaxFQDNLength {
+		return &nsxutil.ValidationError{Desc: fmt.Sprintf("FQDN %q exceeds the maximum length of %d", fqdn, MaxFQDNLength)}
+	}
+	if strings.HasPrefix(name, fqdnWildcardPrefix) {
+		name = strings.TrimPrefix(name, fqdnWildcardPrefix)
+		// A wildcard must be followed by at least a second-level domain, e.g. "*.com" is rejected.
+		if !strings.Contains(name, ".") {
+			return &nsxutil.ValidationError{Desc: fmt.Sprintf("unsupported wildcard FQDN %q: the wildcard must be followed by at least two labels", fqdn)}
+		}
+	}
+	if strings.Contains(name, "*") {
+		return &nsxutil.ValidationError{Desc: fmt.Sprintf("unsupported wildcard FQDN %q: only a single leading \"*.\" label is supported", fqdn)}
+	}
+	for _, label := range strings.Split(name, ".") {
+		if !fqdnLabelRegex.MatchString(label) {
+			return &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid FQDN %q: label %q is not a valid DNS label", fqdn, label)}
+		}
+	}
+	return nil
+}
+
+// validateRuleFQDNPeers validates the FQDN peers of the rule. FQDN peers are only supported as egress destinations,
+// can't be mixed with selector or IPBlock peers since NSX applies the context profile on top of the destination
+// groups, and can't be used with named ports which are resolved from the destination workloads.
+func validateRuleFQDNPeers(rule *v1alpha1.SecurityPolicyRule) error {
+	for _, peer := range getRuleSourcePeers(rule) {
+		if peerHasFQDNs(&peer) {
+			return &nsxutil.ValidationError{Desc: "FQDN peers are only supported in rule destinations"}
+		}
+	}
+	if !ruleHasFQDNPeers(rule) {
+		return nil
+	}
+	ruleDirection, err := getRuleDirection(rule)
+	if err != nil {
+		return err
+	}
+	if ruleDirection != "OUT" {
+		return &nsxutil.ValidationError{Desc: "FQDN peers are only supported in egress rules"}
+	}
+	for _, peer := range getRuleDestinationPeers(rule) {
+		if peerHasSelectorOrIPBlock(&peer) {
+			return &nsxutil.ValidationError{Desc: "FQDN peers cannot be combined with selector or IPBlock peers in the same rule"}
+		}
+		for _, fqdn := range peer.FQDNs {
+			if err := validateFQDN(fqdn); err != nil {
+				return err
+			}
+		}
+	}
+	for _, port := range rule.Ports {
+		if port.Port.Type == intstr.String {
+			return &nsxutil.ValidationError{Desc: fmt.Sprintf("named port %s is not supported in rules with FQDN peers", port.Port.String())}
+		}
+	}
+	return nil
+}
+
+func (service *SecurityPolicyService) buildRuleContextProfileID(obj *v1alpha1.SecurityPolicy, ruleIdx int, ruleBaseID string) string {
+	if IsVPCEnabled(service) {
+		existingProfiles := service.contextProfileStore.GetByIndex(common.TagScopeRuleID, ruleBaseID)
+		if len(existingProfiles) > 0 {
+			return *existingProfiles[0].Id
+		}
+		ruleHash := service.buildRuleHashString(&(obj.Spec.Rules[ruleIdx]))
+		return service.buildVpcGroupIdByRuleAndGroupType(obj, ruleHash, common.FQDNProfileSuffix, func(id string) bool {
+			return service.contextProfileStore.GetByKey(id) != nil
+		})
+	}
+	return util.GenerateID(string(obj.UID), common.SecurityPolicyPrefix, common.FQDNProfileSuffix, strconv.Itoa(ruleIdx))
+}
+
+func (service *SecurityPolicyService) buildRuleContextProfileName(obj *v1alpha1.SecurityPolicy, ruleIdx int) string {
+	ruleHash := service.buildRuleHashString(&(obj.Spec.Rules[ruleIdx]))
+	suffix := strings.Join([]string{ruleHash, common.FQDNProfileSuffix}, common.ConnectorUnderline)
+	return util.GenerateTruncName(common.MaxNameLength, obj.Name, "", suffix, "", "")
+}
+
+// buildRuleContextProfilePath returns the path of the FQDN context profile. The context profiles are created in the
+// same scope as the shared groups, that is /infra for T1 and the NSX Default Project, or the project infra otherwise.
+func (service *SecurityPolicyService) buildRuleContextProfilePath(profileID string, vpcInfo *common.VPCResourceInfo, isDefaultProject bool) (string, error) {
+	if IsVPCEnabled(service) {
+		if vpcInfo == nil {
+			return "", fmt.Errorf("vpcInfo is nil when building context profile %s", profileID)
+		}
+		if !isDefaultProject {
+			return fmt.Sprintf("/orgs/%s/projects/%s/infra/context-profiles/%s", vpcInfo.OrgID, vpcInfo.ProjectID, profileID), nil
+		}
+	}
+	return fmt.Sprintf("/infra/context-profiles/%s", profileID), nil
+}
+
+// buildRuleContextProfile builds the NSX context profile with DOMAIN_NAME attribute for an egress rule with FQDN peers.
+// It returns nil if the rule has no FQDN peers.
+func (service *SecurityPolicyService) buildRuleContextProfile(obj *v1alpha1.SecurityPolicy, rule *v1alpha1.SecurityPolicyRule, ruleIdx int,
+	ruleBaseID, createdFor string, vpcInfo *common.VPCResourceInfo, isDefaultProject bool,
+) (*model.PolicyContextProfile, error) {
+	if err := validateRuleFQDNPeers(rule); err != nil {
+		return nil, err
+	}
+	if !ruleHasFQDNPeers(rule) {
+		return nil, nil
+	}
+
+	profileID := service.buildRuleContextProfileID(obj, ruleIdx, ruleBaseID)
+	profileName := service.buildRuleContextProfileName(obj, ruleIdx)
+	profilePath, err := service.buildRuleContextProfilePath(profileID, vpcInfo, isDefaultProject)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := service.buildBasicTags(obj, createdFor)
+	tags = append(tags, model.Tag{
+		Scope: String(common.TagScopeRuleID),
+		Tag:   String(ruleBaseID),
+	})
+	profile := &model.PolicyContextProfile{
+		Id:          &profileID,
+		DisplayName: &profileName,
+		Path:        &profilePath,
+		Tags:        tags,
+		Attributes: []model.PolicyAttributes{
+			{
+				Key:      String(ContextProfileAttributeDomainName),
+				Datatype: String(model.PolicyAttributes_DATATYPE_STRING),
+				Value:    getRuleFQDNs(rule),
+			},
+		},
+	}
+	log.Debug("Built rule context profile", "ruleBaseID", ruleBaseID, "contextProfile", profile)
+	return profile, nil
+}
+
+// buildDNSSnoopingRule builds the rule allowing the DNS traffic of the workloads the FQDN rule is applied to, with the
+// DNS App ID context profile. NSX learns the IPs of the domain names from the DNS responses matched by such a rule, the
+// DOMAIN_NAME context profile of the FQDN rule matches no traffic without it. The rule only allows the TCP and UDP
+// DNS traffic in the direction of the FQDN rule, from the source groups and in the scope of the FQDN rule, to the
+// DNS servers configured in fqdn_dns_servers. The rule has the sequence number of the FQDN rule and is put before
+// it in the SecurityPolicy.
+// It returns nil if no DNS servers are configured, or if the FQDN rule doesn't allow the traffic, since a Drop or
+// Reject rule must not open any traffic.
+func (service *SecurityPolicyService) buildDNSSnoopingRule(fqdnRule *model.Rule, ruleBaseID string) *model.Rule {
+	if fqdnRule.Action == nil || *fqdnRule.Action != model.Rule_ACTION_ALLOW {
+		return nil
+	}
+	dnsServers := service.getFQDNDNSServers()
+	if len(dnsServers) == 0 {
+		return nil
+	}
+	ruleID := util.NormalizeId(strings.Join([]string{ruleBaseID, common.DNSRuleSuffix}, common.ConnectorUnderline))
+	displayName := util.GenerateTruncName(common.MaxNameLength, *fqdnRule.DisplayName, "", common.DNSRuleSuffix, "", "")
+	dnsRule := &model.Rule{
+		Id:                &ruleID,
+		DisplayName:       &displayName,
+		Direction:         fqdnRule.Direction,
+		SequenceNumber:    fqdnRule.SequenceNumber,
+		Action:            String(model.Rule_ACTION_ALLOW),
+		Services:          dnsServices,
+		SourceGroups:      fqdnRule.SourceGroups,
+		DestinationGroups: dnsServers,
+		Scope:             fqdnRule.Scope,
+		Profiles:          []string{dnsContextProfilePath},
+		Tags:              fqdnRule.Tags,
+		Logged:            fqdnRule.Logged,
+		Tag:               fqdnRule.Tag,
+	}
+	log.Debug("Built DNS snooping rule", "ruleBaseID", ruleBaseID, "nsxRule", dnsRule)
+	return dnsRule
+}
+
+// getFQDNDNSServers returns the sorted IPs or CIDRs of the DNS servers configured for the FQDN rules.
+func (service *SecurityPolicyService) getFQDNDNSServers() []string {
+	if service.NSXConfig == nil || service.NSXConfig.NsxConfig == nil {
+		return nil
+	}
+	dnsServers := sets.New[string]()
+	for _, server := range service.NSXConfig.FQDNDNSServers {
+		if server = strings.TrimSpace(server); server != "" {
+			dnsServers.Insert(server)
+		}
+	}
+	return sets.List(dnsServers)
+}
+
+func (service *SecurityPolicyService) getUpdateContextProfiles(existingProfiles []*model.PolicyContextProfile, expectedProfiles []model.PolicyContextProfile) []model.PolicyContextProfile {
+	changed, stale := common.CompareResources(ContextProfilesPtrToComparable(existingProfiles), ContextProfilesToComparable(expectedProfiles))
+	changedProfiles, staleProfiles := ComparableToContextProfiles(changed), ComparableToContextProfiles(stale)
+	for i := len(staleProfiles) - 1; i >= 0; i-- {
+		staleProfiles[i].MarkedForDelete = &MarkedForDelete
+	}
+	finalProfiles := make([]model.PolicyContextProfile, 0)
+	finalProfiles = append(finalProfiles, staleProfiles...)
+	finalProfiles = append(finalProfiles, changedProfiles...)
+	return finalProfiles
+}
+
+func (service *SecurityPolicyService) getMarkDeleteContextProfiles(existingProfiles []*model.PolicyContextProfile, sp types.UID) []model.PolicyContextProfile {
+	deleteProfiles := make([]model.PolicyContextProfile, 0)
+	if len(existingProfiles) == 0 {
+		log.Debug("Did not get context profiles with SecurityPolicy index", "securityPolicyUID", string(sp))
+		return deleteProfiles
+	}
+	for _, profile := range existingProfiles {
+		deleteProfile := *profile
+		deleteProfile.MarkedForDelete = &MarkedForDelete
+		deleteProfiles = append(deleteProfiles, deleteProfile)
+	}
+	return deleteProfiles
+}
+
+func (service *SecurityPolicyService) getStaleUpdateContextProfiles(profiles []model.PolicyContextProfile) ([]model.PolicyContextProfile, []model.PolicyContextProfile) {
+	staleProfiles := make([]model.PolicyContextProfile, 0)
+	changedProfiles := make([]model.PolicyContextProfile, 0)
+	for i := range profiles {
+		if profiles[i].MarkedForDelete != nil && *profiles[i].MarkedForDelete {
+			staleProfiles = append(staleProfiles, profiles[i])
+		} else {
+			changedProfiles = append(changedProfiles, profiles[i])
+		}
+	}
+	return staleProfiles, changedProfiles
+}
+
+func (service *SecurityPolicyService) wrapContextProfiles(profiles []model.PolicyContextProfile) ([]*data.StructValue, error) {
+	var profilesChildren []*data.StructValue
+	for _, p := range profiles {
+		profile := p
+		dataValue, err := common.WrapPolicyContextProfile(&profile)
+		if err != nil {
+			return nil, err
+		}
+		profilesChildren = append(profilesChildren, dataValue)
+	}
+	return profilesChildren, nil
+}
@@ -0,0 +1,348 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	gomonkey "github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func Test_validateFQDN(t *testing.T) {
+	tests := []struct {
+		name    string
+		fqdn    string
+		wantErr string
+	}{
+		{name: "plain FQDN", fqdn: "www.example.com"},
+		{name: "upper case and trailing dot", fqdn: "WWW.Example.COM."},
+		{name: "leading wildcard", fqdn: "*.example.com"},
+		{name: "empty", fqdn: " ", wantErr: "must not be empty"},
+		{name: "too long", fqdn: strings.Repeat("a.", 127) + "com", wantErr: "exceeds the maximum length"},
+		{name: "wildcard on TLD", fqdn: "*.com", wantErr: "at least two labels"},
+		{name: "bare wildcard", fqdn: "*", wantErr: "only a single leading"},
+		{name: "wildcard in the middle", fqdn: "www.*.example.com", wantErr: "only a single leading"},
+		{name: "partial wildcard label", fqdn: "api*.example.com", wantErr: "only a single leading"},
+		{name: "double wildcard", fqdn: "*.*.example.com", wantErr: "only a single leading"},
+		{name: "invalid label", fqdn: "-bad.example.com", wantErr: "is not a valid DNS label"},
+		{name: "empty label", fqdn: "www..example.com", wantErr: "is not a valid DNS label"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFQDN(tt.fqdn)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			var validationErr *nsxutil.ValidationError
+			assert.ErrorAs(t, err, &validationErr)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func Test_validateRuleFQDNPeers(t *testing.T) {
+	directionOut := v1alpha1.RuleDirectionOut
+	directionIn := v1alpha1.RuleDirectionIn
+	fqdnPeer := v1alpha1.SecurityPolicyPeer{FQDNs: []string{"*.example.com"}}
+	tests := []struct {
+		name    string
+		rule    v1alpha1.SecurityPolicyRule
+		wantErr string
+	}{
+		{
+			name: "egress FQDN peer",
+			rule: v1alpha1.SecurityPolicyRule{Direction: &directionOut, To: []v1alpha1.SecurityPolicyPeer{fqdnPeer}},
+		},
+		{
+			name: "no FQDN peer",
+			rule: v1alpha1.SecurityPolicyRule{Direction: &directionIn, From: []v1alpha1.SecurityPolicyPeer{{IPBlocks: []v1alpha1.IPBlock{{CIDR: "10.0.0.0/24"}}}}},
+		},
+		{
+			name:    "FQDN peer in sources",
+			rule:    v1alpha1.SecurityPolicyRule{Direction: &directionIn, From: []v1alpha1.SecurityPolicyPeer{fqdnPeer}},
+			wantErr: "only supported in rule destinations",
+		},
+		{
+			name:    "FQDN peer in ingress rule",
+			rule:    v1alpha1.SecurityPolicyRule{Direction: &directionIn, To: []v1alpha1.SecurityPolicyPeer{fqdnPeer}},
+			wantErr: "only supported in egress rules",
+		},
+		{
+			name: "FQDN peer mixed with IPBlock",
+			rule: v1alpha1.SecurityPolicyRule{Direction: &directionOut, To: []v1alpha1.SecurityPolicyPeer{
+				fqdnPeer, {IPBlocks: []v1alpha1.IPBlock{{CIDR: "10.0.0.0/24"}}},
+			}},
+			wantErr: "cannot be combined",
+		},
+		{
+			name: "FQDN peer mixed with selector",
+			rule: v1alpha1.SecurityPolicyRule{Direction: &directionOut, To: []v1alpha1.SecurityPolicyPeer{
+				{FQDNs: []string{"www.example.com"}, PodSelector: &v1.LabelSelector{}},
+			}},
+			wantErr: "cannot be combined",
+		},
+		{
+			name:    "unsupported wildcard",
+			rule:    v1alpha1.SecurityPolicyRule{Direction: &directionOut, To: []v1alpha1.SecurityPolicyPeer{{FQDNs: []string{"www.*.com"}}}},
+			wantErr: "unsupported wildcard FQDN",
+		},
+		{
+			name: "named port",
+			rule: v1alpha1.SecurityPolicyRule{
+				Direction: &directionOut,
+				To:        []v1alpha1.SecurityPolicyPeer{fqdnPeer},
+				Ports:     []v1alpha1.SecurityPolicyPort{{Protocol: "TCP", Port: intstr.FromString("https")}},
+			},
+			wantErr: "named port https is not supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRuleFQDNPeers(&tt.rule)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func Test_getRuleFQDNs(t *testing.T) {
+	rule := &v1alpha1.SecurityPolicyRule{
+		To: []v1alpha1.SecurityPolicyPeer{
+			{FQDNs: []string{"WWW.example.com.", "*.example.org"}},
+			{FQDNs: []string{"www.example.com"}},
+		},
+	}
+	assert.Equal(t, []string{"*.example.org", "www.example.com"}, getRuleFQDNs(rule))
+}
+
+func Test_BuildSecurityPolicyWithFQDNPeersForT1(t *testing.T) {
+	config.SetMixedModeStateForTest(true, false)
+	directionOut := v1alpha1.RuleDirectionOut
+	actionAllow := v1alpha1.RuleActionAllow
+	sp := &v1alpha1.SecurityPolicy{
+		ObjectMeta: v1.ObjectMeta{Namespace: "ns1", Name: "spFQDN", UID: "uidFQDN"},
+		Spec: v1alpha1.SecurityPolicySpec{
+			AppliedTo: []v1alpha1.SecurityPolicyTarget{
+				{PodSelector: &v1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+			},
+			Rules: []v1alpha1.SecurityPolicyRule{
+				{
+					Action:    &actionAllow,
+					Direction: &directionOut,
+					To:        []v1alpha1.SecurityPolicyPeer{{FQDNs: []string{"www.example.com", "*.example.org"}}},
+					Ports:     []v1alpha1.SecurityPolicyPort{{Protocol: "TCP", Port: intstr.FromInt32(443)}},
+				},
+			},
+		},
+	}
+
+	s := &SecurityPolicyService{
+		Service: common.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster:          "k8scl-one",
+					EnableVPCNetwork: false,
+				},
+				NsxConfig: &config.NsxConfig{
+					FQDNDNSServers: []string{"10.96.0.10", "10.96.0.10", "fd00::10"},
+				},
+			},
+		},
+	}
+	s.setUpStore(common.TagValueScopeSecurityPolicyUID, false)
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&s.Service), "GetNamespaceUID",
+		func(s *common.Service, ns string) types.UID {
+			return types.UID(tagValueNSUID)
+		})
+	defer patches.Reset()
+
+	nsxPolicy, _, _, nsxProfiles, err := s.buildSecurityPolicy(sp, common.ResourceTypeSecurityPolicy, nil, false)
+	require.NoError(t, err)
+	require.Len(t, *nsxProfiles, 1)
+	profile := (*nsxProfiles)[0]
+	assert.Equal(t, "/infra/context-profiles/"+*profile.Id, *profile.Path)
+	assert.Equal(t, []model.PolicyAttributes{
+		{
+			Key:      String(ContextProfileAttributeDomainName),
+			Datatype: String(model.PolicyAttributes_DATATYPE_STRING),
+			Value:    []string{"*.example.org", "www.example.com"},
+		},
+	}, profile.Attributes)
+
+	require.Len(t, nsxPolicy.Rules, 2)
+	rule := nsxPolicy.Rules[1]
+	assert.Equal(t, []string{*profile.Path}, rule.Profiles)
+	assert.Equal(t, []string{"ANY"}, rule.DestinationGroups)
+	assert.Equal(t, []string{"ANY"}, rule.SourceGroups)
+	assert.Equal(t, nsxPolicy.Scope, rule.Scope)
+
+	// The DNS snooping rule is put before the FQDN rule.
+	dnsRule := nsxPolicy.Rules[0]
+	assert.Equal(t, "sp_uidFQDN_"+s.buildRuleHashString(&sp.Spec.Rules[0])+"_0_dns", *dnsRule.Id)
+	assert.Equal(t, model.Rule_ACTION_ALLOW, *dnsRule.Action)
+	assert.Equal(t, "OUT", *dnsRule.Direction)
+	assert.Equal(t, rule.SequenceNumber, dnsRule.SequenceNumber)
+	assert.Equal(t, []string{"/infra/services/DNS", "/infra/services/DNS-UDP"}, dnsRule.Services)
+	assert.Nil(t, dnsRule.ServiceEntries)
+	assert.Equal(t, []string{"/infra/context-profiles/DNS"}, dnsRule.Profiles)
+	assert.Equal(t, []string{"10.96.0.10", "fd00::10"}, dnsRule.DestinationGroups)
+	assert.Equal(t, rule.SourceGroups, dnsRule.SourceGroups)
+	assert.Equal(t, rule.Scope, dnsRule.Scope)
+
+	// A deny FQDN rule doesn't allow any traffic.
+	for _, action := range []v1alpha1.RuleAction{v1alpha1.RuleActionDrop, v1alpha1.RuleActionReject} {
+		sp.Spec.Rules[0].Action = &action
+		nsxPolicy, _, _, _, err = s.buildSecurityPolicy(sp, common.ResourceTypeSecurityPolicy, nil, false)
+		require.NoError(t, err)
+		require.Len(t, nsxPolicy.Rules, 1)
+		assert.NotEqual(t, model.Rule_ACTION_ALLOW, *nsxPolicy.Rules[0].Action)
+		assert.Equal(t, []string{*profile.Path}, nsxPolicy.Rules[0].Profiles)
+	}
+	sp.Spec.Rules[0].Action = &actionAllow
+
+	// No DNS snooping rule is built without DNS servers.
+	s.NSXConfig.FQDNDNSServers = nil
+	nsxPolicy, _, _, _, err = s.buildSecurityPolicy(sp, common.ResourceTypeSecurityPolicy, nil, false)
+	require.NoError(t, err)
+	require.Len(t, nsxPolicy.Rules, 1)
+	assert.Equal(t, []string{*profile.Path}, nsxPolicy.Rules[0].Profiles)
+
+	// An unsupported wildcard is reported as a validation error.
+	sp.Spec.Rules[0].To[0].FQDNs = []string{"*.com"}
+	_, _, _, _, err = s.buildSecurityPolicy(sp, common.ResourceTypeSecurityPolicy, nil, false)
+	var validationErr *nsxutil.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func Test_buildRuleContextProfilePath(t *testing.T) {
+	config.SetMixedModeStateForTest(false, true)
+	defer config.SetMixedModeStateForTest(true, false)
+	s := &SecurityPolicyService{
+		Service: common.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster:          "k8scl-one",
+					EnableVPCNetwork: true,
+				},
+			},
+		},
+	}
+	vpcInfo := &common.VPCResourceInfo{OrgID: "default", ProjectID: "proj1", VPCID: "vpc1"}
+
+	path, err := s.buildRuleContextProfilePath("p1", vpcInfo, false)
+	require.NoError(t, err)
+	assert.Equal(t, "/orgs/default/projects/proj1/infra/context-profiles/p1", path)
+
+	path, err = s.buildRuleContextProfilePath("p1", vpcInfo, true)
+	require.NoError(t, err)
+	assert.Equal(t, "/infra/context-profiles/p1", path)
+
+	_, err = s.buildRuleContextProfilePath("p1", nil, false)
+	assert.Error(t, err)
+}
+
+func Test_GetUpdateContextProfiles(t *testing.T) {
+	s := &SecurityPolicyService{}
+	newProfile := func(id string, fqdns ...string) model.PolicyContextProfile {
+		return model.PolicyContextProfile{
+			Id:          String(id),
+			DisplayName: String(id),
+			Attributes: []model.PolicyAttributes{
+				{
+					Key:      String(ContextProfileAttributeDomainName),
+					Datatype: String(model.PolicyAttributes_DATATYPE_STRING),
+					Value:    fqdns,
+				},
+			},
+		}
+	}
+	unchanged := newProfile("p1", "www.example.com")
+	changed := newProfile("p2", "www.example.com")
+	stale := newProfile("p3", "www.example.com")
+
+	existing := []*model.PolicyContextProfile{&unchanged, &changed, &stale}
+	expected := []model.PolicyContextProfile{
+		newProfile("p1", "www.example.com"),
+		newProfile("p2", "*.example.com"),
+		newProfile("p4", "www.example.org"),
+	}
+	final := s.getUpdateContextProfiles(existing, expected)
+
+	staleProfiles, changedProfiles := s.getStaleUpdateContextProfiles(final)
+	require.Len(t, staleProfiles, 1)
+	assert.Equal(t, "p3", *staleProfiles[0].Id)
+	assert.True(t, *staleProfiles[0].MarkedForDelete)
+	var changedIDs []string
+	for _, p := range changedProfiles {
+		changedIDs = append(changedIDs, *p.Id)
+	}
+	assert.ElementsMatch(t, []string{"p2", "p4"}, changedIDs)
+
+	deleted := s.getMarkDeleteContextProfiles(existing, "uid")
+	assert.Len(t, deleted, 3)
+	for _, p := range deleted {
+		assert.True(t, *p.MarkedForDelete)
+	}
+}
+
+func Test_ContextProfileStore(t *testing.T) {
+	s := &SecurityPolicyService{}
+	s.setUpStore(common.TagValueScopeSecurityPolicyUID, false)
+	profile := model.PolicyContextProfile{
+		Id: String("p1"),
+		Tags: []model.Tag{
+			{Scope: String(common.TagValueScopeSecurityPolicyUID), Tag: String("sp-uid")},
+			{Scope: String(common.TagScopeRuleID), Tag: String("rule-id")},
+		},
+	}
+	profiles := []model.PolicyContextProfile{profile}
+	require.NoError(t, s.contextProfileStore.Apply(&profiles))
+	assert.NotNil(t, s.contextProfileStore.GetByKey("p1"))
+	assert.Len(t, s.contextProfileStore.GetByIndex(common.TagValueScopeSecurityPolicyUID, "sp-uid"), 1)
+	assert.Len(t, s.contextProfileStore.GetByIndex(common.TagScopeRuleID, "rule-id"), 1)
+
+	profiles[0].MarkedForDelete = &MarkedForDelete
+	require.NoError(t, s.contextProfileStore.Apply(&profiles))
+	assert.Nil(t, s.contextProfileStore.GetByKey("p1"))
+}
+
+func Test_WrapHierarchySecurityPolicyWithContextProfiles(t *testing.T) {
+	config.SetMixedModeStateForTest(true, false)
+	s := &SecurityPolicyService{
+		Service: common.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{Cluster: "k8scl-one"},
+			},
+		},
+	}
+	sp := &model.SecurityPolicy{Id: String("sp1")}
+	profiles := []model.PolicyContextProfile{{Id: String("p1")}}
+	infra, err := s.WrapHierarchySecurityPolicy(sp, nil, profiles)
+	require.NoError(t, err)
+	// One child domain for the security policy and one child context profile.
+	assert.Len(t, infra.Children, 2)
+
+	infra, err = s.wrapHierarchyInfraResources(nil, nil, profiles)
+	require.NoError(t, err)
+	assert.Len(t, infra.Children, 2)
+}
@@ -25,8 +25,16 @@ var (
 	ruleDirectionOut     = util.ToUpper(v1alpha1.RuleDirectionOut)
 )
 
-func getRuleAction(rule *v1alpha1.SecurityPolicyRule) (string, error) {
+func getRuleAction(rule *v1alpha1.SecurityPolicyRule, createdFor string) (string, error) {
 	ruleAction := util.ToUpper(*rule.Action)
+	// Pass is only valid for the AdminNetworkPolicy rules in the Environment category, the traffic skips the remaining
+	// AdminNetworkPolicy rules and is evaluated by the NetworkPolicy rules in the Application category.
+	if ruleAction == util.ToUpper(ruleActionPass) {
+		if createdFor == common.ResourceTypeAdminNetworkPolicy {
+			return nsxRuleActionJumpToApplication, nil
+		}
+		return "", errors.New("rule action Pass is only supported by AdminNetworkPolicy")
+	}
 	for _, validRuleAction := range validRuleActions {
 		if ruleAction == validRuleAction {
 			return ruleAction, nil
@@ -47,6 +55,20 @@ func getRuleDirection(rule *v1alpha1.SecurityPolicyRule) (string, error) {
 	}
 }
 
+// getRuleLogging returns whether the rule packets are logged and the label printed in the packet logs,
+// the rule level settings take precedence over the SecurityPolicy level defaults.
+func getRuleLogging(obj *v1alpha1.SecurityPolicy, rule *v1alpha1.SecurityPolicyRule) (bool, string) {
+	logged := obj.Spec.Logging
+	if rule.Logging != nil {
+		logged = *rule.Logging
+	}
+	logLabel := obj.Spec.LogLabel
+	if rule.LogLabel != "" {
+		logLabel = rule.LogLabel
+	}
+	return logged, logLabel
+}
+
 func getCluster(service *SecurityPolicyService) string {
 	return service.NSXConfig.Cluster
 }
@@ -7,8 +7,52 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
 func Test_GetCluster(t *testing.T) {
 	assert.Equal(t, "k8scl-one", getCluster(service))
 }
+
+func Test_GetRuleLogging(t *testing.T) {
+	tests := []struct {
+		name             string
+		spec             v1alpha1.SecurityPolicySpec
+		rule             v1alpha1.SecurityPolicyRule
+		expectedLogged   bool
+		expectedLogLabel string
+	}{
+		{
+			name: "no-logging",
+		},
+		{
+			name:             "policy-default",
+			spec:             v1alpha1.SecurityPolicySpec{Logging: true, LogLabel: "tenant-a"},
+			expectedLogged:   true,
+			expectedLogLabel: "tenant-a",
+		},
+		{
+			name:             "rule-override",
+			spec:             v1alpha1.SecurityPolicySpec{Logging: true, LogLabel: "tenant-a"},
+			rule:             v1alpha1.SecurityPolicyRule{Logging: common.Bool(false), LogLabel: "tenant-a-web"},
+			expectedLogged:   false,
+			expectedLogLabel: "tenant-a-web",
+		},
+		{
+			name:             "rule-only",
+			rule:             v1alpha1.SecurityPolicyRule{Logging: common.Bool(true)},
+			expectedLogged:   true,
+			expectedLogLabel: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &v1alpha1.SecurityPolicy{Spec: tt.spec}
+			logged, logLabel := getRuleLogging(obj, &tt.rule)
+			assert.Equal(t, tt.expectedLogged, logged)
+			assert.Equal(t, tt.expectedLogLabel, logLabel)
+		})
+	}
+}
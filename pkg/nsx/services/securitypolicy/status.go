@@ -0,0 +1,138 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// RuleError is returned when a SecurityPolicy rule fails to be built, so that the error can be reported in the
+// status of the rule.
+type RuleError struct {
+	// Index is the index of the rule in the SecurityPolicy rules.
+	Index int
+	Err   error
+}
+
+func (e *RuleError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+// GetRuleStatuses returns the realization state of each SecurityPolicy rule, err is the error returned by
+// CreateOrUpdateSecurityPolicy. The NSX rules are read from the stores, so they are the ones realized by the
+// last successful update if err is not nil.
+func (service *SecurityPolicyService) GetRuleStatuses(obj *v1alpha1.SecurityPolicy, err error) []v1alpha1.SecurityPolicyRuleStatus {
+	if len(obj.Spec.Rules) == 0 {
+		return nil
+	}
+	// The rule IDs are built from the normalized rules.
+	obj = obj.DeepCopy()
+	normalizeSecurityPolicyRules(obj)
+
+	createdFor := common.ResourceTypeSecurityPolicy
+	indexScope := getIndexScope(createdFor)
+	policyPath := ""
+	if policies := service.securityPolicyStore.GetByIndex(indexScope, string(obj.UID)); len(policies) > 0 && policies[0].Path != nil {
+		policyPath = *policies[0].Path
+	}
+	nsxRules := service.ruleStore.GetByIndex(indexScope, string(obj.UID))
+
+	var ruleErr *RuleError
+	isRuleErr := errors.As(err, &ruleErr)
+	isRealizeErr := nsxutil.IsRealizeStateError(err)
+
+	statuses := make([]v1alpha1.SecurityPolicyRuleStatus, 0, len(obj.Spec.Rules))
+	for ruleIdx := range obj.Spec.Rules {
+		ruleStatus := v1alpha1.SecurityPolicyRuleStatus{
+			Index: ruleIdx,
+			Name:  obj.Spec.Rules[ruleIdx].Name,
+		}
+		ruleBaseID := service.buildRuleID(obj, ruleIdx, createdFor)
+		for _, nsxRule := range nsxRules {
+			if service.isExpandedRule(nsxRule, ruleBaseID) {
+				ruleStatus.NSXRules = append(ruleStatus.NSXRules, buildNSXRuleStatus(nsxRule, policyPath))
+			}
+		}
+		sort.Slice(ruleStatus.NSXRules, func(i, j int) bool {
+			return ruleStatus.NSXRules[i].ID < ruleStatus.NSXRules[j].ID
+		})
+
+		switch {
+		case err == nil:
+			ruleStatus.RealizationState = model.GenericPolicyRealizedResource_STATE_REALIZED
+		case isRuleErr && ruleErr.Index == ruleIdx, isRealizeErr:
+			ruleStatus.RealizationState = model.GenericPolicyRealizedResource_STATE_ERROR
+			ruleStatus.Message = err.Error()
+		default:
+			// The SecurityPolicy failed for other reasons, e.g. an error in another rule, so the rule is not realized.
+			ruleStatus.RealizationState = model.GenericPolicyRealizedResource_STATE_UNREALIZED
+		}
+		statuses = append(statuses, ruleStatus)
+	}
+	return statuses
+}
+
+// isExpandedRule returns true if the NSX rule is expanded from the SecurityPolicy rule with ruleBaseID.
+func (service *SecurityPolicyService) isExpandedRule(nsxRule *model.Rule, ruleBaseID string) bool {
+	if IsVPCEnabled(service) {
+		for _, tag := range nsxRule.Tags {
+			if tag.Scope != nil && *tag.Scope == common.TagScopeRuleID && tag.Tag != nil {
+				return *tag.Tag == ruleBaseID
+			}
+		}
+		return false
+	}
+	return nsxRule.Id != nil && strings.HasPrefix(*nsxRule.Id, ruleBaseID+common.ConnectorUnderline)
+}
+
+func buildNSXRuleStatus(nsxRule *model.Rule, policyPath string) v1alpha1.SecurityPolicyNSXRule {
+	ruleStatus := v1alpha1.SecurityPolicyNSXRule{
+		ID:    *nsxRule.Id,
+		Ports: getRuleDestinationPorts(nsxRule),
+	}
+	if nsxRule.Path != nil {
+		ruleStatus.Path = *nsxRule.Path
+	} else if policyPath != "" {
+		// The rules built by NSX Operator are cached in the store without the path rendered by NSX.
+		ruleStatus.Path = strings.Join([]string{policyPath, "rules", *nsxRule.Id}, "/")
+	}
+	return ruleStatus
+}
+
+// getRuleDestinationPorts returns the destination ports of the L4 service entries of the NSX rule.
+func getRuleDestinationPorts(nsxRule *model.Rule) []string {
+	var ports []string
+	for _, serviceEntry := range nsxRule.ServiceEntries {
+		if serviceEntry == nil || !serviceEntry.HasField("destination_ports") {
+			continue
+		}
+		value, err := serviceEntry.Field("destination_ports")
+		if err != nil {
+			continue
+		}
+		destinationPorts, ok := value.(*data.ListValue)
+		if !ok {
+			continue
+		}
+		for _, port := range destinationPorts.List() {
+			if portValue, ok := port.(*data.StringValue); ok {
+				ports = append(ports, portValue.Value())
+			}
+		}
+	}
+	return ports
+}
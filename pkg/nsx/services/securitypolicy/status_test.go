@@ -0,0 +1,117 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func TestSecurityPolicyService_GetRuleStatuses(t *testing.T) {
+	s := fakeSecurityPolicyService()
+	s.setUpStore(common.TagValueScopeSecurityPolicyUID, false)
+
+	obj := &v1alpha1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sp1", UID: "sp-uid"},
+		Spec: v1alpha1.SecurityPolicySpec{
+			Rules: []v1alpha1.SecurityPolicyRule{
+				{
+					Name:   "allow-http",
+					Action: &allowAction,
+					Ports: []v1alpha1.SecurityPolicyPort{
+						{Protocol: "TCP", Port: intstr.FromInt32(80)},
+					},
+				},
+				{
+					Name:   "drop-all",
+					Action: &allowDrop,
+				},
+			},
+		},
+	}
+
+	policyID, policyPath := "sp_sp-uid", "/infra/domains/k8scl-one/security-policies/sp_sp-uid"
+	uidTags := []model.Tag{{Scope: common.String(common.TagValueScopeSecurityPolicyUID), Tag: common.String("sp-uid")}}
+	assert.NoError(t, s.securityPolicyStore.Apply(&model.SecurityPolicy{Id: &policyID, Path: &policyPath, Tags: uidTags}))
+
+	normalized := obj.DeepCopy()
+	normalizeSecurityPolicyRules(normalized)
+	rule0ID := s.buildRuleID(normalized, 0, common.ResourceTypeSecurityPolicy) + "_0_0"
+	rule1ID := s.buildRuleID(normalized, 1, common.ResourceTypeSecurityPolicy) + "_all"
+	nsxRules := []model.Rule{
+		{
+			Id:             &rule0ID,
+			ServiceEntries: []*data.StructValue{buildRuleServiceEntries(obj.Spec.Rules[0].Ports[0])},
+			Tags:           uidTags,
+		},
+		{
+			Id:   &rule1ID,
+			Tags: uidTags,
+		},
+	}
+	assert.NoError(t, s.ruleStore.Apply(&nsxRules))
+
+	expectedNSXRules := [][]v1alpha1.SecurityPolicyNSXRule{
+		{{ID: rule0ID, Path: policyPath + "/rules/" + rule0ID, Ports: []string{"80"}}},
+		{{ID: rule1ID, Path: policyPath + "/rules/" + rule1ID}},
+	}
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStates []string
+		expectedMsgs   []string
+	}{
+		{
+			name:           "realized",
+			err:            nil,
+			expectedStates: []string{model.GenericPolicyRealizedResource_STATE_REALIZED, model.GenericPolicyRealizedResource_STATE_REALIZED},
+			expectedMsgs:   []string{"", ""},
+		},
+		{
+			name:           "rule error",
+			err:            fmt.Errorf("failed to build: %w", &RuleError{Index: 1, Err: errors.New("invalid peer")}),
+			expectedStates: []string{model.GenericPolicyRealizedResource_STATE_UNREALIZED, model.GenericPolicyRealizedResource_STATE_ERROR},
+			expectedMsgs:   []string{"", "failed to build: invalid peer"},
+		},
+		{
+			name:           "realize state error",
+			err:            nsxutil.NewRealizeStateError("realization failed", 0),
+			expectedStates: []string{model.GenericPolicyRealizedResource_STATE_ERROR, model.GenericPolicyRealizedResource_STATE_ERROR},
+			expectedMsgs:   []string{"realization failed", "realization failed"},
+		},
+		{
+			name:           "other error",
+			err:            errors.New("NSX API error"),
+			expectedStates: []string{model.GenericPolicyRealizedResource_STATE_UNREALIZED, model.GenericPolicyRealizedResource_STATE_UNREALIZED},
+			expectedMsgs:   []string{"", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses := s.GetRuleStatuses(obj, tt.err)
+			assert.Len(t, statuses, 2)
+			for i, status := range statuses {
+				assert.Equal(t, i, status.Index)
+				assert.Equal(t, obj.Spec.Rules[i].Name, status.Name)
+				assert.Equal(t, expectedNSXRules[i], status.NSXRules)
+				assert.Equal(t, tt.expectedStates[i], status.RealizationState)
+				assert.Equal(t, tt.expectedMsgs[i], status.Message)
+			}
+		})
+	}
+
+	assert.Nil(t, s.GetRuleStatuses(&v1alpha1.SecurityPolicy{}, nil))
+}
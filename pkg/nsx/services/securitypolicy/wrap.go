@@ -16,8 +16,9 @@ import (
 // We use infra patch API in hierarchical mode to create/update/delete entire or part of intent hierarchy,
 // for this convenience we can no longer CRUD CR separately, and reduce the number of API calls to NSX-T.
 
-// WrapHierarchySecurityPolicy wrap the security policy with groups and rules into a hierarchy security policy for InfraClient to patch.
-func (service *SecurityPolicyService) WrapHierarchySecurityPolicy(sp *model.SecurityPolicy, gs []model.Group) (*model.Infra, error) {
+// WrapHierarchySecurityPolicy wrap the security policy with groups, rules and the FQDN context profiles referred by the rules
+// into a hierarchy security policy for InfraClient to patch.
+func (service *SecurityPolicyService) WrapHierarchySecurityPolicy(sp *model.SecurityPolicy, gs []model.Group, profiles []model.PolicyContextProfile) (*model.Infra, error) {
 	rulesChildren, err := service.wrapRules(sp.Rules)
 	if err != nil {
 		return nil, err
@@ -42,6 +43,11 @@ func (service *SecurityPolicyService) WrapHierarchySecurityPolicy(sp *model.Secu
 	if err != nil {
 		return nil, err
 	}
+	profilesChildren, err := service.wrapContextProfiles(profiles)
+	if err != nil {
+		return nil, err
+	}
+	infraChildren = append(infraChildren, profilesChildren...)
 	infra, err := service.wrapInfra(infraChildren)
 	if err != nil {
 		return nil, err
@@ -306,8 +312,8 @@ func (service *SecurityPolicyService) wrapChildTargetInfra(children []*data.Stru
 	return infraChildren, nil
 }
 
-// wrapHierarchyProjectResources wrap the project shares and groups into a project infra children in VPC mode.
-func (service *SecurityPolicyService) wrapHierarchyProjectResources(shares []model.Share, groups []model.Group) ([]*data.StructValue, error) {
+// wrapHierarchyProjectResources wrap the project shares, groups and context profiles into a project infra children in VPC mode.
+func (service *SecurityPolicyService) wrapHierarchyProjectResources(shares []model.Share, groups []model.Group, profiles []model.PolicyContextProfile) ([]*data.StructValue, error) {
 	var domainReferenceChildren []*data.StructValue
 	var infraChildren []*data.StructValue
 
@@ -329,6 +335,12 @@ func (service *SecurityPolicyService) wrapHierarchyProjectResources(shares []mod
 	}
 	infraChildren = append(infraChildren, domainTargetChildren...)
 
+	profilesChildren, err := service.wrapContextProfiles(profiles)
+	if err != nil {
+		return nil, err
+	}
+	infraChildren = append(infraChildren, profilesChildren...)
+
 	wrapProjInfraChildren, err := service.wrapChildTargetInfra(infraChildren)
 	if err != nil {
 		return nil, err
@@ -336,8 +348,8 @@ func (service *SecurityPolicyService) wrapHierarchyProjectResources(shares []mod
 	return wrapProjInfraChildren, nil
 }
 
-// wrapHierarchyInfraResources wrap the infra shares and groups into a infra children in VPC mode.
-func (service *SecurityPolicyService) wrapHierarchyInfraResources(shares []model.Share, groups []model.Group) (*model.Infra, error) {
+// wrapHierarchyInfraResources wrap the infra shares, groups and context profiles into a infra children.
+func (service *SecurityPolicyService) wrapHierarchyInfraResources(shares []model.Share, groups []model.Group, profiles []model.PolicyContextProfile) (*model.Infra, error) {
 	var domainReferenceChildren []*data.StructValue
 	var infraChildren []*data.StructValue
 
@@ -359,6 +371,12 @@ func (service *SecurityPolicyService) wrapHierarchyInfraResources(shares []model
 	}
 	infraChildren = append(infraChildren, domainTargetChildren...)
 
+	profilesChildren, err := service.wrapContextProfiles(profiles)
+	if err != nil {
+		return nil, err
+	}
+	infraChildren = append(infraChildren, profilesChildren...)
+
 	wrapInfra, err := service.wrapInfra(infraChildren)
 	if err != nil {
 		return nil, err
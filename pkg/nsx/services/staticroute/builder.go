@@ -47,11 +47,8 @@ func (service *StaticRouteService) buildStaticRoute(obj *v1alpha1.StaticRoute, n
 	} else {
 		sr.Network = String(obj.Spec.Network)
 	}
-	dis := int64(1)
 	for index := range obj.Spec.NextHops {
-		nexthop := model.RouterNexthop{AdminDistance: &dis}
-		nexthop.IpAddress = &obj.Spec.NextHops[index].IPAddress
-		sr.NextHops = append(sr.NextHops, nexthop)
+		sr.NextHops = append(sr.NextHops, buildNextHop(&obj.Spec.NextHops[index]))
 	}
 
 	tags := service.buildBasicTags(obj)
@@ -65,6 +62,19 @@ func (service *StaticRouteService) buildStaticRoute(obj *v1alpha1.StaticRoute, n
 	return sr, nil
 }
 
+// buildNextHop converts a next hop of the StaticRoute CR, the admin distance defaults to 1.
+func buildNextHop(nextHop *v1alpha1.NextHop) model.RouterNexthop {
+	dis := int64(defaultAdminDistance)
+	if nextHop.AdminDistance > 0 {
+		dis = int64(nextHop.AdminDistance)
+	}
+	nsxNextHop := model.RouterNexthop{AdminDistance: &dis, IpAddress: String(nextHop.IPAddress)}
+	if len(nextHop.Scope) > 0 {
+		nsxNextHop.Scope = append([]string{}, nextHop.Scope...)
+	}
+	return nsxNextHop
+}
+
 func (service *StaticRouteService) buildStaticRouteId(obj v1.Object) string {
 	return common.BuildUniqueIDWithRandomUUID(obj, util.GenerateIDByObject, service.staticRoutesIdExists)
 }
@@ -94,3 +94,15 @@ func TestBuildStaticRoute(t *testing.T) {
 	expId := "teststaticroute_du8nz"
 	assert.Equal(t, expId, *staticroutes.Id)
 }
+
+func TestBuildNextHop(t *testing.T) {
+	nextHop := buildNextHop(&v1alpha1.NextHop{IPAddress: "10.0.0.1"})
+	assert.Equal(t, "10.0.0.1", *nextHop.IpAddress)
+	assert.Equal(t, int64(1), *nextHop.AdminDistance)
+	assert.Nil(t, nextHop.Scope)
+
+	scope := []string{"/orgs/default/projects/p1/transit-gateways/default/attachments/a1"}
+	nextHop = buildNextHop(&v1alpha1.NextHop{IPAddress: "10.0.0.2", AdminDistance: 10, Scope: scope})
+	assert.Equal(t, int64(10), *nextHop.AdminDistance)
+	assert.Equal(t, scope, nextHop.Scope)
+}
@@ -1,13 +1,16 @@
 package staticroute
 
 import (
+	"slices"
+
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
-	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+const defaultAdminDistance = 1
+
 // assume that staticroute doesn't have the same ipaddress, return true if equal
 func (service *StaticRouteService) compareStaticRoute(oldStaticRoute *model.StaticRoutes, newStaticRoute *model.StaticRoutes) bool {
-	if *oldStaticRoute.Network != *newStaticRoute.Network {
+	if !equalString(oldStaticRoute.Network, newStaticRoute.Network) || !equalString(oldStaticRoute.NetworkIpAllocationPath, newStaticRoute.NetworkIpAllocationPath) {
 		return false
 	}
 	oldNextHops := oldStaticRoute.NextHops
@@ -15,14 +18,30 @@ func (service *StaticRouteService) compareStaticRoute(oldStaticRoute *model.Stat
 	if len(oldNextHops) != len(newNextHops) {
 		return false
 	}
-	oldHopsSet := sets.NewString()
-	for _, addr := range oldNextHops {
-		oldHopsSet.Insert(*addr.IpAddress)
+	oldHops := make(map[string]model.RouterNexthop, len(oldNextHops))
+	for _, hop := range oldNextHops {
+		oldHops[*hop.IpAddress] = hop
 	}
-	for _, addr := range newNextHops {
-		if !oldHopsSet.Has(*addr.IpAddress) {
+	for _, hop := range newNextHops {
+		oldHop, ok := oldHops[*hop.IpAddress]
+		if !ok || adminDistance(oldHop) != adminDistance(hop) || !slices.Equal(oldHop.Scope, hop.Scope) {
 			return false
 		}
 	}
 	return true
 }
+
+func equalString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// adminDistance returns the admin distance of the next hop, NSX defaults it to 1.
+func adminDistance(hop model.RouterNexthop) int64 {
+	if hop.AdminDistance == nil {
+		return defaultAdminDistance
+	}
+	return *hop.AdminDistance
+}
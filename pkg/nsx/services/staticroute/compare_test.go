@@ -45,4 +45,39 @@ func TestCompareStaticRoute(t *testing.T) {
 	assert.True(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteSame))
 	assert.False(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferent))
 	assert.False(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferentNetwork))
+
+	distance := int64(10)
+	newStaticRouteDifferentDistance := &model.StaticRoutes{
+		Network: util.Ptr("192.168.1.0/24"),
+		NextHops: []model.RouterNexthop{
+			{IpAddress: util.Ptr("192.168.1.1")},
+			{IpAddress: util.Ptr("192.168.1.2"), AdminDistance: &distance},
+		},
+	}
+	newStaticRouteDifferentScope := &model.StaticRoutes{
+		Network: util.Ptr("192.168.1.0/24"),
+		NextHops: []model.RouterNexthop{
+			{IpAddress: util.Ptr("192.168.1.1"), Scope: []string{"/infra/tier-0s/t0/locale-services/default/interfaces/uplink1"}},
+			{IpAddress: util.Ptr("192.168.1.2")},
+		},
+	}
+	assert.False(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferentDistance))
+	assert.False(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferentScope))
+
+	defaultDistance := int64(1)
+	newStaticRouteDefaultDistance := &model.StaticRoutes{
+		Network: util.Ptr("192.168.1.0/24"),
+		NextHops: []model.RouterNexthop{
+			{IpAddress: util.Ptr("192.168.1.2"), AdminDistance: &defaultDistance},
+			{IpAddress: util.Ptr("192.168.1.1"), AdminDistance: &defaultDistance},
+		},
+	}
+	assert.True(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDefaultDistance))
+
+	allocationStaticRoute := &model.StaticRoutes{
+		NetworkIpAllocationPath: util.Ptr("/orgs/default/projects/p1/vpcs/v1/ip-address-allocations/alloc-1"),
+		NextHops:                oldStaticRoute.NextHops,
+	}
+	assert.False(t, service.compareStaticRoute(oldStaticRoute, allocationStaticRoute))
+	assert.True(t, service.compareStaticRoute(allocationStaticRoute, allocationStaticRoute))
 }
@@ -3,11 +3,13 @@ package staticroute
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
@@ -124,7 +126,7 @@ func (service *StaticRouteService) CreateOrUpdateStaticRoute(ctx context.Context
 			// unrealized StaticRoute will be saved to the store after full sync.
 			// Recheck the realizedstate if the StaticRoute CR is not ready.
 			if !isStaticRouteReady(obj) {
-				return service.checkStaticRouteRealizeState(existingStaticRoute)
+				return service.checkStaticRouteRealizeState(ctx, existingStaticRoute)
 			}
 			return nil
 		}
@@ -143,7 +145,7 @@ func (service *StaticRouteService) CreateOrUpdateStaticRoute(ctx context.Context
 	if err != nil {
 		return err
 	}
-	err = service.checkStaticRouteRealizeState(&staticRoute)
+	err = service.checkStaticRouteRealizeState(ctx, &staticRoute)
 	if err != nil {
 		return err
 	}
@@ -154,11 +156,91 @@ func (service *StaticRouteService) CreateOrUpdateStaticRoute(ctx context.Context
 	return nil
 }
 
-func (service *StaticRouteService) checkStaticRouteRealizeState(staticRoute *model.StaticRoutes) error {
+// GetNextHopsStatus returns the realization state of the next hops of the StaticRoute CR. A next
+// hop is active if the VPC forwarding table has a route of the static route network through it,
+// which is only the case for the reachable next hops with the lowest admin distance. The next hops
+// with a higher admin distance than the active ones are standby, the others are inactive.
+// If the network is an IPAddressAllocation, the routes are only matched by their next hop.
+func (service *StaticRouteService) GetNextHopsStatus(obj *v1alpha1.StaticRoute) ([]v1alpha1.NextHopStatus, error) {
+	nsxStaticRoute := service.StaticRouteStore.GetStaticRoutesByCRUID(obj.GetUID())
+	if nsxStaticRoute == nil || nsxStaticRoute.Path == nil {
+		return nil, nil
+	}
+	vpcInfo, err := common.ParseVPCResourcePath(*nsxStaticRoute.Path)
+	if err != nil {
+		return nil, err
+	}
+	activeNextHops := sets.New[string]()
+	var cursor *string
+	for {
+		result, err := service.NSXClient.VPCForwardingTableClient.List(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, nil, cursor, nil, nil, nil, nil, nil, nil, nsxStaticRoute.Network, nil, nil, nil, nil)
+		err = nsxutil.TransNSXApiError(err)
+		if err != nil {
+			return nil, err
+		}
+		for _, table := range result.Results {
+			for _, entry := range table.RouteEntries {
+				if entry.NextHop == nil || (nsxStaticRoute.Network != nil && !sameNetwork(entry.Network, *nsxStaticRoute.Network)) {
+					continue
+				}
+				activeNextHops.Insert(normalizeIP(*entry.NextHop))
+			}
+		}
+		if result.Cursor == nil || *result.Cursor == "" {
+			break
+		}
+		cursor = result.Cursor
+	}
+
+	// The next hops with a higher distance than the used ones are standby by design.
+	usedDistance := int64(-1)
+	for _, hop := range nsxStaticRoute.NextHops {
+		if activeNextHops.Has(normalizeIP(*hop.IpAddress)) && (usedDistance < 0 || adminDistance(hop) < usedDistance) {
+			usedDistance = adminDistance(hop)
+		}
+	}
+	var status []v1alpha1.NextHopStatus
+	for _, hop := range nsxStaticRoute.NextHops {
+		state := v1alpha1.NextHopStateInactive
+		if activeNextHops.Has(normalizeIP(*hop.IpAddress)) {
+			state = v1alpha1.NextHopStateActive
+		} else if usedDistance >= 0 && adminDistance(hop) > usedDistance {
+			state = v1alpha1.NextHopStateStandby
+		}
+		status = append(status, v1alpha1.NextHopStatus{
+			IPAddress:     *hop.IpAddress,
+			AdminDistance: int(adminDistance(hop)),
+			State:         state,
+		})
+	}
+	return status, nil
+}
+
+// sameNetwork returns true if the routing entry network is the CIDR network.
+func sameNetwork(entryNetwork *string, network string) bool {
+	if entryNetwork == nil {
+		return false
+	}
+	_, a, errA := net.ParseCIDR(*entryNetwork)
+	_, b, errB := net.ParseCIDR(network)
+	if errA != nil || errB != nil {
+		return *entryNetwork == network
+	}
+	return a.String() == b.String()
+}
+
+func normalizeIP(ip string) string {
+	if parsed := net.ParseIP(ip); parsed != nil {
+		return parsed.String()
+	}
+	return ip
+}
+
+func (service *StaticRouteService) checkStaticRouteRealizeState(ctx context.Context, staticRoute *model.StaticRoutes) error {
 	realizeService := realizestate.InitializeRealizeState(service.Service)
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, *staticRoute.Path, []string{}); err != nil {
+	if err := realizeService.CheckRealizeState(ctx, util.NSXTRealizeRetry, *staticRoute.Path, []string{}); err != nil {
 		log.Error(err, "Failed to check static route realization state", "ID", *staticRoute.Id)
-		deleteErr := service.DeleteStaticRoute(staticRoute)
+		deleteErr := service.DeleteStaticRoute(ctx, staticRoute)
 		if deleteErr != nil {
 			log.Error(deleteErr, "Failed to delete static route after realization check failure", "ID", *staticRoute.Id)
 			return fmt.Errorf("realization check failed: %v; deletion failed: %v", err, deleteErr)
@@ -177,8 +259,8 @@ func (service *StaticRouteService) patch(orgId string, projectId string, vpcId s
 	return nil
 }
 
-func (service *StaticRouteService) DeleteStaticRoute(nsxStaticRoute *model.StaticRoutes) error {
-	staticRouteClient := service.NSXClient.StaticRouteClient
+func (service *StaticRouteService) DeleteStaticRoute(ctx context.Context, nsxStaticRoute *model.StaticRoutes) error {
+	staticRouteClient := service.NSXClient.WithContext(ctx).StaticRouteClient
 	vpcInfo, err := common.ParseVPCResourcePath(*nsxStaticRoute.Path)
 	if err != nil {
 		log.Error(err, "Failed to parse NSX VPC path for StaticRoute", "path", *nsxStaticRoute.Path)
@@ -209,7 +291,7 @@ func (service *StaticRouteService) GetUID(staticroute *model.StaticRoutes) *stri
 
 }
 
-func (service *StaticRouteService) DeleteStaticRouteByCR(obj *v1alpha1.StaticRoute) error {
+func (service *StaticRouteService) DeleteStaticRouteByCR(ctx context.Context, obj *v1alpha1.StaticRoute) error {
 	// Use obj.UID as the index to search the NSX StaticRoute from the local cache. Since this function is called
 	// when the "StaticRoute" is got from the kube-apiserver and its DeletionTimestamp is not Zero, the UID field
 	// must be set in the CR.
@@ -218,7 +300,7 @@ func (service *StaticRouteService) DeleteStaticRouteByCR(obj *v1alpha1.StaticRou
 		return nil
 	}
 	staticroute := staticroutes[0].(*model.StaticRoutes)
-	return service.DeleteStaticRoute(staticroute)
+	return service.DeleteStaticRoute(ctx, staticroute)
 }
 
 func (service *StaticRouteService) ListStaticRouteByName(ns, name string) []*model.StaticRoutes {
@@ -47,11 +47,11 @@ func (f *fakeIPAllocationService) GetIPAddressAllocationByOwner(_ v1.Object) (*m
 func (f *fakeIPAllocationService) CreateIPAddressAllocationForAddressBinding(_ *v1alpha1.AddressBinding, _ *v1alpha1.SubnetPort, _ bool) error {
 	return nil
 }
-func (f *fakeIPAllocationService) DeleteIPAddressAllocationForAddressBinding(_ v1.Object) error {
+func (f *fakeIPAllocationService) DeleteIPAddressAllocationForAddressBinding(_ context.Context, _ v1.Object) error {
 	return nil
 }
 func (f *fakeIPAllocationService) BuildIPAddressAllocationID(_ v1.Object) string { return "" }
-func (f *fakeIPAllocationService) DeleteIPAddressAllocationByNSXResource(_ *model.VpcIpAddressAllocation) error {
+func (f *fakeIPAllocationService) DeleteIPAddressAllocationByNSXResource(_ context.Context, _ *model.VpcIpAddressAllocation) error {
 	return nil
 }
 func (f *fakeIPAllocationService) ListIPAddressAllocationWithAddressBinding() []*model.VpcIpAddressAllocation {
@@ -174,14 +174,14 @@ func TestStaticRouteService_DeleteStaticRouteByCR(t *testing.T) {
 
 	// no record found
 	mockStaticRouteclient.EXPECT().Delete(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Times(0)
-	err = returnservice.DeleteStaticRouteByCR(srObj)
+	err = returnservice.DeleteStaticRouteByCR(context.TODO(), srObj)
 	assert.Equal(t, err, nil)
 
 	returnservice.StaticRouteStore.Add(sr1)
 
 	// delete record
 	mockStaticRouteclient.EXPECT().Delete("default", "project-1", "vpc-1", id).Return(nil).Times(1)
-	err = returnservice.DeleteStaticRouteByCR(srObj)
+	err = returnservice.DeleteStaticRouteByCR(context.TODO(), srObj)
 	assert.Equal(t, err, nil)
 	srs := returnservice.StaticRouteStore.List()
 	assert.Equal(t, len(srs), 0)
@@ -365,7 +365,7 @@ func TestStaticRouteService_DeleteStaticRoute(t *testing.T) {
 	t.Run("Error parsing path", func(t *testing.T) {
 		staticRouteID := "nonexistent-id"
 
-		err := service.DeleteStaticRoute(&model.StaticRoutes{
+		err := service.DeleteStaticRoute(context.TODO(), &model.StaticRoutes{
 			Path: &staticRouteID,
 			Id:   &staticRouteID,
 		})
@@ -379,7 +379,7 @@ func TestStaticRouteService_DeleteStaticRoute(t *testing.T) {
 		service.StaticRouteStore.Add(staticRoute)
 
 		mockStaticRouteclient.EXPECT().Delete("org1", "project1", "vpc1", staticRouteID).Return(nil).Times(1)
-		err := service.DeleteStaticRoute(&model.StaticRoutes{
+		err := service.DeleteStaticRoute(context.TODO(), &model.StaticRoutes{
 			Path: common.String(fmt.Sprintf("/orgs/org1/projects/project1/vpcs/vpc1/static-routes/%s", staticRouteID)),
 			Id:   &staticRouteID,
 		})
@@ -393,7 +393,7 @@ func TestStaticRouteService_DeleteStaticRoute(t *testing.T) {
 
 		mockStaticRouteclient.EXPECT().Delete("org1", "project1", "vpc1", staticRouteID).Return(fmt.Errorf("delete error")).Times(1)
 
-		err := service.DeleteStaticRoute(&model.StaticRoutes{
+		err := service.DeleteStaticRoute(context.TODO(), &model.StaticRoutes{
 			Path: common.String(fmt.Sprintf("/orgs/org1/projects/project1/vpcs/vpc1/static-routes/%s", staticRouteID)),
 			Id:   &staticRouteID,
 		})
@@ -595,11 +595,11 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		// Patch StaticRouteClient.Get to succeed, but realization check fails and delete fails
 		mockStaticRouteclient.EXPECT().Get("org1", "proj1", "vpc1", staticRouteID).Return(*nsxStaticRoute, nil).Times(1)
 		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeState,
-			func(_ *realizestate.RealizeStateService, _ wait.Backoff, _ string, _ []string) error {
+			func(_ *realizestate.RealizeStateService, _ context.Context, _ wait.Backoff, _ string, _ []string) error {
 				return nsxutil.NewRealizeStateError("mocked realized error", 0)
 			})
 		defer patchRealize.Reset()
-		patchDelete := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *StaticRouteService, _ *model.StaticRoutes) error {
+		patchDelete := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *StaticRouteService, _ context.Context, _ *model.StaticRoutes) error {
 			return fmt.Errorf("delete error")
 		})
 		defer patchDelete.Reset()
@@ -642,12 +642,12 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		// Patch StaticRouteClient.Get to succeed, but realization check fails and delete fails
 		mockStaticRouteclient.EXPECT().Get("org1", "proj1", "vpc1", staticRouteID).Return(*nsxStaticRoute, nil).Times(1)
 		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeState,
-			func(_ *realizestate.RealizeStateService, _ wait.Backoff, _ string, _ []string) error {
+			func(_ *realizestate.RealizeStateService, _ context.Context, _ wait.Backoff, _ string, _ []string) error {
 				return nsxutil.NewRealizeStateError("mocked realized error", 0)
 			})
 		defer patchRealize.Reset()
 		// Patch DeleteStaticRoute to succeed
-		patchDelete := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *StaticRouteService, _ *model.StaticRoutes) error {
+		patchDelete := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *StaticRouteService, _ context.Context, _ *model.StaticRoutes) error {
 			return nil
 		})
 		defer patchDelete.Reset()
@@ -686,7 +686,7 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		defer patchPatch.Reset()
 		// Patch Add to succeed, should return nil
 		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeState,
-			func(_ *realizestate.RealizeStateService, _ wait.Backoff, _ string, _ []string) error {
+			func(_ *realizestate.RealizeStateService, _ context.Context, _ wait.Backoff, _ string, _ []string) error {
 				return nil
 			})
 		defer patchRealize.Reset()
@@ -899,3 +899,88 @@ func TestCreateOrUpdateStaticRoute_WithNetworkIPAllocationName(t *testing.T) {
 		assert.Equal(t, nsxPath, capturedPath)
 	})
 }
+
+type fakeForwardingTableClient struct {
+	networkPrefix *string
+	results       []model.RoutingTableListResult
+	err           error
+}
+
+func (f *fakeForwardingTableClient) List(_ string, _ string, _ string, _ *string, cursorParam *string, _ *string, _ *string, _ *string, _ *string, _ *string, _ *string, networkPrefixParam *string, _ *int64, _ *string, _ *bool, _ *string) (model.RoutingTableListResult, error) {
+	f.networkPrefix = networkPrefixParam
+	if f.err != nil {
+		return model.RoutingTableListResult{}, f.err
+	}
+	if cursorParam != nil {
+		return f.results[1], nil
+	}
+	return f.results[0], nil
+}
+
+func TestStaticRouteService_GetNextHopsStatus(t *testing.T) {
+	service, mockController, _ := createService(t)
+	defer mockController.Finish()
+	obj := &v1alpha1.StaticRoute{ObjectMeta: v1.ObjectMeta{Name: "sr1", Namespace: "ns1", UID: "uid1"}}
+
+	// The StaticRoute is not created on NSX yet.
+	status, err := service.GetNextHopsStatus(obj)
+	assert.NoError(t, err)
+	assert.Nil(t, status)
+
+	distance := int64(10)
+	nsxStaticRoute := &model.StaticRoutes{
+		Id:         util.Ptr("sr1"),
+		Path:       util.Ptr("/orgs/default/projects/p1/vpcs/vpc1/static-routes/sr1"),
+		ParentPath: util.Ptr("/orgs/default/projects/p1/vpcs/vpc1"),
+		Network:    util.Ptr("10.10.0.0/16"),
+		NextHops: []model.RouterNexthop{
+			{IpAddress: util.Ptr("192.168.1.1")},
+			{IpAddress: util.Ptr("192.168.1.2")},
+			{IpAddress: util.Ptr("192.168.1.3"), AdminDistance: &distance},
+		},
+		Tags: []model.Tag{{Scope: util.Ptr(common.TagScopeStaticRouteCRUID), Tag: util.Ptr("uid1")}},
+	}
+	assert.NoError(t, service.StaticRouteStore.Add(nsxStaticRoute))
+
+	forwardingTableClient := &fakeForwardingTableClient{results: []model.RoutingTableListResult{
+		{
+			Cursor: util.Ptr("1"),
+			Results: []model.RoutingTable{{RouteEntries: []model.RoutingEntry{
+				{Network: util.Ptr("10.10.0.0/16"), NextHop: util.Ptr("192.168.1.1")},
+				{Network: util.Ptr("10.10.1.0/24"), NextHop: util.Ptr("192.168.1.3")},
+			}}},
+		},
+		{
+			Results: []model.RoutingTable{{RouteEntries: []model.RoutingEntry{
+				{Network: util.Ptr("10.10.0.0/16"), NextHop: util.Ptr("192.168.1.2")},
+			}}},
+		},
+	}}
+	service.NSXClient.VPCForwardingTableClient = forwardingTableClient
+	status, err = service.GetNextHopsStatus(obj)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.10.0.0/16", *forwardingTableClient.networkPrefix)
+	assert.Equal(t, []v1alpha1.NextHopStatus{
+		{IPAddress: "192.168.1.1", AdminDistance: 1, State: v1alpha1.NextHopStateActive},
+		{IPAddress: "192.168.1.2", AdminDistance: 1, State: v1alpha1.NextHopStateActive},
+		{IPAddress: "192.168.1.3", AdminDistance: 10, State: v1alpha1.NextHopStateStandby},
+	}, status)
+
+	// The next hops with the lowest distance are unreachable, the standby one is used.
+	forwardingTableClient.results = []model.RoutingTableListResult{
+		{Results: []model.RoutingTable{{RouteEntries: []model.RoutingEntry{
+			{Network: util.Ptr("10.10.0.0/16"), NextHop: util.Ptr("192.168.1.3")},
+		}}}},
+	}
+	status, err = service.GetNextHopsStatus(obj)
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.NextHopStatus{
+		{IPAddress: "192.168.1.1", AdminDistance: 1, State: v1alpha1.NextHopStateInactive},
+		{IPAddress: "192.168.1.2", AdminDistance: 1, State: v1alpha1.NextHopStateInactive},
+		{IPAddress: "192.168.1.3", AdminDistance: 10, State: v1alpha1.NextHopStateActive},
+	}, status)
+
+	forwardingTableClient.err = fmt.Errorf("forwarding table unavailable")
+	_, err = service.GetNextHopsStatus(obj)
+	assert.Error(t, err)
+}
@@ -18,7 +18,7 @@ func (subnet *Subnet) Key() string {
 }
 
 func (subnet *Subnet) Value() data.DataValue {
-	// IPv4SubnetSize/AccessMode/IPAddresses are immutable field,
+	// IPv4SubnetSize/AccessMode are immutable field, IPAddresses can only be appended which is checked separately,
 	// Changes of tags, subnetDHCPConfig, subnetDHCPv6Config, and IPAddressType are considered as changed.
 	// TODO AccessMode may also need to be compared in future.
 	var advancedConfig *model.SubnetAdvancedConfig
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -99,7 +101,7 @@ func InitializeSubnetService(service common.Service) (*SubnetService, error) {
 	return subnetService, nil
 }
 
-func (service *SubnetService) RestoreSubnetSet(obj *v1alpha1.SubnetSet, vpcInfo common.VPCResourceInfo, tags []model.Tag) error {
+func (service *SubnetService) RestoreSubnetSet(ctx context.Context, obj *v1alpha1.SubnetSet, vpcInfo common.VPCResourceInfo, tags []model.Tag) error {
 	nsxSubnets := service.SubnetStore.GetByIndex(common.TagScopeSubnetSetCRUID, string(obj.UID))
 	var errList []error
 	for _, subnetInfo := range obj.Status.Subnets {
@@ -127,7 +129,7 @@ func (service *SubnetService) RestoreSubnetSet(obj *v1alpha1.SubnetSet, vpcInfo
 			}
 		}
 		if changed {
-			_, err = service.createOrUpdateSubnet(obj, nsxSubnet, &vpcInfo, true)
+			_, err = service.createOrUpdateSubnet(ctx, obj, nsxSubnet, &vpcInfo, true)
 			if err != nil {
 				errList = append(errList, err)
 			}
@@ -148,7 +150,7 @@ func isSubnetReady(subnet *v1alpha1.Subnet) bool {
 	return false
 }
 
-func (service *SubnetService) CreateOrUpdateSubnet(obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (subnet *model.VpcSubnet, err error) {
+func (service *SubnetService) CreateOrUpdateSubnet(ctx context.Context, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (subnet *model.VpcSubnet, err error) {
 	uid := string(obj.GetUID())
 	nsxSubnet, err := service.buildSubnet(obj, tags, []string{})
 
@@ -179,13 +181,23 @@ func (service *SubnetService) CreateOrUpdateSubnet(obj client.Object, vpcInfo co
 					nsxSubnet.AdvancedConfig.DhcpServerAddresses = existingSubnet.AdvancedConfig.DhcpServerAddresses
 				}
 			}
-			changed = common.CompareResource(SubnetToComparable(existingSubnet), SubnetToComparable(nsxSubnet))
+			// CIDRs appended to spec.ipAddresses grow the Subnet in place.
+			addedIPAddresses := addedSubnetIPAddresses(existingSubnet.IpAddresses, subnet.Spec.IPAddresses)
+			changed = common.CompareResource(SubnetToComparable(existingSubnet), SubnetToComparable(nsxSubnet)) || len(addedIPAddresses) > 0
 			if changed {
-				// Only ipAddressType, tags, dhcp and specific advancedConfig fields are expected to be updated
+				// Only ipAddressType, tags, dhcp, appended ipAddresses and specific advancedConfig fields are expected to be updated
 				// inherit other fields from the existing Subnet
 				// Avoid modification on existingSubnet to ensure
 				// Subnet store is only updated after the updating succeeds.
 				updatedSubnet := *existingSubnet
+				if len(addedIPAddresses) > 0 {
+					if err := service.validateAddedIPAddresses(ctx, subnet, existingSubnet, addedIPAddresses, vpcInfo); err != nil {
+						log.Error(err, "Failed to grow Subnet", "SubnetId", uid, "added", addedIPAddresses)
+						return nil, err
+					}
+					log.Info("Growing Subnet with new CIDRs", "SubnetId", uid, "existing", existingSubnet.IpAddresses, "added", addedIPAddresses)
+					updatedSubnet.IpAddresses = append(slices.Clone(existingSubnet.IpAddresses), addedIPAddresses...)
+				}
 				updatedSubnet.Tags = nsxSubnet.Tags
 				updatedSubnet.SubnetDhcpConfig = nsxSubnet.SubnetDhcpConfig
 				updatedSubnet.SubnetDhcpv6Config = nsxSubnet.SubnetDhcpv6Config
@@ -208,7 +220,7 @@ func (service *SubnetService) CreateOrUpdateSubnet(obj client.Object, vpcInfo co
 			// unrealized Subnet will be saved to the store after full sync
 			// Recheck the realizedstate if the Subnet CR is not ready.
 			if !isSubnetReady(subnet) {
-				if err = service.checkSubnetRealizeState(nsxSubnet); err != nil {
+				if err = service.checkSubnetRealizeState(ctx, nsxSubnet); err != nil {
 					return nil, err
 				}
 			}
@@ -216,18 +228,112 @@ func (service *SubnetService) CreateOrUpdateSubnet(obj client.Object, vpcInfo co
 			return existingSubnet, nil
 		}
 	}
-	return service.createOrUpdateSubnet(obj, nsxSubnet, &vpcInfo, false)
+	return service.createOrUpdateSubnet(ctx, obj, nsxSubnet, &vpcInfo, false)
+}
+
+// addedSubnetIPAddresses returns the CIDRs in ipAddresses which are not yet in the ip_addresses of the NSX Subnet.
+// A Subnet whose CIDRs are not known yet is never grown.
+func addedSubnetIPAddresses(existing []string, ipAddresses []string) []string {
+	if len(existing) == 0 {
+		return nil
+	}
+	existingSet := sets.New(existing...)
+	var added []string
+	for _, ipAddress := range ipAddresses {
+		if !existingSet.Has(ipAddress) {
+			added = append(added, ipAddress)
+		}
+	}
+	return added
+}
+
+// validateAddedIPAddresses checks that the CIDRs added to the NSX Subnet existingSubnet of the Subnet CR are in the VPC
+// IP blocks of the Subnet access mode, that is the private IPs of the VPC, or the external or private TGW IP blocks,
+// and don't overlap with the CIDRs of the other Subnets of the VPC. It returns a ValidationError otherwise, so that
+// NSX is not patched with CIDRs it would reject or which conflict with another Subnet.
+func (service *SubnetService) validateAddedIPAddresses(ctx context.Context, subnet *v1alpha1.Subnet, existingSubnet *model.VpcSubnet, addedIPAddresses []string, vpcInfo common.VPCResourceInfo) error {
+	ipBlocks, err := service.getVPCIPBlocks(ctx, string(subnet.Spec.AccessMode), vpcInfo)
+	if err != nil {
+		return err
+	}
+	blockNets := parseCIDRs(ipBlocks)
+	vpcPath := vpcInfo.GetVPCPath()
+	var siblingNets []*net.IPNet
+	for _, obj := range service.SubnetStore.List() {
+		sibling := obj.(*model.VpcSubnet)
+		if sibling.Id == nil || *sibling.Id == *existingSubnet.Id || sibling.ParentPath == nil || *sibling.ParentPath != vpcPath {
+			continue
+		}
+		siblingNets = append(siblingNets, parseCIDRs(sibling.IpAddresses)...)
+	}
+	for _, ipAddress := range addedIPAddresses {
+		_, ipNet, err := net.ParseCIDR(ipAddress)
+		if err != nil {
+			return &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid CIDR %s in ipAddresses", ipAddress)}
+		}
+		if !slices.ContainsFunc(blockNets, func(block *net.IPNet) bool { return cidrContains(block, ipNet) }) {
+			return &nsxutil.ValidationError{Desc: fmt.Sprintf("CIDR %s is not in the VPC IP blocks %v of access mode %s", ipAddress, ipBlocks, subnet.Spec.AccessMode)}
+		}
+		for _, siblingNet := range siblingNets {
+			if siblingNet.Contains(ipNet.IP) || ipNet.Contains(siblingNet.IP) {
+				return &nsxutil.ValidationError{Desc: fmt.Sprintf("CIDR %s overlaps with CIDR %s of another Subnet in the VPC", ipAddress, siblingNet)}
+			}
+		}
+	}
+	return nil
+}
+
+// getVPCIPBlocks returns the CIDRs of the IP blocks the Subnets of the access mode are allocated from in the VPC.
+func (service *SubnetService) getVPCIPBlocks(ctx context.Context, accessMode string, vpcInfo common.VPCResourceInfo) ([]string, error) {
+	switch accessMode {
+	case v1alpha1.AccessModePublic, v1alpha1.AccessModeProject:
+		ipBlocksInfo := &v1alpha1.IPBlocksInfo{}
+		if err := service.Client.Get(ctx, types.NamespacedName{Name: common.IPBlocksInfoCRName}, ipBlocksInfo); err != nil {
+			return nil, fmt.Errorf("failed to get IPBlocksInfo %s: %w", common.IPBlocksInfoCRName, err)
+		}
+		if accessMode == v1alpha1.AccessModePublic {
+			return ipBlocksInfo.ExternalIPCIDRs, nil
+		}
+		return ipBlocksInfo.PrivateTGWIPCIDRs, nil
+	default:
+		if len(vpcInfo.PrivateIps) > 0 {
+			return vpcInfo.PrivateIps, nil
+		}
+		// The private IPs of a pre-created VPC are not in the VPC info.
+		vpc, err := service.NSXClient.WithContext(ctx).VPCClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID)
+		if err != nil {
+			return nil, nsxutil.TransNSXApiError(err)
+		}
+		return vpc.PrivateIps, nil
+	}
+}
+
+func parseCIDRs(cidrs []string) []*net.IPNet {
+	var ipNets []*net.IPNet
+	for _, cidr := range cidrs {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			ipNets = append(ipNets, ipNet)
+		}
+	}
+	return ipNets
+}
+
+// cidrContains returns true if the CIDR ipNet is included in the CIDR block.
+func cidrContains(block, ipNet *net.IPNet) bool {
+	blockOnes, blockBits := block.Mask.Size()
+	ones, bits := ipNet.Mask.Size()
+	return blockBits == bits && blockOnes <= ones && block.Contains(ipNet.IP)
 }
 
-func (service *SubnetService) checkSubnetRealizeState(nsxSubnet *model.VpcSubnet) error {
+func (service *SubnetService) checkSubnetRealizeState(ctx context.Context, nsxSubnet *model.VpcSubnet) error {
 	realizeService := realizestate.InitializeRealizeState(service.Service)
 	// Failure of CheckRealizeState may result in the creation of an existing Subnet.
 	// For Subnets, it's important to reuse the already created NSXSubnet.
 	// For SubnetSets, since the ID includes a random value, the created NSX Subnet needs to be deleted and recreated.
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, *nsxSubnet.Path, []string{}); err != nil {
+	if err := realizeService.CheckRealizeState(ctx, util.NSXTRealizeRetry, *nsxSubnet.Path, []string{}); err != nil {
 		log.Error(err, "Failed to check Subnet realization state", "ID", *nsxSubnet.Id)
 		// Delete the subnet if the realization check fails, avoiding creating duplicate subnets continuously.
-		deleteErr := service.DeleteSubnet(*nsxSubnet)
+		deleteErr := service.DeleteSubnet(ctx, *nsxSubnet)
 		if deleteErr != nil {
 			log.Error(deleteErr, "Failed to delete Subnet after realization check failure", "ID", *nsxSubnet.Id)
 			return fmt.Errorf("realization check failed: %v; deletion failed: %v", err, deleteErr)
@@ -237,8 +343,8 @@ func (service *SubnetService) checkSubnetRealizeState(nsxSubnet *model.VpcSubnet
 	return nil
 }
 
-func (service *SubnetService) createOrUpdateSubnet(obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
-	err := service.NSXClient.SubnetsClient.Patch(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id, *nsxSubnet)
+func (service *SubnetService) createOrUpdateSubnet(ctx context.Context, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+	err := service.NSXClient.WithContext(ctx).SubnetsClient.Patch(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id, *nsxSubnet)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to create or update nsxSubnet", "ID", *nsxSubnet.Id)
@@ -247,12 +353,12 @@ func (service *SubnetService) createOrUpdateSubnet(obj client.Object, nsxSubnet
 	}
 
 	// Get Subnet from NSX after patch operation as NSX renders several fields like `path`/`parent_path`.
-	if *nsxSubnet, err = service.NSXClient.SubnetsClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id); err != nil {
+	if *nsxSubnet, err = service.NSXClient.WithContext(ctx).SubnetsClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id); err != nil {
 		err = nsxutil.TransNSXApiError(err)
 		service.updateSubnetSetConditionOnFail(obj, err)
 		return nil, err
 	}
-	err = service.checkSubnetRealizeState(nsxSubnet)
+	err = service.checkSubnetRealizeState(ctx, nsxSubnet)
 	if err != nil {
 		service.updateSubnetSetConditionOnFail(obj, err)
 		return nil, err
@@ -277,10 +383,10 @@ func (service *SubnetService) createOrUpdateSubnet(obj client.Object, nsxSubnet
 	return nsxSubnet, nil
 }
 
-func (service *SubnetService) DeleteSubnet(nsxSubnet model.VpcSubnet) error {
+func (service *SubnetService) DeleteSubnet(ctx context.Context, nsxSubnet model.VpcSubnet) error {
 	subnetInfo, _ := common.ParseVPCResourcePath(*nsxSubnet.Path)
 	nsxSubnet.MarkedForDelete = &MarkedForDelete
-	err := service.NSXClient.SubnetsClient.Delete(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID)
+	err := service.NSXClient.WithContext(ctx).SubnetsClient.Delete(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		// GC will finally delete subnets that are not deleted successfully.
@@ -376,10 +482,12 @@ func (service *SubnetService) UpdateSubnetSetStatus(obj *v1alpha1.SubnetSet) err
 		}
 		subnetInfoList = append(subnetInfoList, subnetInfo)
 	}
-	if reflect.DeepEqual(obj.Status.Subnets, subnetInfoList) {
+	usage := &v1alpha1.SubnetSetUsage{Subnets: len(nsxSubnets), IPs: common.CountIPv4Addresses(nsxSubnets)}
+	if reflect.DeepEqual(obj.Status.Subnets, subnetInfoList) && reflect.DeepEqual(obj.Status.Usage, usage) {
 		return nil
 	}
 	obj.Status.Subnets = subnetInfoList
+	obj.Status.Usage = usage
 	if err := service.Client.Status().Update(context.Background(), obj); err != nil {
 		log.Error(err, "Failed to update SubnetSet status")
 		return err
@@ -515,7 +623,7 @@ func (service *SubnetService) GenerateSubnetNSTags(obj client.Object) []model.Ta
 	return tags
 }
 
-func (service *SubnetService) UpdateSubnetSet(ns string, vpcSubnets []*model.VpcSubnet, tags []model.Tag, subnetsetCR *v1alpha1.SubnetSet) error {
+func (service *SubnetService) UpdateSubnetSet(ctx context.Context, ns string, vpcSubnets []*model.VpcSubnet, tags []model.Tag, subnetsetCR *v1alpha1.SubnetSet) error {
 	dhcpMode := string(subnetsetCR.Spec.SubnetDHCPConfig.Mode)
 	dhcpv6Mode := string(subnetsetCR.Spec.SubnetDHCPv6Config.Mode)
 	if subnetsetCR.Spec.SubnetDHCPConfig.Mode == "" {
@@ -591,7 +699,7 @@ func (service *SubnetService) UpdateSubnetSet(ns string, vpcSubnets []*model.Vpc
 			err := fmt.Errorf("failed to parse NSX VPC path for Subnet %s: %s", subnetPath, err)
 			return err
 		}
-		if _, err := service.createOrUpdateSubnet(subnetSet, &updatedSubnet, &vpcInfo, false); err != nil {
+		if _, err := service.createOrUpdateSubnet(ctx, subnetSet, &updatedSubnet, &vpcInfo, false); err != nil {
 			return fmt.Errorf("failed to update Subnet %s in SubnetSet %s: %w", *vpcSubnet.Id, subnetSet.Name, err)
 		}
 		log.Info("Successfully updated SubnetSet", "subnetSet", subnetSet, "Subnet", *vpcSubnet.Id)
@@ -32,6 +32,7 @@ import (
 	mockOrgRoot "github.com/vmware-tanzu/nsx-operator/pkg/mock/orgrootclient"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -341,7 +342,7 @@ func TestInitializeSubnetService(t *testing.T) {
 			res := service.ListAllSubnet()
 			assert.Equal(t, tc.expectAllSubnetNum, len(res))
 
-			createdNSXSubnet, err := service.CreateOrUpdateSubnet(tc.existingSubnetCR, *tc.existingVPCInfo, tc.subnetCRTags)
+			createdNSXSubnet, err := service.CreateOrUpdateSubnet(context.TODO(), tc.existingSubnetCR, *tc.existingVPCInfo, tc.subnetCRTags)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.expectCreateSubnetUID, *createdNSXSubnet.Id)
 
@@ -570,7 +571,7 @@ func TestSubnetService_UpdateSubnetSet(t *testing.T) {
 	})
 
 	patchesCreateOrUpdateSubnet := gomonkey.ApplyFunc((*SubnetService).createOrUpdateSubnet,
-		func(r *SubnetService, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+		func(r *SubnetService, _ context.Context, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
 			return &model.VpcSubnet{Path: &fakeSubnetPath}, nil
 		})
 	defer patchesCreateOrUpdateSubnet.Reset()
@@ -582,7 +583,7 @@ func TestSubnetService_UpdateSubnetSet(t *testing.T) {
 		func(_ client.Client, _ string) (bool, error) {
 			return false, nil
 		})
-	err := service.UpdateSubnetSet("ns-1", vpcSubnets, tags, &v1alpha1.SubnetSet{})
+	err := service.UpdateSubnetSet(context.TODO(), "ns-1", vpcSubnets, tags, &v1alpha1.SubnetSet{})
 	assert.Nil(t, err)
 }
 
@@ -636,7 +637,7 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 					})
 				// Patch the realization check
 				p.ApplyPrivateMethod(reflect.TypeOf(service), "checkSubnetRealizeState",
-					func(_ *SubnetService, _ *model.VpcSubnet) error {
+					func(_ *SubnetService, _ context.Context, _ *model.VpcSubnet) error {
 						return nil
 					})
 				p.ApplyMethod(reflect.TypeOf(service), "UpdateSubnetSetStatus",
@@ -664,7 +665,7 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 					})
 				// Patch the realization check
 				p.ApplyPrivateMethod(reflect.TypeOf(service), "checkSubnetRealizeState",
-					func(_ *SubnetService, _ *model.VpcSubnet) error {
+					func(_ *SubnetService, _ context.Context, _ *model.VpcSubnet) error {
 						return nil
 					})
 			},
@@ -698,7 +699,7 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 			// We pass a SubnetSet as the obj to test the status update branch
 			obj := &v1alpha1.SubnetSet{}
 
-			res, err := service.createOrUpdateSubnet(obj, tt.nsxSubnet, tt.vpcInfo, tt.restoreMode)
+			res, err := service.createOrUpdateSubnet(context.TODO(), obj, tt.nsxSubnet, tt.vpcInfo, tt.restoreMode)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -807,7 +808,7 @@ func TestSubnetService_DeleteSubnet(t *testing.T) {
 				patches := tt.prepareFunc()
 				defer patches.Reset()
 			}
-			err := service.DeleteSubnet(fakeSubnet)
+			err := service.DeleteSubnet(context.TODO(), fakeSubnet)
 			if tt.expectedErr != "" {
 				assert.NotNil(t, err, "Expected an error but got nil")
 				if err != nil {
@@ -861,7 +862,7 @@ func TestSubnetService_RestoreSubnetSet(t *testing.T) {
 					}
 					return []*model.VpcSubnet{}
 				})
-				patches.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+				patches.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, _ context.Context, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
 					return nil, nil
 				})
 				return patches
@@ -892,7 +893,7 @@ func TestSubnetService_RestoreSubnetSet(t *testing.T) {
 					}
 					return []*model.VpcSubnet{}
 				})
-				patches.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+				patches.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, _ context.Context, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
 					return nil, nil
 				})
 				return patches
@@ -920,7 +921,7 @@ func TestSubnetService_RestoreSubnetSet(t *testing.T) {
 					}
 					return []*model.VpcSubnet{}
 				})
-				patches.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+				patches.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, _ context.Context, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
 					return nil, fmt.Errorf("mocked error")
 				})
 				return patches
@@ -930,7 +931,7 @@ func TestSubnetService_RestoreSubnetSet(t *testing.T) {
 	}
 	for _, tt := range tests {
 		patches := tt.prepareFunc()
-		err := service.RestoreSubnetSet(tt.subnetset, common.VPCResourceInfo{}, []model.Tag{})
+		err := service.RestoreSubnetSet(context.TODO(), tt.subnetset, common.VPCResourceInfo{}, []model.Tag{})
 		if tt.expectedErr != "" {
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), tt.expectedErr)
@@ -2452,7 +2453,7 @@ func TestSubnetService_CreateOrUpdateSubnet_ConnectivityState(t *testing.T) {
 			}
 
 			updateCalled := false
-			patches := gomonkey.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+			patches := gomonkey.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, _ context.Context, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
 				updateCalled = true
 				// Verify the connectivity state is correctly set
 				if tc.expectedConnectivityState != nil {
@@ -2468,7 +2469,7 @@ func TestSubnetService_CreateOrUpdateSubnet_ConnectivityState(t *testing.T) {
 			})
 			defer patches.Reset()
 
-			_, err := service.CreateOrUpdateSubnet(subnetCR, vpcResourceInfo, basicTags)
+			_, err := service.CreateOrUpdateSubnet(context.TODO(), subnetCR, vpcResourceInfo, basicTags)
 			require.NoError(t, err)
 
 			if tc.expectUpdate {
@@ -2588,7 +2589,7 @@ func TestSubnetService_CreateOrUpdateSubnet_Consistency(t *testing.T) {
 				require.NoError(t, service.SubnetStore.Apply(tc.existingSubnet))
 			}
 
-			patches := gomonkey.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+			patches := gomonkey.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, _ context.Context, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
 				for _, tags := range nsxSubnet.Tags {
 					fmt.Printf("tags scope %s tag %s\n", *tags.Scope, *tags.Tag)
 				}
@@ -2597,12 +2598,12 @@ func TestSubnetService_CreateOrUpdateSubnet_Consistency(t *testing.T) {
 				}
 				return nil, nil
 			})
-			patches.ApplyFunc((*SubnetService).checkSubnetRealizeState, func(service *SubnetService, nsxSubnet *model.VpcSubnet) error {
+			patches.ApplyFunc((*SubnetService).checkSubnetRealizeState, func(service *SubnetService, _ context.Context, nsxSubnet *model.VpcSubnet) error {
 				return nil
 			})
 			defer patches.Reset()
 
-			_, err := service.CreateOrUpdateSubnet(subnetCR, vpcResourceInfo, basicTags)
+			_, err := service.CreateOrUpdateSubnet(context.TODO(), subnetCR, vpcResourceInfo, basicTags)
 			require.NoError(t, err)
 		})
 	}
@@ -3068,3 +3069,125 @@ func TestSubnetService_GetAllGatewayPrefixesOfSubnet_Integration(t *testing.T) {
 		})
 	}
 }
+
+func TestAddedSubnetIPAddresses(t *testing.T) {
+	assert.Nil(t, addedSubnetIPAddresses(nil, []string{"10.0.0.0/28"}))
+	assert.Nil(t, addedSubnetIPAddresses([]string{"10.0.0.0/28"}, []string{"10.0.0.0/28"}))
+	assert.Equal(t, []string{"10.0.1.0/28", "10.0.2.0/28"}, addedSubnetIPAddresses([]string{"10.0.0.0/28"}, []string{"10.0.0.0/28", "10.0.1.0/28", "10.0.2.0/28"}))
+}
+
+func TestSubnetService_CreateOrUpdateSubnet_GrowIPAddresses(t *testing.T) {
+	service := &SubnetService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{
+				SubnetsClient:      &fakeSubnetsClient{},
+				SubnetStatusClient: &fakeSubnetStatusClient{},
+			},
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster: "k8scl-one:test",
+				},
+			},
+		},
+		SubnetStore: &SubnetStore{
+			ResourceStore: common.ResourceStore{
+				Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
+					common.TagScopeSubnetCRUID:    subnetIndexFunc,
+					common.TagScopeSubnetSetCRUID: subnetSetIndexFunc,
+					common.TagScopeVMNamespace:    subnetIndexVMNamespaceFunc,
+					common.TagScopeNamespace:      subnetIndexNamespaceFunc,
+				}),
+				BindingType: model.VpcSubnetBindingType(),
+			},
+		},
+	}
+
+	uuidStr := "test-uuid-grow"
+	basicTags := []model.Tag{
+		{Scope: String(common.TagScopeSubnetCRName), Tag: String("subnet-grow")},
+		{Scope: String(common.TagScopeSubnetCRUID), Tag: String(uuidStr)},
+		{Scope: String(common.TagScopeNamespaceUID), Tag: String("ns1")},
+	}
+	require.NoError(t, service.SubnetStore.Apply(&model.VpcSubnet{
+		Id:          String("existing-subnet-id"),
+		DisplayName: String("existing-subnet-name"),
+		Tags:        basicTags,
+		IpAddresses: []string{"10.0.0.0/28"},
+		AccessMode:  String("Private"),
+	}))
+	subnetCR := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:       types.UID(uuidStr),
+			Name:      "subnet-grow",
+			Namespace: "ns1",
+		},
+		Spec: v1alpha1.SubnetSpec{
+			IPAddresses: []string{"10.0.0.0/28", "10.0.1.0/28"},
+		},
+	}
+	vpcResourceInfo, _ := common.ParseVPCResourcePath("/orgs/default/projects/test/vpcs/vpc1")
+	vpcResourceInfo.PrivateIps = []string{"10.0.0.0/16"}
+
+	var updated *model.VpcSubnet
+	patches := gomonkey.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, _ context.Context, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+		updated = nsxSubnet
+		return nsxSubnet, nil
+	})
+	patches.ApplyFunc(controllerscommon.IsNamespaceInTepLessMode, func(_ client.Client, _ string) (bool, error) {
+		return false, nil
+	})
+	defer patches.Reset()
+
+	_, err := service.CreateOrUpdateSubnet(context.TODO(), subnetCR, vpcResourceInfo, basicTags)
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	assert.Equal(t, "existing-subnet-id", *updated.Id)
+	assert.Equal(t, []string{"10.0.0.0/28", "10.0.1.0/28"}, updated.IpAddresses)
+	// The existing NSX Subnet in the store is not modified before the update succeeds.
+	existing := service.SubnetStore.GetByIndex(common.TagScopeSubnetCRUID, uuidStr)
+	require.Len(t, existing, 1)
+	assert.Equal(t, []string{"10.0.0.0/28"}, existing[0].IpAddresses)
+
+	// The appended CIDRs are validated against the VPC IP blocks and the other Subnets of the VPC.
+	require.NoError(t, service.SubnetStore.Apply(&model.VpcSubnet{
+		Id:          String("sibling-subnet-id"),
+		ParentPath:  String("/orgs/default/projects/test/vpcs/vpc1"),
+		IpAddresses: []string{"10.0.2.0/24"},
+	}))
+	scheme := runtime.NewScheme()
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	service.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.IPBlocksInfo{
+		ObjectMeta:      metav1.ObjectMeta{Name: common.IPBlocksInfoCRName},
+		ExternalIPCIDRs: []string{"192.168.0.0/24"},
+	}).Build()
+	tests := []struct {
+		name        string
+		accessMode  v1alpha1.AccessMode
+		ipAddresses []string
+		wantErr     string
+	}{
+		{name: "out of the VPC private IPs", ipAddresses: []string{"10.0.0.0/28", "10.1.0.0/28"}, wantErr: "CIDR 10.1.0.0/28 is not in the VPC IP blocks [10.0.0.0/16] of access mode "},
+		{name: "larger than the VPC private IPs", ipAddresses: []string{"10.0.0.0/28", "10.0.0.0/8"}, wantErr: "CIDR 10.0.0.0/8 is not in the VPC IP blocks"},
+		{name: "overlapping with another Subnet", ipAddresses: []string{"10.0.0.0/28", "10.0.2.16/28"}, wantErr: "CIDR 10.0.2.16/28 overlaps with CIDR 10.0.2.0/24 of another Subnet in the VPC"},
+		{name: "in the external IP blocks", accessMode: v1alpha1.AccessMode(v1alpha1.AccessModePublic), ipAddresses: []string{"10.0.0.0/28", "192.168.0.16/28"}},
+		{name: "out of the external IP blocks", accessMode: v1alpha1.AccessMode(v1alpha1.AccessModePublic), ipAddresses: []string{"10.0.0.0/28", "10.0.1.0/28"}, wantErr: "CIDR 10.0.1.0/28 is not in the VPC IP blocks [192.168.0.0/24] of access mode Public"},
+		{name: "no private TGW IP blocks", accessMode: v1alpha1.AccessMode(v1alpha1.AccessModeProject), ipAddresses: []string{"10.0.0.0/28", "10.0.1.0/28"}, wantErr: "CIDR 10.0.1.0/28 is not in the VPC IP blocks [] of access mode PrivateTGW"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated = nil
+			subnetCR.Spec.AccessMode = tt.accessMode
+			subnetCR.Spec.IPAddresses = tt.ipAddresses
+			_, err := service.CreateOrUpdateSubnet(context.TODO(), subnetCR, vpcResourceInfo, basicTags)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.ipAddresses, updated.IpAddresses)
+				return
+			}
+			var validationErr *nsxutil.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Nil(t, updated)
+		})
+	}
+}
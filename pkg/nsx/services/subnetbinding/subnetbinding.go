@@ -95,14 +95,14 @@ func (s *BindingService) CreateOrUpdateSubnetConnectionBindingMap(
 
 // DeleteSubnetConnectionBindingMapsByCRName deletes all the SubnetConnectionBindingMaps generated by the given subnetBinding
 // CR's Name.
-func (s *BindingService) DeleteSubnetConnectionBindingMapsByCRName(bindingName string, bindingNamespace string) error {
+func (s *BindingService) DeleteSubnetConnectionBindingMapsByCRName(ctx context.Context, bindingName string, bindingNamespace string) error {
 	bindingMaps := s.BindingStore.getBindingsByBindingMapCRName(bindingName, bindingNamespace)
-	return s.deleteSubnetConnectionBindingMaps(bindingMaps)
+	return s.deleteSubnetConnectionBindingMaps(ctx, bindingMaps)
 }
 
 // DeleteSubnetConnectionBindingMapsByParentSubnet deletes all the SubnetConnectionBindingMaps bound to the
 // given parentSubnet.
-func (s *BindingService) DeleteSubnetConnectionBindingMapsByParentSubnet(parentSubnet *model.VpcSubnet) error {
+func (s *BindingService) DeleteSubnetConnectionBindingMapsByParentSubnet(ctx context.Context, parentSubnet *model.VpcSubnet) error {
 	bindingMaps := make([]*model.SubnetConnectionBindingMap, 0)
 	// This should not happen in the production setup, adding this check is for security.
 	if parentSubnet.Path == nil {
@@ -111,7 +111,7 @@ func (s *BindingService) DeleteSubnetConnectionBindingMapsByParentSubnet(parentS
 	}
 	subnetPath := *parentSubnet.Path
 	bindingMaps = append(bindingMaps, s.BindingStore.getBindingsByParentSubnet(subnetPath)...)
-	return s.deleteSubnetConnectionBindingMaps(bindingMaps)
+	return s.deleteSubnetConnectionBindingMaps(ctx, bindingMaps)
 }
 
 // GetSubnetConnectionBindingMapsBySubnet returns all the SubnetConnectionBindingMaps referred to the given subnet.
@@ -247,7 +247,7 @@ func (s *BindingService) Apply(subnetPath string, bindingMaps []*model.SubnetCon
 // deleteSubnetConnectionBindingMaps uses HAPI call to delete multiple SubnetConnectionBindingMaps on NSX in one
 // transaction, and then delete these resources from local store. Since NSX can accept no more than 5K children Nodes
 // in one HAPI call, paging is used in this function to avoid scale issues.
-func (s *BindingService) deleteSubnetConnectionBindingMaps(bindingMaps []*model.SubnetConnectionBindingMap) error {
+func (s *BindingService) deleteSubnetConnectionBindingMaps(ctx context.Context, bindingMaps []*model.SubnetConnectionBindingMap) error {
 	// Add this check for security purpose. The caller has similar pre-check and returned if no items exist in the bindingMaps.
 	bindingMapsCount := len(bindingMaps)
 	if bindingMapsCount == 0 {
@@ -259,12 +259,12 @@ func (s *BindingService) deleteSubnetConnectionBindingMaps(bindingMaps []*model.
 		bm.MarkedForDelete = &markForDelete
 	}
 
-	return s.builder.PagingUpdateResources(context.TODO(), bindingMaps, servicecommon.DefaultHAPIChildrenCount, s.NSXClient, func(deletedObjs []*model.SubnetConnectionBindingMap) {
+	return s.builder.PagingUpdateResources(ctx, bindingMaps, servicecommon.DefaultHAPIChildrenCount, s.NSXClient, func(deletedObjs []*model.SubnetConnectionBindingMap) {
 		s.BindingStore.DeleteMultipleObjects(deletedObjs)
 	})
 }
 
-func (s *BindingService) DeleteMultiSubnetConnectionBindingMapsByCRs(bindingCRs sets.Set[string]) error {
+func (s *BindingService) DeleteMultiSubnetConnectionBindingMapsByCRs(ctx context.Context, bindingCRs sets.Set[string]) error {
 	if bindingCRs.Len() == 0 {
 		return nil
 	}
@@ -273,7 +273,7 @@ func (s *BindingService) DeleteMultiSubnetConnectionBindingMapsByCRs(bindingCRs
 		bms := s.BindingStore.getBindingsByBindingMapCRUID(crID)
 		finalBindingMaps = append(finalBindingMaps, bms...)
 	}
-	return s.deleteSubnetConnectionBindingMaps(finalBindingMaps)
+	return s.deleteSubnetConnectionBindingMaps(ctx, finalBindingMaps)
 }
 
 func (s *BindingService) GetSubnetConnectionBindingMapCRName(bindingMap *model.SubnetConnectionBindingMap) string {
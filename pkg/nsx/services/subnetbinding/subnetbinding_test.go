@@ -457,7 +457,7 @@ func TestDeleteMultiSubnetConnectionBindingMapsByCRs(t *testing.T) {
 				tc.prepareFunc()
 			}
 
-			err = svc.DeleteMultiSubnetConnectionBindingMapsByCRs(sets.New[string](tc.bindingCRIDs...))
+			err = svc.DeleteMultiSubnetConnectionBindingMapsByCRs(context.TODO(), sets.New[string](tc.bindingCRIDs...))
 			if tc.expErr != "" {
 				require.EqualError(t, err, tc.expErr)
 			} else {
@@ -483,7 +483,7 @@ func TestDeleteSubnetConnectionBindingMaps(t *testing.T) {
 			name: "test with DeleteSubnetConnectionBindingMapsByCRName",
 			deleteFn: func(svc *BindingService) error {
 				mockOrgRootClient.EXPECT().Patch(gomock.Any(), &enforceRevisionCheckParam).Return(nil)
-				return svc.DeleteSubnetConnectionBindingMapsByCRName(binding1.Name, binding1.Namespace)
+				return svc.DeleteSubnetConnectionBindingMapsByCRName(context.TODO(), binding1.Name, binding1.Namespace)
 			},
 			expErr:                "",
 			expBindingMapsInStore: []*model.SubnetConnectionBindingMap{},
@@ -491,7 +491,7 @@ func TestDeleteSubnetConnectionBindingMaps(t *testing.T) {
 			name: "test with DeleteSubnetConnectionBindingMapsByParentSubnet",
 			deleteFn: func(svc *BindingService) error {
 				mockOrgRootClient.EXPECT().Patch(gomock.Any(), &enforceRevisionCheckParam).Return(nil)
-				return svc.DeleteSubnetConnectionBindingMapsByParentSubnet(parentSubnet1)
+				return svc.DeleteSubnetConnectionBindingMapsByParentSubnet(context.TODO(), parentSubnet1)
 			},
 			expErr:                "",
 			expBindingMapsInStore: []*model.SubnetConnectionBindingMap{},
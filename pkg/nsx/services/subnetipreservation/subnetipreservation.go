@@ -1,6 +1,7 @@
 package subnetipreservation
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -163,42 +164,42 @@ func (s *IPReservationService) GetOrCreateDynamicIPReservation(ipReservation *v1
 	return nsxIPReservationCreated.Ips, nil
 }
 
-func (s *IPReservationService) DeleteIPReservationByCRName(ns, name string) error {
+func (s *IPReservationService) DeleteIPReservationByCRName(ctx context.Context, ns, name string) error {
 	namespacedName := types.NamespacedName{Namespace: ns, Name: name}
 	nsxDynamicIPReservations := s.DynamicIPReservationStore.GetByIndex(common.TagScopeSubnetIPReservationCRName, namespacedName.String())
 	for _, nsxIPReservation := range nsxDynamicIPReservations {
-		if err := s.DeleteDynamicIPReservation(nsxIPReservation); err != nil {
+		if err := s.DeleteDynamicIPReservation(ctx, nsxIPReservation); err != nil {
 			return err
 		}
 	}
 	nsxStaticIPReservations := s.StaticIPReservationStore.GetByIndex(common.TagScopeSubnetIPReservationCRName, namespacedName.String())
 	for _, nsxIPReservation := range nsxStaticIPReservations {
-		if err := s.DeleteStaticIPReservation(nsxIPReservation); err != nil {
+		if err := s.DeleteStaticIPReservation(ctx, nsxIPReservation); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (s *IPReservationService) DeleteIPReservationByCRId(id string) error {
+func (s *IPReservationService) DeleteIPReservationByCRId(ctx context.Context, id string) error {
 	nsxDynamicIPReservations := s.DynamicIPReservationStore.GetByIndex(common.TagScopeSubnetIPReservationCRUID, id)
 	for _, nsxIPReservation := range nsxDynamicIPReservations {
-		if err := s.DeleteDynamicIPReservation(nsxIPReservation); err != nil {
+		if err := s.DeleteDynamicIPReservation(ctx, nsxIPReservation); err != nil {
 			return err
 		}
 	}
 	nsxStaticIPReservations := s.StaticIPReservationStore.GetByIndex(common.TagScopeSubnetIPReservationCRUID, id)
 	for _, nsxIPReservation := range nsxStaticIPReservations {
-		if err := s.DeleteStaticIPReservation(nsxIPReservation); err != nil {
+		if err := s.DeleteStaticIPReservation(ctx, nsxIPReservation); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (s *IPReservationService) DeleteStaticIPReservation(nsxIPReservation *model.StaticIpAddressReservation) error {
+func (s *IPReservationService) DeleteStaticIPReservation(ctx context.Context, nsxIPReservation *model.StaticIpAddressReservation) error {
 	ipReservationInfo, _ := common.ParseVPCResourcePath(*nsxIPReservation.Path)
-	err := s.NSXClient.StaticIPReservationsClient.Delete(ipReservationInfo.OrgID, ipReservationInfo.ProjectID, ipReservationInfo.VPCID, ipReservationInfo.ParentID, *nsxIPReservation.Id)
+	err := s.NSXClient.WithContext(ctx).StaticIPReservationsClient.Delete(ipReservationInfo.OrgID, ipReservationInfo.ProjectID, ipReservationInfo.VPCID, ipReservationInfo.ParentID, *nsxIPReservation.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to delete Subnet StaticIPReservation", "StaticIPReservation", *nsxIPReservation.Path)
@@ -215,9 +216,9 @@ func (s *IPReservationService) DeleteStaticIPReservation(nsxIPReservation *model
 	return nil
 }
 
-func (s *IPReservationService) DeleteDynamicIPReservation(nsxIPReservation *model.DynamicIpAddressReservation) error {
+func (s *IPReservationService) DeleteDynamicIPReservation(ctx context.Context, nsxIPReservation *model.DynamicIpAddressReservation) error {
 	ipReservationInfo, _ := common.ParseVPCResourcePath(*nsxIPReservation.Path)
-	err := s.NSXClient.DynamicIPReservationsClient.Delete(ipReservationInfo.OrgID, ipReservationInfo.ProjectID, ipReservationInfo.VPCID, ipReservationInfo.ParentID, *nsxIPReservation.Id)
+	err := s.NSXClient.WithContext(ctx).DynamicIPReservationsClient.Delete(ipReservationInfo.OrgID, ipReservationInfo.ProjectID, ipReservationInfo.VPCID, ipReservationInfo.ParentID, *nsxIPReservation.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to delete Subnet DynamicIPReservation", "DynamicIPReservation", *nsxIPReservation.Path)
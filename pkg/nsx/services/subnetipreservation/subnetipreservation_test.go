@@ -1,6 +1,7 @@
 package subnetipreservation
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -112,21 +113,21 @@ func TestDeleteIPReservation(t *testing.T) {
 		return nil
 	})
 	defer patches.Reset()
-	err := service.DeleteIPReservationByCRId("ipr1-uuid")
+	err := service.DeleteIPReservationByCRId(context.TODO(), "ipr1-uuid")
 	require.Nil(t, err)
-	err = service.DeleteIPReservationByCRName("ns-2", "ipr1")
+	err = service.DeleteIPReservationByCRName(context.TODO(), "ns-2", "ipr1")
 	require.Nil(t, err)
 	iprs := service.DynamicIPReservationStore.List()
 	require.Equal(t, 1, len(iprs))
 	require.Equal(t, ipr2, iprs[0].(*model.DynamicIpAddressReservation))
 
 	service.StaticIPReservationStore.Apply(staticIpr1)
-	err = service.DeleteIPReservationByCRName("ns-1", "sipr1")
+	err = service.DeleteIPReservationByCRName(context.TODO(), "ns-1", "sipr1")
 	require.NoError(t, err)
 	require.Equal(t, 0, len(service.StaticIPReservationStore.List()))
 
 	service.StaticIPReservationStore.Apply(staticIpr1)
-	err = service.DeleteIPReservationByCRId("sipr1-uuid")
+	err = service.DeleteIPReservationByCRId(context.TODO(), "sipr1-uuid")
 	require.NoError(t, err)
 	require.Equal(t, 0, len(service.StaticIPReservationStore.List()))
 }
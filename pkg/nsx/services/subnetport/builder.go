@@ -46,8 +46,10 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 	var addressBindings []model.PortAddressBindingEntry
 	var hasMacSpecified bool
 	var staticIpAllocationType string
+	var portSettingName string
 	switch o := obj.(type) {
 	case *v1alpha1.SubnetPort:
+		portSettingName = o.Spec.PortSettingName
 		externalAddressBinding, err = service.buildExternalAddressBinding(o, restoreMode)
 		if err != nil {
 			return nil, err
@@ -92,6 +94,7 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 		}
 		staticIpAllocationType = controllercommon.ConvertCRStaticIPAddressTypeToNSX(o.Spec.StaticIPAllocationType)
 	case *corev1.Pod:
+		portSettingName = o.GetAnnotations()[common.AnnotationPortSetting]
 		if restoreMode && len(o.Status.PodIPs) > 0 {
 			addressBindings = []model.PortAddressBindingEntry{}
 			for _, ip := range o.Status.PodIPs {
@@ -111,6 +114,13 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 		)
 	}
 
+	var portConfigPath *string
+	if portSettingName != "" {
+		if portConfigPath, err = service.buildPortConfigPath(objNamespace, portSettingName, nsxSubnet); err != nil {
+			return nil, err
+		}
+	}
+
 	// Compute allocateAddresses from staticIpAllocationType × hasMacSpecified matrix:
 	//   static non-None + MAC → IP_POOL
 	//   static non-None + no MAC → BOTH
@@ -226,6 +236,7 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 		Path:                   &nsxSubnetPortPath,
 		ParentPath:             nsxSubnet.Path,
 		ExternalAddressBinding: externalAddressBinding,
+		PortConfigPath:         portConfigPath,
 	}
 	// For backward compatibility, only set StaticIpAllocationType for 9.2+
 	if service.NSXClient.NSXCheckVersion(nsx.IPv6) {
@@ -241,6 +252,22 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 	return nsxSubnetPort, nil
 }
 
+// buildPortConfigPath returns the path of the NSX VpcSubnetPortConfig realized for the SubnetPortSetting. The
+// VpcSubnetPortConfig must be under the same NSX VpcSubnet with the port.
+func (service *SubnetPortService) buildPortConfigPath(namespace, portSettingName string, nsxSubnet *model.VpcSubnet) (*string, error) {
+	if service.SubnetPortSettingService == nil {
+		return nil, fmt.Errorf("SubnetPortSetting %s/%s is not supported", namespace, portSettingName)
+	}
+	portConfigPath, err := service.SubnetPortSettingService.GetPortConfigPath(namespace, portSettingName)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(portConfigPath, *nsxSubnet.Path+"/") {
+		return nil, fmt.Errorf("SubnetPortSetting %s/%s is not for the Subnet %s", namespace, portSettingName, *nsxSubnet.Path)
+	}
+	return &portConfigPath, nil
+}
+
 // getStatefulSetInfo returns the StatefulSet name and UID if the pod's controller
 // is a StatefulSet (matches real API server behavior: the STS sets controller=true).
 func getStatefulSetInfo(obj interface{}) (string, string) {
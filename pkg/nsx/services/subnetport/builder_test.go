@@ -1659,3 +1659,75 @@ func TestBuildSubnetPortMACVersionGating(t *testing.T) {
 		assert.Equal(t, "aa:bb:cc:dd:ee:ff", *port.AddressBindings[0].MacAddress)
 	}
 }
+
+func TestBuildSubnetPortWithPortSetting(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	k8sClient := mock_client.NewMockClient(mockCtl)
+	nsxClient := &nsx.Client{}
+	portSettingService := &mock.MockSubnetPortSettingServiceProvider{}
+	service := &SubnetPortService{
+		Service: common.Service{
+			Client:    k8sClient,
+			NSXClient: nsxClient,
+			NSXConfig: &config.NSXOperatorConfig{
+				NsxConfig: &config.NsxConfig{EnforcementPoint: "vmc-enforcementpoint"},
+				CoeConfig: &config.CoeConfig{Cluster: "fake_cluster"},
+			},
+		},
+		SubnetPortStore:          setupStore(),
+		SubnetPortSettingService: portSettingService,
+	}
+	k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(nsxClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+		return true
+	})
+	defer patches.Reset()
+
+	subnetPath := "/orgs/default/projects/default/vpcs/vpc1/subnets/subnet1"
+	nsxSubnet := &model.VpcSubnet{Path: common.String(subnetPath)}
+	portConfigPath := subnetPath + "/port-configs/ps1_abcde"
+	portSettingService.On("GetPortConfigPath", "fake_ns", "ps1").Return(portConfigPath, nil)
+	portSettingService.On("GetPortConfigPath", "fake_ns", "ps2").Return("/orgs/default/projects/default/vpcs/vpc1/subnets/subnet2/port-configs/ps2_abcde", nil)
+	portSettingService.On("GetPortConfigPath", "fake_ns", "ps3").Return("", fmt.Errorf("SubnetPortSetting fake_ns/ps3 is not realized"))
+
+	newSubnetPort := func(portSettingName string) *v1alpha1.SubnetPort {
+		return &v1alpha1.SubnetPort{
+			ObjectMeta: metav1.ObjectMeta{
+				UID:       "2ccec3b9-7546-4fd2-812a-1e3a4afd7acc",
+				Name:      "fake_subnetport",
+				Namespace: "fake_ns",
+			},
+			Spec: v1alpha1.SubnetPortSpec{PortSettingName: portSettingName},
+		}
+	}
+
+	port, err := service.buildSubnetPort(newSubnetPort("ps1"), nsxSubnet, "", nil, false, false, v1alpha1.IPAddressTypeIPv4)
+	assert.Nil(t, err)
+	assert.Equal(t, portConfigPath, *port.PortConfigPath)
+
+	port, err = service.buildSubnetPort(newSubnetPort(""), nsxSubnet, "", nil, false, false, v1alpha1.IPAddressTypeIPv4)
+	assert.Nil(t, err)
+	assert.Nil(t, port.PortConfigPath)
+
+	_, err = service.buildSubnetPort(newSubnetPort("ps2"), nsxSubnet, "", nil, false, false, v1alpha1.IPAddressTypeIPv4)
+	assert.ErrorContains(t, err, "SubnetPortSetting fake_ns/ps2 is not for the Subnet "+subnetPath)
+
+	_, err = service.buildSubnetPort(newSubnetPort("ps3"), nsxSubnet, "", nil, false, false, v1alpha1.IPAddressTypeIPv4)
+	assert.ErrorContains(t, err, "SubnetPortSetting fake_ns/ps3 is not realized")
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:         "c5db1800-ce4c-11de-bedc-84a0de00c35b",
+			Name:        "fake_pod",
+			Namespace:   "fake_ns",
+			Annotations: map[string]string{common.AnnotationPortSetting: "ps1"},
+		},
+	}
+	port, err = service.buildSubnetPort(pod, nsxSubnet, "", nil, false, false, v1alpha1.IPAddressTypeIPv4)
+	assert.Nil(t, err)
+	assert.Equal(t, portConfigPath, *port.PortConfigPath)
+
+	service.SubnetPortSettingService = nil
+	_, err = service.buildSubnetPort(newSubnetPort("ps1"), nsxSubnet, "", nil, false, false, v1alpha1.IPAddressTypeIPv4)
+	assert.ErrorContains(t, err, "SubnetPortSetting fake_ns/ps1 is not supported")
+}
@@ -24,6 +24,7 @@ func (sp *SubnetPort) Value() data.DataValue {
 		Tags:                   sp.Tags,
 		Attachment:             sp.Attachment,
 		StaticIpAllocationType: sp.StaticIpAllocationType,
+		PortConfigPath:         sp.PortConfigPath,
 	}
 	if sp.Attachment != nil {
 		// Ignoring the fields BmsInterfaceConfig, ContextType, EvpnVlans, HyperbusMode
@@ -39,11 +39,12 @@ type SubnetPortService struct {
 	SubnetPortStore            *SubnetPortStore
 	VPCService                 servicecommon.VPCServiceProvider
 	IpAddressAllocationService servicecommon.IPAddressAllocationServiceProvider
+	SubnetPortSettingService   servicecommon.SubnetPortSettingServiceProvider
 	builder                    *servicecommon.PolicyTreeBuilder[*model.VpcSubnetPort]
 }
 
 // InitializeSubnetPort sync NSX resources.
-func InitializeSubnetPort(service servicecommon.Service, vpcService servicecommon.VPCServiceProvider, ipAddressAllocationService servicecommon.IPAddressAllocationServiceProvider) (*SubnetPortService, error) {
+func InitializeSubnetPort(service servicecommon.Service, vpcService servicecommon.VPCServiceProvider, ipAddressAllocationService servicecommon.IPAddressAllocationServiceProvider, subnetPortSettingService servicecommon.SubnetPortSettingServiceProvider) (*SubnetPortService, error) {
 	builder, _ := servicecommon.PolicyPathVpcSubnetPort.NewPolicyTreeBuilder()
 
 	wg := sync.WaitGroup{}
@@ -56,6 +57,7 @@ func InitializeSubnetPort(service servicecommon.Service, vpcService servicecommo
 		Service:                    service,
 		VPCService:                 vpcService,
 		IpAddressAllocationService: ipAddressAllocationService,
+		SubnetPortSettingService:   subnetPortSettingService,
 		builder:                    builder,
 	}
 
@@ -130,7 +132,7 @@ func (service *SubnetPortService) portAlreadyRealized(obj interface{}, nsxSubnet
 	return false
 }
 
-func (service *SubnetPortService) CreateOrUpdateSubnetPort(obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
+func (service *SubnetPortService) CreateOrUpdateSubnetPort(ctx context.Context, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 	var uid string
 	var attachmentID string
 	switch o := obj.(type) {
@@ -157,6 +159,11 @@ func (service *SubnetPortService) CreateOrUpdateSubnetPort(obj interface{}, nsxS
 			nsxSubnetPort.Attachment.Id = existingSubnetPort.Attachment.Id
 		}
 		nsxSubnetPort.AddressBindings = mergeSubnetPortAddressBinding(existingSubnetPort.AddressBindings, nsxSubnetPort.AddressBindings)
+		// Keep the port config of the existing port if no SubnetPortSetting is specified, e.g. the default port config
+		// set by NSX, as PATCH cannot unset it.
+		if nsxSubnetPort.PortConfigPath == nil {
+			nsxSubnetPort.PortConfigPath = existingSubnetPort.PortConfigPath
+		}
 		isChanged = servicecommon.CompareResource(SubnetPortToComparable(existingSubnetPort), SubnetPortToComparable(nsxSubnetPort))
 	}
 	// In restore mode, restore attachment id in k8s CR when NSX >= 9.2
@@ -175,7 +182,7 @@ func (service *SubnetPortService) CreateOrUpdateSubnetPort(obj interface{}, nsxS
 		}
 	} else {
 		log.Info("Updating the NSX subnet port", "existingSubnetPort", existingSubnetPort, "desiredSubnetPort", nsxSubnetPort)
-		err = service.NSXClient.PortClient.Patch(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, *nsxSubnetPort.Id, *nsxSubnetPort)
+		err = service.NSXClient.WithContext(ctx).PortClient.Patch(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, *nsxSubnetPort.Id, *nsxSubnetPort)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			log.Error(err, "failed to create or update subnet port", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "nsxSubnetPath", *nsxSubnet.Path)
@@ -191,7 +198,7 @@ func (service *SubnetPortService) CreateOrUpdateSubnetPort(obj interface{}, nsxS
 			log.Info("Created NSX subnet port", "nsxSubnetPort.Path", *nsxSubnetPort.Path)
 		}
 	}
-	nsxSubnetPortState, err := service.CheckSubnetPortState(obj, *nsxSubnet.Path)
+	nsxSubnetPortState, err := service.CheckSubnetPortState(ctx, obj, *nsxSubnet.Path)
 	if err != nil {
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "check and update NSX subnet port state failed, would retry with delay", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "nsxSubnetPath", *nsxSubnet.Path)
@@ -200,7 +207,7 @@ func (service *SubnetPortService) CreateOrUpdateSubnetPort(obj interface{}, nsxS
 		}
 		return nil, err
 	}
-	createdNSXSubnetPort, err := service.NSXClient.PortClient.Get(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, *nsxSubnetPort.Id)
+	createdNSXSubnetPort, err := service.NSXClient.WithContext(ctx).PortClient.Get(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, *nsxSubnetPort.Id)
 	if err != nil {
 		log.Error(err, "check and update NSX subnet port failed, would retry exponentially", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "nsxSubnetPath", *nsxSubnet.Path)
 		return nil, err
@@ -243,7 +250,7 @@ func mergeSubnetPortAddressBinding(existingAddressBinding []model.PortAddressBin
 }
 
 // CheckSubnetPortState will check the port realized status then get the port state to prepare the CR status.
-func (service *SubnetPortService) CheckSubnetPortState(obj interface{}, nsxSubnetPath string) (*model.SegmentPortState, error) {
+func (service *SubnetPortService) CheckSubnetPortState(ctx context.Context, obj interface{}, nsxSubnetPath string) (*model.SegmentPortState, error) {
 	var objMeta metav1.ObjectMeta
 	switch o := obj.(type) {
 	case *v1alpha1.SubnetPort:
@@ -263,7 +270,7 @@ func (service *SubnetPortService) CheckSubnetPortState(obj interface{}, nsxSubne
 	portID := *nsxSubnetPort.Id
 	realizeService := realizestate.InitializeRealizeState(service.Service)
 
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, *nsxSubnetPort.Path, []string{}); err != nil {
+	if err := realizeService.CheckRealizeState(ctx, util.NSXTRealizeRetry, *nsxSubnetPort.Path, []string{}); err != nil {
 		log.Error(err, "Failed to get realized status", "nsxSubnetPortPath", *nsxSubnetPort.Path)
 		if nsxutil.IsRealizeStateError(err) {
 			realizedStateErr := err.(*nsxutil.RealizeStateError)
@@ -272,7 +279,7 @@ func (service *SubnetPortService) CheckSubnetPortState(obj interface{}, nsxSubne
 			}
 			log.Error(err, "The created SubnetPort is in error realization state, cleaning the resource", "SubnetPort", portID)
 			// only recreate subnet port on RealizationErrorStateError.
-			if err := service.DeleteSubnetPortById(portID); err != nil {
+			if err := service.DeleteSubnetPortById(ctx, portID); err != nil {
 				log.Error(err, "Cleanup error SubnetPort failed", "SubnetPort", portID)
 				return nil, err
 			}
@@ -299,12 +306,12 @@ func (service *SubnetPortService) GetSubnetPortState(nsxSubnetPortID string, nsx
 	return &nsxSubnetPortState, nil
 }
 
-func (service *SubnetPortService) DeleteSubnetPort(nsxSubnetPort *model.VpcSubnetPort) error {
+func (service *SubnetPortService) DeleteSubnetPort(ctx context.Context, nsxSubnetPort *model.VpcSubnetPort) error {
 	if nsxSubnetPort.Path == nil {
 		return errors.New("subnet port path is nil")
 	}
 	subnetPortInfo, _ := servicecommon.ParseVPCResourcePath(*nsxSubnetPort.Path)
-	err := service.NSXClient.PortClient.Delete(subnetPortInfo.OrgID, subnetPortInfo.ProjectID, subnetPortInfo.VPCID, subnetPortInfo.ParentID, *nsxSubnetPort.Id)
+	err := service.NSXClient.WithContext(ctx).PortClient.Delete(subnetPortInfo.OrgID, subnetPortInfo.ProjectID, subnetPortInfo.VPCID, subnetPortInfo.ParentID, *nsxSubnetPort.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "failed to delete nsxSubnetPort", "nsxSubnetPort.Path", *nsxSubnetPort.Path)
@@ -317,13 +324,13 @@ func (service *SubnetPortService) DeleteSubnetPort(nsxSubnetPort *model.VpcSubne
 	return nil
 }
 
-func (service *SubnetPortService) DeleteSubnetPortById(portID string) error {
+func (service *SubnetPortService) DeleteSubnetPortById(ctx context.Context, portID string) error {
 	nsxSubnetPort := service.SubnetPortStore.GetByKey(portID)
 	if nsxSubnetPort == nil || nsxSubnetPort.Id == nil {
 		log.Info("NSX subnet port is not found in store, skip deleting it", "id", portID)
 		return nil
 	}
-	return service.DeleteSubnetPort(nsxSubnetPort)
+	return service.DeleteSubnetPort(ctx, nsxSubnetPort)
 }
 
 func (service *SubnetPortService) ListNSXSubnetPortIDForCR() sets.Set[string] {
@@ -363,6 +370,15 @@ func (service *SubnetPortService) GetSubnetPathForSubnetPortFromStore(crUid type
 	return *existingSubnetPort.ParentPath
 }
 
+// GetPortConfigPathForSubnetPortFromStore returns the path of the NSX VpcSubnetPortConfig bound to the SubnetPort.
+func (service *SubnetPortService) GetPortConfigPathForSubnetPortFromStore(crUid types.UID) string {
+	existingSubnetPort, err := service.SubnetPortStore.GetVpcSubnetPortByUID(crUid)
+	if err != nil || existingSubnetPort == nil || existingSubnetPort.PortConfigPath == nil {
+		return ""
+	}
+	return *existingSubnetPort.PortConfigPath
+}
+
 func (service *SubnetPortService) GetPortsOfSubnet(subnetPath string) (ports []*model.VpcSubnetPort) {
 	subnetPortList := service.SubnetPortStore.GetByIndex(servicecommon.IndexKeySubnetPath, subnetPath)
 	return subnetPortList
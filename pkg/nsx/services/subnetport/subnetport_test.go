@@ -161,7 +161,7 @@ func Test_InitializeSubnetPort(t *testing.T) {
 			ipAddressAllocationService := &ipaddressallocation.IPAddressAllocationService{}
 			patches := tt.prepareFunc(t, &commonService, ctx)
 			defer patches.Reset()
-			got, err := InitializeSubnetPort(commonService, vpcService, ipAddressAllocationService)
+			got, err := InitializeSubnetPort(commonService, vpcService, ipAddressAllocationService, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("InitializeSubnetPort() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -480,7 +480,7 @@ func TestSubnetPortService_CreateOrUpdateSubnetPort(t *testing.T) {
 			if patches != nil {
 				defer patches.Reset()
 			}
-			_, err := service.CreateOrUpdateSubnetPort(tt.obj, tt.nsxSubnet, "", nil, false, tt.restore, "")
+			_, err := service.CreateOrUpdateSubnetPort(context.TODO(), tt.obj, tt.nsxSubnet, "", nil, false, tt.restore, "")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CreateOrUpdateSubnetPort() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -558,7 +558,7 @@ func TestSubnetPortService_DeleteSubnetPort(t *testing.T) {
 				defer patches.Reset()
 			}
 
-			if err := service.DeleteSubnetPortById(subnetPortId1); (err != nil) != tt.wantErr {
+			if err := service.DeleteSubnetPortById(context.TODO(), subnetPortId1); (err != nil) != tt.wantErr {
 				t.Errorf("DeleteSubnetPort() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -644,6 +644,40 @@ func TestSubnetPortService_GetSubnetPathForSubnetPortFromStore(t *testing.T) {
 	}
 }
 
+func TestSubnetPortService_GetPortConfigPathForSubnetPortFromStore(t *testing.T) {
+	crUID := types.UID("aaaaaaaa")
+	portConfigPath := subnetPath + "/port-configs/ps1_abcde"
+	service := &SubnetPortService{
+		SubnetPortStore: &SubnetPortStore{ResourceStore: common.ResourceStore{
+			Indexer: cache.NewIndexer(
+				keyFunc,
+				cache.Indexers{
+					common.TagScopeSubnetPortCRUID: subnetPortIndexByCRUID,
+					common.TagScopePodUID:          subnetPortIndexByPodUID,
+				}),
+			BindingType: model.VpcSubnetPortBindingType(),
+		}},
+	}
+	assert.Equal(t, "", service.GetPortConfigPathForSubnetPortFromStore(crUID))
+
+	nsxSubnetPort := &model.VpcSubnetPort{
+		Id:         &subnetPortId1,
+		Path:       &subnetPortPath1,
+		ParentPath: &subnetPath,
+		Tags: []model.Tag{
+			{
+				Scope: common.String(common.TagScopeSubnetPortCRUID),
+				Tag:   common.String(string(crUID)),
+			},
+		},
+	}
+	service.SubnetPortStore.Add(nsxSubnetPort)
+	assert.Equal(t, "", service.GetPortConfigPathForSubnetPortFromStore(crUID))
+
+	nsxSubnetPort.PortConfigPath = &portConfigPath
+	assert.Equal(t, portConfigPath, service.GetPortConfigPathForSubnetPortFromStore(crUID))
+}
+
 func TestSubnetPortService_GetPortsOfSubnet(t *testing.T) {
 	port := model.VpcSubnetPort{
 		Id:         &subnetPortId1,
@@ -0,0 +1,178 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetportsetting
+
+import (
+	"fmt"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+var (
+	macLimitPolicyMap = map[v1alpha1.MACLimitPolicy]string{
+		v1alpha1.MACLimitPolicyAllow: model.MacDiscoveryProfile_MAC_LIMIT_POLICY_ALLOW,
+		v1alpha1.MACLimitPolicyDrop:  model.MacDiscoveryProfile_MAC_LIMIT_POLICY_DROP,
+	}
+	dscpModeMap = map[v1alpha1.DSCPMode]string{
+		v1alpha1.DSCPModeTrusted:   model.QosDscp_MODE_TRUSTED,
+		v1alpha1.DSCPModeUntrusted: model.QosDscp_MODE_UNTRUSTED,
+	}
+)
+
+func (s *SubnetPortSettingService) buildPortConfig(portSetting *v1alpha1.SubnetPortSetting, subnetPath string) (*model.VpcSubnetPortConfig, error) {
+	macDiscovery, err := buildMacDiscoveryProfile(portSetting.Spec.MACDiscovery)
+	if err != nil {
+		return nil, err
+	}
+	qos, err := buildQosProfile(portSetting.Spec.QoS)
+	if err != nil {
+		return nil, err
+	}
+	id := s.buildPortConfigID(portSetting, subnetPath)
+	return &model.VpcSubnetPortConfig{
+		Id:             common.String(id),
+		DisplayName:    common.String(portSetting.Name),
+		Path:           common.String(fmt.Sprintf("%s/port-configs/%s", subnetPath, id)),
+		ParentPath:     common.String(subnetPath),
+		ResourceType:   common.String(ResourceTypeVpcSubnetPortConfig),
+		Tags:           util.BuildBasicTags(getCluster(s), portSetting, ""),
+		MacDiscovery:   macDiscovery,
+		IpDiscovery:    buildIPDiscoveryProfile(portSetting.Spec.IPDiscovery),
+		SpoofGuard:     buildSpoofGuardProfile(portSetting.Spec.SpoofGuard),
+		Qos:            qos,
+		SubnetSecurity: buildSegmentSecurityProfile(portSetting.Spec.SegmentSecurity),
+	}, nil
+}
+
+func buildMacDiscoveryProfile(setting *v1alpha1.MACDiscoverySetting) (*model.MacDiscoveryProfile, error) {
+	if setting == nil {
+		return nil, nil
+	}
+	profile := &model.MacDiscoveryProfile{
+		MacChangeEnabled:              setting.MACChangeEnabled,
+		MacLearningEnabled:            setting.MACLearningEnabled,
+		MacLearningAgingTime:          int64Ptr(setting.MACLearningAgingTime),
+		MacLimit:                      int64Ptr(setting.MACLimit),
+		UnknownUnicastFloodingEnabled: setting.UnknownUnicastFloodingEnabled,
+	}
+	if setting.MACLimitPolicy != "" {
+		policy, ok := macLimitPolicyMap[setting.MACLimitPolicy]
+		if !ok {
+			return nil, fmt.Errorf("unsupported macLimitPolicy %s", setting.MACLimitPolicy)
+		}
+		profile.MacLimitPolicy = common.String(policy)
+	}
+	return profile, nil
+}
+
+func buildIPDiscoveryProfile(setting *v1alpha1.IPDiscoverySetting) *model.IPDiscoveryProfile {
+	if setting == nil {
+		return nil
+	}
+	profile := &model.IPDiscoveryProfile{
+		ArpNdBindingTimeout: int64Ptr(setting.ARPNDBindingTimeout),
+		TofuEnabled:         setting.TOFUEnabled,
+	}
+	if setting.ARPSnoopingEnabled != nil || setting.ARPBindingLimit != nil || setting.DHCPSnoopingEnabled != nil || setting.VMToolsEnabled != nil {
+		profile.IpV4DiscoveryOptions = &model.IPv4DiscoveryOptions{
+			DhcpSnoopingEnabled: setting.DHCPSnoopingEnabled,
+			VmtoolsEnabled:      setting.VMToolsEnabled,
+		}
+		if setting.ARPSnoopingEnabled != nil || setting.ARPBindingLimit != nil {
+			profile.IpV4DiscoveryOptions.ArpSnoopingConfig = &model.ArpSnoopingConfig{
+				ArpSnoopingEnabled: setting.ARPSnoopingEnabled,
+				ArpBindingLimit:    int64Ptr(setting.ARPBindingLimit),
+			}
+		}
+	}
+	if setting.DHCPv6SnoopingEnabled != nil || setting.NDSnoopingEnabled != nil || setting.NDSnoopingLimit != nil || setting.VMToolsV6Enabled != nil {
+		profile.IpV6DiscoveryOptions = &model.IPv6DiscoveryOptions{
+			DhcpSnoopingV6Enabled: setting.DHCPv6SnoopingEnabled,
+			VmtoolsV6Enabled:      setting.VMToolsV6Enabled,
+		}
+		if setting.NDSnoopingEnabled != nil || setting.NDSnoopingLimit != nil {
+			profile.IpV6DiscoveryOptions.NdSnoopingConfig = &model.NdSnoopingConfig{
+				NdSnoopingEnabled: setting.NDSnoopingEnabled,
+				NdSnoopingLimit:   int64Ptr(setting.NDSnoopingLimit),
+			}
+		}
+	}
+	return profile
+}
+
+func buildSpoofGuardProfile(setting *v1alpha1.SpoofGuardSetting) *model.SpoofGuardProfile {
+	if setting == nil {
+		return nil
+	}
+	return &model.SpoofGuardProfile{
+		AddressBindingAllowlist: setting.AddressBindingAllowlist,
+	}
+}
+
+func buildQosProfile(setting *v1alpha1.QoSSetting) (*model.QosProfile, error) {
+	if setting == nil {
+		return nil, nil
+	}
+	profile := &model.QosProfile{
+		ClassOfService: int64Ptr(setting.ClassOfService),
+	}
+	if setting.DSCPMode != "" || setting.DSCPPriority != nil {
+		profile.Dscp = &model.QosDscp{
+			Priority: int64Ptr(setting.DSCPPriority),
+		}
+		if setting.DSCPMode != "" {
+			mode, ok := dscpModeMap[setting.DSCPMode]
+			if !ok {
+				return nil, fmt.Errorf("unsupported dscpMode %s", setting.DSCPMode)
+			}
+			profile.Dscp.Mode = common.String(mode)
+		}
+	}
+	return profile, nil
+}
+
+func buildSegmentSecurityProfile(setting *v1alpha1.SegmentSecuritySetting) *model.SegmentSecurityProfile {
+	if setting == nil {
+		return nil
+	}
+	return &model.SegmentSecurityProfile{
+		BpduFilterEnable:         setting.BPDUFilterEnabled,
+		DhcpClientBlockEnabled:   setting.DHCPClientBlockEnabled,
+		DhcpServerBlockEnabled:   setting.DHCPServerBlockEnabled,
+		NonIpTrafficBlockEnabled: setting.NonIPTrafficBlockEnabled,
+		RaGuardEnabled:           setting.RAGuardEnabled,
+		RateLimitsEnabled:        setting.RateLimitsEnabled,
+	}
+}
+
+func int64Ptr(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+	return common.Int64(int64(*v))
+}
+
+func getCluster(service *SubnetPortSettingService) string {
+	return service.NSXConfig.Cluster
+}
+
+// buildPortConfigID generates the ID of NSX VpcSubnetPortConfig resource, its format is like this,
+// ${SubnetPortSetting_CR}.name_hash(${parent_VpcSubnet}.Path)[:5], e.g., portsetting1_823ca. Note, if
+// the generated id has collision with the existing NSX VpcSubnetPortConfig.id, a random UUID is used as
+// an alternative of the parent path to generate the hash suffix.
+func (s *SubnetPortSettingService) buildPortConfigID(portSetting *v1alpha1.SubnetPortSetting, subnetPath string) string {
+	idCR := &v1.ObjectMeta{
+		Name: portSetting.GetName(),
+		UID:  types.UID(subnetPath),
+	}
+	return common.BuildUniqueIDWithRandomUUID(idCR, util.GenerateIDByObject, func(id string) bool {
+		return s.PortConfigStore.GetByKey(id) != nil
+	})
+}
@@ -0,0 +1,132 @@
+package subnetportsetting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func TestBuildPortConfig(t *testing.T) {
+	service := createFakeService()
+	subnetPath := "/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1"
+	portSetting := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "ps1",
+			UID:       "ps1-uuid",
+		},
+		Spec: v1alpha1.SubnetPortSettingSpec{
+			SubnetName: "subnet-1",
+			MACDiscovery: &v1alpha1.MACDiscoverySetting{
+				MACLearningEnabled: common.Bool(true),
+				MACLimit:           int32Ptr(100),
+				MACLimitPolicy:     v1alpha1.MACLimitPolicyDrop,
+			},
+			IPDiscovery: &v1alpha1.IPDiscoverySetting{
+				ARPNDBindingTimeout: int32Ptr(20),
+				ARPSnoopingEnabled:  common.Bool(true),
+				NDSnoopingLimit:     int32Ptr(4),
+			},
+			SpoofGuard: &v1alpha1.SpoofGuardSetting{
+				AddressBindingAllowlist: common.Bool(true),
+			},
+			QoS: &v1alpha1.QoSSetting{
+				ClassOfService: int32Ptr(3),
+				DSCPMode:       v1alpha1.DSCPModeUntrusted,
+				DSCPPriority:   int32Ptr(10),
+			},
+			SegmentSecurity: &v1alpha1.SegmentSecuritySetting{
+				DHCPServerBlockEnabled: common.Bool(true),
+			},
+		},
+	}
+
+	portConfig, err := service.buildPortConfig(portSetting, subnetPath)
+	require.NoError(t, err)
+	require.Equal(t, "ps1", *portConfig.DisplayName)
+	require.Equal(t, subnetPath, *portConfig.ParentPath)
+	require.Equal(t, subnetPath+"/port-configs/"+*portConfig.Id, *portConfig.Path)
+	require.Equal(t, ResourceTypeVpcSubnetPortConfig, *portConfig.ResourceType)
+	require.Contains(t, portConfig.Tags, model.Tag{Scope: common.String(common.TagScopeSubnetPortSettingCRUID), Tag: common.String("ps1-uuid")})
+	require.Contains(t, portConfig.Tags, model.Tag{Scope: common.String(common.TagScopeSubnetPortSettingCRName), Tag: common.String("ps1")})
+
+	require.Equal(t, &model.MacDiscoveryProfile{
+		MacLearningEnabled: common.Bool(true),
+		MacLimit:           common.Int64(100),
+		MacLimitPolicy:     common.String(model.MacDiscoveryProfile_MAC_LIMIT_POLICY_DROP),
+	}, portConfig.MacDiscovery)
+	require.Equal(t, &model.IPDiscoveryProfile{
+		ArpNdBindingTimeout: common.Int64(20),
+		IpV4DiscoveryOptions: &model.IPv4DiscoveryOptions{
+			ArpSnoopingConfig: &model.ArpSnoopingConfig{
+				ArpSnoopingEnabled: common.Bool(true),
+			},
+		},
+		IpV6DiscoveryOptions: &model.IPv6DiscoveryOptions{
+			NdSnoopingConfig: &model.NdSnoopingConfig{
+				NdSnoopingLimit: common.Int64(4),
+			},
+		},
+	}, portConfig.IpDiscovery)
+	require.Equal(t, &model.SpoofGuardProfile{AddressBindingAllowlist: common.Bool(true)}, portConfig.SpoofGuard)
+	require.Equal(t, &model.QosProfile{
+		ClassOfService: common.Int64(3),
+		Dscp: &model.QosDscp{
+			Mode:     common.String(model.QosDscp_MODE_UNTRUSTED),
+			Priority: common.Int64(10),
+		},
+	}, portConfig.Qos)
+	require.Equal(t, &model.SegmentSecurityProfile{DhcpServerBlockEnabled: common.Bool(true)}, portConfig.SubnetSecurity)
+
+	// The profiles not specified in the SubnetPortSetting are left to the NSX defaults.
+	portSetting.Spec = v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"}
+	portConfig, err = service.buildPortConfig(portSetting, subnetPath)
+	require.NoError(t, err)
+	require.Nil(t, portConfig.MacDiscovery)
+	require.Nil(t, portConfig.IpDiscovery)
+	require.Nil(t, portConfig.SpoofGuard)
+	require.Nil(t, portConfig.Qos)
+	require.Nil(t, portConfig.SubnetSecurity)
+}
+
+func TestBuildPortConfigUnsupportedEnum(t *testing.T) {
+	service := createFakeService()
+	portSetting := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+		Spec: v1alpha1.SubnetPortSettingSpec{
+			MACDiscovery: &v1alpha1.MACDiscoverySetting{MACLimitPolicy: "Unknown"},
+		},
+	}
+	_, err := service.buildPortConfig(portSetting, "/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1")
+	require.ErrorContains(t, err, "unsupported macLimitPolicy Unknown")
+
+	portSetting.Spec = v1alpha1.SubnetPortSettingSpec{
+		QoS: &v1alpha1.QoSSetting{DSCPMode: "Unknown"},
+	}
+	_, err = service.buildPortConfig(portSetting, "/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1")
+	require.ErrorContains(t, err, "unsupported dscpMode Unknown")
+}
+
+func TestBuildPortConfigID(t *testing.T) {
+	service := createFakeService()
+	portSetting := &v1alpha1.SubnetPortSetting{
+		ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+	}
+	subnetPath := "/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1"
+	id := service.buildPortConfigID(portSetting, subnetPath)
+	require.Equal(t, id, service.buildPortConfigID(portSetting, subnetPath))
+	require.NotEqual(t, id, service.buildPortConfigID(portSetting, "/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-2"))
+
+	// A random UUID is used to generate the ID if there is a collision.
+	service.PortConfigStore.Apply(&model.VpcSubnetPortConfig{Id: common.String(id)})
+	require.NotEqual(t, id, service.buildPortConfigID(portSetting, subnetPath))
+}
@@ -0,0 +1,52 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetportsetting
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// CleanupBeforeVPCDeletion deletes all the NSX VpcSubnetPortConfigs created for the SubnetPortSetting CRs. The
+// SDK doesn't provide the H-API child type of VpcSubnetPortConfig, so the resources are deleted one by one. A
+// VpcSubnetPortConfig still referred by the VpcSubnetPorts fails to be deleted, and it is retried by the caller
+// after the VpcSubnetPorts are cleaned up.
+func (s *SubnetPortSettingService) CleanupBeforeVPCDeletion(ctx context.Context) error {
+	objs := s.PortConfigStore.List()
+	log.Info("Cleaning up VpcSubnetPortConfigs", "Count", len(objs), "status", "attempting")
+	if len(objs) == 0 {
+		log.Info("No VpcSubnetPortConfigs found to clean up", "count", 0)
+		return nil
+	}
+	report := common.CleanupReportFromContext(ctx)
+	filter := common.CleanupFilterFromContext(ctx)
+	for _, obj := range objs {
+		select {
+		case <-ctx.Done():
+			return errors.Join(nsxutil.TimeoutFailed, ctx.Err())
+		default:
+		}
+		portConfig := obj.(*model.VpcSubnetPortConfig)
+		if !filter.MatchResource(*portConfig.Path, portConfig.Tags) {
+			continue
+		}
+		if report.IsDryRun() {
+			report.Record(ResourceTypeVpcSubnetPortConfig, *portConfig.Id, *portConfig.Path, nil)
+			continue
+		}
+		err := s.DeletePortConfig(ctx, portConfig)
+		report.Record(ResourceTypeVpcSubnetPortConfig, *portConfig.Id, *portConfig.Path, err)
+		if err != nil {
+			log.Error(err, "Failed to clean up VpcSubnetPortConfigs", "count", len(objs), "status", "failed")
+			return err
+		}
+	}
+	log.Info("Successfully cleaned up VpcSubnetPortConfigs", "count", len(objs), "status", "success")
+	return nil
+}
@@ -0,0 +1,56 @@
+package subnetportsetting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func TestCleanupBeforeVPCDeletion(t *testing.T) {
+	service := createFakeService()
+	require.NoError(t, service.CleanupBeforeVPCDeletion(context.TODO()))
+
+	service.PortConfigStore.Apply(portConfig1)
+	service.PortConfigStore.Apply(portConfig2)
+	service.PortConfigStore.Apply(portConfig3)
+
+	// The VpcSubnetPortConfig still referred by VpcSubnetPorts fails to be deleted.
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakePortConfigsClient{}), "Delete", func(_ *fakePortConfigsClient, _ string, _ string, _ string, _ string, _ string) error {
+		return fmt.Errorf("mocked delete error")
+	})
+	err := service.CleanupBeforeVPCDeletion(context.TODO())
+	require.ErrorContains(t, err, "mocked delete error")
+	require.Equal(t, 3, len(service.PortConfigStore.List()))
+	patches.Reset()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = service.CleanupBeforeVPCDeletion(ctx)
+	require.True(t, errors.Is(err, nsxutil.TimeoutFailed))
+	require.Equal(t, 3, len(service.PortConfigStore.List()))
+
+	// Only the VpcSubnetPortConfigs in the Namespace are handled in a scoped cleanup.
+	report := common.NewCleanupReport(true)
+	ctx = common.WithCleanupFilter(common.WithCleanupReport(context.TODO(), report), common.NewCleanupFilter([]string{"ns-2"}, nil, nil))
+	require.NoError(t, service.CleanupBeforeVPCDeletion(ctx))
+	require.Equal(t, common.CleanupSummary{Planned: 1}, report.Result().Summary)
+
+	// Nothing is deleted in dry-run mode.
+	report = common.NewCleanupReport(true)
+	err = service.CleanupBeforeVPCDeletion(common.WithCleanupReport(context.TODO(), report))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(service.PortConfigStore.List()))
+	require.Equal(t, common.CleanupSummary{Planned: 3}, report.Result().Summary)
+
+	err = service.CleanupBeforeVPCDeletion(context.TODO())
+	require.NoError(t, err)
+	require.Equal(t, 0, len(service.PortConfigStore.List()))
+}
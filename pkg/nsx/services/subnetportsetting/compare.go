@@ -0,0 +1,42 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetportsetting
+
+import (
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+type (
+	VpcSubnetPortConfig model.VpcSubnetPortConfig
+)
+
+func (m *VpcSubnetPortConfig) Key() string {
+	return *m.Id
+}
+
+func (m *VpcSubnetPortConfig) Value() data.DataValue {
+	s := &VpcSubnetPortConfig{
+		Id:             m.Id,
+		DisplayName:    m.DisplayName,
+		Tags:           m.Tags,
+		MacDiscovery:   m.MacDiscovery,
+		IpDiscovery:    m.IpDiscovery,
+		SpoofGuard:     m.SpoofGuard,
+		Qos:            m.Qos,
+		SubnetSecurity: m.SubnetSecurity,
+	}
+	dataValue, _ := ComparableToVpcSubnetPortConfig(s).GetDataValue__()
+	return dataValue
+}
+
+func VpcSubnetPortConfigToComparable(portConfig *model.VpcSubnetPortConfig) common.Comparable {
+	return (*VpcSubnetPortConfig)(portConfig)
+}
+
+func ComparableToVpcSubnetPortConfig(portConfig common.Comparable) *model.VpcSubnetPortConfig {
+	return (*model.VpcSubnetPortConfig)(portConfig.(*VpcSubnetPortConfig))
+}
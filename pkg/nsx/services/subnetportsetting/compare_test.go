@@ -0,0 +1,49 @@
+package subnetportsetting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestVpcSubnetPortConfig_Key(t *testing.T) {
+	m := &VpcSubnetPortConfig{
+		Id: common.String("config-id-1"),
+	}
+	require.Equal(t, "config-id-1", m.Key())
+}
+
+func TestComparableToVpcSubnetPortConfig(t *testing.T) {
+	nsxObj := &model.VpcSubnetPortConfig{
+		Id:          common.String("id1"),
+		DisplayName: common.String("dn1"),
+	}
+	c := VpcSubnetPortConfigToComparable(nsxObj)
+	back := ComparableToVpcSubnetPortConfig(c)
+	require.Equal(t, nsxObj.Id, back.Id)
+	require.Equal(t, nsxObj.DisplayName, back.DisplayName)
+}
+
+func TestVpcSubnetPortConfig_Value(t *testing.T) {
+	existing := &model.VpcSubnetPortConfig{
+		Id:          common.String("id1"),
+		DisplayName: common.String("dn1"),
+		Path:        common.String("/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1/port-configs/id1"),
+		SpoofGuard:  &model.SpoofGuardProfile{AddressBindingAllowlist: common.Bool(true)},
+	}
+	// The fields not managed by the operator are ignored.
+	desired := *existing
+	desired.Path = nil
+	desired.Revision = common.Int64(3)
+	require.False(t, common.CompareResource(VpcSubnetPortConfigToComparable(existing), VpcSubnetPortConfigToComparable(&desired)))
+
+	desired.SpoofGuard = &model.SpoofGuardProfile{AddressBindingAllowlist: common.Bool(false)}
+	require.True(t, common.CompareResource(VpcSubnetPortConfigToComparable(existing), VpcSubnetPortConfigToComparable(&desired)))
+
+	desired.SpoofGuard = existing.SpoofGuard
+	desired.Qos = &model.QosProfile{ClassOfService: common.Int64(2)}
+	require.True(t, common.CompareResource(VpcSubnetPortConfigToComparable(existing), VpcSubnetPortConfigToComparable(&desired)))
+}
@@ -0,0 +1,119 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetportsetting
+
+import (
+	"errors"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+type PortConfigStore struct {
+	common.ResourceStore
+}
+
+func (s *PortConfigStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	portConfig := i.(*model.VpcSubnetPortConfig)
+	if portConfig.MarkedForDelete != nil && *portConfig.MarkedForDelete {
+		err := s.Delete(portConfig)
+		if err != nil {
+			log.Error(err, "Failed to delete VpcSubnetPortConfig", "VpcSubnetPortConfig", portConfig)
+			return err
+		}
+		log.Debug("Deleted VpcSubnetPortConfig from store", "VpcSubnetPortConfig", portConfig)
+	} else {
+		err := s.Add(portConfig)
+		if err != nil {
+			log.Error(err, "Failed to add VpcSubnetPortConfig", "VpcSubnetPortConfig", portConfig)
+			return err
+		}
+		log.Debug("Added VpcSubnetPortConfig to store", "VpcSubnetPortConfig", portConfig)
+	}
+	return nil
+}
+
+func keyFunc(obj interface{}) (string, error) {
+	switch v := obj.(type) {
+	case *model.VpcSubnetPortConfig:
+		return *v.Id, nil
+	case string:
+		return v, nil
+	default:
+		return "", errors.New("keyFunc doesn't support unknown type")
+	}
+}
+
+func portConfigCRUIDIndexFunc(obj interface{}) ([]string, error) {
+	switch o := obj.(type) {
+	case *model.VpcSubnetPortConfig:
+		for _, tag := range o.Tags {
+			if *tag.Scope == common.TagScopeSubnetPortSettingCRUID {
+				return []string{*tag.Tag}, nil
+			}
+		}
+		return []string{}, nil
+	default:
+		return nil, errors.New("portConfigCRUIDIndexFunc doesn't support unknown type")
+	}
+}
+
+func portConfigCRNameIndexFunc(obj interface{}) ([]string, error) {
+	switch o := obj.(type) {
+	case *model.VpcSubnetPortConfig:
+		var res []string
+		var crName, crNamespace string
+		for _, tag := range o.Tags {
+			switch *tag.Scope {
+			case common.TagScopeSubnetPortSettingCRName:
+				crName = *tag.Tag
+			case common.TagScopeNamespace:
+				crNamespace = *tag.Tag
+			}
+		}
+		if crName != "" && crNamespace != "" {
+			res = append(res, types.NamespacedName{Name: crName, Namespace: crNamespace}.String())
+		}
+		return res, nil
+	default:
+		return nil, errors.New("portConfigCRNameIndexFunc doesn't support unknown type")
+	}
+}
+
+func (s *PortConfigStore) GetByKey(key string) *model.VpcSubnetPortConfig {
+	var portConfig *model.VpcSubnetPortConfig
+	obj := s.ResourceStore.GetByKey(key)
+	if obj != nil {
+		portConfig = obj.(*model.VpcSubnetPortConfig)
+	}
+	return portConfig
+}
+
+func (s *PortConfigStore) GetByIndex(key string, value string) []*model.VpcSubnetPortConfig {
+	portConfigs := make([]*model.VpcSubnetPortConfig, 0)
+	objs := s.ResourceStore.GetByIndex(key, value)
+	for _, portConfig := range objs {
+		portConfigs = append(portConfigs, portConfig.(*model.VpcSubnetPortConfig))
+	}
+	return portConfigs
+}
+
+func SetupPortConfigStore() *PortConfigStore {
+	return &PortConfigStore{
+		ResourceStore: common.ResourceStore{
+			Indexer: cache.NewIndexer(
+				keyFunc, cache.Indexers{
+					common.TagScopeSubnetPortSettingCRUID:  portConfigCRUIDIndexFunc,
+					common.TagScopeSubnetPortSettingCRName: portConfigCRNameIndexFunc,
+				}),
+			BindingType: model.VpcSubnetPortConfigBindingType(),
+		},
+	}
+}
@@ -0,0 +1,130 @@
+package subnetportsetting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+var (
+	portConfig1 = &model.VpcSubnetPortConfig{
+		Id:          common.String("ps1_3yw4m"),
+		DisplayName: common.String("ps1"),
+		Path:        common.String("/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1/port-configs/ps1_3yw4m"),
+		ParentPath:  common.String("/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1"),
+		Tags: []model.Tag{
+			{
+				Scope: common.String(common.TagScopeNamespace),
+				Tag:   common.String("ns-1"),
+			},
+			{
+				Scope: common.String(common.TagScopeSubnetPortSettingCRName),
+				Tag:   common.String("ps1"),
+			},
+			{
+				Scope: common.String(common.TagScopeSubnetPortSettingCRUID),
+				Tag:   common.String("ps1-uuid"),
+			},
+		},
+	}
+	portConfig2 = &model.VpcSubnetPortConfig{
+		Id:          common.String("ps2_3yw4m"),
+		DisplayName: common.String("ps2"),
+		Path:        common.String("/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1/port-configs/ps2_3yw4m"),
+		ParentPath:  common.String("/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1"),
+		Tags: []model.Tag{
+			{
+				Scope: common.String(common.TagScopeNamespace),
+				Tag:   common.String("ns-1"),
+			},
+			{
+				Scope: common.String(common.TagScopeSubnetPortSettingCRName),
+				Tag:   common.String("ps2"),
+			},
+			{
+				Scope: common.String(common.TagScopeSubnetPortSettingCRUID),
+				Tag:   common.String("ps2-uuid"),
+			},
+		},
+	}
+	portConfig3 = &model.VpcSubnetPortConfig{
+		Id:          common.String("ps1_luf2j"),
+		DisplayName: common.String("ps1"),
+		Path:        common.String("/orgs/default/projects/default/vpcs/ns-2/subnets/subnet-1/port-configs/ps1_luf2j"),
+		ParentPath:  common.String("/orgs/default/projects/default/vpcs/ns-2/subnets/subnet-1"),
+		Tags: []model.Tag{
+			{
+				Scope: common.String(common.TagScopeNamespace),
+				Tag:   common.String("ns-2"),
+			},
+			{
+				Scope: common.String(common.TagScopeSubnetPortSettingCRName),
+				Tag:   common.String("ps1"),
+			},
+			{
+				Scope: common.String(common.TagScopeSubnetPortSettingCRUID),
+				Tag:   common.String("ps3-uuid"),
+			},
+		},
+	}
+)
+
+func TestPortConfigStore(t *testing.T) {
+	store := SetupPortConfigStore()
+	require.NoError(t, store.Apply(portConfig1))
+	require.NoError(t, store.Apply(portConfig2))
+	require.NoError(t, store.Apply(portConfig3))
+	require.NoError(t, store.Apply(nil))
+
+	require.Equal(t, portConfig1, store.GetByKey("ps1_3yw4m"))
+	require.Nil(t, store.GetByKey("non-existing"))
+
+	portConfigs := store.GetByIndex(common.TagScopeSubnetPortSettingCRUID, "ps2-uuid")
+	require.Equal(t, []*model.VpcSubnetPortConfig{portConfig2}, portConfigs)
+
+	portConfigs = store.GetByIndex(common.TagScopeSubnetPortSettingCRName, types.NamespacedName{Namespace: "ns-2", Name: "ps1"}.String())
+	require.Equal(t, []*model.VpcSubnetPortConfig{portConfig3}, portConfigs)
+
+	deletedPortConfig := *portConfig1
+	deletedPortConfig.MarkedForDelete = common.Bool(true)
+	require.NoError(t, store.Apply(&deletedPortConfig))
+	require.Nil(t, store.GetByKey("ps1_3yw4m"))
+	require.Equal(t, 2, len(store.List()))
+}
+
+func TestKeyFunc(t *testing.T) {
+	key, err := keyFunc(portConfig1)
+	require.NoError(t, err)
+	require.Equal(t, "ps1_3yw4m", key)
+
+	key, err = keyFunc("ps1_3yw4m")
+	require.NoError(t, err)
+	require.Equal(t, "ps1_3yw4m", key)
+
+	_, err = keyFunc(&model.VpcSubnetPort{})
+	require.ErrorContains(t, err, "keyFunc doesn't support unknown type")
+}
+
+func TestPortConfigIndexFunc(t *testing.T) {
+	crUIDs, err := portConfigCRUIDIndexFunc(portConfig1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ps1-uuid"}, crUIDs)
+	crUIDs, err = portConfigCRUIDIndexFunc(&model.VpcSubnetPortConfig{})
+	require.NoError(t, err)
+	require.Empty(t, crUIDs)
+	_, err = portConfigCRUIDIndexFunc(&model.VpcSubnetPort{})
+	require.ErrorContains(t, err, "portConfigCRUIDIndexFunc doesn't support unknown type")
+
+	crNames, err := portConfigCRNameIndexFunc(portConfig3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ns-2/ps1"}, crNames)
+	crNames, err = portConfigCRNameIndexFunc(&model.VpcSubnetPortConfig{})
+	require.NoError(t, err)
+	require.Empty(t, crNames)
+	_, err = portConfigCRNameIndexFunc(&model.VpcSubnetPort{})
+	require.ErrorContains(t, err, "portConfigCRNameIndexFunc doesn't support unknown type")
+}
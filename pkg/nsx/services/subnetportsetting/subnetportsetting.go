@@ -0,0 +1,171 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetportsetting
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+var (
+	log                             = logger.Log
+	ResourceTypeVpcSubnetPortConfig = common.ResourceTypeVpcSubnetPortConfig
+)
+
+// SubnetPortSettingService realizes the SubnetPortSetting CRs as NSX VpcSubnetPortConfigs, which carry the
+// MAC discovery, IP discovery, SpoofGuard, QoS and segment security profiles of the SubnetPorts.
+type SubnetPortSettingService struct {
+	common.Service
+	PortConfigStore *PortConfigStore
+}
+
+// InitializeService initializes SubnetPortSetting service.
+func InitializeService(service common.Service) (*SubnetPortSettingService, error) {
+	wg := sync.WaitGroup{}
+	wgDone := make(chan bool)
+	fatalErrors := make(chan error, 1)
+
+	portSettingService := &SubnetPortSettingService{
+		Service:         service,
+		PortConfigStore: SetupPortConfigStore(),
+	}
+
+	wg.Add(1)
+	go portSettingService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeVpcSubnetPortConfig, nil, portSettingService.PortConfigStore)
+	go func() {
+		wg.Wait()
+		close(wgDone)
+	}()
+
+	select {
+	case <-wgDone:
+		break
+	case err := <-fatalErrors:
+		return portSettingService, err
+	}
+
+	return portSettingService, nil
+}
+
+// CreateOrUpdatePortConfig creates or updates the NSX VpcSubnetPortConfig under the given Subnet according to the SubnetPortSetting CR.
+func (s *SubnetPortSettingService) CreateOrUpdatePortConfig(portSetting *v1alpha1.SubnetPortSetting, subnetPath string) (*model.VpcSubnetPortConfig, error) {
+	nsxPortConfig, err := s.buildPortConfig(portSetting, subnetPath)
+	if err != nil {
+		return nil, err
+	}
+	existingPortConfigs := s.PortConfigStore.GetByIndex(common.TagScopeSubnetPortSettingCRUID, string(portSetting.UID))
+	if len(existingPortConfigs) > 0 {
+		existingPortConfig := existingPortConfigs[0]
+		if existingPortConfig.ParentPath != nil && *existingPortConfig.ParentPath != subnetPath {
+			return nil, fmt.Errorf("NSX VpcSubnetPortConfig %s already exists under another Subnet %s", *existingPortConfig.Path, *existingPortConfig.ParentPath)
+		}
+		nsxPortConfig.Id = existingPortConfig.Id
+		nsxPortConfig.Path = existingPortConfig.Path
+		nsxPortConfig.Revision = existingPortConfig.Revision
+		if !common.CompareResource(VpcSubnetPortConfigToComparable(existingPortConfig), VpcSubnetPortConfigToComparable(nsxPortConfig)) {
+			log.Info("NSX VpcSubnetPortConfig not changed, skipping the update", "VpcSubnetPortConfig", *existingPortConfig.Path)
+			return existingPortConfig, nil
+		}
+	}
+	subnetInfo, err := common.ParseVPCResourcePath(subnetPath)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Updating the NSX VpcSubnetPortConfig", "existingVpcSubnetPortConfig", existingPortConfigs, "desiredVpcSubnetPortConfig", nsxPortConfig)
+	if len(existingPortConfigs) == 0 {
+		err = s.NSXClient.PortConfigClient.Patch(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, *nsxPortConfig.Id, *nsxPortConfig)
+	} else {
+		// Replace the existing VpcSubnetPortConfig with PUT rather than PATCH, otherwise the profiles removed
+		// from the SubnetPortSetting CR are sent as nil fields and NSX keeps their previous values.
+		var updatedPortConfig model.VpcSubnetPortConfig
+		updatedPortConfig, err = s.NSXClient.PortConfigClient.Update(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, *nsxPortConfig.Id, *nsxPortConfig)
+		if err == nil {
+			nsxPortConfig.Revision = updatedPortConfig.Revision
+		}
+	}
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to create or update NSX VpcSubnetPortConfig", "VpcSubnetPortConfig", *nsxPortConfig.Id, "nsxSubnetPath", subnetPath)
+		return nil, err
+	}
+	// Cache the desired VpcSubnetPortConfig rather than the one returned by NSX, as NSX fills the unset profile
+	// attributes with the default values, which makes the comparison always detect changes.
+	if err = s.PortConfigStore.Apply(nsxPortConfig); err != nil {
+		return nil, err
+	}
+	log.Info("Created or updated NSX VpcSubnetPortConfig", "VpcSubnetPortConfig", *nsxPortConfig.Path)
+	return nsxPortConfig, nil
+}
+
+// GetPortConfigPath returns the path of the NSX VpcSubnetPortConfig realized for the SubnetPortSetting CR.
+func (s *SubnetPortSettingService) GetPortConfigPath(namespace, name string) (string, error) {
+	namespacedName := types.NamespacedName{Namespace: namespace, Name: name}
+	nsxPortConfigs := s.PortConfigStore.GetByIndex(common.TagScopeSubnetPortSettingCRName, namespacedName.String())
+	if len(nsxPortConfigs) == 0 || nsxPortConfigs[0].Path == nil {
+		return "", fmt.Errorf("SubnetPortSetting %s is not realized", namespacedName)
+	}
+	return *nsxPortConfigs[0].Path, nil
+}
+
+func (s *SubnetPortSettingService) DeletePortConfigByCRName(ctx context.Context, ns, name string) error {
+	namespacedName := types.NamespacedName{Namespace: ns, Name: name}
+	nsxPortConfigs := s.PortConfigStore.GetByIndex(common.TagScopeSubnetPortSettingCRName, namespacedName.String())
+	for _, nsxPortConfig := range nsxPortConfigs {
+		if err := s.DeletePortConfig(ctx, nsxPortConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SubnetPortSettingService) DeletePortConfigByCRId(ctx context.Context, id string) error {
+	nsxPortConfigs := s.PortConfigStore.GetByIndex(common.TagScopeSubnetPortSettingCRUID, id)
+	for _, nsxPortConfig := range nsxPortConfigs {
+		if err := s.DeletePortConfig(ctx, nsxPortConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SubnetPortSettingService) DeletePortConfig(ctx context.Context, nsxPortConfig *model.VpcSubnetPortConfig) error {
+	portConfigInfo, err := common.ParseVPCResourcePath(*nsxPortConfig.Path)
+	if err != nil {
+		return err
+	}
+	err = s.NSXClient.WithContext(ctx).PortConfigClient.Delete(portConfigInfo.OrgID, portConfigInfo.ProjectID, portConfigInfo.VPCID, portConfigInfo.ParentID, *nsxPortConfig.Id)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to delete NSX VpcSubnetPortConfig", "VpcSubnetPortConfig", *nsxPortConfig.Path)
+		return err
+	}
+	if err = s.PortConfigStore.Delete(nsxPortConfig); err != nil {
+		return err
+	}
+	log.Info("Successfully deleted NSX VpcSubnetPortConfig", "VpcSubnetPortConfig", *nsxPortConfig.Path)
+	return nil
+}
+
+func (s *SubnetPortSettingService) ListSubnetPortSettingCRUIDsInStore() sets.Set[string] {
+	crUIDs := sets.New[string]()
+	for _, obj := range s.PortConfigStore.List() {
+		portConfig, _ := obj.(*model.VpcSubnetPortConfig)
+		for _, tag := range portConfig.Tags {
+			if *tag.Scope == common.TagScopeSubnetPortSettingCRUID {
+				crUIDs.Insert(*tag.Tag)
+			}
+		}
+	}
+	return crUIDs
+}
@@ -0,0 +1,330 @@
+package subnetportsetting
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+type fakeQueryClient struct{}
+
+func (c *fakeQueryClient) List(queryParam string, cursorParam *string, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model.SearchResponse, error) {
+	return model.SearchResponse{}, nil
+}
+
+type fakePortConfigsClient struct{}
+
+func (c *fakePortConfigsClient) Delete(orgIdParam string, projectIdParam string, vpcIdParam string, subnetIdParam string, portConfigIdParam string) error {
+	return nil
+}
+
+func (c *fakePortConfigsClient) Get(orgIdParam string, projectIdParam string, vpcIdParam string, subnetIdParam string, portConfigIdParam string) (model.VpcSubnetPortConfig, error) {
+	return model.VpcSubnetPortConfig{}, nil
+}
+
+func (c *fakePortConfigsClient) List(orgIdParam string, projectIdParam string, vpcIdParam string, subnetIdParam string, cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model.VpcSubnetPortConfigListResult, error) {
+	return model.VpcSubnetPortConfigListResult{}, nil
+}
+
+func (c *fakePortConfigsClient) Patch(orgIdParam string, projectIdParam string, vpcIdParam string, subnetIdParam string, portConfigIdParam string, vpcSubnetPortConfigParam model.VpcSubnetPortConfig) error {
+	return nil
+}
+
+func (c *fakePortConfigsClient) Update(orgIdParam string, projectIdParam string, vpcIdParam string, subnetIdParam string, portConfigIdParam string, vpcSubnetPortConfigParam model.VpcSubnetPortConfig) (model.VpcSubnetPortConfig, error) {
+	return model.VpcSubnetPortConfig{}, nil
+}
+
+func TestInitializeService(t *testing.T) {
+	commonService := common.Service{
+		NSXClient: &nsx.Client{
+			QueryClient: &fakeQueryClient{},
+			NsxConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster: "k8scl-one:test",
+				},
+			},
+		},
+	}
+	patches := gomonkey.ApplyMethodFunc(commonService.NSXClient.QueryClient, "List",
+		func(query string, cursor *string, fields *string, size *int64, asc *bool, sort *string) (model.SearchResponse, error) {
+			return model.SearchResponse{}, nil
+		},
+	)
+	defer patches.Reset()
+	portSettingService, err := InitializeService(commonService)
+	require.Nil(t, err)
+	if !reflect.DeepEqual(portSettingService.Service, commonService) {
+		t.Errorf("InitializeService() got = %v, want %v", portSettingService.Service, commonService)
+	}
+
+	patches = gomonkey.ApplyMethodFunc(commonService.NSXClient.QueryClient, "List",
+		func(query string, cursor *string, fields *string, size *int64, asc *bool, sort *string) (model.SearchResponse, error) {
+			return model.SearchResponse{}, fmt.Errorf("mocked error")
+		},
+	)
+	defer patches.Reset()
+	_, err = InitializeService(commonService)
+	require.Contains(t, err.Error(), "mocked error")
+}
+
+func TestCreateOrUpdatePortConfig(t *testing.T) {
+	subnetPath := "/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-1"
+	// existingConfig is the VpcSubnetPortConfig realized for the SubnetPortSetting ps1 without any profile.
+	existingConfig, _ := createFakeService().buildPortConfig(&v1alpha1.SubnetPortSetting{
+		ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+		Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+	}, subnetPath)
+	// configWithProfiles is the VpcSubnetPortConfig realized for the SubnetPortSetting ps1 with QoS and SpoofGuard.
+	configWithProfiles, _ := createFakeService().buildPortConfig(&v1alpha1.SubnetPortSetting{
+		ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+		Spec: v1alpha1.SubnetPortSettingSpec{
+			SubnetName: "subnet-1",
+			QoS:        &v1alpha1.QoSSetting{ClassOfService: int32Ptr(1)},
+			SpoofGuard: &v1alpha1.SpoofGuardSetting{AddressBindingAllowlist: common.Bool(true)},
+		},
+	}, subnetPath)
+	configWithProfiles.Revision = common.Int64(3)
+	tests := []struct {
+		name             string
+		portSetting      *v1alpha1.SubnetPortSetting
+		subnetPath       string
+		existingConfig   *model.VpcSubnetPortConfig
+		prepareFunc      func() *gomonkey.Patches
+		expectedPatch    bool
+		expectedUpdate   bool
+		expectedErr      string
+		expectedConfigID string
+	}{
+		{
+			name: "Create",
+			portSetting: &v1alpha1.SubnetPortSetting{
+				ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+				Spec: v1alpha1.SubnetPortSettingSpec{
+					SubnetName: "subnet-1",
+					SpoofGuard: &v1alpha1.SpoofGuardSetting{AddressBindingAllowlist: common.Bool(true)},
+				},
+			},
+			subnetPath:    subnetPath,
+			expectedPatch: true,
+		},
+		{
+			name: "NotChanged",
+			portSetting: &v1alpha1.SubnetPortSetting{
+				ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+				Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+			},
+			subnetPath:       subnetPath,
+			existingConfig:   existingConfig,
+			expectedConfigID: *existingConfig.Id,
+		},
+		{
+			name: "Update",
+			portSetting: &v1alpha1.SubnetPortSetting{
+				ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+				Spec: v1alpha1.SubnetPortSettingSpec{
+					SubnetName: "subnet-1",
+					QoS:        &v1alpha1.QoSSetting{ClassOfService: int32Ptr(1)},
+				},
+			},
+			subnetPath:       subnetPath,
+			existingConfig:   portConfig1,
+			expectedUpdate:   true,
+			expectedConfigID: *portConfig1.Id,
+		},
+		{
+			name: "RemoveSections",
+			portSetting: &v1alpha1.SubnetPortSetting{
+				ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+				Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+			},
+			subnetPath:       subnetPath,
+			existingConfig:   configWithProfiles,
+			expectedUpdate:   true,
+			expectedConfigID: *configWithProfiles.Id,
+		},
+		{
+			name: "SubnetChanged",
+			portSetting: &v1alpha1.SubnetPortSetting{
+				ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+				Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-2"},
+			},
+			subnetPath:     "/orgs/default/projects/default/vpcs/ns-1/subnets/subnet-2",
+			existingConfig: existingConfig,
+			expectedErr:    "already exists under another Subnet",
+		},
+		{
+			name: "PatchFailure",
+			portSetting: &v1alpha1.SubnetPortSetting{
+				ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+				Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+			},
+			subnetPath: subnetPath,
+			prepareFunc: func() *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(&fakePortConfigsClient{}), "Patch", func(_ *fakePortConfigsClient, _ string, _ string, _ string, _ string, _ string, _ model.VpcSubnetPortConfig) error {
+					return fmt.Errorf("mocked patch error")
+				})
+			},
+			expectedErr: "mocked patch error",
+		},
+		{
+			name: "UpdateFailure",
+			portSetting: &v1alpha1.SubnetPortSetting{
+				ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+				Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+			},
+			subnetPath:     subnetPath,
+			existingConfig: configWithProfiles,
+			prepareFunc: func() *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(&fakePortConfigsClient{}), "Update", func(_ *fakePortConfigsClient, _ string, _ string, _ string, _ string, _ string, _ model.VpcSubnetPortConfig) (model.VpcSubnetPortConfig, error) {
+					return model.VpcSubnetPortConfig{}, fmt.Errorf("mocked update error")
+				})
+			},
+			expectedErr: "mocked update error",
+		},
+		{
+			name: "InvalidSubnetPath",
+			portSetting: &v1alpha1.SubnetPortSetting{
+				ObjectMeta: v1.ObjectMeta{Namespace: "ns-1", Name: "ps1", UID: "ps1-uuid"},
+				Spec:       v1alpha1.SubnetPortSettingSpec{SubnetName: "subnet-1"},
+			},
+			subnetPath:  "invalid-path",
+			expectedErr: "invalid path",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := createFakeService()
+			if tt.existingConfig != nil {
+				service.PortConfigStore.Apply(tt.existingConfig)
+			}
+			patched := false
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakePortConfigsClient{}), "Patch", func(_ *fakePortConfigsClient, orgID string, projectID string, vpcID string, subnetID string, id string, obj model.VpcSubnetPortConfig) error {
+				require.Equal(t, "default", orgID)
+				require.Equal(t, "default", projectID)
+				require.Equal(t, "ns-1", vpcID)
+				require.Equal(t, "subnet-1", subnetID)
+				require.Equal(t, *obj.Id, id)
+				patched = true
+				return nil
+			})
+			defer patches.Reset()
+			updated := false
+			updatePatches := gomonkey.ApplyMethod(reflect.TypeOf(&fakePortConfigsClient{}), "Update", func(_ *fakePortConfigsClient, orgID string, projectID string, vpcID string, subnetID string, id string, obj model.VpcSubnetPortConfig) (model.VpcSubnetPortConfig, error) {
+				require.Equal(t, "subnet-1", subnetID)
+				require.Equal(t, *obj.Id, id)
+				require.Equal(t, tt.existingConfig.Revision, obj.Revision)
+				// The removed profiles must be sent as unset so that NSX resets them to the defaults.
+				require.Equal(t, tt.portSetting.Spec.QoS == nil, obj.Qos == nil)
+				require.Equal(t, tt.portSetting.Spec.SpoofGuard == nil, obj.SpoofGuard == nil)
+				updated = true
+				obj.Revision = common.Int64(4)
+				return obj, nil
+			})
+			defer updatePatches.Reset()
+			if tt.prepareFunc != nil {
+				patches := tt.prepareFunc()
+				defer patches.Reset()
+			}
+			portConfig, err := service.CreateOrUpdatePortConfig(tt.portSetting, tt.subnetPath)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedPatch, patched)
+			require.Equal(t, tt.expectedUpdate, updated)
+			if updated {
+				require.Equal(t, int64(4), *portConfig.Revision)
+			}
+			if tt.expectedConfigID != "" {
+				require.Equal(t, tt.expectedConfigID, *portConfig.Id)
+			}
+			require.Equal(t, portConfig, service.PortConfigStore.GetByKey(*portConfig.Id))
+			path, err := service.GetPortConfigPath(tt.portSetting.Namespace, tt.portSetting.Name)
+			require.NoError(t, err)
+			require.Equal(t, *portConfig.Path, path)
+		})
+	}
+}
+
+func TestGetPortConfigPath(t *testing.T) {
+	service := createFakeService()
+	service.PortConfigStore.Apply(portConfig1)
+	service.PortConfigStore.Apply(portConfig3)
+
+	path, err := service.GetPortConfigPath("ns-2", "ps1")
+	require.NoError(t, err)
+	require.Equal(t, *portConfig3.Path, path)
+
+	_, err = service.GetPortConfigPath("ns-1", "ps2")
+	require.ErrorContains(t, err, "SubnetPortSetting ns-1/ps2 is not realized")
+}
+
+func TestDeletePortConfig(t *testing.T) {
+	service := createFakeService()
+	service.PortConfigStore.Apply(portConfig1)
+	service.PortConfigStore.Apply(portConfig2)
+	service.PortConfigStore.Apply(portConfig3)
+
+	err := service.DeletePortConfigByCRId(context.TODO(), "ps1-uuid")
+	require.NoError(t, err)
+	err = service.DeletePortConfigByCRName(context.TODO(), "ns-2", "ps1")
+	require.NoError(t, err)
+	portConfigs := service.PortConfigStore.List()
+	require.Equal(t, 1, len(portConfigs))
+	require.Equal(t, portConfig2, portConfigs[0].(*model.VpcSubnetPortConfig))
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakePortConfigsClient{}), "Delete", func(_ *fakePortConfigsClient, _ string, _ string, _ string, _ string, _ string) error {
+		return fmt.Errorf("mocked delete error")
+	})
+	defer patches.Reset()
+	err = service.DeletePortConfigByCRName(context.TODO(), "ns-1", "ps2")
+	require.ErrorContains(t, err, "mocked delete error")
+	require.Equal(t, 1, len(service.PortConfigStore.List()))
+}
+
+func TestListSubnetPortSettingCRUIDsInStore(t *testing.T) {
+	service := createFakeService()
+	service.PortConfigStore.Apply(portConfig1)
+	service.PortConfigStore.Apply(portConfig2)
+	service.PortConfigStore.Apply(portConfig3)
+
+	ids := service.ListSubnetPortSettingCRUIDsInStore()
+	require.Equal(t, 3, len(ids))
+	require.True(t, ids.Has("ps1-uuid"))
+	require.True(t, ids.Has("ps2-uuid"))
+	require.True(t, ids.Has("ps3-uuid"))
+}
+
+func createFakeService() *SubnetPortSettingService {
+	return &SubnetPortSettingService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{
+				PortConfigClient: &fakePortConfigsClient{},
+				QueryClient:      &fakeQueryClient{},
+				NsxConfig: &config.NSXOperatorConfig{
+					CoeConfig: &config.CoeConfig{
+						Cluster: "k8scl-one:test",
+					},
+				},
+			},
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster: "k8scl-one:test",
+				},
+			},
+		},
+		PortConfigStore: SetupPortConfigStore(),
+	}
+}
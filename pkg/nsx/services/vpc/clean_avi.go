@@ -70,15 +70,22 @@ func CleanAviSubnetPorts(ctx context.Context, cluster *nsx.Cluster, vpcPath stri
 	}
 
 	log.Info("Deleting Avi Subnet ports", "vpcPath", vpcPath, "portCount", allPaths.Len())
+	report := common.CleanupReportFromContext(ctx)
 	for path := range allPaths {
 		portID := extractIDFromAviPath(path)
+		if report.IsDryRun() {
+			report.Record(common.ResourceTypeSubnetPort, portID, path, nil)
+			continue
+		}
 		log.Info("Attempting to delete Avi subnet port", "portPath", path, "portID", portID, "vpcPath", vpcPath)
 		url := PolicyAPI + path
 		select {
 		case <-ctx.Done():
 			return errors.Join(nsxutil.TimeoutFailed, ctx.Err())
 		default:
-			if err := cluster.HttpDelete(url); err != nil {
+			err := cluster.HttpDelete(url)
+			report.Record(common.ResourceTypeSubnetPort, portID, path, err)
+			if err != nil {
 				log.Error(err, "Failed to delete Avi subnet port", "portPath", path, "portID", portID, "vpcPath", vpcPath)
 				return fmt.Errorf("failed to delete Avi Subnet port at %s: %w", url, err)
 			}
@@ -223,12 +223,12 @@ func (s *VPCService) ListVPC() []model.Vpc {
 }
 
 // DeleteVPC will try to delete VPC resource from NSX.
-func (s *VPCService) DeleteVPC(path string) error {
+func (s *VPCService) DeleteVPC(ctx context.Context, path string) error {
 	pathInfo, err := common.ParseVPCResourcePath(path)
 	if err != nil {
 		return err
 	}
-	vpcClient := s.NSXClient.VPCClient
+	vpcClient := s.NSXClient.WithContext(ctx).VPCClient
 
 	if err := vpcClient.Delete(pathInfo.OrgID, pathInfo.ProjectID, pathInfo.VPCID, common.Bool(true)); err != nil {
 		err = nsxutil.TransNSXApiError(err)
@@ -626,15 +626,15 @@ func (s *VPCService) CreateOrUpdateVPC(ctx context.Context, obj *v1alpha1.Networ
 		// Recheck the realizedstate if the Namespace CR is not ready.
 		if !isNamespaceReady(nsObj) {
 			// Check VPC realization state
-			if err := s.checkVPCRealizationState(existingVPC[0], *existingVPC[0].Path); err != nil {
+			if err := s.checkVPCRealizationState(ctx, existingVPC[0], *existingVPC[0].Path); err != nil {
 				return nil, err
 			}
 			// Check LBS realization
-			if err := s.checkLBSRealization(createdLBS, existingVPC[0], nc, *existingVPC[0].Path); err != nil {
+			if err := s.checkLBSRealization(ctx, createdLBS, existingVPC[0], nc, *existingVPC[0].Path); err != nil {
 				return nil, err
 			}
 			// Check VpcAttachment realization
-			if err := s.checkVpcAttachmentRealization(createdAttachment, existingVPC[0], nc, *existingVPC[0].Path); err != nil {
+			if err := s.checkVpcAttachmentRealization(ctx, createdAttachment, existingVPC[0], nc, *existingVPC[0].Path); err != nil {
 				return nil, err
 			}
 		}
@@ -661,7 +661,7 @@ func (s *VPCService) CreateOrUpdateVPC(ctx context.Context, obj *v1alpha1.Networ
 	}
 
 	// Check VPC realization state
-	if err := s.checkVPCRealizationState(createdVpc, *newVpc.Path); err != nil {
+	if err := s.checkVPCRealizationState(ctx, createdVpc, *newVpc.Path); err != nil {
 		return nil, err
 	}
 
@@ -670,12 +670,12 @@ func (s *VPCService) CreateOrUpdateVPC(ctx context.Context, obj *v1alpha1.Networ
 	}
 
 	// Check LBS realization
-	if err := s.checkLBSRealization(createdLBS, createdVpc, nc, *newVpc.Path); err != nil {
+	if err := s.checkLBSRealization(ctx, createdLBS, createdVpc, nc, *newVpc.Path); err != nil {
 		return nil, err
 	}
 
 	// Check VpcAttachment realization
-	if err := s.checkVpcAttachmentRealization(createdAttachment, createdVpc, nc, *newVpc.Path); err != nil {
+	if err := s.checkVpcAttachmentRealization(ctx, createdAttachment, createdVpc, nc, *newVpc.Path); err != nil {
 		return nil, err
 	}
 
@@ -709,15 +709,15 @@ func (s *VPCService) createNSXVPC(createdVpc *model.Vpc, nc *v1alpha1.VPCNetwork
 	return nil
 }
 
-func (s *VPCService) checkVPCRealizationState(createdVpc *model.Vpc, newVpcPath string) error {
+func (s *VPCService) checkVPCRealizationState(ctx context.Context, createdVpc *model.Vpc, newVpcPath string) error {
 	log.Trace("Check VPC realization state", "VPC", *createdVpc.Id)
 	realizeService := realizestate.InitializeRealizeState(s.Service)
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, newVpcPath, []string{common.GatewayInterfaceId}); err != nil {
+	if err := realizeService.CheckRealizeState(ctx, util.NSXTRealizeRetry, newVpcPath, []string{common.GatewayInterfaceId}); err != nil {
 		log.Error(err, "Failed to check VPC realization state", "VPC", *createdVpc.Id)
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "The created VPC is in error realization state, cleaning the resource", "VPC", *createdVpc.Id)
 			// delete the nsx vpc object and re-create it in the next loop
-			if err := s.DeleteVPC(newVpcPath); err != nil {
+			if err := s.DeleteVPC(ctx, newVpcPath); err != nil {
 				log.Error(err, "Cleanup VPC failed", "VPC", *createdVpc.Id)
 				return err
 			}
@@ -790,7 +790,7 @@ func (s *VPCService) buildLBServiceIPAllocsForRestore(obj *v1alpha1.NetworkInfo,
 	return allocs, nil
 }
 
-func (s *VPCService) checkLBSRealization(createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+func (s *VPCService) checkLBSRealization(ctx context.Context, createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
 	if createdLBS == nil {
 		return nil
 	}
@@ -799,7 +799,7 @@ func (s *VPCService) checkLBSRealization(createdLBS *model.LBService, createdVpc
 		log.Error(err, "Failed to parse NSX project in NetworkConfig", "ProjectPath", nc.Spec.NSXProject)
 		return err
 	}
-	newLBS, err := s.NSXClient.VPCLBSClient.Get(org, project, *createdVpc.Id, *createdLBS.Id)
+	newLBS, err := s.NSXClient.WithContext(ctx).VPCLBSClient.Get(org, project, *createdVpc.Id, *createdLBS.Id)
 	if err != nil || newLBS.ConnectivityPath == nil {
 		log.Error(err, "Failed to read LBS object after creating or updating", "LBS", createdLBS.Id)
 		if err == nil {
@@ -811,12 +811,12 @@ func (s *VPCService) checkLBSRealization(createdLBS *model.LBService, createdVpc
 
 	log.Trace("Check LBS realization state", "LBS", *createdLBS.Id)
 	realizeService := realizestate.InitializeRealizeState(s.Service)
-	if err = realizeService.CheckRealizeState(util.NSXTRealizeRetry, *newLBS.Path, []string{}); err != nil {
+	if err = realizeService.CheckRealizeState(ctx, util.NSXTRealizeRetry, *newLBS.Path, []string{}); err != nil {
 		log.Error(err, "Failed to check LBS realization state", "LBS", *createdLBS.Id)
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "The created LBS is in error realization state, cleaning the resource", "LBS", *createdLBS.Id)
 			// delete the nsx vpc object and re-create it in the next loop
-			if err := s.DeleteVPC(newVpcPath); err != nil {
+			if err := s.DeleteVPC(ctx, newVpcPath); err != nil {
 				log.Error(err, "Cleanup VPC failed", "VPC", *createdVpc.Id)
 				return err
 			}
@@ -826,7 +826,7 @@ func (s *VPCService) checkLBSRealization(createdLBS *model.LBService, createdVpc
 	return nil
 }
 
-func (s *VPCService) checkVpcAttachmentRealization(createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+func (s *VPCService) checkVpcAttachmentRealization(ctx context.Context, createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
 	if createdAttachment == nil {
 		return nil
 	}
@@ -835,7 +835,7 @@ func (s *VPCService) checkVpcAttachmentRealization(createdAttachment *model.VpcA
 		log.Error(err, "Failed to parse NSX project in NetworkConfig", "ProjectPath", nc.Spec.NSXProject)
 		return err
 	}
-	newAttachment, err := s.NSXClient.VpcAttachmentClient.Get(org, project, *createdVpc.Id, *createdAttachment.Id)
+	newAttachment, err := s.NSXClient.WithContext(ctx).VpcAttachmentClient.Get(org, project, *createdVpc.Id, *createdAttachment.Id)
 	if err != nil || newAttachment.VpcConnectivityProfile == nil {
 		log.Error(err, "Failed to read VPC attachment object after creating or updating", "VpcAttachment", createdAttachment.Id)
 		if err == nil {
@@ -845,12 +845,12 @@ func (s *VPCService) checkVpcAttachmentRealization(createdAttachment *model.VpcA
 	}
 	log.Trace("Check VPC attachment realization state", "VpcAttachment", *createdAttachment.Id)
 	realizeService := realizestate.InitializeRealizeState(s.Service)
-	if err = realizeService.CheckRealizeState(util.NSXTRealizeRetry, *newAttachment.Path, []string{}); err != nil {
+	if err = realizeService.CheckRealizeState(ctx, util.NSXTRealizeRetry, *newAttachment.Path, []string{}); err != nil {
 		log.Error(err, "Failed to check VPC attachment realization state", "VpcAttachment", *createdAttachment.Id)
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "The created VPC attachment is in error realization state, cleaning the resource", "VpcAttachment", *createdAttachment.Id)
 			// delete the nsx vpc object and re-create it in the next loop
-			if err := s.DeleteVPC(newVpcPath); err != nil {
+			if err := s.DeleteVPC(ctx, newVpcPath); err != nil {
 				log.Error(err, "Cleanup VPC failed", "VPC", *createdVpc.Id)
 				return err
 			}
@@ -1286,7 +1286,12 @@ func (s *VPCService) GetNamespacesWithPreCreatedVPCs() (map[string]string, error
 
 func (s *VPCService) cleanupAviSubnetPorts(ctx context.Context) error {
 	vpcs := s.ListVPC()
+	filter := common.CleanupFilterFromContext(ctx)
 	for _, vpc := range vpcs {
+		// The Avi Subnet ports are only deleted for the VPCs to be deleted.
+		if !filter.MatchResource(*vpc.Path, vpc.Tags) {
+			continue
+		}
 		if err := CleanAviSubnetPorts(ctx, s.NSXClient.Cluster, *vpc.Path); err != nil {
 			return err
 		}
@@ -1914,7 +1914,7 @@ func TestVPCService_DeleteVPC(t *testing.T) {
 				service.VpcStore.Add(tt.Vpc)
 			}
 
-			err := service.DeleteVPC(tt.path)
+			err := service.DeleteVPC(context.TODO(), tt.path)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("VPCService.DeleteVPC() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -2340,13 +2340,13 @@ func TestVPCService_CreateOrUpdateVPC(t *testing.T) {
 						{Path: &vpcPath, Id: &fakeVPCID},
 					}
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVPCRealizationState", func(_ *VPCService, createdVpc *model.Vpc, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVPCRealizationState", func(_ *VPCService, _ context.Context, createdVpc *model.Vpc, newVpcPath string) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkLBSRealization", func(_ *VPCService, createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkLBSRealization", func(_ *VPCService, _ context.Context, createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVpcAttachmentRealization", func(_ *VPCService, createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVpcAttachmentRealization", func(_ *VPCService, _ context.Context, createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
 					return nil
 				})
 				return patches
@@ -2387,13 +2387,13 @@ func TestVPCService_CreateOrUpdateVPC(t *testing.T) {
 				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "createNSXVPC", func(_ *VPCService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, orgRoot *model.OrgRoot) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVPCRealizationState", func(_ *VPCService, createdVpc *model.Vpc, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVPCRealizationState", func(_ *VPCService, _ context.Context, createdVpc *model.Vpc, newVpcPath string) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkLBSRealization", func(_ *VPCService, createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkLBSRealization", func(_ *VPCService, _ context.Context, createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVpcAttachmentRealization", func(_ *VPCService, createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVpcAttachmentRealization", func(_ *VPCService, _ context.Context, createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
 					return nil
 				})
 				vpcPath := "/vpc/1"
@@ -2493,7 +2493,7 @@ func TestVPCService_checkVPCRealizationState(t *testing.T) {
 							},
 						}, nil
 					})
-				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, path string) error {
+				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, _ context.Context, path string) error {
 					return nil
 				})
 				return patches
@@ -2514,7 +2514,7 @@ func TestVPCService_checkVPCRealizationState(t *testing.T) {
 							},
 						}, nil
 					})
-				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, path string) error {
+				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, _ context.Context, path string) error {
 					return fmt.Errorf("mocked deletion error")
 				})
 				return patches
@@ -2529,7 +2529,7 @@ func TestVPCService_checkVPCRealizationState(t *testing.T) {
 				patches := tt.prepareFunc()
 				defer patches.Reset()
 			}
-			err := service.checkVPCRealizationState(&model.Vpc{Id: common.String("vpc-1")}, "/vpc-path")
+			err := service.checkVPCRealizationState(context.TODO(), &model.Vpc{Id: common.String("vpc-1")}, "/vpc-path")
 			if tt.expectedErr != "" {
 				assert.Contains(t, err.Error(), tt.expectedErr)
 			} else {
@@ -2602,7 +2602,7 @@ func TestVPCService_checkLBSRealization(t *testing.T) {
 							},
 						}, nil
 					})
-				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, path string) error {
+				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, _ context.Context, path string) error {
 					return nil
 				})
 				return patches
@@ -2627,7 +2627,7 @@ func TestVPCService_checkLBSRealization(t *testing.T) {
 							},
 						}, nil
 					})
-				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, path string) error {
+				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, _ context.Context, path string) error {
 					return fmt.Errorf("mocked deletion error")
 				})
 				return patches
@@ -2643,7 +2643,7 @@ func TestVPCService_checkLBSRealization(t *testing.T) {
 				patches := tt.prepareFunc()
 				defer patches.Reset()
 			}
-			err := service.checkLBSRealization(
+			err := service.checkLBSRealization(context.TODO(),
 				&model.LBService{Id: common.String("lbs-1")},
 				&model.Vpc{Id: common.String("vpc-1")},
 				tt.nc,
@@ -2721,7 +2721,7 @@ func TestVPCService_checkVpcAttachmentRealization(t *testing.T) {
 							},
 						}, nil
 					})
-				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, path string) error {
+				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, _ context.Context, path string) error {
 					return nil
 				})
 				return patches
@@ -2746,7 +2746,7 @@ func TestVPCService_checkVpcAttachmentRealization(t *testing.T) {
 							},
 						}, nil
 					})
-				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, path string) error {
+				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, _ context.Context, path string) error {
 					return fmt.Errorf("mocked deletion error")
 				})
 				return patches
@@ -2762,7 +2762,7 @@ func TestVPCService_checkVpcAttachmentRealization(t *testing.T) {
 				patches := tt.prepareFunc()
 				defer patches.Reset()
 			}
-			err := service.checkVpcAttachmentRealization(
+			err := service.checkVpcAttachmentRealization(context.TODO(),
 				&model.VpcAttachment{Id: common.String("attachment-1")},
 				&model.Vpc{Id: common.String("vpc-1")},
 				tt.nc,
@@ -0,0 +1,52 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+func (s *VPCEndpointService) buildServiceEndpoint(obj *v1alpha1.ServiceEndpoint) *model.VpcServiceEndpoint {
+	return &model.VpcServiceEndpoint{
+		Id:                    common.String(s.buildServiceEndpointID(obj)),
+		DisplayName:           common.String(obj.Name),
+		Tags:                  util.BuildBasicTags(getCluster(s), obj, ""),
+		ServiceEndpointIp:     common.String(obj.Spec.ServiceEndpointIP),
+		ServiceEndpointIpType: common.String(model.VpcServiceEndpoint_SERVICE_ENDPOINT_IP_TYPE_WORKLOAD),
+	}
+}
+
+func (s *VPCEndpointService) buildVPCEndpoint(obj *v1alpha1.VPCEndpoint, ipAllocationPath, serviceEndpointPath string) *model.VpcEndpoint {
+	return &model.VpcEndpoint{
+		Id:                 common.String(s.buildVPCEndpointID(obj)),
+		DisplayName:        common.String(obj.Name),
+		Tags:               util.BuildBasicTags(getCluster(s), obj, ""),
+		IpAllocationPath:   common.String(ipAllocationPath),
+		VpcServiceEndpoint: common.String(serviceEndpointPath),
+	}
+}
+
+func getCluster(service *VPCEndpointService) string {
+	return service.NSXConfig.Cluster
+}
+
+// buildServiceEndpointID generates the ID of NSX VpcServiceEndpoint, its format is like ${ServiceEndpoint_CR}.name_hash(uid)[:5].
+// A random UUID is used to generate the hash suffix if the ID collides with an existing NSX VpcServiceEndpoint.
+func (s *VPCEndpointService) buildServiceEndpointID(obj *v1alpha1.ServiceEndpoint) string {
+	return common.BuildUniqueIDWithRandomUUID(obj, util.GenerateIDByObject, func(id string) bool {
+		return s.ServiceEndpointStore.GetByKey(id) != nil
+	})
+}
+
+// buildVPCEndpointID generates the ID of NSX VpcEndpoint, its format is like ${VPCEndpoint_CR}.name_hash(uid)[:5].
+// A random UUID is used to generate the hash suffix if the ID collides with an existing NSX VpcEndpoint.
+func (s *VPCEndpointService) buildVPCEndpointID(obj *v1alpha1.VPCEndpoint) string {
+	return common.BuildUniqueIDWithRandomUUID(obj, util.GenerateIDByObject, func(id string) bool {
+		return s.VPCEndpointStore.GetByKey(id) != nil
+	})
+}
@@ -0,0 +1,53 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func TestBuildServiceEndpoint(t *testing.T) {
+	service := createFakeService()
+	sep := service.buildServiceEndpoint(serviceEndpointCR)
+	require.True(t, strings.HasPrefix(*sep.Id, "sep-1_"))
+	require.Equal(t, "sep-1", *sep.DisplayName)
+	require.Equal(t, "10.0.0.10", *sep.ServiceEndpointIp)
+	require.Equal(t, model.VpcServiceEndpoint_SERVICE_ENDPOINT_IP_TYPE_WORKLOAD, *sep.ServiceEndpointIpType)
+	require.Equal(t, "k8scl-one:test", nsxutil.FindTag(sep.Tags, common.TagScopeCluster))
+	require.Equal(t, "ns-provider", nsxutil.FindTag(sep.Tags, common.TagScopeNamespace))
+	require.Equal(t, "sep-1", nsxutil.FindTag(sep.Tags, common.TagScopeServiceEndpointCRName))
+	require.Equal(t, "sep-1-uid", nsxutil.FindTag(sep.Tags, common.TagScopeServiceEndpointCRUID))
+}
+
+func TestBuildVPCEndpoint(t *testing.T) {
+	service := createFakeService()
+	ep := service.buildVPCEndpoint(vpcEndpointCR, ipAllocationPath, *serviceEndpoint1.Path)
+	require.True(t, strings.HasPrefix(*ep.Id, "vpcep-1_"))
+	require.Equal(t, "vpcep-1", *ep.DisplayName)
+	require.Equal(t, ipAllocationPath, *ep.IpAllocationPath)
+	require.Equal(t, *serviceEndpoint1.Path, *ep.VpcServiceEndpoint)
+	require.Equal(t, "ns-consumer", nsxutil.FindTag(ep.Tags, common.TagScopeNamespace))
+	require.Equal(t, "vpcep-1", nsxutil.FindTag(ep.Tags, common.TagScopeVPCEndpointCRName))
+	require.Equal(t, "vpcep-1-uid", nsxutil.FindTag(ep.Tags, common.TagScopeVPCEndpointCRUID))
+}
+
+func TestBuildEndpointIDWithCollision(t *testing.T) {
+	service := createFakeService()
+	id := service.buildVPCEndpointID(vpcEndpointCR)
+	service.VPCEndpointStore.Apply(&model.VpcEndpoint{Id: common.String(id)})
+	newID := service.buildVPCEndpointID(vpcEndpointCR)
+	require.NotEqual(t, id, newID)
+	require.True(t, strings.HasPrefix(newID, "vpcep-1_"))
+
+	id = service.buildServiceEndpointID(serviceEndpointCR)
+	service.ServiceEndpointStore.Apply(&model.VpcServiceEndpoint{Id: common.String(id)})
+	require.NotEqual(t, id, service.buildServiceEndpointID(serviceEndpointCR))
+}
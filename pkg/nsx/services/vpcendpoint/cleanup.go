@@ -0,0 +1,74 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// CleanupBeforeVPCDeletion deletes the NSX VpcEndpoints and VpcServiceEndpoints before the VPCs are deleted.
+// A VpcEndpoint refers to a VpcServiceEndpoint in another VPC, which would block that VPC from being deleted,
+// so the VpcEndpoints are deleted before the VpcServiceEndpoints.
+func (s *VPCEndpointService) CleanupBeforeVPCDeletion(ctx context.Context) error {
+	if err := s.CleanupVPCEndpoints(ctx); err != nil {
+		return err
+	}
+	return s.CleanupServiceEndpoints(ctx)
+}
+
+func (s *VPCEndpointService) CleanupVPCEndpoints(ctx context.Context) error {
+	objs := s.VPCEndpointStore.List()
+	log.Info("Cleaning up VpcEndpoint", "Count", len(objs), "status", "attempting")
+	if len(objs) == 0 {
+		log.Info("No VpcEndpoint found to clean up", "count", 0)
+		return nil
+	}
+	// Mark the resources for delete.
+	eps := make([]*model.VpcEndpoint, len(objs))
+	for i, obj := range objs {
+		ep := obj.(*model.VpcEndpoint)
+		ep.MarkedForDelete = &MarkedForDelete
+		eps[i] = ep
+	}
+	log.Info("Starting deletion of VpcEndpoint", "count", len(eps))
+	err := s.vpcEndpointBuilder.PagingUpdateResources(ctx, eps, common.DefaultHAPIChildrenCount, s.NSXClient, func(delObjs []*model.VpcEndpoint) {
+		s.VPCEndpointStore.DeleteMultipleObjects(delObjs)
+	})
+	if err != nil {
+		log.Error(err, "Failed to clean up VpcEndpoint", "count", len(eps), "status", "failed")
+		return err
+	}
+	log.Info("Successfully cleaned up VpcEndpoint", "count", len(eps), "status", "success")
+	return nil
+}
+
+func (s *VPCEndpointService) CleanupServiceEndpoints(ctx context.Context) error {
+	objs := s.ServiceEndpointStore.List()
+	log.Info("Cleaning up VpcServiceEndpoint", "Count", len(objs), "status", "attempting")
+	if len(objs) == 0 {
+		log.Info("No VpcServiceEndpoint found to clean up", "count", 0)
+		return nil
+	}
+	// Mark the resources for delete.
+	seps := make([]*model.VpcServiceEndpoint, len(objs))
+	for i, obj := range objs {
+		sep := obj.(*model.VpcServiceEndpoint)
+		sep.MarkedForDelete = &MarkedForDelete
+		seps[i] = sep
+	}
+	log.Info("Starting deletion of VpcServiceEndpoint", "count", len(seps))
+	err := s.serviceEndpointBuilder.PagingUpdateResources(ctx, seps, common.DefaultHAPIChildrenCount, s.NSXClient, func(delObjs []*model.VpcServiceEndpoint) {
+		s.ServiceEndpointStore.DeleteMultipleObjects(delObjs)
+	})
+	if err != nil {
+		log.Error(err, "Failed to clean up VpcServiceEndpoint", "count", len(seps), "status", "failed")
+		return err
+	}
+	log.Info("Successfully cleaned up VpcServiceEndpoint", "count", len(seps), "status", "success")
+	return nil
+}
@@ -0,0 +1,64 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	orgroot_mocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/orgrootclient"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestCleanupBeforeVPCDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRootClient := orgroot_mocks.NewMockOrgRootClient(ctrl)
+	mockRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	service := createFakeService()
+	service.vpcEndpointBuilder, _ = common.PolicyPathVpcEndpoint.NewPolicyTreeBuilder()
+	service.serviceEndpointBuilder, _ = common.PolicyPathVpcServiceEndpoint.NewPolicyTreeBuilder()
+	service.NSXClient.OrgRootClient = mockRootClient
+	// Copy the shared fixtures since cleanup marks the stored objects for delete.
+	ep, sep := *vpcEndpoint1, *serviceEndpoint1
+	service.VPCEndpointStore.Apply(&ep)
+	service.ServiceEndpointStore.Apply(&sep)
+
+	err := service.CleanupBeforeVPCDeletion(context.TODO())
+	require.NoError(t, err)
+	require.Empty(t, service.VPCEndpointStore.List())
+	require.Empty(t, service.ServiceEndpointStore.List())
+
+	// Nothing is sent to NSX when the stores are empty.
+	require.NoError(t, service.CleanupBeforeVPCDeletion(context.TODO()))
+}
+
+func TestCleanupVPCEndpointsFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRootClient := orgroot_mocks.NewMockOrgRootClient(ctrl)
+	mockRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(fmt.Errorf("mocked patch error"))
+
+	service := createFakeService()
+	service.vpcEndpointBuilder, _ = common.PolicyPathVpcEndpoint.NewPolicyTreeBuilder()
+	service.serviceEndpointBuilder, _ = common.PolicyPathVpcServiceEndpoint.NewPolicyTreeBuilder()
+	service.NSXClient.OrgRootClient = mockRootClient
+	// Copy the shared fixtures since cleanup marks the stored objects for delete.
+	ep, sep := *vpcEndpoint1, *serviceEndpoint1
+	service.VPCEndpointStore.Apply(&ep)
+	service.ServiceEndpointStore.Apply(&sep)
+
+	// The VpcServiceEndpoints are kept if the VpcEndpoints consuming them fail to be deleted.
+	err := service.CleanupBeforeVPCDeletion(context.TODO())
+	require.ErrorContains(t, err, "mocked patch error")
+	require.Len(t, service.VPCEndpointStore.List(), 1)
+	require.Len(t, service.ServiceEndpointStore.List(), 1)
+}
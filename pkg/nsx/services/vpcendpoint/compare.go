@@ -0,0 +1,64 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+type (
+	VpcEndpoint        model.VpcEndpoint
+	VpcServiceEndpoint model.VpcServiceEndpoint
+)
+
+func (m *VpcEndpoint) Key() string {
+	return *m.Id
+}
+
+func (m *VpcEndpoint) Value() data.DataValue {
+	s := &VpcEndpoint{
+		Id:                 m.Id,
+		DisplayName:        m.DisplayName,
+		Tags:               m.Tags,
+		IpAllocationPath:   m.IpAllocationPath,
+		VpcServiceEndpoint: m.VpcServiceEndpoint,
+	}
+	dataValue, _ := ComparableToVpcEndpoint(s).GetDataValue__()
+	return dataValue
+}
+
+func (m *VpcServiceEndpoint) Key() string {
+	return *m.Id
+}
+
+func (m *VpcServiceEndpoint) Value() data.DataValue {
+	s := &VpcServiceEndpoint{
+		Id:                    m.Id,
+		DisplayName:           m.DisplayName,
+		Tags:                  m.Tags,
+		ServiceEndpointIp:     m.ServiceEndpointIp,
+		ServiceEndpointIpType: m.ServiceEndpointIpType,
+	}
+	dataValue, _ := ComparableToVpcServiceEndpoint(s).GetDataValue__()
+	return dataValue
+}
+
+func VpcEndpointToComparable(ep *model.VpcEndpoint) common.Comparable {
+	return (*VpcEndpoint)(ep)
+}
+
+func ComparableToVpcEndpoint(ep common.Comparable) *model.VpcEndpoint {
+	return (*model.VpcEndpoint)(ep.(*VpcEndpoint))
+}
+
+func VpcServiceEndpointToComparable(sep *model.VpcServiceEndpoint) common.Comparable {
+	return (*VpcServiceEndpoint)(sep)
+}
+
+func ComparableToVpcServiceEndpoint(sep common.Comparable) *model.VpcServiceEndpoint {
+	return (*model.VpcServiceEndpoint)(sep.(*VpcServiceEndpoint))
+}
@@ -0,0 +1,53 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestCompareVpcEndpoint(t *testing.T) {
+	existing := &model.VpcEndpoint{
+		Id:                 common.String("vpcep-1-id"),
+		DisplayName:        common.String("vpcep-1"),
+		Path:               common.String(vpcPath + "/vpc-endpoints/vpcep-1-id"),
+		IpAllocationPath:   common.String(ipAllocationPath),
+		VpcServiceEndpoint: serviceEndpoint1.Path,
+		Revision:           common.Int64(2),
+	}
+	desired := &model.VpcEndpoint{
+		Id:                 common.String("vpcep-1-id"),
+		DisplayName:        common.String("vpcep-1"),
+		IpAllocationPath:   common.String(ipAllocationPath),
+		VpcServiceEndpoint: serviceEndpoint1.Path,
+	}
+	// Fields populated by NSX are not compared.
+	require.False(t, common.CompareResource(VpcEndpointToComparable(existing), VpcEndpointToComparable(desired)))
+	desired.Tags = []model.Tag{{Scope: common.String(common.TagScopeCluster), Tag: common.String("cluster")}}
+	require.True(t, common.CompareResource(VpcEndpointToComparable(existing), VpcEndpointToComparable(desired)))
+	require.Equal(t, "vpcep-1-id", VpcEndpointToComparable(existing).Key())
+}
+
+func TestCompareVpcServiceEndpoint(t *testing.T) {
+	existing := &model.VpcServiceEndpoint{
+		Id:                common.String("sep-1-id"),
+		DisplayName:       common.String("sep-1"),
+		Path:              serviceEndpoint1.Path,
+		ServiceEndpointIp: common.String("10.0.0.10"),
+	}
+	desired := &model.VpcServiceEndpoint{
+		Id:                common.String("sep-1-id"),
+		DisplayName:       common.String("sep-1"),
+		ServiceEndpointIp: common.String("10.0.0.10"),
+	}
+	require.False(t, common.CompareResource(VpcServiceEndpointToComparable(existing), VpcServiceEndpointToComparable(desired)))
+	desired.ServiceEndpointIp = common.String("10.0.0.11")
+	require.True(t, common.CompareResource(VpcServiceEndpointToComparable(existing), VpcServiceEndpointToComparable(desired)))
+	require.Equal(t, "sep-1-id", VpcServiceEndpointToComparable(existing).Key())
+}
@@ -0,0 +1,192 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"errors"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+type VPCEndpointStore struct {
+	common.ResourceStore
+}
+
+type ServiceEndpointStore struct {
+	common.ResourceStore
+}
+
+func (s *VPCEndpointStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	ep := i.(*model.VpcEndpoint)
+	if ep.MarkedForDelete != nil && *ep.MarkedForDelete {
+		err := s.Delete(ep)
+		if err != nil {
+			log.Error(err, "Failed to delete VpcEndpoint", "VpcEndpoint", ep)
+			return err
+		}
+		log.Debug("Deleted VpcEndpoint from store", "VpcEndpoint", ep)
+	} else {
+		err := s.Add(ep)
+		if err != nil {
+			log.Error(err, "Failed to add VpcEndpoint", "VpcEndpoint", ep)
+			return err
+		}
+		log.Debug("Added VpcEndpoint to store", "VpcEndpoint", ep)
+	}
+	return nil
+}
+
+func (s *ServiceEndpointStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	sep := i.(*model.VpcServiceEndpoint)
+	if sep.MarkedForDelete != nil && *sep.MarkedForDelete {
+		err := s.Delete(sep)
+		if err != nil {
+			log.Error(err, "Failed to delete VpcServiceEndpoint", "VpcServiceEndpoint", sep)
+			return err
+		}
+		log.Debug("Deleted VpcServiceEndpoint from store", "VpcServiceEndpoint", sep)
+	} else {
+		err := s.Add(sep)
+		if err != nil {
+			log.Error(err, "Failed to add VpcServiceEndpoint", "VpcServiceEndpoint", sep)
+			return err
+		}
+		log.Debug("Added VpcServiceEndpoint to store", "VpcServiceEndpoint", sep)
+	}
+	return nil
+}
+
+func keyFunc(obj interface{}) (string, error) {
+	switch v := obj.(type) {
+	case *model.VpcEndpoint:
+		return *v.Id, nil
+	case *model.VpcServiceEndpoint:
+		return *v.Id, nil
+	case string:
+		return v, nil
+	default:
+		return "", errors.New("keyFunc doesn't support unknown type")
+	}
+}
+
+func getTags(obj interface{}) ([]model.Tag, error) {
+	switch o := obj.(type) {
+	case *model.VpcEndpoint:
+		return o.Tags, nil
+	case *model.VpcServiceEndpoint:
+		return o.Tags, nil
+	default:
+		return nil, errors.New("getTags doesn't support unknown type")
+	}
+}
+
+func crUIDIndexFunc(tagScope string) cache.IndexFunc {
+	return func(obj interface{}) ([]string, error) {
+		tags, err := getTags(obj)
+		if err != nil {
+			return nil, err
+		}
+		if uid := nsxutil.FindTag(tags, tagScope); uid != "" {
+			return []string{uid}, nil
+		}
+		return []string{}, nil
+	}
+}
+
+// crNameIndexFunc indexes the NSX resource by the namespaced name of its owner CR.
+func crNameIndexFunc(tagScope string) cache.IndexFunc {
+	return func(obj interface{}) ([]string, error) {
+		tags, err := getTags(obj)
+		if err != nil {
+			return nil, err
+		}
+		crName := nsxutil.FindTag(tags, tagScope)
+		crNamespace := nsxutil.FindTag(tags, common.TagScopeNamespace)
+		if crName == "" || crNamespace == "" {
+			return []string{}, nil
+		}
+		return []string{types.NamespacedName{Namespace: crNamespace, Name: crName}.String()}, nil
+	}
+}
+
+func (s *VPCEndpointStore) GetByKey(key string) *model.VpcEndpoint {
+	obj := s.ResourceStore.GetByKey(key)
+	if obj != nil {
+		return obj.(*model.VpcEndpoint)
+	}
+	return nil
+}
+
+func (s *ServiceEndpointStore) GetByKey(key string) *model.VpcServiceEndpoint {
+	obj := s.ResourceStore.GetByKey(key)
+	if obj != nil {
+		return obj.(*model.VpcServiceEndpoint)
+	}
+	return nil
+}
+
+func (s *VPCEndpointStore) GetByIndex(key string, value string) []*model.VpcEndpoint {
+	eps := make([]*model.VpcEndpoint, 0)
+	for _, ep := range s.ResourceStore.GetByIndex(key, value) {
+		eps = append(eps, ep.(*model.VpcEndpoint))
+	}
+	return eps
+}
+
+func (s *ServiceEndpointStore) GetByIndex(key string, value string) []*model.VpcServiceEndpoint {
+	seps := make([]*model.VpcServiceEndpoint, 0)
+	for _, sep := range s.ResourceStore.GetByIndex(key, value) {
+		seps = append(seps, sep.(*model.VpcServiceEndpoint))
+	}
+	return seps
+}
+
+func (s *VPCEndpointStore) DeleteMultipleObjects(eps []*model.VpcEndpoint) {
+	for _, ep := range eps {
+		s.Delete(ep)
+	}
+}
+
+func (s *ServiceEndpointStore) DeleteMultipleObjects(seps []*model.VpcServiceEndpoint) {
+	for _, sep := range seps {
+		s.Delete(sep)
+	}
+}
+
+func SetupVPCEndpointStore() *VPCEndpointStore {
+	return &VPCEndpointStore{
+		ResourceStore: common.ResourceStore{
+			Indexer: cache.NewIndexer(
+				keyFunc, cache.Indexers{
+					common.TagScopeVPCEndpointCRUID:  crUIDIndexFunc(common.TagScopeVPCEndpointCRUID),
+					common.TagScopeVPCEndpointCRName: crNameIndexFunc(common.TagScopeVPCEndpointCRName),
+				}),
+			BindingType: model.VpcEndpointBindingType(),
+		},
+	}
+}
+
+func SetupServiceEndpointStore() *ServiceEndpointStore {
+	return &ServiceEndpointStore{
+		ResourceStore: common.ResourceStore{
+			Indexer: cache.NewIndexer(
+				keyFunc, cache.Indexers{
+					common.TagScopeServiceEndpointCRUID:  crUIDIndexFunc(common.TagScopeServiceEndpointCRUID),
+					common.TagScopeServiceEndpointCRName: crNameIndexFunc(common.TagScopeServiceEndpointCRName),
+				}),
+			BindingType: model.VpcServiceEndpointBindingType(),
+		},
+	}
+}
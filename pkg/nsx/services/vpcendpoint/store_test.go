@@ -0,0 +1,105 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+var (
+	vpcEndpoint1 = &model.VpcEndpoint{
+		Id:   common.String("vpcep-1-id"),
+		Path: common.String(vpcPath + "/vpc-endpoints/vpcep-1-id"),
+		Tags: []model.Tag{
+			{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-consumer")},
+			{Scope: common.String(common.TagScopeVPCEndpointCRName), Tag: common.String("vpcep-1")},
+			{Scope: common.String(common.TagScopeVPCEndpointCRUID), Tag: common.String("vpcep-1-uid")},
+		},
+	}
+	vpcEndpoint2 = &model.VpcEndpoint{
+		Id:   common.String("vpcep-2-id"),
+		Path: common.String(vpcPath + "/vpc-endpoints/vpcep-2-id"),
+		Tags: []model.Tag{
+			{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-consumer")},
+			{Scope: common.String(common.TagScopeVPCEndpointCRName), Tag: common.String("vpcep-2")},
+			{Scope: common.String(common.TagScopeVPCEndpointCRUID), Tag: common.String("vpcep-2-uid")},
+		},
+	}
+	serviceEndpoint1 = &model.VpcServiceEndpoint{
+		Id:   common.String("sep-1-id"),
+		Path: common.String("/orgs/default/projects/project-1/vpcs/vpc-2/vpc-service-endpoints/sep-1-id"),
+		Tags: []model.Tag{
+			{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-provider")},
+			{Scope: common.String(common.TagScopeServiceEndpointCRName), Tag: common.String("sep-1")},
+			{Scope: common.String(common.TagScopeServiceEndpointCRUID), Tag: common.String("sep-1-uid")},
+		},
+	}
+	serviceEndpoint2 = &model.VpcServiceEndpoint{
+		Id:   common.String("sep-2-id"),
+		Path: common.String("/orgs/default/projects/project-1/vpcs/vpc-2/vpc-service-endpoints/sep-2-id"),
+		Tags: []model.Tag{
+			{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-provider")},
+			{Scope: common.String(common.TagScopeServiceEndpointCRName), Tag: common.String("sep-2")},
+			{Scope: common.String(common.TagScopeServiceEndpointCRUID), Tag: common.String("sep-2-uid")},
+		},
+	}
+)
+
+func TestVPCEndpointStore(t *testing.T) {
+	store := SetupVPCEndpointStore()
+	require.NoError(t, store.Apply(vpcEndpoint1))
+	require.NoError(t, store.Apply(vpcEndpoint2))
+	require.NoError(t, store.Apply(nil))
+
+	require.Equal(t, vpcEndpoint1, store.GetByKey("vpcep-1-id"))
+	require.Nil(t, store.GetByKey("non-existing"))
+	require.Equal(t, []*model.VpcEndpoint{vpcEndpoint2}, store.GetByIndex(common.TagScopeVPCEndpointCRUID, "vpcep-2-uid"))
+	require.Equal(t, []*model.VpcEndpoint{vpcEndpoint1}, store.GetByIndex(common.TagScopeVPCEndpointCRName, "ns-consumer/vpcep-1"))
+	require.Empty(t, store.GetByIndex(common.TagScopeVPCEndpointCRName, "ns-provider/vpcep-1"))
+
+	deleted := *vpcEndpoint1
+	deleted.MarkedForDelete = common.Bool(true)
+	require.NoError(t, store.Apply(&deleted))
+	require.Nil(t, store.GetByKey("vpcep-1-id"))
+
+	store.DeleteMultipleObjects([]*model.VpcEndpoint{vpcEndpoint2})
+	require.Empty(t, store.List())
+}
+
+func TestServiceEndpointStore(t *testing.T) {
+	store := SetupServiceEndpointStore()
+	require.NoError(t, store.Apply(serviceEndpoint1))
+	require.NoError(t, store.Apply(serviceEndpoint2))
+
+	require.Equal(t, serviceEndpoint2, store.GetByKey("sep-2-id"))
+	require.Equal(t, []*model.VpcServiceEndpoint{serviceEndpoint1}, store.GetByIndex(common.TagScopeServiceEndpointCRUID, "sep-1-uid"))
+	require.Equal(t, []*model.VpcServiceEndpoint{serviceEndpoint2}, store.GetByIndex(common.TagScopeServiceEndpointCRName, "ns-provider/sep-2"))
+
+	deleted := *serviceEndpoint2
+	deleted.MarkedForDelete = common.Bool(true)
+	require.NoError(t, store.Apply(&deleted))
+	require.Nil(t, store.GetByKey("sep-2-id"))
+
+	store.DeleteMultipleObjects([]*model.VpcServiceEndpoint{serviceEndpoint1})
+	require.Empty(t, store.List())
+}
+
+func TestKeyFunc(t *testing.T) {
+	key, err := keyFunc(vpcEndpoint1)
+	require.NoError(t, err)
+	require.Equal(t, "vpcep-1-id", key)
+	key, err = keyFunc(serviceEndpoint1)
+	require.NoError(t, err)
+	require.Equal(t, "sep-1-id", key)
+	key, err = keyFunc("id")
+	require.NoError(t, err)
+	require.Equal(t, "id", key)
+	_, err = keyFunc(1)
+	require.Error(t, err)
+}
@@ -0,0 +1,289 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+var (
+	log             = logger.Log
+	MarkedForDelete = true
+)
+
+type VPCEndpointService struct {
+	common.Service
+	VPCEndpointStore       *VPCEndpointStore
+	ServiceEndpointStore   *ServiceEndpointStore
+	VPCService             common.VPCServiceProvider
+	IPAllocationService    common.IPAddressAllocationServiceProvider
+	vpcEndpointBuilder     *common.PolicyTreeBuilder[*model.VpcEndpoint]
+	serviceEndpointBuilder *common.PolicyTreeBuilder[*model.VpcServiceEndpoint]
+}
+
+// InitializeVPCEndpointService initializes the service for both NSX VpcEndpoint and VpcServiceEndpoint.
+func InitializeVPCEndpointService(service common.Service, vpcService common.VPCServiceProvider, ipAllocationService common.IPAddressAllocationServiceProvider) (*VPCEndpointService, error) {
+	vpcEndpointBuilder, _ := common.PolicyPathVpcEndpoint.NewPolicyTreeBuilder()
+	serviceEndpointBuilder, _ := common.PolicyPathVpcServiceEndpoint.NewPolicyTreeBuilder()
+	wg := sync.WaitGroup{}
+	wgDone := make(chan bool)
+	fatalErrors := make(chan error, 2)
+
+	vpcEndpointService := &VPCEndpointService{
+		Service:                service,
+		VPCEndpointStore:       SetupVPCEndpointStore(),
+		ServiceEndpointStore:   SetupServiceEndpointStore(),
+		VPCService:             vpcService,
+		IPAllocationService:    ipAllocationService,
+		vpcEndpointBuilder:     vpcEndpointBuilder,
+		serviceEndpointBuilder: serviceEndpointBuilder,
+	}
+
+	wg.Add(2)
+	go vpcEndpointService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeVpcEndpoint, nil, vpcEndpointService.VPCEndpointStore)
+	go vpcEndpointService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeVpcServiceEndpoint, nil, vpcEndpointService.ServiceEndpointStore)
+	go func() {
+		wg.Wait()
+		close(wgDone)
+	}()
+
+	select {
+	case <-wgDone:
+		break
+	case err := <-fatalErrors:
+		return vpcEndpointService, err
+	}
+
+	return vpcEndpointService, nil
+}
+
+// CreateOrUpdateServiceEndpoint realizes the ServiceEndpoint CR as an NSX VpcServiceEndpoint in the VPC of
+// the CR's Namespace. The service endpoint IP is immutable on NSX, so a change on it is reported as an error.
+func (s *VPCEndpointService) CreateOrUpdateServiceEndpoint(ctx context.Context, obj *v1alpha1.ServiceEndpoint) (*model.VpcServiceEndpoint, error) {
+	nsxServiceEndpoint := s.buildServiceEndpoint(obj)
+	existingServiceEndpoints := s.ServiceEndpointStore.GetByIndex(common.TagScopeServiceEndpointCRUID, string(obj.UID))
+	if len(existingServiceEndpoints) > 0 {
+		existing := existingServiceEndpoints[0]
+		nsxServiceEndpoint.Id = existing.Id
+		if existing.ServiceEndpointIp != nil && *existing.ServiceEndpointIp != *nsxServiceEndpoint.ServiceEndpointIp {
+			return nil, fmt.Errorf("serviceEndpointIP is immutable, existing value %s, desired value %s", *existing.ServiceEndpointIp, *nsxServiceEndpoint.ServiceEndpointIp)
+		}
+		if !common.CompareResource(VpcServiceEndpointToComparable(existing), VpcServiceEndpointToComparable(nsxServiceEndpoint)) {
+			log.Info("NSX VpcServiceEndpoint not changed, skipping the update", "VpcServiceEndpoint", *existing.Path)
+			return existing, nil
+		}
+	}
+
+	vpcInfo := s.VPCService.ListVPCInfo(obj.Namespace)
+	if len(vpcInfo) == 0 {
+		return nil, fmt.Errorf("no VPC found for Namespace %s", obj.Namespace)
+	}
+	orgID, projectID, vpcID := vpcInfo[0].OrgID, vpcInfo[0].ProjectID, vpcInfo[0].ID
+	log.Info("Updating the NSX VpcServiceEndpoint", "ServiceEndpoint", types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name}, "desired", nsxServiceEndpoint)
+	err := s.NSXClient.WithContext(ctx).VpcServiceEndpointsClient.Patch(orgID, projectID, vpcID, *nsxServiceEndpoint.Id, *nsxServiceEndpoint)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to create or update NSX VpcServiceEndpoint", "VpcServiceEndpoint", *nsxServiceEndpoint.Id)
+		return nil, err
+	}
+	nsxServiceEndpointCreated, err := s.NSXClient.WithContext(ctx).VpcServiceEndpointsClient.Get(orgID, projectID, vpcID, *nsxServiceEndpoint.Id)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to get NSX VpcServiceEndpoint", "VpcServiceEndpoint", *nsxServiceEndpoint.Id)
+		return nil, err
+	}
+	realizeService := realizestate.InitializeRealizeState(s.Service)
+	if err = realizeService.CheckRealizeState(ctx, util.NSXTRealizeRetry, *nsxServiceEndpointCreated.Path, []string{}); err != nil {
+		log.Error(err, "Failed to check NSX VpcServiceEndpoint realization state", "VpcServiceEndpoint", *nsxServiceEndpointCreated.Path)
+		return nil, err
+	}
+	if err = s.ServiceEndpointStore.Apply(&nsxServiceEndpointCreated); err != nil {
+		return nil, err
+	}
+	log.Info("Created or updated NSX VpcServiceEndpoint", "VpcServiceEndpoint", *nsxServiceEndpointCreated.Path)
+	return &nsxServiceEndpointCreated, nil
+}
+
+// CreateOrUpdateVPCEndpoint realizes the VPCEndpoint CR as an NSX VpcEndpoint in the VPC of the CR's Namespace.
+// The IPAddressAllocation and the ServiceEndpoint referred by the VPCEndpoint must have been realized on NSX.
+// Both references are immutable on NSX, so a change on them is reported as an error.
+func (s *VPCEndpointService) CreateOrUpdateVPCEndpoint(ctx context.Context, obj *v1alpha1.VPCEndpoint, ipAllocation *v1alpha1.IPAddressAllocation, serviceEndpoint *v1alpha1.ServiceEndpoint) (*model.VpcEndpoint, error) {
+	nsxIPAllocation, err := s.IPAllocationService.GetIPAddressAllocationByOwner(ipAllocation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up NSX allocation for IPAddressAllocation %s/%s: %w", ipAllocation.Namespace, ipAllocation.Name, err)
+	}
+	if nsxIPAllocation == nil || nsxIPAllocation.Path == nil {
+		return nil, fmt.Errorf("NSX allocation for IPAddressAllocation %s/%s not found", ipAllocation.Namespace, ipAllocation.Name)
+	}
+	nsxServiceEndpoint := s.GetServiceEndpointByCR(serviceEndpoint)
+	if nsxServiceEndpoint == nil || nsxServiceEndpoint.Path == nil {
+		return nil, fmt.Errorf("NSX VpcServiceEndpoint for ServiceEndpoint %s/%s not found", serviceEndpoint.Namespace, serviceEndpoint.Name)
+	}
+
+	nsxVPCEndpoint := s.buildVPCEndpoint(obj, *nsxIPAllocation.Path, *nsxServiceEndpoint.Path)
+	existingVPCEndpoints := s.VPCEndpointStore.GetByIndex(common.TagScopeVPCEndpointCRUID, string(obj.UID))
+	if len(existingVPCEndpoints) > 0 {
+		existing := existingVPCEndpoints[0]
+		nsxVPCEndpoint.Id = existing.Id
+		if existing.IpAllocationPath != nil && *existing.IpAllocationPath != *nsxVPCEndpoint.IpAllocationPath {
+			return nil, fmt.Errorf("ipAllocationName is immutable, existing NSX allocation %s, desired NSX allocation %s", *existing.IpAllocationPath, *nsxVPCEndpoint.IpAllocationPath)
+		}
+		if existing.VpcServiceEndpoint != nil && *existing.VpcServiceEndpoint != *nsxVPCEndpoint.VpcServiceEndpoint {
+			return nil, fmt.Errorf("serviceEndpointName is immutable, existing NSX service endpoint %s, desired NSX service endpoint %s", *existing.VpcServiceEndpoint, *nsxVPCEndpoint.VpcServiceEndpoint)
+		}
+		if !common.CompareResource(VpcEndpointToComparable(existing), VpcEndpointToComparable(nsxVPCEndpoint)) {
+			log.Info("NSX VpcEndpoint not changed, skipping the update", "VpcEndpoint", *existing.Path)
+			return existing, nil
+		}
+	}
+
+	vpcInfo := s.VPCService.ListVPCInfo(obj.Namespace)
+	if len(vpcInfo) == 0 {
+		return nil, fmt.Errorf("no VPC found for Namespace %s", obj.Namespace)
+	}
+	orgID, projectID, vpcID := vpcInfo[0].OrgID, vpcInfo[0].ProjectID, vpcInfo[0].ID
+	log.Info("Updating the NSX VpcEndpoint", "VPCEndpoint", types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name}, "desired", nsxVPCEndpoint)
+	err = s.NSXClient.WithContext(ctx).VpcEndpointsClient.Patch(orgID, projectID, vpcID, *nsxVPCEndpoint.Id, *nsxVPCEndpoint)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to create or update NSX VpcEndpoint", "VpcEndpoint", *nsxVPCEndpoint.Id)
+		return nil, err
+	}
+	nsxVPCEndpointCreated, err := s.NSXClient.WithContext(ctx).VpcEndpointsClient.Get(orgID, projectID, vpcID, *nsxVPCEndpoint.Id)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to get NSX VpcEndpoint", "VpcEndpoint", *nsxVPCEndpoint.Id)
+		return nil, err
+	}
+	realizeService := realizestate.InitializeRealizeState(s.Service)
+	if err = realizeService.CheckRealizeState(ctx, util.NSXTRealizeRetry, *nsxVPCEndpointCreated.Path, []string{}); err != nil {
+		log.Error(err, "Failed to check NSX VpcEndpoint realization state", "VpcEndpoint", *nsxVPCEndpointCreated.Path)
+		return nil, err
+	}
+	if err = s.VPCEndpointStore.Apply(&nsxVPCEndpointCreated); err != nil {
+		return nil, err
+	}
+	log.Info("Created or updated NSX VpcEndpoint", "VpcEndpoint", *nsxVPCEndpointCreated.Path)
+	return &nsxVPCEndpointCreated, nil
+}
+
+// GetServiceEndpointByCR returns the NSX VpcServiceEndpoint realized for the given ServiceEndpoint CR.
+func (s *VPCEndpointService) GetServiceEndpointByCR(obj *v1alpha1.ServiceEndpoint) *model.VpcServiceEndpoint {
+	serviceEndpoints := s.ServiceEndpointStore.GetByIndex(common.TagScopeServiceEndpointCRUID, string(obj.UID))
+	if len(serviceEndpoints) == 0 {
+		return nil
+	}
+	return serviceEndpoints[0]
+}
+
+func (s *VPCEndpointService) DeleteVPCEndpointByCRName(ctx context.Context, ns, name string) error {
+	namespacedName := types.NamespacedName{Namespace: ns, Name: name}
+	for _, nsxVPCEndpoint := range s.VPCEndpointStore.GetByIndex(common.TagScopeVPCEndpointCRName, namespacedName.String()) {
+		if err := s.DeleteVPCEndpoint(ctx, nsxVPCEndpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *VPCEndpointService) DeleteVPCEndpointByCRId(ctx context.Context, id string) error {
+	for _, nsxVPCEndpoint := range s.VPCEndpointStore.GetByIndex(common.TagScopeVPCEndpointCRUID, id) {
+		if err := s.DeleteVPCEndpoint(ctx, nsxVPCEndpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *VPCEndpointService) DeleteVPCEndpoint(ctx context.Context, nsxVPCEndpoint *model.VpcEndpoint) error {
+	vpcInfo, err := common.ParseVPCResourcePath(*nsxVPCEndpoint.Path)
+	if err != nil {
+		log.Error(err, "Failed to parse NSX VPC path for VpcEndpoint", "path", *nsxVPCEndpoint.Path)
+		return err
+	}
+	err = s.NSXClient.WithContext(ctx).VpcEndpointsClient.Delete(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxVPCEndpoint.Id)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to delete NSX VpcEndpoint", "VpcEndpoint", *nsxVPCEndpoint.Path)
+		return err
+	}
+	if err = s.VPCEndpointStore.Delete(nsxVPCEndpoint); err != nil {
+		return err
+	}
+	log.Info("Successfully deleted NSX VpcEndpoint", "VpcEndpoint", *nsxVPCEndpoint.Path)
+	return nil
+}
+
+func (s *VPCEndpointService) DeleteServiceEndpointByCRName(ctx context.Context, ns, name string) error {
+	namespacedName := types.NamespacedName{Namespace: ns, Name: name}
+	for _, nsxServiceEndpoint := range s.ServiceEndpointStore.GetByIndex(common.TagScopeServiceEndpointCRName, namespacedName.String()) {
+		if err := s.DeleteServiceEndpoint(ctx, nsxServiceEndpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *VPCEndpointService) DeleteServiceEndpointByCRId(ctx context.Context, id string) error {
+	for _, nsxServiceEndpoint := range s.ServiceEndpointStore.GetByIndex(common.TagScopeServiceEndpointCRUID, id) {
+		if err := s.DeleteServiceEndpoint(ctx, nsxServiceEndpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *VPCEndpointService) DeleteServiceEndpoint(ctx context.Context, nsxServiceEndpoint *model.VpcServiceEndpoint) error {
+	vpcInfo, err := common.ParseVPCResourcePath(*nsxServiceEndpoint.Path)
+	if err != nil {
+		log.Error(err, "Failed to parse NSX VPC path for VpcServiceEndpoint", "path", *nsxServiceEndpoint.Path)
+		return err
+	}
+	err = s.NSXClient.WithContext(ctx).VpcServiceEndpointsClient.Delete(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxServiceEndpoint.Id)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to delete NSX VpcServiceEndpoint", "VpcServiceEndpoint", *nsxServiceEndpoint.Path)
+		return err
+	}
+	if err = s.ServiceEndpointStore.Delete(nsxServiceEndpoint); err != nil {
+		return err
+	}
+	log.Info("Successfully deleted NSX VpcServiceEndpoint", "VpcServiceEndpoint", *nsxServiceEndpoint.Path)
+	return nil
+}
+
+func (s *VPCEndpointService) ListVPCEndpointCRUIDsInStore() sets.Set[string] {
+	crUIDs := sets.New[string]()
+	for _, obj := range s.VPCEndpointStore.List() {
+		ep := obj.(*model.VpcEndpoint)
+		if uid := nsxutil.FindTag(ep.Tags, common.TagScopeVPCEndpointCRUID); uid != "" {
+			crUIDs.Insert(uid)
+		}
+	}
+	return crUIDs
+}
+
+func (s *VPCEndpointService) ListServiceEndpointCRUIDsInStore() sets.Set[string] {
+	crUIDs := sets.New[string]()
+	for _, obj := range s.ServiceEndpointStore.List() {
+		sep := obj.(*model.VpcServiceEndpoint)
+		if uid := nsxutil.FindTag(sep.Tags, common.TagScopeServiceEndpointCRUID); uid != "" {
+			crUIDs.Insert(uid)
+		}
+	}
+	return crUIDs
+}
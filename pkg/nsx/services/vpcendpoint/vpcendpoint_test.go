@@ -0,0 +1,327 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package vpcendpoint
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	pkgmock "github.com/vmware-tanzu/nsx-operator/pkg/mock"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
+)
+
+const (
+	vpcPath          = "/orgs/default/projects/project-1/vpcs/vpc-1"
+	ipAllocationPath = vpcPath + "/ip-address-allocations/ipa-1"
+)
+
+type fakeQueryClient struct{}
+
+func (c *fakeQueryClient) List(queryParam string, cursorParam *string, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model.SearchResponse, error) {
+	return model.SearchResponse{}, nil
+}
+
+// fakeVpcEndpointsClient keeps the patched VpcEndpoints in memory.
+type fakeVpcEndpointsClient struct {
+	endpoints map[string]model.VpcEndpoint
+	patchErr  error
+	deleted   []string
+}
+
+func (c *fakeVpcEndpointsClient) Delete(orgIdParam string, projectIdParam string, vpcIdParam string, vpcEndpointIdParam string) error {
+	c.deleted = append(c.deleted, vpcEndpointIdParam)
+	delete(c.endpoints, vpcEndpointIdParam)
+	return nil
+}
+
+func (c *fakeVpcEndpointsClient) Get(orgIdParam string, projectIdParam string, vpcIdParam string, vpcEndpointIdParam string) (model.VpcEndpoint, error) {
+	ep, ok := c.endpoints[vpcEndpointIdParam]
+	if !ok {
+		return model.VpcEndpoint{}, fmt.Errorf("VpcEndpoint %s not found", vpcEndpointIdParam)
+	}
+	return ep, nil
+}
+
+func (c *fakeVpcEndpointsClient) List(orgIdParam string, projectIdParam string, vpcIdParam string, cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model.VpcEndpointListResult, error) {
+	return model.VpcEndpointListResult{}, nil
+}
+
+func (c *fakeVpcEndpointsClient) Patch(orgIdParam string, projectIdParam string, vpcIdParam string, vpcEndpointIdParam string, vpcEndpointParam model.VpcEndpoint) error {
+	if c.patchErr != nil {
+		return c.patchErr
+	}
+	vpcEndpointParam.Path = common.String(fmt.Sprintf("/orgs/%s/projects/%s/vpcs/%s/vpc-endpoints/%s", orgIdParam, projectIdParam, vpcIdParam, vpcEndpointIdParam))
+	c.endpoints[vpcEndpointIdParam] = vpcEndpointParam
+	return nil
+}
+
+func (c *fakeVpcEndpointsClient) Update(orgIdParam string, projectIdParam string, vpcIdParam string, vpcEndpointIdParam string, vpcEndpointParam model.VpcEndpoint) (model.VpcEndpoint, error) {
+	return model.VpcEndpoint{}, nil
+}
+
+// fakeVpcServiceEndpointsClient keeps the patched VpcServiceEndpoints in memory.
+type fakeVpcServiceEndpointsClient struct {
+	serviceEndpoints map[string]model.VpcServiceEndpoint
+	patchErr         error
+	deleted          []string
+}
+
+func (c *fakeVpcServiceEndpointsClient) Delete(orgIdParam string, projectIdParam string, vpcIdParam string, vpcServiceEndpointIdParam string) error {
+	c.deleted = append(c.deleted, vpcServiceEndpointIdParam)
+	delete(c.serviceEndpoints, vpcServiceEndpointIdParam)
+	return nil
+}
+
+func (c *fakeVpcServiceEndpointsClient) Get(orgIdParam string, projectIdParam string, vpcIdParam string, vpcServiceEndpointIdParam string) (model.VpcServiceEndpoint, error) {
+	sep, ok := c.serviceEndpoints[vpcServiceEndpointIdParam]
+	if !ok {
+		return model.VpcServiceEndpoint{}, fmt.Errorf("VpcServiceEndpoint %s not found", vpcServiceEndpointIdParam)
+	}
+	return sep, nil
+}
+
+func (c *fakeVpcServiceEndpointsClient) List(orgIdParam string, projectIdParam string, vpcIdParam string, cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model.VpcServiceEndpointListResult, error) {
+	return model.VpcServiceEndpointListResult{}, nil
+}
+
+func (c *fakeVpcServiceEndpointsClient) Patch(orgIdParam string, projectIdParam string, vpcIdParam string, vpcServiceEndpointIdParam string, vpcServiceEndpointParam model.VpcServiceEndpoint) error {
+	if c.patchErr != nil {
+		return c.patchErr
+	}
+	vpcServiceEndpointParam.Path = common.String(fmt.Sprintf("/orgs/%s/projects/%s/vpcs/%s/vpc-service-endpoints/%s", orgIdParam, projectIdParam, vpcIdParam, vpcServiceEndpointIdParam))
+	c.serviceEndpoints[vpcServiceEndpointIdParam] = vpcServiceEndpointParam
+	return nil
+}
+
+func (c *fakeVpcServiceEndpointsClient) Update(orgIdParam string, projectIdParam string, vpcIdParam string, vpcServiceEndpointIdParam string, vpcServiceEndpointParam model.VpcServiceEndpoint) (model.VpcServiceEndpoint, error) {
+	return model.VpcServiceEndpoint{}, nil
+}
+
+func createFakeService() *VPCEndpointService {
+	vpcService := new(pkgmock.MockVPCServiceProvider)
+	vpcService.On("ListVPCInfo", "ns-consumer").Return([]common.VPCResourceInfo{{OrgID: "default", ProjectID: "project-1", VPCID: "vpc-1", ID: "vpc-1"}})
+	vpcService.On("ListVPCInfo", "ns-provider").Return([]common.VPCResourceInfo{{OrgID: "default", ProjectID: "project-1", VPCID: "vpc-2", ID: "vpc-2"}})
+	vpcService.On("ListVPCInfo", "ns-no-vpc").Return([]common.VPCResourceInfo{})
+	return &VPCEndpointService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{
+				QueryClient:               &fakeQueryClient{},
+				VpcEndpointsClient:        &fakeVpcEndpointsClient{endpoints: map[string]model.VpcEndpoint{}},
+				VpcServiceEndpointsClient: &fakeVpcServiceEndpointsClient{serviceEndpoints: map[string]model.VpcServiceEndpoint{}},
+				NsxConfig: &config.NSXOperatorConfig{
+					CoeConfig: &config.CoeConfig{
+						Cluster: "k8scl-one:test",
+					},
+				},
+			},
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster: "k8scl-one:test",
+				},
+			},
+		},
+		VPCEndpointStore:     SetupVPCEndpointStore(),
+		ServiceEndpointStore: SetupServiceEndpointStore(),
+		VPCService:           vpcService,
+		IPAllocationService:  &pkgmock.MockIPAddressAllocationProvider{},
+	}
+}
+
+func patchRealizeState(err error) *gomonkey.Patches {
+	return gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeState,
+		func(_ *realizestate.RealizeStateService, _ context.Context, _ wait.Backoff, _ string, _ []string) error {
+			return err
+		})
+}
+
+func patchIPAllocation(service *VPCEndpointService, allocation *model.VpcIpAddressAllocation) *gomonkey.Patches {
+	return gomonkey.ApplyMethod(reflect.TypeOf(service.IPAllocationService), "GetIPAddressAllocationByOwner",
+		func(_ *pkgmock.MockIPAddressAllocationProvider, _ metav1.Object) (*model.VpcIpAddressAllocation, error) {
+			return allocation, nil
+		})
+}
+
+var (
+	serviceEndpointCR = &v1alpha1.ServiceEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-provider", Name: "sep-1", UID: "sep-1-uid"},
+		Spec:       v1alpha1.ServiceEndpointSpec{ServiceEndpointIP: "10.0.0.10"},
+	}
+	vpcEndpointCR = &v1alpha1.VPCEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-consumer", Name: "vpcep-1", UID: "vpcep-1-uid"},
+		Spec:       v1alpha1.VPCEndpointSpec{ServiceEndpointName: "ns-provider/sep-1", IPAllocationName: "ipa-1"},
+	}
+	ipAllocationCR = &v1alpha1.IPAddressAllocation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-consumer", Name: "ipa-1", UID: "ipa-1-uid"},
+	}
+)
+
+func TestInitializeVPCEndpointService(t *testing.T) {
+	commonService := createFakeService().Service
+	vpcEndpointService, err := InitializeVPCEndpointService(commonService, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, commonService, vpcEndpointService.Service)
+	require.NotNil(t, vpcEndpointService.vpcEndpointBuilder)
+	require.NotNil(t, vpcEndpointService.serviceEndpointBuilder)
+
+	patches := gomonkey.ApplyMethodFunc(commonService.NSXClient.QueryClient, "List",
+		func(query string, cursor *string, fields *string, size *int64, asc *bool, sort *string) (model.SearchResponse, error) {
+			return model.SearchResponse{}, fmt.Errorf("mocked error")
+		},
+	)
+	defer patches.Reset()
+	_, err = InitializeVPCEndpointService(commonService, nil, nil)
+	require.ErrorContains(t, err, "mocked error")
+}
+
+func TestCreateOrUpdateServiceEndpoint(t *testing.T) {
+	t.Run("NoVPC", func(t *testing.T) {
+		service := createFakeService()
+		cr := serviceEndpointCR.DeepCopy()
+		cr.Namespace = "ns-no-vpc"
+		_, err := service.CreateOrUpdateServiceEndpoint(context.TODO(), cr)
+		require.ErrorContains(t, err, "no VPC found for Namespace ns-no-vpc")
+	})
+
+	t.Run("PatchFailure", func(t *testing.T) {
+		service := createFakeService()
+		service.NSXClient.VpcServiceEndpointsClient.(*fakeVpcServiceEndpointsClient).patchErr = fmt.Errorf("mocked patch error")
+		_, err := service.CreateOrUpdateServiceEndpoint(context.TODO(), serviceEndpointCR)
+		require.ErrorContains(t, err, "mocked patch error")
+		require.Empty(t, service.ServiceEndpointStore.List())
+	})
+
+	t.Run("RealizationFailure", func(t *testing.T) {
+		service := createFakeService()
+		patches := patchRealizeState(fmt.Errorf("mocked realized error"))
+		defer patches.Reset()
+		_, err := service.CreateOrUpdateServiceEndpoint(context.TODO(), serviceEndpointCR)
+		require.ErrorContains(t, err, "mocked realized error")
+		require.Empty(t, service.ServiceEndpointStore.List())
+	})
+
+	t.Run("CreateAndSkipUnchanged", func(t *testing.T) {
+		service := createFakeService()
+		patches := patchRealizeState(nil)
+		defer patches.Reset()
+		sep, err := service.CreateOrUpdateServiceEndpoint(context.TODO(), serviceEndpointCR)
+		require.NoError(t, err)
+		require.Equal(t, "10.0.0.10", *sep.ServiceEndpointIp)
+		require.Equal(t, model.VpcServiceEndpoint_SERVICE_ENDPOINT_IP_TYPE_WORKLOAD, *sep.ServiceEndpointIpType)
+		require.Equal(t, "/orgs/default/projects/project-1/vpcs/vpc-2/vpc-service-endpoints/"+*sep.Id, *sep.Path)
+		require.Equal(t, sep, service.GetServiceEndpointByCR(serviceEndpointCR))
+
+		// The second call finds the unchanged NSX resource in the store and does not patch it again.
+		service.NSXClient.VpcServiceEndpointsClient.(*fakeVpcServiceEndpointsClient).patchErr = fmt.Errorf("unexpected patch")
+		existing, err := service.CreateOrUpdateServiceEndpoint(context.TODO(), serviceEndpointCR)
+		require.NoError(t, err)
+		require.Equal(t, *sep.Id, *existing.Id)
+	})
+
+	t.Run("ImmutableIP", func(t *testing.T) {
+		service := createFakeService()
+		patches := patchRealizeState(nil)
+		defer patches.Reset()
+		_, err := service.CreateOrUpdateServiceEndpoint(context.TODO(), serviceEndpointCR)
+		require.NoError(t, err)
+		cr := serviceEndpointCR.DeepCopy()
+		cr.Spec.ServiceEndpointIP = "10.0.0.11"
+		_, err = service.CreateOrUpdateServiceEndpoint(context.TODO(), cr)
+		require.ErrorContains(t, err, "serviceEndpointIP is immutable")
+	})
+}
+
+func TestCreateOrUpdateVPCEndpoint(t *testing.T) {
+	nsxAllocation := &model.VpcIpAddressAllocation{Id: common.String("ipa-1"), Path: common.String(ipAllocationPath)}
+
+	t.Run("IPAllocationNotRealized", func(t *testing.T) {
+		service := createFakeService()
+		_, err := service.CreateOrUpdateVPCEndpoint(context.TODO(), vpcEndpointCR, ipAllocationCR, serviceEndpointCR)
+		require.ErrorContains(t, err, "NSX allocation for IPAddressAllocation ns-consumer/ipa-1 not found")
+	})
+
+	t.Run("ServiceEndpointNotRealized", func(t *testing.T) {
+		service := createFakeService()
+		patches := patchIPAllocation(service, nsxAllocation)
+		defer patches.Reset()
+		_, err := service.CreateOrUpdateVPCEndpoint(context.TODO(), vpcEndpointCR, ipAllocationCR, serviceEndpointCR)
+		require.ErrorContains(t, err, "NSX VpcServiceEndpoint for ServiceEndpoint ns-provider/sep-1 not found")
+	})
+
+	t.Run("CreateAndImmutable", func(t *testing.T) {
+		service := createFakeService()
+		patches := patchIPAllocation(service, nsxAllocation)
+		defer patches.Reset()
+		patches.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeState,
+			func(_ *realizestate.RealizeStateService, _ context.Context, _ wait.Backoff, _ string, _ []string) error {
+				return nil
+			})
+		sep, err := service.CreateOrUpdateServiceEndpoint(context.TODO(), serviceEndpointCR)
+		require.NoError(t, err)
+
+		ep, err := service.CreateOrUpdateVPCEndpoint(context.TODO(), vpcEndpointCR, ipAllocationCR, serviceEndpointCR)
+		require.NoError(t, err)
+		require.Equal(t, ipAllocationPath, *ep.IpAllocationPath)
+		require.Equal(t, *sep.Path, *ep.VpcServiceEndpoint)
+		require.Equal(t, vpcPath+"/vpc-endpoints/"+*ep.Id, *ep.Path)
+		require.True(t, service.ListVPCEndpointCRUIDsInStore().Has("vpcep-1-uid"))
+
+		// The allocation of an existing VpcEndpoint cannot be changed.
+		nsxAllocation2 := &model.VpcIpAddressAllocation{Id: common.String("ipa-2"), Path: common.String(vpcPath + "/ip-address-allocations/ipa-2")}
+		patches.ApplyMethod(reflect.TypeOf(service.IPAllocationService), "GetIPAddressAllocationByOwner",
+			func(_ *pkgmock.MockIPAddressAllocationProvider, _ metav1.Object) (*model.VpcIpAddressAllocation, error) {
+				return nsxAllocation2, nil
+			})
+		_, err = service.CreateOrUpdateVPCEndpoint(context.TODO(), vpcEndpointCR, ipAllocationCR, serviceEndpointCR)
+		require.ErrorContains(t, err, "ipAllocationName is immutable")
+	})
+}
+
+func TestDeleteEndpoints(t *testing.T) {
+	service := createFakeService()
+	vpcEndpointsClient := service.NSXClient.VpcEndpointsClient.(*fakeVpcEndpointsClient)
+	serviceEndpointsClient := service.NSXClient.VpcServiceEndpointsClient.(*fakeVpcServiceEndpointsClient)
+	service.VPCEndpointStore.Apply(vpcEndpoint1)
+	service.VPCEndpointStore.Apply(vpcEndpoint2)
+	service.ServiceEndpointStore.Apply(serviceEndpoint1)
+	service.ServiceEndpointStore.Apply(serviceEndpoint2)
+
+	require.NoError(t, service.DeleteVPCEndpointByCRName(context.TODO(), "ns-consumer", "vpcep-1"))
+	require.NoError(t, service.DeleteVPCEndpointByCRId(context.TODO(), "vpcep-2-uid"))
+	require.NoError(t, service.DeleteServiceEndpointByCRName(context.TODO(), "ns-provider", "sep-1"))
+	require.NoError(t, service.DeleteServiceEndpointByCRId(context.TODO(), "sep-2-uid"))
+	require.ElementsMatch(t, []string{"vpcep-1-id", "vpcep-2-id"}, vpcEndpointsClient.deleted)
+	require.ElementsMatch(t, []string{"sep-1-id", "sep-2-id"}, serviceEndpointsClient.deleted)
+	require.Empty(t, service.VPCEndpointStore.List())
+	require.Empty(t, service.ServiceEndpointStore.List())
+
+	// Deleting a CR without NSX resource is a no-op.
+	require.NoError(t, service.DeleteVPCEndpointByCRName(context.TODO(), "ns-consumer", "non-existing"))
+	require.NoError(t, service.DeleteServiceEndpointByCRId(context.TODO(), "non-existing"))
+}
+
+func TestListCRUIDsInStore(t *testing.T) {
+	service := createFakeService()
+	service.VPCEndpointStore.Apply(vpcEndpoint1)
+	service.VPCEndpointStore.Apply(vpcEndpoint2)
+	service.ServiceEndpointStore.Apply(serviceEndpoint1)
+
+	vpcEndpointUIDs := service.ListVPCEndpointCRUIDsInStore()
+	require.Equal(t, 2, vpcEndpointUIDs.Len())
+	require.True(t, vpcEndpointUIDs.HasAll("vpcep-1-uid", "vpcep-2-uid"))
+	serviceEndpointUIDs := service.ListServiceEndpointCRUIDsInStore()
+	require.Equal(t, 1, serviceEndpointUIDs.Len())
+	require.True(t, serviceEndpointUIDs.Has("sep-1-uid"))
+}
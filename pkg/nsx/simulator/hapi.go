@@ -0,0 +1,90 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package simulator
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	resourceTypeChildResourceReference = "ChildResourceReference"
+	fieldTargetType                    = "target_type"
+)
+
+// applyHierarchy applies the children of an OrgRoot or Infra H-API request under
+// parentPath. A child is either a ChildResourceReference, which only walks into the
+// referenced resource, or a Child<Type> wrapping the resource to create, update or
+// delete with marked_for_delete.
+func (s *Server) applyHierarchy(parentPath string, children interface{}) error {
+	items, ok := children.([]interface{})
+	if !ok {
+		return nil
+	}
+	for _, item := range items {
+		child, ok := item.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid child under %s", parentPath)
+		}
+		childType, _ := child[fieldResourceType].(string)
+		if childType == resourceTypeChildResourceReference {
+			targetType, _ := child[fieldTargetType].(string)
+			id, _ := child[fieldID].(string)
+			path, err := childPath(parentPath, targetType, id)
+			if err != nil {
+				return err
+			}
+			if err := s.applyHierarchy(path, child[fieldChildren]); err != nil {
+				return err
+			}
+			continue
+		}
+		if !strings.HasPrefix(childType, "Child") {
+			return fmt.Errorf("unsupported child type %q under %s", childType, parentPath)
+		}
+		obj := wrappedResource(child)
+		if obj == nil {
+			return fmt.Errorf("%s under %s wraps no resource", childType, parentPath)
+		}
+		resourceType, _ := obj[fieldResourceType].(string)
+		if resourceType == "" {
+			resourceType = strings.TrimPrefix(childType, "Child")
+		}
+		path, _ := obj[fieldPath].(string)
+		if path == "" {
+			id, _ := obj[fieldID].(string)
+			var err error
+			if path, err = childPath(parentPath, resourceType, id); err != nil {
+				return err
+			}
+		}
+		if isMarkedForDelete(child) || isMarkedForDelete(obj) {
+			s.store.delete(path)
+			continue
+		}
+		s.store.patch(path, obj, false)
+		if err := s.applyHierarchy(path, obj[fieldChildren]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wrappedResource returns the resource wrapped by a Child<Type>, it is the only object field of the child.
+func wrappedResource(child map[string]interface{}) Resource {
+	for k, v := range child {
+		if k == fieldChildren {
+			continue
+		}
+		if obj, ok := v.(map[string]interface{}); ok {
+			return obj
+		}
+	}
+	return nil
+}
+
+func isMarkedForDelete(obj map[string]interface{}) bool {
+	deleted, _ := obj[fieldMarkedForDelete].(bool)
+	return deleted
+}
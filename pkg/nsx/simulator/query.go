@@ -0,0 +1,325 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package simulator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// matcher matches a resource against a parsed search query.
+type matcher func(res Resource) bool
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind tokenKind
+	// text is the unescaped word.
+	text string
+	// colon is the index in text of the first unescaped colon, or -1.
+	colon int
+	// quoted is true if the word is a quoted phrase.
+	quoted bool
+}
+
+// parseQuery parses the subset of the Lucene syntax used in NSX search queries:
+// field:value terms combined with AND, OR, NOT and parentheses, field:(v1 OR v2) value
+// groups, backslash escapes, quoted phrases, trailing * wildcards and _exists_:field.
+// The values are matched case-insensitively, as NSX does for keyword fields.
+func parseQuery(query string) (matcher, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{tokens: tokens}
+	if len(tokens) == 0 {
+		return func(Resource) bool { return true }, nil
+	}
+	m, err := p.parseOr("")
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token at position %d in query %q", p.pos, query)
+	}
+	return m, nil
+}
+
+func tokenize(query string) ([]token, error) {
+	var tokens []token
+	var b strings.Builder
+	colon := -1
+	inWord := false
+	quoted := false
+	flush := func() {
+		if inWord {
+			tokens = append(tokens, token{kind: tokenWord, text: b.String(), colon: colon, quoted: quoted})
+		}
+		b.Reset()
+		colon = -1
+		inWord = false
+		quoted = false
+	}
+	runes := []rune(query)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\\':
+			if i+1 == len(runes) {
+				return nil, fmt.Errorf("dangling escape in query %q", query)
+			}
+			i++
+			b.WriteRune(runes[i])
+			inWord = true
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated phrase in query %q", query)
+			}
+			b.WriteString(string(runes[i+1 : end]))
+			inWord = true
+			quoted = true
+			i = end
+		case r == ':' && colon == -1 && inWord:
+			colon = b.Len()
+			b.WriteRune(r)
+		case r == '(' || r == ')':
+			flush()
+			kind := tokenLParen
+			if r == ')' {
+				kind = tokenRParen
+			}
+			tokens = append(tokens, token{kind: kind})
+		case r == ' ' || r == '\t' || r == '\n':
+			flush()
+		default:
+			b.WriteRune(r)
+			inWord = true
+		}
+	}
+	flush()
+	return tokens, nil
+}
+
+type queryParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *queryParser) peek() *token {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *queryParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t != nil && t.kind == tokenWord && !t.quoted && t.colon == -1 && t.text == keyword
+}
+
+// parseOr parses the terms separated by OR, field is the field of a value group or empty.
+func (p *queryParser) parseOr(field string) (matcher, error) {
+	m, err := p.parseAnd(field)
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("OR") || p.isKeyword("||") {
+		p.pos++
+		right, err := p.parseAnd(field)
+		if err != nil {
+			return nil, err
+		}
+		left := m
+		m = func(res Resource) bool { return left(res) || right(res) }
+	}
+	return m, nil
+}
+
+// parseAnd parses the terms separated by AND or by nothing.
+func (p *queryParser) parseAnd(field string) (matcher, error) {
+	m, err := p.parseNot(field)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t == nil || t.kind == tokenRParen || p.isKeyword("OR") || p.isKeyword("||") {
+			return m, nil
+		}
+		if p.isKeyword("AND") || p.isKeyword("&&") {
+			p.pos++
+		}
+		right, err := p.parseNot(field)
+		if err != nil {
+			return nil, err
+		}
+		left := m
+		m = func(res Resource) bool { return left(res) && right(res) }
+	}
+}
+
+func (p *queryParser) parseNot(field string) (matcher, error) {
+	if p.isKeyword("NOT") {
+		p.pos++
+		m, err := p.parseNot(field)
+		if err != nil {
+			return nil, err
+		}
+		return func(res Resource) bool { return !m(res) }, nil
+	}
+	return p.parsePrimary(field)
+}
+
+func (p *queryParser) parsePrimary(field string) (matcher, error) {
+	t := p.peek()
+	if t == nil {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+	switch t.kind {
+	case tokenLParen:
+		p.pos++
+		m, err := p.parseOr(field)
+		if err != nil {
+			return nil, err
+		}
+		if t := p.peek(); t == nil || t.kind != tokenRParen {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return m, nil
+	case tokenRParen:
+		return nil, fmt.Errorf("unexpected closing parenthesis")
+	}
+	p.pos++
+	negate := false
+	text, colon := t.text, t.colon
+	if !t.quoted && (strings.HasPrefix(text, "-") || strings.HasPrefix(text, "!")) {
+		negate = true
+		text = text[1:]
+		colon--
+	}
+	var m matcher
+	if colon >= 0 {
+		termField, value := text[:colon], text[colon+1:]
+		if value == "" {
+			// field:(v1 OR v2)
+			if next := p.peek(); next == nil || next.kind != tokenLParen {
+				return nil, fmt.Errorf("missing value of field %s", termField)
+			}
+			p.pos++
+			group, err := p.parseOr(termField)
+			if err != nil {
+				return nil, err
+			}
+			if next := p.peek(); next == nil || next.kind != tokenRParen {
+				return nil, fmt.Errorf("missing closing parenthesis of field %s", termField)
+			}
+			p.pos++
+			m = group
+		} else {
+			m = termMatcher(termField, value, t.quoted)
+		}
+	} else if field != "" {
+		m = termMatcher(field, text, t.quoted)
+	} else {
+		// A bare value matches any field.
+		m = termMatcher("", text, t.quoted)
+	}
+	if negate {
+		inner := m
+		m = func(res Resource) bool { return !inner(res) }
+	}
+	return m, nil
+}
+
+func termMatcher(field, value string, quoted bool) matcher {
+	if field == "_exists_" {
+		return func(res Resource) bool {
+			return len(fieldValues(map[string]interface{}(res), strings.Split(value, "."))) > 0
+		}
+	}
+	match := func(v string) bool { return strings.EqualFold(v, value) }
+	if !quoted && value == "*" {
+		match = func(string) bool { return true }
+	} else if !quoted && strings.HasSuffix(value, "*") {
+		prefix := strings.ToLower(strings.TrimSuffix(value, "*"))
+		match = func(v string) bool { return strings.HasPrefix(strings.ToLower(v), prefix) }
+	}
+	return func(res Resource) bool {
+		var values []string
+		if field == "" {
+			values = allValues(res)
+		} else {
+			values = fieldValues(map[string]interface{}(res), strings.Split(field, "."))
+		}
+		for _, v := range values {
+			if match(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// fieldValues returns the scalar values of the dotted field in obj, the arrays on the
+// way are flattened, so that tags.scope returns the scope of every tag.
+func fieldValues(obj interface{}, field []string) []string {
+	switch v := obj.(type) {
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			values = append(values, fieldValues(item, field)...)
+		}
+		return values
+	case map[string]interface{}:
+		if len(field) == 0 {
+			return nil
+		}
+		child, ok := v[field[0]]
+		if !ok {
+			return nil
+		}
+		return fieldValues(child, field[1:])
+	case nil:
+		return nil
+	default:
+		if len(field) != 0 {
+			return nil
+		}
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+func allValues(obj interface{}) []string {
+	switch v := obj.(type) {
+	case Resource:
+		return allValues(map[string]interface{}(v))
+	case map[string]interface{}:
+		var values []string
+		for _, child := range v {
+			values = append(values, allValues(child)...)
+		}
+		return values
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			values = append(values, allValues(item)...)
+		}
+		return values
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
@@ -0,0 +1,59 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package simulator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery(t *testing.T) {
+	res := Resource{
+		"resource_type": "VpcSubnet",
+		"display_name":  "subnet 1",
+		"path":          "/orgs/default/projects/p1/vpcs/v1/subnets/s1",
+		"tags": []interface{}{
+			map[string]interface{}{"scope": "nsx-op/cluster", "tag": "cluster1"},
+			map[string]interface{}{"scope": "nsx-op/namespace", "tag": "ns1"},
+		},
+	}
+	tests := []struct {
+		query   string
+		match   bool
+		wantErr bool
+	}{
+		{query: "", match: true},
+		{query: "resource_type:VpcSubnet", match: true},
+		{query: "resource_type:vpcsubnet", match: true},
+		{query: "resource_type:Vpc", match: false},
+		{query: "(resource_type:Vpc)", match: false},
+		{query: "resource_type:(Vpc OR VpcSubnet)", match: true},
+		{query: `resource_type:VpcSubnet AND tags.scope:nsx-op\/cluster AND tags.tag:cluster1`, match: true},
+		{query: `resource_type:VpcSubnet tags.tag:cluster2`, match: false},
+		{query: "resource_type:VpcSubnet AND NOT tags.tag:ns1", match: false},
+		{query: "resource_type:VpcSubnet AND -tags.tag:ns2", match: true},
+		{query: "resource_type:Vpc || tags.tag:ns1", match: true},
+		{query: `display_name:"subnet 1"`, match: true},
+		{query: `path:\/orgs\/default\/projects\/p1*`, match: true},
+		{query: "_exists_:tags.scope", match: true},
+		{query: "_exists_:parent_path", match: false},
+		{query: "cluster1", match: true},
+		{query: "resource_type:(Vpc", wantErr: true},
+		{query: `display_name:"subnet`, wantErr: true},
+		{query: "resource_type:Vpc)", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			m, err := parseQuery(tt.query)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.match, m(res))
+		})
+	}
+}
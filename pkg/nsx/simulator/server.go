@@ -0,0 +1,417 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+// Package simulator provides an in-process fake of the NSX Policy API, so that the NSX
+// client, the services and the controllers can be tested without a NSX manager.
+//
+// The simulator keeps the policy resources in memory by path. It serves the H-API
+// PATCH of OrgRoot and Infra, the CRUD of any policy resource path, the search API,
+// the realized state API, and the session, health, version and license APIs the
+// NSX client calls when it is created:
+//
+//	s := simulator.NewServer()
+//	defer s.Close()
+//	nsxClient := nsx.GetClient(s.NSXOperatorConfig("cluster1"))
+//
+// An API which is not simulated, or a response which needs to be customized, can be
+// served by a handler registered with Handle.
+package simulator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+const (
+	policyAPIPrefix       = "/policy/api/v1"
+	orgRootAPI            = policyAPIPrefix + "/org-root"
+	infraAPI              = policyAPIPrefix + "/infra"
+	searchAPI             = policyAPIPrefix + "/search/query"
+	realizedEntitiesAPI   = policyAPIPrefix + "/infra/realized-state/realized-entities"
+	sessionAPI            = "/api/session/create"
+	healthAPI             = "/api/v1/reverse-proxy/node/health"
+	versionAPI            = "/api/v1/node/version"
+	licenseAPI            = "/api/v1/licenses/licensed-features"
+	defaultPageSize       = 1000
+	DefaultVersion        = "9.2.0.0.0"
+	DefaultUsername       = "admin"
+	DefaultPassword       = "admin"
+	realizedStateRealized = "REALIZED"
+	xsrfTokenHeader       = "X-XSRF-TOKEN"
+	sessionCookie         = "JSESSIONID"
+	sessionToken          = "simulator-xsrf-token"
+	sessionCookieValue    = "simulator-session"
+)
+
+// realizedExtraIDs are the IDs of the realized entities, besides the resource itself,
+// which the services wait for after creating a resource of the type.
+var realizedExtraIDs = map[string][]string{
+	"Vpc": {"gateway-interface"},
+}
+
+// Request is a request served by the simulator.
+type Request struct {
+	Method string
+	// Path is the URL path, without the query.
+	Path string
+}
+
+// RealizedEntity is the realized state of an intent path returned by the realized state API.
+type RealizedEntity struct {
+	ID    string
+	State string
+	// Alarms are the error messages of the realized entity in the ERROR state.
+	Alarms []string
+}
+
+// Server is the in-process NSX Policy API simulator.
+type Server struct {
+	*httptest.Server
+	store *store
+
+	lock     sync.Mutex
+	version  string
+	licenses map[string]bool
+	// realized overrides the realized entities of the intent paths.
+	realized map[string][]RealizedEntity
+	handlers map[string]http.HandlerFunc
+	requests []Request
+	username string
+	password string
+}
+
+// NewServer starts a simulator serving HTTPS on a local port. The server is licensed
+// for all the features checked by the operator and reports DefaultVersion.
+func NewServer() *Server {
+	s := &Server{
+		store:    newStore(),
+		version:  DefaultVersion,
+		licenses: map[string]bool{},
+		realized: map[string][]RealizedEntity{},
+		handlers: map[string]http.HandlerFunc{},
+		username: DefaultUsername,
+		password: DefaultPassword,
+	}
+	for _, feature := range []string{util.LicenseContainerNetwork, util.LicenseDFW, util.LicenseContainer, util.LicenseVPCSecurity, util.LicenseVPCNetworking} {
+		s.licenses[feature] = true
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Host returns the host:port the simulator listens on.
+func (s *Server) Host() string {
+	return s.Listener.Addr().String()
+}
+
+// Thumbprint returns the SHA-256 thumbprint of the server certificate.
+func (s *Server) Thumbprint() string {
+	digest := sha256.Sum256(s.Certificate().Raw)
+	return hex.EncodeToString(digest[:])
+}
+
+// NSXOperatorConfig returns an operator configuration connecting to the simulator.
+func (s *Server) NSXOperatorConfig(cluster string) *config.NSXOperatorConfig {
+	cf := config.NewNSXOpertorConfig()
+	cf.Cluster = cluster
+	cf.NsxApiManagers = []string{s.Host()}
+	cf.NsxApiUser = s.username
+	cf.NsxApiPassword = s.password
+	cf.Thumbprint = []string{s.Thumbprint()}
+	return cf
+}
+
+// SetVersion sets the NSX product version reported by the version API.
+func (s *Server) SetVersion(version string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.version = version
+}
+
+// SetLicense sets whether the license of feature is reported as licensed.
+func (s *Server) SetLicense(feature string, licensed bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.licenses[feature] = licensed
+}
+
+// SetRealizedState overrides the realized entities of intentPath. By default an existing
+// resource is realized with the entities the services wait for.
+func (s *Server) SetRealizedState(intentPath string, entities ...RealizedEntity) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(entities) == 0 {
+		delete(s.realized, intentPath)
+		return
+	}
+	s.realized[intentPath] = entities
+}
+
+// Handle serves the requests of method on the URL path with handler instead of the
+// simulator. The path is matched exactly, without the query.
+func (s *Server) Handle(method, path string, handler http.HandlerFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handlers[method+" "+path] = handler
+}
+
+// Requests returns the requests served so far.
+func (s *Server) Requests() []Request {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// AddResource creates or replaces the resource at the policy path, e.g. to add the
+// resources which are not created by the operator like Projects and VPC connectivity profiles.
+func (s *Server) AddResource(path string, res Resource) Resource {
+	return s.store.patch(path, res, true)
+}
+
+// GetResource returns the resource at the policy path.
+func (s *Server) GetResource(path string) (Resource, bool) {
+	return s.store.get(path)
+}
+
+// ListResources returns the resources of resourceType, sorted by path.
+func (s *Server) ListResources(resourceType string) []Resource {
+	return s.store.all(func(res Resource) bool {
+		return res[fieldResourceType] == resourceType
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path})
+	handler, ok := s.handlers[r.Method+" "+r.URL.Path]
+	s.lock.Unlock()
+	if ok {
+		handler(w, r)
+		return
+	}
+
+	switch {
+	case r.URL.Path == sessionAPI && r.Method == http.MethodPost:
+		s.serveSession(w, r)
+	case r.URL.Path == healthAPI:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"healthy":           true,
+			"components_health": "POLICY:UP, SEARCH:UP, MANAGER:UP, NODE_MGMT:UP, UI:UP",
+		})
+	case r.URL.Path == versionAPI:
+		s.lock.Lock()
+		version := s.version
+		s.lock.Unlock()
+		writeJSON(w, http.StatusOK, map[string]interface{}{"node_version": version, "product_version": version})
+	case r.URL.Path == licenseAPI:
+		s.serveLicense(w)
+	case r.URL.Path == searchAPI && r.Method == http.MethodGet:
+		s.serveSearch(w, r)
+	case r.URL.Path == realizedEntitiesAPI && r.Method == http.MethodGet:
+		s.serveRealizedEntities(w, r)
+	case r.URL.Path == orgRootAPI && r.Method == http.MethodPatch:
+		s.serveHierarchy(w, r, "")
+	case r.URL.Path == infraAPI && r.Method == http.MethodPatch:
+		s.serveHierarchy(w, r, "/infra")
+	case strings.HasPrefix(r.URL.Path, policyAPIPrefix+"/"):
+		s.serveResource(w, r, strings.TrimPrefix(r.URL.Path, policyAPIPrefix))
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("API %s %s is not simulated", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) serveSession(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if r.PostForm.Get("j_username") != s.username || r.PostForm.Get("j_password") != s.password {
+		writeError(w, http.StatusForbidden, "The username/password combination is incorrect")
+		return
+	}
+	w.Header().Set(xsrfTokenHeader, sessionToken)
+	http.SetCookie(w, &http.Cookie{Name: sessionCookie, Value: sessionCookieValue, Path: "/"})
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) serveLicense(w http.ResponseWriter) {
+	s.lock.Lock()
+	features := make([]string, 0, len(s.licenses))
+	for feature := range s.licenses {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+	results := make([]map[string]interface{}, 0, len(features))
+	for _, feature := range features {
+		results = append(results, map[string]interface{}{"feature_name": feature, "is_licensed": s.licenses[feature]})
+	}
+	s.lock.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"results": results, "result_count": len(results)})
+}
+
+func (s *Server) serveSearch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	match, err := parseQuery(query.Get("query"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	results := s.store.all(func(res Resource) bool { return match(res) })
+	writeJSON(w, http.StatusOK, page(results, query.Get("cursor"), query.Get("page_size")))
+}
+
+func (s *Server) serveRealizedEntities(w http.ResponseWriter, r *http.Request) {
+	intentPath := r.URL.Query().Get("intent_path")
+	s.lock.Lock()
+	entities, ok := s.realized[intentPath]
+	s.lock.Unlock()
+	if !ok {
+		if res, exists := s.store.get(intentPath); exists {
+			resourceType, _ := res[fieldResourceType].(string)
+			entities = append(entities, RealizedEntity{ID: res[fieldID].(string), State: realizedStateRealized})
+			for _, id := range realizedExtraIDs[resourceType] {
+				entities = append(entities, RealizedEntity{ID: id, State: realizedStateRealized})
+			}
+		}
+	}
+	results := make([]map[string]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		result := map[string]interface{}{
+			"id":            entity.ID,
+			"state":         entity.State,
+			"intent_paths":  []string{intentPath},
+			"resource_type": "GenericPolicyRealizedResource",
+		}
+		if len(entity.Alarms) > 0 {
+			var alarms []map[string]interface{}
+			for _, message := range entity.Alarms {
+				alarms = append(alarms, map[string]interface{}{"message": message})
+			}
+			result["alarms"] = alarms
+		}
+		results = append(results, result)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"results": results, "result_count": len(results)})
+}
+
+func (s *Server) serveHierarchy(w http.ResponseWriter, r *http.Request, rootPath string) {
+	var root map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&root); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := s.applyHierarchy(rootPath, root[fieldChildren]); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// serveResource serves the CRUD of the resource or the collection at the policy path.
+func (s *Server) serveResource(w http.ResponseWriter, r *http.Request, path string) {
+	path = strings.TrimSuffix(path, "/")
+	_, segment, id := splitPath(path)
+	isCollection := id == "" && segment != segmentInfra
+	switch r.Method {
+	case http.MethodGet:
+		if isCollection {
+			query := r.URL.Query()
+			writeJSON(w, http.StatusOK, page(s.store.list(path), query.Get("cursor"), query.Get("page_size")))
+			return
+		}
+		res, ok := s.store.get(path)
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The path=[%s] is invalid", path))
+			return
+		}
+		writeJSON(w, http.StatusOK, res)
+	case http.MethodPatch, http.MethodPut:
+		if isCollection {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s is not allowed on collection %s", r.Method, path))
+			return
+		}
+		var obj Resource
+		if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		replace := r.Method == http.MethodPut
+		if existing, ok := s.store.get(path); ok && replace {
+			if revision, set := obj[fieldRevision]; set && toInt64(revision) != toInt64(existing[fieldRevision]) {
+				writeError(w, http.StatusPreconditionFailed, fmt.Sprintf("The object was modified by somebody else, path=[%s]", path))
+				return
+			}
+		}
+		res := s.store.patch(path, obj, replace)
+		if err := s.applyHierarchy(path, obj[fieldChildren]); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if replace {
+			writeJSON(w, http.StatusOK, res)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		if isCollection {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("DELETE is not allowed on collection %s", path))
+			return
+		}
+		// Deleting a resource which does not exist succeeds, as it does on NSX.
+		s.store.delete(path)
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s is not allowed on %s", r.Method, path))
+	}
+}
+
+// page returns the list result of the page of results starting at cursor.
+func page(results []Resource, cursor, pageSize string) map[string]interface{} {
+	start, _ := strconv.Atoi(cursor)
+	size, err := strconv.Atoi(pageSize)
+	if err != nil || size <= 0 {
+		size = defaultPageSize
+	}
+	if start > len(results) {
+		start = len(results)
+	}
+	end := start + size
+	if end > len(results) {
+		end = len(results)
+	}
+	list := map[string]interface{}{
+		"results":      append([]Resource{}, results[start:end]...),
+		"result_count": len(results),
+	}
+	if end < len(results) {
+		list["cursor"] = strconv.Itoa(end)
+	}
+	return list
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error in the format of the NSX API errors.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"httpStatus":    strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_")),
+		"error_code":    status,
+		"module_name":   "simulator",
+		"error_message": message,
+	})
+}
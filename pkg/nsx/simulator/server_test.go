@@ -0,0 +1,184 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package simulator_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/simulator"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+const (
+	vpcPath    = "/orgs/default/projects/project1/vpcs/vpc1"
+	subnetPath = vpcPath + "/subnets/subnet1"
+)
+
+var realizeBackoff = wait.Backoff{Steps: 1}
+
+func newClient(t *testing.T) (*simulator.Server, *nsx.Client) {
+	s := simulator.NewServer()
+	t.Cleanup(s.Close)
+	nsxClient := nsx.GetClient(s.NSXOperatorConfig("cluster1"))
+	require.NotNil(t, nsxClient)
+	return s, nsxClient
+}
+
+func TestServer_Client(t *testing.T) {
+	_, nsxClient := newClient(t)
+	assert.Equal(t, nsx.GREEN, nsxClient.Cluster.Health())
+	assert.True(t, nsxClient.NSXCheckVersion(nsx.VPC))
+	assert.NoError(t, nsxClient.Cluster.FetchLicense())
+	assert.True(t, util.IsLicensed(util.FeatureContainer))
+
+	s := simulator.NewServer()
+	t.Cleanup(s.Close)
+	s.SetVersion("3.2.0")
+	nsxClient = nsx.GetClient(s.NSXOperatorConfig("cluster1"))
+	require.NotNil(t, nsxClient)
+	version, err := nsxClient.Cluster.GetVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "3.2.0", version.ProductVersion)
+	assert.False(t, nsxClient.NSXCheckVersion(nsx.VPC))
+}
+
+func TestServer_ResourceCRUD(t *testing.T) {
+	s, nsxClient := newClient(t)
+
+	subnet := model.VpcSubnet{
+		DisplayName: common.String("subnet1"),
+		IpAddresses: []string{"10.0.0.0/28"},
+		Tags:        []model.Tag{{Scope: common.String(common.TagScopeCluster), Tag: common.String("cluster1")}},
+	}
+	require.NoError(t, nsxClient.SubnetsClient.Patch("default", "project1", "vpc1", "subnet1", subnet))
+
+	got, err := nsxClient.SubnetsClient.Get("default", "project1", "vpc1", "subnet1")
+	require.NoError(t, err)
+	assert.Equal(t, subnetPath, *got.Path)
+	assert.Equal(t, vpcPath, *got.ParentPath)
+	assert.Equal(t, common.ResourceTypeSubnet, *got.ResourceType)
+	assert.Equal(t, int64(0), *got.Revision)
+
+	got.IpAddresses = []string{"10.0.0.0/26"}
+	updated, err := nsxClient.SubnetsClient.Update("default", "project1", "vpc1", "subnet1", got)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), *updated.Revision)
+	assert.Equal(t, []string{"10.0.0.0/26"}, updated.IpAddresses)
+	// A stale revision is rejected.
+	_, err = nsxClient.SubnetsClient.Update("default", "project1", "vpc1", "subnet1", got)
+	assert.Error(t, err)
+
+	list, err := nsxClient.SubnetsClient.List("default", "project1", "vpc1", nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, list.Results, 1)
+
+	require.NoError(t, nsxClient.SubnetsClient.Delete("default", "project1", "vpc1", "subnet1"))
+	_, err = nsxClient.SubnetsClient.Get("default", "project1", "vpc1", "subnet1")
+	assert.Error(t, err)
+	_, ok := s.GetResource(subnetPath)
+	assert.False(t, ok)
+}
+
+func TestServer_HierarchicalAPI(t *testing.T) {
+	s, nsxClient := newClient(t)
+	builder, err := common.PolicyPathVpcSubnetPort.NewPolicyTreeBuilder()
+	require.NoError(t, err)
+
+	ports := []*model.VpcSubnetPort{
+		{Id: common.String("port1"), Path: common.String(subnetPath + "/ports/port1"), ParentPath: common.String(subnetPath), DisplayName: common.String("port1")},
+		{Id: common.String("port2"), Path: common.String(subnetPath + "/ports/port2"), ParentPath: common.String(subnetPath), DisplayName: common.String("port2")},
+	}
+	require.NoError(t, builder.UpdateMultipleResourcesOnNSX(context.TODO(), ports, nsxClient))
+	assert.Len(t, s.ListResources(common.ResourceTypeSubnetPort), 2)
+	port, ok := s.GetResource(subnetPath + "/ports/port1")
+	require.True(t, ok)
+	assert.Equal(t, "port1", port["display_name"])
+
+	ports[0].MarkedForDelete = common.Bool(true)
+	require.NoError(t, builder.UpdateMultipleResourcesOnNSX(context.TODO(), ports[:1], nsxClient))
+	resources := s.ListResources(common.ResourceTypeSubnetPort)
+	require.Len(t, resources, 1)
+	assert.Equal(t, subnetPath+"/ports/port2", resources[0]["path"])
+}
+
+func TestServer_Search(t *testing.T) {
+	s, nsxClient := newClient(t)
+	for _, id := range []string{"subnet1", "subnet2", "subnet3"} {
+		s.AddResource(vpcPath+"/subnets/"+id, simulator.Resource{
+			"tags": []interface{}{map[string]interface{}{"scope": common.TagScopeCluster, "tag": "cluster1"}},
+		})
+	}
+	s.AddResource(vpcPath+"/subnets/subnet4", simulator.Resource{
+		"tags": []interface{}{map[string]interface{}{"scope": common.TagScopeCluster, "tag": "cluster2"}},
+	})
+	s.AddResource(vpcPath, simulator.Resource{})
+
+	service := common.Service{NSXClient: nsxClient, NSXConfig: nsxClient.NsxConfig}
+	store := &common.ResourceStore{
+		Indexer:     cache.NewIndexer(func(obj interface{}) (string, error) { return *obj.(*model.VpcSubnet).Path, nil }, cache.Indexers{}),
+		BindingType: model.VpcSubnetBindingType(),
+	}
+	count, err := service.SearchResource("", common.QueryTagCondition(common.ResourceTypeSubnet, "cluster1"), &subnetStore{store}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), count)
+
+	pageSize := int64(2)
+	query := "resource_type:(Vpc OR VpcSubnet) AND NOT tags.tag:cluster2"
+	response, err := nsxClient.QueryClient.List(query, nil, nil, &pageSize, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), *response.ResultCount)
+	assert.Len(t, response.Results, 2)
+	require.NotNil(t, response.Cursor)
+	response, err = nsxClient.QueryClient.List(query, response.Cursor, nil, &pageSize, nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, response.Results, 2)
+	assert.Nil(t, response.Cursor)
+}
+
+func TestServer_RealizedState(t *testing.T) {
+	s, nsxClient := newClient(t)
+	service := realizestate.InitializeRealizeState(common.Service{NSXClient: nsxClient, NSXConfig: nsxClient.NsxConfig})
+
+	assert.Error(t, service.CheckRealizeState(context.TODO(), realizeBackoff, vpcPath, []string{common.GatewayInterfaceId}))
+	s.AddResource(vpcPath, simulator.Resource{})
+	assert.NoError(t, service.CheckRealizeState(context.TODO(), realizeBackoff, vpcPath, []string{common.GatewayInterfaceId}))
+
+	s.SetRealizedState(vpcPath, simulator.RealizedEntity{ID: "vpc1", State: model.GenericPolicyRealizedResource_STATE_ERROR, Alarms: []string{"failed"}})
+	assert.ErrorContains(t, service.CheckRealizeState(context.TODO(), realizeBackoff, vpcPath, nil), "failed")
+}
+
+func TestServer_Handle(t *testing.T) {
+	s, nsxClient := newClient(t)
+	s.Handle(http.MethodGet, "/policy/api/v1/orgs/default/projects/project1/vpcs/vpc1/state", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"realized_state": "REALIZED"}`))
+	})
+	_, err := nsxClient.VPCStateClient.Get("default", "project1", "vpc1", nil)
+	assert.NoError(t, err)
+	assert.Contains(t, s.Requests(), simulator.Request{Method: http.MethodGet, Path: "/policy/api/v1/orgs/default/projects/project1/vpcs/vpc1/state"})
+}
+
+// subnetStore is a minimal common.Store of VpcSubnets for SearchResource.
+type subnetStore struct {
+	*common.ResourceStore
+}
+
+func (s *subnetStore) Apply(interface{}) error {
+	return nil
+}
+
+func (s *subnetStore) IsPolicyAPI() bool {
+	return true
+}
@@ -0,0 +1,272 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Resource is a policy resource as the JSON object served by the NSX Policy API.
+type Resource map[string]interface{}
+
+const (
+	fieldID              = "id"
+	fieldPath            = "path"
+	fieldParentPath      = "parent_path"
+	fieldRelativePath    = "relative_path"
+	fieldResourceType    = "resource_type"
+	fieldMarkedForDelete = "marked_for_delete"
+	fieldRevision        = "_revision"
+	fieldUniqueID        = "unique_id"
+	fieldChildren        = "children"
+	fieldCreateTime      = "_create_time"
+	fieldLastModified    = "_last_modified_time"
+
+	// segmentInfra is the only path segment which is not followed by an ID.
+	segmentInfra = "infra"
+)
+
+var (
+	// typeSegments maps the resource types to the path segment of their collection.
+	typeSegments = map[string]string{
+		"Org":                         "orgs",
+		"Project":                     "projects",
+		"Vpc":                         "vpcs",
+		"VpcSubnet":                   "subnets",
+		"VpcSubnetPort":               "ports",
+		"VpcSubnetPortConfig":         "port-configs",
+		"SubnetConnectionBindingMap":  "subnet-connection-binding-maps",
+		"VpcIpAddressAllocation":      "ip-address-allocations",
+		"VpcAttachment":               "attachments",
+		"VpcConnectivityProfile":      "vpc-connectivity-profiles",
+		"VpcEndpoint":                 "vpc-endpoints",
+		"VpcServiceEndpoint":          "vpc-service-endpoints",
+		"StaticRoutes":                "static-routes",
+		"SecurityPolicy":              "security-policies",
+		"Rule":                        "rules",
+		"Group":                       "groups",
+		"Domain":                      "domains",
+		"Share":                       "shares",
+		"SharedResource":              "resources",
+		"PolicyContextProfile":        "context-profiles",
+		"TlsCertificate":              "certificates",
+		"DynamicIpAddressReservation": "dynamic-ip-reservations",
+		"StaticIpAddressReservation":  "static-ip-reservations",
+		"DnsRecord":                   "dns-records",
+		"IpAddressBlock":              "ip-blocks",
+		"TransitGateway":              "transit-gateways",
+		"LBService":                   "lb-services",
+		"LBPool":                      "lb-pools",
+		"LBVirtualServer":             "lb-virtual-servers",
+	}
+	// vpcTypeSegments overrides typeSegments for the resources under a VPC.
+	vpcTypeSegments = map[string]string{
+		"LBService":       "vpc-lbs",
+		"LBPool":          "vpc-lb-pools",
+		"LBVirtualServer": "vpc-lb-virtual-servers",
+	}
+	// segmentTypes maps the path segments to the resource type of their collection.
+	segmentTypes = map[string]string{}
+)
+
+func init() {
+	for _, segments := range []map[string]string{typeSegments, vpcTypeSegments} {
+		for resourceType, segment := range segments {
+			segmentTypes[segment] = resourceType
+		}
+	}
+}
+
+// childPath returns the path of the resource with resourceType and id under parentPath.
+func childPath(parentPath, resourceType, id string) (string, error) {
+	if resourceType == "Infra" {
+		return parentPath + "/" + segmentInfra, nil
+	}
+	segment, ok := typeSegments[resourceType]
+	if vpcSegment, isVPCType := vpcTypeSegments[resourceType]; isVPCType && strings.Contains(parentPath, "/vpcs/") {
+		segment = vpcSegment
+	}
+	if !ok {
+		return "", fmt.Errorf("unsupported resource type %s", resourceType)
+	}
+	if id == "" {
+		return "", fmt.Errorf("%s under %s has no id", resourceType, parentPath)
+	}
+	return parentPath + "/" + segment + "/" + id, nil
+}
+
+// splitPath splits a policy path into its parent path, collection segment and ID, the
+// ID is empty if the path is a collection.
+func splitPath(path string) (parentPath, segment, id string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	i := 0
+	for i < len(segments) {
+		if segments[i] == segmentInfra {
+			if i == len(segments)-1 {
+				return "/" + strings.Join(segments[:i], "/"), segmentInfra, ""
+			}
+			i++
+			continue
+		}
+		if i == len(segments)-1 {
+			return "/" + strings.Join(segments[:i], "/"), segments[i], ""
+		}
+		if i == len(segments)-2 {
+			return "/" + strings.Join(segments[:i], "/"), segments[i], segments[i+1]
+		}
+		i += 2
+	}
+	return "", "", ""
+}
+
+// store keeps the policy resources by path.
+type store struct {
+	sync.RWMutex
+	resources map[string]Resource
+}
+
+func newStore() *store {
+	return &store{resources: map[string]Resource{}}
+}
+
+// get returns a copy of the resource at path.
+func (s *store) get(path string) (Resource, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	res, ok := s.resources[path]
+	if !ok {
+		return nil, false
+	}
+	return res.copy(), true
+}
+
+// list returns the copies of the resources in the collection path, sorted by path.
+func (s *store) list(collectionPath string) []Resource {
+	s.RLock()
+	defer s.RUnlock()
+	prefix := strings.TrimSuffix(collectionPath, "/") + "/"
+	var results []Resource
+	for path, res := range s.resources {
+		if strings.HasPrefix(path, prefix) && !strings.Contains(path[len(prefix):], "/") {
+			results = append(results, res.copy())
+		}
+	}
+	sortByPath(results)
+	return results
+}
+
+// all returns the copies of all the resources matching filter, sorted by path.
+func (s *store) all(filter func(Resource) bool) []Resource {
+	s.RLock()
+	defer s.RUnlock()
+	var results []Resource
+	for _, res := range s.resources {
+		if filter(res) {
+			results = append(results, res.copy())
+		}
+	}
+	sortByPath(results)
+	return results
+}
+
+// patch merges obj into the resource at path, or creates it. If replace is true, the
+// resource is replaced by obj instead.
+func (s *store) patch(path string, obj Resource, replace bool) Resource {
+	s.Lock()
+	defer s.Unlock()
+	parentPath, segment, id := splitPath(path)
+	now := time.Now().UnixMilli()
+	existing, ok := s.resources[path]
+	res := Resource{}
+	if ok && !replace {
+		res = existing.copy()
+	}
+	for k, v := range obj {
+		if k == fieldChildren {
+			continue
+		}
+		res[k] = v
+	}
+	if segment == segmentInfra {
+		id = segmentInfra
+	}
+	res[fieldID] = id
+	res[fieldPath] = path
+	res[fieldParentPath] = parentPath
+	res[fieldRelativePath] = id
+	res[fieldMarkedForDelete] = false
+	if _, ok := res[fieldResourceType]; !ok {
+		if resourceType, ok := segmentTypes[segment]; ok {
+			res[fieldResourceType] = resourceType
+		} else if segment == segmentInfra {
+			res[fieldResourceType] = "Infra"
+		}
+	}
+	if ok {
+		res[fieldRevision] = toInt64(existing[fieldRevision]) + 1
+		res[fieldUniqueID] = existing[fieldUniqueID]
+		res[fieldCreateTime] = existing[fieldCreateTime]
+	} else {
+		res[fieldRevision] = int64(0)
+		res[fieldUniqueID] = uuid.Must(uuid.NewV4()).String()
+		res[fieldCreateTime] = now
+	}
+	res[fieldLastModified] = now
+	s.resources[path] = res
+	return res.copy()
+}
+
+// delete removes the resource at path with all the resources under it, it returns false
+// if the resource does not exist.
+func (s *store) delete(path string) bool {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.resources[path]; !ok {
+		return false
+	}
+	prefix := path + "/"
+	for p := range s.resources {
+		if p == path || strings.HasPrefix(p, prefix) {
+			delete(s.resources, p)
+		}
+	}
+	return true
+}
+
+func (r Resource) copy() Resource {
+	c := make(Resource, len(r))
+	for k, v := range r {
+		c[k] = v
+	}
+	return c
+}
+
+func (r Resource) path() string {
+	path, _ := r[fieldPath].(string)
+	return path
+}
+
+func sortByPath(resources []Resource) {
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].path() < resources[j].path()
+	})
+}
+
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	case float64:
+		return int64(n)
+	}
+	return 0
+}
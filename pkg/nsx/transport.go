@@ -5,14 +5,26 @@ package nsx
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/third_party/retry"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 // Transport is used in http.Client to replace default implement.
@@ -21,6 +33,36 @@ type Transport struct {
 	Base      http.RoundTripper
 	endpoints []*Endpoint
 	config    *Config
+	// lock guards endpoints and config, which are replaced when the cluster endpoints are updated.
+	lock sync.RWMutex
+}
+
+func (t *Transport) setEndpoints(endpoints []*Endpoint, config *Config) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.endpoints = endpoints
+	t.config = config
+}
+
+func (t *Transport) getEndpoints() []*Endpoint {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.endpoints
+}
+
+func (t *Transport) getConfig() *Config {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.config
+}
+
+// httpTimeout returns the timeout of a request, 0 if the requests have no timeout.
+func (t *Transport) httpTimeout() time.Duration {
+	config := t.getConfig()
+	if config == nil {
+		return 0
+	}
+	return time.Duration(config.HTTPTimeout) * time.Second
 }
 
 // RoundTrip is the core of the transport. It accepts a request,
@@ -28,12 +70,47 @@ type Transport struct {
 // It will block the request if the speed is too fast.
 // It will retry the request if nsx-t returns error and error type is retriable or ground
 // It returns the response to the caller.
+// The request is traced in a span, whose trace context is propagated to NSX in the request headers.
 func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 	var resp *http.Response
 	var resul error
 
+	// The span is a child of the span of the request context, set by the SDK clients of
+	// Client.WithContext.
+	ctx, span := tracing.Tracer().Start(r.Context(), "nsx.RoundTrip", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("http.request.method", r.Method),
+		attribute.String("url.path", r.URL.Path),
+	))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(r.Header))
+	// The response body is read before RoundTrip returns, so the timeout covers the whole
+	// request like http.Client.Timeout does.
+	if timeout := t.httpTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+		r = r.WithContext(ctx)
+	}
+	class := requestClass(r)
+	span.SetAttributes(attribute.String("nsx.rate_limit_class", string(class)))
+	attempts := 0
+	var totalWait time.Duration
+	defer func() {
+		span.SetAttributes(
+			attribute.Int("nsx.retry_count", max(attempts-1, 0)),
+			attribute.Int64("nsx.rate_limit_wait_ms", totalWait.Milliseconds()),
+		)
+		if resp != nil {
+			span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+			if resp.StatusCode >= http.StatusBadRequest {
+				span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+			}
+		}
+		tracing.EndSpan(span, resul)
+	}()
+
 	retry.Do(
 		func() error {
+			attempts++
 			ep, err := t.selectEndpoint()
 			if err != nil {
 				log.Error(err, "Endpoint is unavailable")
@@ -41,19 +118,30 @@ func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 			}
 			ep.increaseConnNumber()
 			defer ep.decreaseConnNumber()
+			span.SetAttributes(attribute.String("nsx.endpoint", ep.Host()))
 
 			util.UpdateRequestURL(r.URL, ep.Host(), ep.Thumbprint)
 			ep.UpdateHttpRequestAuth(r)
 			ep.UpdateCAforEnvoy(r)
 			start := time.Now()
-			ep.wait()
+			ep.wait(class)
 			util.DumpHttpRequest(r)
 			waitTime := time.Since(start)
+			totalWait += waitTime
+			span.AddEvent("attempt", trace.WithAttributes(
+				attribute.Int("nsx.attempt", attempts),
+				attribute.String("nsx.endpoint", ep.Host()),
+				attribute.Int64("nsx.rate_limit_wait_ms", waitTime.Milliseconds()),
+			))
 			if resp, resul = t.base().RoundTrip(r); resul != nil {
+				t.observeRequest(ep, r.Method, 0, time.Since(start)-waitTime)
+				ep.breaker.record(time.Since(start)-waitTime, true)
 				ep.setStatus(DOWN)
 				return handleRoundTripError(resul, ep)
 			}
 			transTime := time.Since(start) - waitTime
+			t.observeRequest(ep, r.Method, resp.StatusCode, transTime)
+			ep.breaker.record(transTime, isFailedRequest(resp.StatusCode))
 			ep.adjustRate(waitTime, resp.StatusCode)
 			if resp == nil {
 				return nil
@@ -72,10 +160,11 @@ func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 				return nil
 			}
 			if util.ShouldRegenerate(err) {
-				if t.config.TokenProvider != nil {
-					t.config.TokenProvider.GetToken(true)
+				config := t.getConfig()
+				if config.TokenProvider != nil {
+					config.TokenProvider.GetToken(true)
 				} else {
-					ep.createAuthSession(t.config.ClientCertProvider, t.config.TokenProvider, t.config.Username, t.config.Password, jarCache)
+					ep.createAuthSession(config.ClientCertProvider, config.TokenProvider, config.Username, config.Password, jarCache)
 				}
 			}
 			return err
@@ -94,6 +183,32 @@ func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 	return resp, resul
 }
 
+// requestClass returns the API class of the request for the rate limiter and removes the
+// RateLimitClassHeader from the request. The class is set in the header by the clients which
+// don't set the request context, or else in the request context by ratelimiter.WithClass. The
+// search and the container inventory requests without class are classified by their path, and
+// the other requests are interactive.
+func requestClass(r *http.Request) ratelimiter.Class {
+	if value := r.Header.Get(RateLimitClassHeader); value != "" {
+		r.Header.Del(RateLimitClassHeader)
+		for _, class := range ratelimiter.Classes {
+			if string(class) == value {
+				return class
+			}
+		}
+	}
+	if class, ok := ratelimiter.ClassFromContext(r.Context()); ok {
+		return class
+	}
+	switch {
+	case strings.Contains(r.URL.Path, "/search/") || strings.HasSuffix(r.URL.Path, "/search"):
+		return ratelimiter.ClassSearch
+	case strings.HasPrefix(r.URL.Path, "/api/v1/fabric/container-") || strings.HasPrefix(r.URL.Path, "/api/v1/inventory/container/"):
+		return ratelimiter.ClassBackground
+	}
+	return ratelimiter.ClassInteractive
+}
+
 func handleRoundTripError(err error, ep *Endpoint) error {
 	log.Error(err, "Failed to request")
 	errString := err.Error()
@@ -107,6 +222,14 @@ func handleRoundTripError(err error, ep *Endpoint) error {
 	}
 }
 
+func (t *Transport) observeRequest(ep *Endpoint, method string, statusCode int, duration time.Duration) {
+	config := t.getConfig()
+	if config == nil || !config.EnableMetrics {
+		return
+	}
+	metrics.ObserveNSXAPIRequest(ep.Host(), method, statusCode, duration)
+}
+
 func (t *Transport) base() http.RoundTripper {
 	if t.Base != nil {
 		return t.Base
@@ -114,27 +237,35 @@ func (t *Transport) base() http.RoundTripper {
 	return http.DefaultTransport
 }
 
+// selectEndpoint selects the available endpoint with the lowest score, which is its
+// number of connections weighted by its average latency. The endpoints which are DOWN
+// or whose circuit breaker is open are skipped.
 func (t *Transport) selectEndpoint() (*Endpoint, error) {
-	small := 100
-	index := -1
-	for i, ep := range t.endpoints {
-		if ep.Status() == DOWN {
+	endpoints := t.getEndpoints()
+	var candidates []*Endpoint
+	scores := map[*Endpoint]float64{}
+	for _, ep := range endpoints {
+		if !ep.available() {
 			continue
 		}
-		conn := ep.ConnNumber()
-		if conn < small {
-			small = conn
-			index = i
-		}
+		candidates = append(candidates, ep)
+		scores[ep] = float64(ep.ConnNumber()+1) * float64(ep.breaker.latencyScore())
 	}
-	if index == -1 {
-		var eps []string
-		for _, i := range t.endpoints {
-			eps = append(eps, i.Host())
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i]] < scores[candidates[j]]
+	})
+	for _, ep := range candidates {
+		// The half-open breaker lets a single probe request through, the next endpoint
+		// is selected if another request took it meanwhile.
+		if ep.breaker.allow() {
+			return ep, nil
 		}
-		log.Error(errors.New("all endpoints down for cluster"), "select endpoint failed")
-		id := strings.Join(eps, ",")
-		return nil, util.CreateServiceClusterUnavailable(id)
 	}
-	return t.endpoints[index], nil
+	var eps []string
+	for _, i := range endpoints {
+		eps = append(eps, i.Host())
+	}
+	log.Error(errors.New("all endpoints down for cluster"), "select endpoint failed")
+	id := strings.Join(eps, ",")
+	return nil, util.CreateServiceClusterUnavailable(id)
 }
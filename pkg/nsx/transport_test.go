@@ -4,6 +4,7 @@
 package nsx
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -14,6 +15,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 )
@@ -75,7 +82,7 @@ func TestSelectEndpoint(t *testing.T) {
 	config := NewConfig(a, "admin", "passw0rd", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.AIMD, nil, nil, []string{})
 	cluster := &Cluster{config: &Config{}}
 	tr := cluster.createTransport(idleConnTimeout)
-	client := cluster.createHTTPClient(tr, timeout)
+	client := cluster.createHTTPClient(tr)
 	noBClient := cluster.createNoBalancerClient(timeout, idleConnTimeout)
 	r := ratelimiter.NewRateLimiter(config.APIRateMode)
 	eps, _ := cluster.createEndpoints(config.APIManagers, client, noBClient, r, nil)
@@ -101,6 +108,21 @@ func TestSelectEndpoint(t *testing.T) {
 	assert.Nil(err, fmt.Sprintf("Select endpoint failed due to %v", err))
 	assert.Equal(ep.Host(), eps[1].Host(), "Select endpoint error, ep is %s, error is %s", ep.Host(), err)
 
+	// select ep with lower latency even if it has more connections
+	eps[1].breaker.latencyEWMA = 100 * time.Millisecond
+	eps[2].breaker.latencyEWMA = 20 * time.Millisecond
+	eps[0].breaker.latencyEWMA = 50 * time.Millisecond
+	ep, err = tr.selectEndpoint()
+	assert.Nil(err)
+	assert.Equal(eps[2].Host(), ep.Host())
+
+	// skip ep whose circuit breaker is open
+	eps[2].breaker.state = BreakerOpen
+	eps[2].breaker.openedAt = time.Now()
+	ep, err = tr.selectEndpoint()
+	assert.Nil(err)
+	assert.Equal(eps[0].Host(), ep.Host())
+
 	eps[0].connnumber = 0
 	eps[1].connnumber = 4
 	eps[2].connnumber = 0
@@ -151,7 +173,7 @@ func Test_handleRoundTripError(t *testing.T) {
 	config := NewConfig(a, "admin", "passw0rd", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.AIMD, nil, nil, []string{})
 	cluster := &Cluster{config: &Config{}}
 	tr := cluster.createTransport(idleConnTimeout)
-	client := cluster.createHTTPClient(tr, timeout)
+	client := cluster.createHTTPClient(tr)
 	noBClient := cluster.createNoBalancerClient(timeout, idleConnTimeout)
 	r := ratelimiter.NewRateLimiter(config.APIRateMode)
 	eps, _ := cluster.createEndpoints(config.APIManagers, client, noBClient, r, nil)
@@ -164,6 +186,38 @@ func Test_handleRoundTripError(t *testing.T) {
 	assert.NotNil(t, handleRoundTripError(err, eps[2]))
 }
 
+func Test_requestClass(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		ctx    context.Context
+		header string
+		url    string
+		class  ratelimiter.Class
+	}{
+		{name: "interactive", url: "https://10.0.0.1/policy/api/v1/orgs/default/projects/p1/vpcs/v1/subnets/s1/ports/p1", class: ratelimiter.ClassInteractive},
+		{name: "search", url: "https://10.0.0.1/policy/api/v1/search/query?query=resource_type:VpcSubnet", class: ratelimiter.ClassSearch},
+		{name: "inventory", url: "https://10.0.0.1/api/v1/inventory/container/cluster1?action=updates", class: ratelimiter.ClassBackground},
+		{name: "container cluster", url: "https://10.0.0.1/api/v1/fabric/container-clusters", class: ratelimiter.ClassBackground},
+		{name: "context", ctx: ratelimiter.WithClass(context.Background(), ratelimiter.ClassBackground), url: "https://10.0.0.1/policy/api/v1/search/query", class: ratelimiter.ClassBackground},
+		{name: "header", header: "background", url: "https://10.0.0.1/policy/api/v1/orgs/default/projects/p1/vpcs/v1/subnets/s1", class: ratelimiter.ClassBackground},
+		{name: "unknown header", header: "bulk", url: "https://10.0.0.1/policy/api/v1/search/query", class: ratelimiter.ClassSearch},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			r, err := http.NewRequestWithContext(ctx, http.MethodGet, tc.url, nil)
+			assert.NoError(t, err)
+			if tc.header != "" {
+				r.Header.Set(RateLimitClassHeader, tc.header)
+			}
+			assert.Equal(t, tc.class, requestClass(r))
+			assert.Empty(t, r.Header.Get(RateLimitClassHeader))
+		})
+	}
+}
+
 func TestTransport_base(t *testing.T) {
 	type fields struct {
 		Base      http.RoundTripper
@@ -223,3 +277,46 @@ func TestTransport_selectEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestRoundTripTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	}()
+
+	healthresult := `{"healthy" : true}`
+	var traceParent string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "reverse-proxy/node/health") && !strings.Contains(r.URL.Path, "api/session/create") {
+			traceParent = r.Header.Get("traceparent")
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(healthresult))
+	}))
+	defer ts.Close()
+	a := ts.URL[strings.Index(ts.URL, "//")+2:]
+	config := NewConfig(a, "admin", "passw0rd", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.AIMD, nil, nil, []string{})
+	cluster, err := NewCluster(config)
+	assert.NoError(t, err)
+	cluster.endpoints[0], _ = NewEndpoint(ts.URL, cluster.client, cluster.noBalancerClient, cluster.endpoints[0].ratelimiter, nil)
+	cluster.endpoints[0].keepAlive()
+	req, _ := http.NewRequest("GET", ts.URL+"/policy/api/v1/infra", nil)
+	_, err = cluster.transport.RoundTrip(req)
+	assert.NoError(t, err)
+
+	var span sdktrace.ReadOnlySpan
+	for _, s := range recorder.Ended() {
+		if s.Name() == "nsx.RoundTrip" {
+			span = s
+		}
+	}
+	if assert.NotNil(t, span) {
+		assert.Contains(t, traceParent, span.SpanContext().TraceID().String())
+		assert.Contains(t, span.Attributes(), attribute.String("url.path", "/policy/api/v1/infra"))
+		assert.Contains(t, span.Attributes(), attribute.Int("nsx.retry_count", 0))
+		assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	}
+}
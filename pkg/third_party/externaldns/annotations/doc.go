@@ -7,11 +7,14 @@
 //	SplitHostnameAnnotation — same comma-separated hostname tokenization as upstream processors.
 //	HostnamesFromAnnotations(input, hostnameKey) — same parsing as upstream after resolving the key; upstream
 //	overload reads a package-level hostname key constant.
+//	TTLFromAnnotations(input, ttlKey) — same duration-or-seconds parsing and [1, MaxInt32] range as upstream.
 //
 // # Modified from external-dns
 //
 //	HostnamesFromAnnotations — returns nil if hostnameKey is "" or input is nil (defensive); upstream resolves
 //	from a fixed key and may not short-circuit the same way.
+//	TTLFromAnnotations — returns an error for invalid values instead of logging a warning, so callers can
+//	report it with their own resource context.
 //
 // # nsx-operator / subset
 //
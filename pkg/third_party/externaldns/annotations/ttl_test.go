@@ -0,0 +1,42 @@
+// Copyright 2026 Broadcom, Inc.
+// SPDX-License-Identifier: Apache-2.0
+package annotations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+func TestTTLFromAnnotations(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   map[string]string
+		ttlKey  string
+		want    endpoint.TTL
+		wantErr bool
+	}{
+		{"nil-input", nil, "ttl", 0, false},
+		{"empty-key", map[string]string{"ttl": "60"}, "", 0, false},
+		{"missing-key", map[string]string{"other": "60"}, "ttl", 0, false},
+		{"seconds", map[string]string{"ttl": "60"}, "ttl", 60, false},
+		{"duration", map[string]string{"ttl": "5m"}, "ttl", 300, false},
+		{"not-a-number", map[string]string{"ttl": "abc"}, "ttl", 0, true},
+		{"zero", map[string]string{"ttl": "0"}, "ttl", 0, true},
+		{"negative", map[string]string{"ttl": "-1"}, "ttl", 0, true},
+		{"too-large", map[string]string{"ttl": "4294967296"}, "ttl", 0, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := TTLFromAnnotations(tc.input, tc.ttlKey)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Reconciler wraps a reconciler to trace each Reconcile in a span named "<name>.Reconcile".
+// The context passed to the wrapped reconciler carries the span.
+type Reconciler struct {
+	name       string
+	reconciler reconcile.Reconciler
+}
+
+// NewReconciler returns r wrapped in a Reconciler with the span name prefix name,
+// which is usually the kind of the reconciled resource.
+func NewReconciler(name string, r reconcile.Reconciler) *Reconciler {
+	return &Reconciler{name: name, reconciler: r}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := Start(ctx, r.name+".Reconcile",
+		attribute.String("k8s.namespace", req.Namespace),
+		attribute.String("k8s.name", req.Name),
+	)
+	result, err := r.reconciler.Reconcile(ctx, req)
+	span.SetAttributes(
+		attribute.Bool("reconcile.requeue", result.Requeue || result.RequeueAfter > 0),
+		attribute.String("reconcile.requeue_after", result.RequeueAfter.String()),
+	)
+	EndSpan(span, err)
+	return result, err
+}
@@ -0,0 +1,105 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+// Package tracing sets up the OpenTelemetry traces of nsx-operator. The spans cover
+// the controller reconciles, the SubnetSet lock waits, the H-API batches of
+// PolicyTreeBuilder, the realized state polling and the NSX API requests sent by
+// nsx.Transport.
+//
+// The reconcile context is passed down to the services, which send the NSX API requests
+// with the SDK clients of nsx.Client.WithContext(ctx). These clients set the span of ctx
+// in the request context, and nsx.Transport starts the NSX API span as a child of it. The
+// trace context of the NSX API span is then propagated to NSX in the request headers.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+)
+
+const (
+	tracerName  = "github.com/vmware-tanzu/nsx-operator"
+	serviceName = "nsx-operator"
+
+	protocolHTTP = "http"
+)
+
+var log = logger.Log
+
+// Tracer returns the tracer of nsx-operator, its spans are not recorded unless Init enabled tracing.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// Start starts a span with the attributes, it is a shortcut of Tracer().Start.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err, if any, in the span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Init sets up the global tracer provider exporting the spans to the OTLP collector in
+// the tracing section of cf and the W3C trace context propagator. It returns the
+// function flushing and stopping the exporter, which does nothing if tracing is disabled.
+func Init(ctx context.Context, cf *config.NSXOperatorConfig) (func(context.Context) error, error) {
+	if cf.TracingConfig == nil || !cf.EnableTracing {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := newExporter(ctx, cf.TracingConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	attrs := []attribute.KeyValue{attribute.String("service.name", serviceName)}
+	if cf.CoeConfig != nil && cf.Cluster != "" {
+		attrs = append(attrs, attribute.String("nsx.cluster", cf.Cluster))
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attrs...))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cf.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	log.Info("Tracing enabled", "endpoint", cf.OTLPEndpoint, "protocol", cf.OTLPProtocol, "sampleRatio", cf.SampleRatio)
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, cf *config.TracingConfig) (*otlptrace.Exporter, error) {
+	if cf.OTLPProtocol == protocolHTTP {
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cf.OTLPEndpoint)}
+		if cf.OTLPInsecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cf.OTLPEndpoint)}
+	if cf.OTLPInsecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	return otlptracegrpc.New(ctx, opts...)
+}
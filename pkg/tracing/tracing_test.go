@@ -0,0 +1,84 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+)
+
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestReconciler(t *testing.T) {
+	recorder := newRecorder(t)
+	var spanInReconcile trace.SpanContext
+	r := NewReconciler("SubnetSet", reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+		spanInReconcile = trace.SpanContextFromContext(ctx)
+		if req.Name == "fail" {
+			return ctrl.Result{}, errors.New("failed")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}))
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "subnetset1"}})
+	require.NoError(t, err)
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "fail"}})
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "SubnetSet.Reconcile", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("k8s.namespace", "ns1"))
+	assert.Contains(t, spans[0].Attributes(), attribute.String("k8s.name", "subnetset1"))
+	assert.Contains(t, spans[0].Attributes(), attribute.Bool("reconcile.requeue", true))
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spanInReconcile.SpanID())
+}
+
+func TestInit(t *testing.T) {
+	cf := config.NewNSXOpertorConfig()
+	shutdown, err := Init(context.Background(), cf)
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	for _, protocol := range []string{"", protocolHTTP} {
+		cf.EnableTracing = true
+		cf.OTLPEndpoint = "127.0.0.1:4317"
+		cf.OTLPProtocol = protocol
+		cf.OTLPInsecure = true
+		shutdown, err = Init(context.Background(), cf)
+		require.NoError(t, err)
+		_, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+		assert.True(t, ok)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_ = shutdown(ctx)
+		cancel()
+	}
+}